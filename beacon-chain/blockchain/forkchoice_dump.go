@@ -0,0 +1,42 @@
+package blockchain
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+)
+
+// dumpForkChoiceOnReorg writes a JSON snapshot of the fork choice tree to the dump directory when a
+// reorg is deeper than the configured depth, so that operators can investigate it afterwards.
+// The snapshot is named after the slot of the new head and the time of the reorg.
+func (s *Service) dumpForkChoiceOnReorg(depth, newHeadSlot uint64) error {
+	if s.forkChoiceDumpDepth == 0 || depth <= s.forkChoiceDumpDepth {
+		return nil
+	}
+	store, ok := s.forkChoiceStore.(*protoarray.ForkChoice)
+	if !ok || store == nil {
+		return nil
+	}
+	enc, err := store.Store().Export().JSON()
+	if err != nil {
+		return errors.Wrap(err, "could not encode fork choice tree")
+	}
+	if err := fileutil.MkdirAll(s.forkChoiceDumpDir); err != nil {
+		return errors.Wrap(err, "could not create fork choice dump directory")
+	}
+	name := fmt.Sprintf("forkchoice-slot-%d-%d.json", newHeadSlot, time.Now().Unix())
+	path := filepath.Join(s.forkChoiceDumpDir, name)
+	if err := fileutil.WriteFile(path, enc); err != nil {
+		return errors.Wrap(err, "could not write fork choice dump")
+	}
+	log.WithFields(logrus.Fields{
+		"depth": depth,
+		"path":  path,
+	}).Info("Dumped fork choice tree after deep reorg")
+	return nil
+}
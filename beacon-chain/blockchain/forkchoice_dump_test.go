@@ -0,0 +1,46 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_DumpForkChoiceOnReorg(t *testing.T) {
+	ctx := context.Background()
+	store := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	require.NoError(t, store.ProcessBlock(ctx, 0, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, store.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	dir := filepath.Join(t.TempDir(), "dumps")
+	s := &Service{forkChoiceStore: store, forkChoiceDumpDepth: 2, forkChoiceDumpDir: dir}
+
+	// Reorgs up to the configured depth are not dumped.
+	require.NoError(t, s.dumpForkChoiceOnReorg(1, 1))
+	require.NoError(t, s.dumpForkChoiceOnReorg(2, 1))
+	_, err := ioutil.ReadDir(dir)
+	assert.NotNil(t, err, "Dump directory should not exist")
+
+	require.NoError(t, s.dumpForkChoiceOnReorg(3, 1))
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	enc, err := ioutil.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	tree := &protoarray.ExportedTree{}
+	require.NoError(t, json.Unmarshal(enc, tree))
+	assert.DeepEqual(t, store.Store().Export(), tree)
+
+	// Dumps are disabled with a zero depth.
+	s.forkChoiceDumpDepth = 0
+	require.NoError(t, s.dumpForkChoiceOnReorg(10, 2))
+	files, err = ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+}
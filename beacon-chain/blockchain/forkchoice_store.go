@@ -0,0 +1,83 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// saveForkChoiceStore persists the proto array nodes, votes and balances of the fork choice store, so that
+// non finalized branches and latest votes survive a restart.
+func (s *Service) saveForkChoiceStore(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveForkChoiceStore")
+	defer span.End()
+
+	store, ok := s.forkChoiceStore.(*protoarray.ForkChoice)
+	if !ok || store == nil {
+		return nil
+	}
+	return s.beaconDB.SaveForkChoiceStore(ctx, store.ToProto())
+}
+
+// persistForkChoiceStoreRoutine saves the fork choice store once every epoch until the service stops.
+func (s *Service) persistForkChoiceStoreRoutine() {
+	interval := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.saveForkChoiceStore(s.ctx); err != nil {
+				log.WithError(err).Error("Could not save fork choice store")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// restoreForkChoiceStore loads the fork choice store persisted before the last shutdown. The store is only
+// used if it agrees with the justified and finalized checkpoints of the DB and every one of its blocks is
+// in the DB, otherwise an error is returned and the caller rebuilds the store from the checkpoints.
+func (s *Service) restoreForkChoiceStore(
+	ctx context.Context,
+	justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint,
+) (*protoarray.ForkChoice, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.restoreForkChoiceStore")
+	defer span.End()
+
+	pb, err := s.beaconDB.ForkChoiceStore(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve fork choice store")
+	}
+	if pb == nil {
+		return nil, errors.New("no fork choice store in db")
+	}
+	if pb.JustifiedEpoch != justifiedCheckpoint.Epoch || pb.FinalizedEpoch != finalizedCheckpoint.Epoch ||
+		!bytes.Equal(pb.FinalizedRoot, finalizedCheckpoint.Root) {
+		return nil, errors.Errorf("fork choice store checkpoints at justified epoch %d and finalized epoch %d "+
+			"do not match the db", pb.JustifiedEpoch, pb.FinalizedEpoch)
+	}
+	store, err := protoarray.InitializeFromProto(pb)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize fork choice store")
+	}
+	justifiedRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(justifiedCheckpoint.Root))
+	if !store.HasNode(justifiedRoot) {
+		return nil, errors.Errorf("fork choice store is missing justified block %#x", justifiedRoot)
+	}
+	for _, n := range pb.Nodes {
+		if !s.beaconDB.HasBlock(ctx, bytesutil.ToBytes32(n.Root)) {
+			return nil, errors.Errorf("fork choice store block %#x is not in db", n.Root)
+		}
+	}
+	return store, nil
+}
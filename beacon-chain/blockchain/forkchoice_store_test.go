@@ -0,0 +1,82 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_SaveRestoreForkChoiceStore(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	blk.Block.ParentRoot = genesisRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+
+	store := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	require.NoError(t, store.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, store.ProcessBlock(ctx, 1, root, genesisRoot, [32]byte{}, 0, 0))
+	store.ProcessAttestation(ctx, []uint64{0, 1}, root, 0)
+	_, err = store.Head(ctx, 0, genesisRoot, []uint64{1, 1}, 0)
+	require.NoError(t, err)
+
+	serviceCtx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:             serviceCtx,
+		cancel:          cancel,
+		beaconDB:        db,
+		genesisRoot:     genesisRoot,
+		forkChoiceStore: store,
+		initSyncBlocks:  make(map[[32]byte]*ethpb.SignedBeaconBlock),
+		stateGen:        stategen.New(db, cache.NewStateSummaryCache()),
+	}
+	require.NoError(t, s.Stop())
+
+	checkpoint := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	s.ctx = ctx
+	s.forkChoiceStore = nil
+	assert.Equal(t, true, s.resumeForkChoice(checkpoint, checkpoint))
+	restored, ok := s.forkChoiceStore.(*protoarray.ForkChoice)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, store.ToProto(), restored.ToProto())
+	r, err := restored.Head(ctx, 0, genesisRoot, []uint64{1, 1}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, root, r)
+
+	// A store which doesn't match the checkpoints of the DB is not restored.
+	_, err = s.restoreForkChoiceStore(ctx, &ethpb.Checkpoint{Epoch: 1, Root: genesisRoot[:]}, checkpoint)
+	assert.ErrorContains(t, "do not match the db", err)
+	assert.Equal(t, false, s.resumeForkChoice(&ethpb.Checkpoint{Epoch: 1, Root: genesisRoot[:]}, checkpoint))
+	assert.Equal(t, 0, len(s.forkChoiceStore.(*protoarray.ForkChoice).Nodes()))
+
+	// A store with a block missing from the DB is not restored.
+	require.NoError(t, store.ProcessBlock(ctx, 2, [32]byte{'a'}, root, [32]byte{}, 0, 0))
+	require.NoError(t, db.SaveForkChoiceStore(ctx, store.ToProto()))
+	_, err = s.restoreForkChoiceStore(ctx, checkpoint, checkpoint)
+	assert.ErrorContains(t, "is not in db", err)
+}
+
+func TestService_RestoreForkChoiceStore_NoStore(t *testing.T) {
+	db, _ := testDB.SetupDB(t)
+	s := &Service{beaconDB: db}
+	checkpoint := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	_, err := s.restoreForkChoiceStore(context.Background(), checkpoint, checkpoint)
+	assert.ErrorContains(t, "no fork choice store in db", err)
+}
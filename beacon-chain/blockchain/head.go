@@ -117,16 +117,45 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 
 	// A chain re-org occurred, so we fire an event notifying the rest of the services.
 	headSlot := s.HeadSlot()
-	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != bytesutil.ToBytes32(r) {
+	oldHeadRoot := bytesutil.ToBytes32(r)
+	reorged, depth := false, uint64(0)
+	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != oldHeadRoot {
+		reorged, depth, err = s.reorgDepth(ctx, oldHeadRoot, headRoot, headSlot)
+		if err != nil {
+			// The old head may not be an ancestor of the new head, so the change is reported as a reorg.
+			log.WithError(err).Debug("Could not determine reorg depth")
+			reorged = true
+		}
+	}
+	if reorged {
+		oldHeadBlock, err := s.HeadBlock(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve old head block")
+		}
+		var oldStateRoot [32]byte
+		if oldHeadBlock != nil && oldHeadBlock.Block != nil {
+			oldStateRoot = bytesutil.ToBytes32(oldHeadBlock.Block.StateRoot)
+		}
 		log.WithFields(logrus.Fields{
 			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
 			"oldSlot": fmt.Sprintf("%d", headSlot),
+			"depth":   depth,
+			"newRoot": fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+			"oldRoot": fmt.Sprintf("%#x", bytesutil.Trunc(oldHeadRoot[:])),
 		}).Debug("Chain reorg occurred")
+		if err := s.dumpForkChoiceOnReorg(depth, newHeadBlock.Block.Slot); err != nil {
+			log.WithError(err).Error("Could not dump fork choice tree")
+		}
 		s.stateNotifier.StateFeed().Send(&feed.Event{
 			Type: statefeed.Reorg,
 			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: headSlot,
+				NewSlot:      newHeadBlock.Block.Slot,
+				OldSlot:      headSlot,
+				Depth:        depth,
+				OldHeadBlock: oldHeadRoot,
+				NewHeadBlock: headRoot,
+				OldHeadState: oldStateRoot,
+				NewHeadState: bytesutil.ToBytes32(newHeadBlock.Block.StateRoot),
 			},
 		})
 
@@ -141,9 +170,36 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		return errors.Wrap(err, "could not save head root in DB")
 	}
 
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{
+			Slot:            newHeadBlock.Block.Slot,
+			BlockRoot:       headRoot,
+			StateRoot:       bytesutil.ToBytes32(newHeadBlock.Block.StateRoot),
+			EpochTransition: helpers.SlotToEpoch(newHeadBlock.Block.Slot) > helpers.SlotToEpoch(headSlot),
+		},
+	})
+
 	return nil
 }
 
+// This returns whether the new head reorged the old head out of the canonical chain, which is
+// not the case when the old head is the common ancestor of the old and new head as tracked by
+// fork choice, and the number of slots between the old head and the common ancestor.
+func (s *Service) reorgDepth(ctx context.Context, oldHeadRoot, newHeadRoot [32]byte, oldHeadSlot uint64) (bool, uint64, error) {
+	ancestorRoot, ancestorSlot, err := s.forkChoiceStore.CommonAncestorRoot(ctx, oldHeadRoot, newHeadRoot)
+	if err != nil {
+		return false, 0, err
+	}
+	if ancestorRoot == oldHeadRoot {
+		return false, 0, nil
+	}
+	if ancestorSlot > oldHeadSlot {
+		return true, 0, nil
+	}
+	return true, oldHeadSlot - ancestorSlot, nil
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of initial-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
@@ -6,8 +6,12 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -94,6 +98,100 @@ func TestSaveHead_Different_Reorg(t *testing.T) {
 	require.LogsContain(t, hook, "Chain reorg occurred")
 }
 
+func TestSaveHead_Reorg_NotifiesDepth(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+	eventsChannel := make(chan *feed.Event, 2)
+	sub := service.stateNotifier.StateFeed().Subscribe(eventsChannel)
+	defer sub.Unsubscribe()
+
+	// Old head at slot 3 and new head at slot 4 fork off the block at slot 1.
+	ancestor := [32]byte{'a'}
+	oldHeadBlock := testutil.NewBeaconBlock()
+	oldHeadBlock.Block.Slot = 3
+	oldHeadBlock.Block.StateRoot = bytesutil.PadTo([]byte{'o'}, 32)
+	oldRoot, err := oldHeadBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	oldHeadState := testutil.NewBeaconState()
+	require.NoError(t, oldHeadState.SetSlot(3))
+	service.head = &head{slot: 3, root: oldRoot, block: oldHeadBlock, state: oldHeadState}
+
+	newHeadBlock := testutil.NewBeaconBlock()
+	newHeadBlock.Block.Slot = 4
+	newHeadBlock.Block.ParentRoot = ancestor[:]
+	newHeadBlock.Block.StateRoot = bytesutil.PadTo([]byte{'n'}, 32)
+	newRoot, err := newHeadBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 1, ancestor, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 3, oldRoot, ancestor, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 4, newRoot, ancestor, [32]byte{}, 0, 0))
+
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, newHeadBlock))
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(4))
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 4, Root: newRoot[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, headState, newRoot))
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	require.Equal(t, 2, len(eventsChannel))
+	events := []*feed.Event{<-eventsChannel, <-eventsChannel}
+	require.Equal(t, statefeed.Reorg, int(events[0].Type))
+	reorg, ok := events[0].Data.(*statefeed.ReorgData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(2), reorg.Depth)
+	assert.Equal(t, uint64(3), reorg.OldSlot)
+	assert.Equal(t, uint64(4), reorg.NewSlot)
+	assert.Equal(t, oldRoot, reorg.OldHeadBlock)
+	assert.Equal(t, newRoot, reorg.NewHeadBlock)
+	assert.Equal(t, bytesutil.ToBytes32(oldHeadBlock.Block.StateRoot), reorg.OldHeadState)
+	assert.Equal(t, bytesutil.ToBytes32(newHeadBlock.Block.StateRoot), reorg.NewHeadState)
+
+	require.Equal(t, statefeed.NewHead, int(events[1].Type))
+	newHead, ok := events[1].Data.(*statefeed.NewHeadData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(4), newHead.Slot)
+	assert.Equal(t, newRoot, newHead.BlockRoot)
+	assert.Equal(t, false, newHead.EpochTransition)
+}
+
+func TestSaveHead_MultiBlockAdvance_NoReorg(t *testing.T) {
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+	eventsChannel := make(chan *feed.Event, 2)
+	sub := service.stateNotifier.StateFeed().Subscribe(eventsChannel)
+	defer sub.Unsubscribe()
+
+	// The new head at slot 3 builds on the old head at slot 1 through the block at slot 2.
+	oldRoot := [32]byte{'a'}
+	middleRoot := [32]byte{'b'}
+	service.head = &head{slot: 1, root: oldRoot}
+	newHeadBlock := testutil.NewBeaconBlock()
+	newHeadBlock.Block.Slot = 3
+	newHeadBlock.Block.ParentRoot = middleRoot[:]
+	newRoot, err := newHeadBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 1, oldRoot, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 2, middleRoot, oldRoot, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 3, newRoot, middleRoot, [32]byte{}, 0, 0))
+
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, newHeadBlock))
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(3))
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 3, Root: newRoot[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, headState, newRoot))
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	require.Equal(t, 1, len(eventsChannel))
+	event := <-eventsChannel
+	require.Equal(t, statefeed.NewHead, int(event.Type))
+	require.LogsDoNotContain(t, hook, "Chain reorg occurred")
+}
+
 func TestCacheJustifiedStateBalances_CanCache(t *testing.T) {
 	db, sc := testDB.SetupDB(t)
 	service := setupBeaconChain(t, db, sc)
@@ -99,6 +99,9 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 	if err := s.savePostStateInfo(ctx, blockRoot, signed, postState, false /* reg sync */); err != nil {
 		return err
 	}
+	if featureconfig.Get().EnableProposerBoost {
+		s.forkChoiceStore.BoostProposerRoot(ctx, b.Slot, blockRoot, s.genesisTime)
+	}
 
 	// Update justified check point.
 	if postState.CurrentJustifiedCheckpoint().Epoch > s.justifiedCheckpt.Epoch {
@@ -7,6 +7,8 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
@@ -239,6 +241,23 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 		return errors.Wrap(err, "could not migrate to cold")
 	}
 
+	fBlock, err := s.beaconDB.Block(ctx, fRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve finalized block")
+	}
+	var fStateRoot [32]byte
+	if fBlock != nil && fBlock.Block != nil {
+		fStateRoot = bytesutil.ToBytes32(fBlock.Block.StateRoot)
+	}
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &statefeed.FinalizedCheckpointData{
+			Epoch:     cp.Epoch,
+			BlockRoot: fRoot,
+			StateRoot: fStateRoot,
+		},
+	})
+
 	return nil
 }
 
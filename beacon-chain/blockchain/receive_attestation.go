@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
@@ -115,6 +116,13 @@ func (s *Service) processAttestation(subscribedToStateEvents chan struct{}) {
 			return
 		case <-st.C():
 			ctx := s.ctx
+			// The boost of a block is only given for the slot it was proposed in.
+			if featureconfig.Get().EnableProposerBoost {
+				s.forkChoiceStore.ResetBoostedProposerRoot(ctx)
+				if err := s.updateHead(ctx, s.getJustifiedBalances()); err != nil {
+					log.WithError(err).Warn("Could not update head after resetting proposer boost")
+				}
+			}
 			atts := s.attPool.ForkchoiceAttestations()
 			for _, a := range atts {
 				// Based on the spec, don't process the attestation until the subsequent slot.
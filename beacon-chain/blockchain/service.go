@@ -78,6 +78,8 @@ type Service struct {
 	wsEpoch               uint64
 	wsRoot                []byte
 	wsVerified            bool
+	forkChoiceDumpDepth   uint64
+	forkChoiceDumpDir     string
 }
 
 // Config options for the service.
@@ -97,6 +99,9 @@ type Config struct {
 	StateGen          *stategen.State
 	WspBlockRoot      []byte
 	WspEpoch          uint64
+	// ForkChoiceDumpDepth is the reorg depth above which the fork choice tree is dumped, 0 disables dumps.
+	ForkChoiceDumpDepth uint64
+	ForkChoiceDumpDir   string
 }
 
 // NewService instantiates a new block service instance that will
@@ -124,6 +129,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		justifiedBalances:    make([]uint64, 0),
 		wsEpoch:              cfg.WspEpoch,
 		wsRoot:               cfg.WspBlockRoot,
+		forkChoiceDumpDepth:  cfg.ForkChoiceDumpDepth,
+		forkChoiceDumpDir:    cfg.ForkChoiceDumpDir,
 	}, nil
 }
 
@@ -199,7 +206,7 @@ func (s *Service) Start() {
 		s.bestJustifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
 		s.finalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
 		s.prevFinalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
-		s.resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint)
+		restored := s.resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint)
 
 		ss, err := helpers.StartSlot(s.finalizedCheckpt.Epoch)
 		if err != nil {
@@ -215,6 +222,13 @@ func (s *Service) Start() {
 				log.Fatalf("Could not fill in fork choice store missing blocks: %v", err)
 			}
 		}
+		// A restored fork choice store already holds the latest votes, so the head can be
+		// picked right away instead of waiting for new blocks and attestations.
+		if restored {
+			if err := s.updateHead(s.ctx, s.getJustifiedBalances()); err != nil {
+				log.WithError(err).Warn("Could not update head from restored fork choice store")
+			}
+		}
 
 		if err := s.VerifyWeakSubjectivityRoot(s.ctx); err != nil {
 			// Exit run time if the node failed to verify weak subjectivity checkpoint.
@@ -264,6 +278,7 @@ func (s *Service) Start() {
 	}
 
 	go s.processAttestation(attestationProcessorSubscribed)
+	go s.persistForkChoiceStoreRoutine()
 }
 
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
@@ -348,7 +363,12 @@ func (s *Service) Stop() error {
 	}
 
 	// Save initial sync cached blocks to the DB before stop.
-	return s.beaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
+	if err := s.beaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks()); err != nil {
+		return err
+	}
+
+	// Save fork choice store to the DB before stop, its blocks are all in the DB by now.
+	return s.saveForkChoiceStore(s.ctx)
 }
 
 // Status always returns nil unless there is an error condition that causes
@@ -506,11 +526,20 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	return nil
 }
 
-// This is called when a client starts from non-genesis slot. This passes last justified and finalized
-// information to fork choice service to initializes fork choice store.
-func (s *Service) resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint) {
+// This is called when a client starts from non-genesis slot. This restores the fork choice store saved
+// in the DB, or passes last justified and finalized information to fork choice service to initializes
+// fork choice store if the saved one can't be used. It returns true if the saved store was restored.
+func (s *Service) resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint) bool {
+	restored, err := s.restoreForkChoiceStore(s.ctx, justifiedCheckpoint, finalizedCheckpoint)
+	if err == nil {
+		log.WithField("nodes", len(restored.Nodes())).Info("Restored fork choice store from DB")
+		s.forkChoiceStore = restored
+		return true
+	}
+	log.WithError(err).Warn("Could not restore fork choice store, initializing it from the last checkpoints")
 	store := protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
 	s.forkChoiceStore = store
+	return false
 }
 
 // This returns true if block has been processed before. Two ways to verify the block has been processed:
@@ -33,15 +33,24 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 	if err := s.beaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
 		return err
 	}
-	// A node should have the weak subjectivity block in the DB.
-	if !s.beaconDB.HasBlock(ctx, r) {
-		return fmt.Errorf("node does not have root in DB: %#x", r)
-	}
-
 	startSlot, err := helpers.StartSlot(s.wsEpoch)
 	if err != nil {
 		return err
 	}
+	// A node should have the weak subjectivity block in the DB.
+	if !s.beaconDB.HasBlock(ctx, r) {
+		// A node started from a checkpoint does not have the blocks before its origin block.
+		originSlot, err := s.originSlot(ctx)
+		if err != nil {
+			return err
+		}
+		if startSlot < originSlot {
+			log.Warnf("Weak subjectivity checkpoint in epoch %d predates the checkpoint the node was started from, skipping check", s.wsEpoch)
+			s.wsVerified = true
+			return nil
+		}
+		return fmt.Errorf("node does not have root in DB: %#x", r)
+	}
 	// A node should have the weak subjectivity block corresponds to the correct epoch in the DB.
 	filter := filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(startSlot + params.BeaconConfig().SlotsPerEpoch)
 	roots, err := s.beaconDB.BlockRoots(ctx, filter)
@@ -58,3 +67,23 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 
 	return fmt.Errorf("node does not have root in db corresponding to epoch: %#x %d", r, s.wsEpoch)
 }
+
+// originSlot returns the slot of the block the node was started from with checkpoint sync,
+// or zero when the node synced from genesis.
+func (s *Service) originSlot(ctx context.Context) (uint64, error) {
+	originRoot, err := s.beaconDB.OriginBlockRoot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if originRoot == params.BeaconConfig().ZeroHash {
+		return 0, nil
+	}
+	origin, err := s.beaconDB.Block(ctx, originRoot)
+	if err != nil {
+		return 0, err
+	}
+	if origin == nil || origin.Block == nil {
+		return 0, fmt.Errorf("origin block %#x not found in DB", originRoot)
+	}
+	return origin.Block.Slot, nil
+}
@@ -7,6 +7,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -83,3 +84,33 @@ func TestService_VerifyWeakSubjectivityRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestService_VerifyWeakSubjectivityRoot_BeforeOrigin(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+
+	origin := testutil.NewBeaconBlock()
+	origin.Block.Slot = 96
+	require.NoError(t, db.SaveBlock(ctx, origin))
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, originRoot))
+
+	s := &Service{
+		beaconDB:         db,
+		wsRoot:           []byte{'a'},
+		wsEpoch:          1,
+		finalizedCheckpt: &ethpb.Checkpoint{Epoch: 3},
+	}
+	require.NoError(t, s.VerifyWeakSubjectivityRoot(ctx))
+	assert.Equal(t, true, s.wsVerified)
+
+	// A checkpoint after the origin block must be in the DB.
+	s = &Service{
+		beaconDB:         db,
+		wsRoot:           []byte{'a'},
+		wsEpoch:          3,
+		finalizedCheckpt: &ethpb.Checkpoint{Epoch: 3},
+	}
+	require.ErrorContains(t, "node does not have root in DB", s.VerifyWeakSubjectivityRoot(ctx))
+}
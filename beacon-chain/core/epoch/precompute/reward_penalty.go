@@ -69,35 +69,85 @@ func AttestationsDelta(state *stateTrie.BeaconState, pBal *Balance, vp []*Valida
 }
 
 func attestationDelta(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uint64) (uint64, uint64) {
+	d := attestationDeltaComponents(pBal, v, prevEpoch, finalizedEpoch)
+	return d.Reward(), d.Penalty()
+}
+
+// AttestationDelta stores the components of the rewards and penalties of an individual validator
+// for its attestations of the previous epoch.
+type AttestationDelta struct {
+	// SourceReward is the reward for voting for the correct source.
+	SourceReward uint64
+	// SourcePenalty is the penalty for not voting for the correct source.
+	SourcePenalty uint64
+	// TargetReward is the reward for voting for the correct target.
+	TargetReward uint64
+	// TargetPenalty is the penalty for not voting for the correct target.
+	TargetPenalty uint64
+	// HeadReward is the reward for voting for the correct head.
+	HeadReward uint64
+	// HeadPenalty is the penalty for not voting for the correct head.
+	HeadPenalty uint64
+	// InclusionDelayReward is the reward for the attestation being included, scaled down by the inclusion distance.
+	InclusionDelayReward uint64
+	// InactivityPenalty is the penalty applied while the chain is in an inactivity leak.
+	InactivityPenalty uint64
+}
+
+// Reward returns the sum of the reward components.
+func (d *AttestationDelta) Reward() uint64 {
+	return d.SourceReward + d.TargetReward + d.HeadReward + d.InclusionDelayReward
+}
+
+// Penalty returns the sum of the penalty components.
+func (d *AttestationDelta) Penalty() uint64 {
+	return d.SourcePenalty + d.TargetPenalty + d.HeadPenalty + d.InactivityPenalty
+}
+
+// AttestationsDeltaComponents computes and returns the components of the rewards and penalties for individual
+// validators based on the voting records. The components of each validator add up to the deltas returned by
+// AttestationsDelta.
+func AttestationsDeltaComponents(state *stateTrie.BeaconState, pBal *Balance, vp []*Validator) ([]*AttestationDelta, error) {
+	deltas := make([]*AttestationDelta, len(vp))
+	prevEpoch := helpers.PrevEpoch(state)
+	finalizedEpoch := state.FinalizedCheckpointEpoch()
+
+	for i, v := range vp {
+		deltas[i] = attestationDeltaComponents(pBal, v, prevEpoch, finalizedEpoch)
+	}
+	return deltas, nil
+}
+
+func attestationDeltaComponents(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uint64) *AttestationDelta {
+	d := &AttestationDelta{}
 	eligible := v.IsActivePrevEpoch || (v.IsSlashed && !v.IsWithdrawableCurrentEpoch)
 	if !eligible || pBal.ActiveCurrentEpoch == 0 {
-		return 0, 0
+		return d
 	}
 
 	baseRewardsPerEpoch := params.BeaconConfig().BaseRewardsPerEpoch
 	effectiveBalanceIncrement := params.BeaconConfig().EffectiveBalanceIncrement
 	vb := v.CurrentEpochEffectiveBalance
 	br := vb * params.BeaconConfig().BaseRewardFactor / mathutil.IntegerSquareRoot(pBal.ActiveCurrentEpoch) / baseRewardsPerEpoch
-	r, p := uint64(0), uint64(0)
 	currentEpochBalance := pBal.ActiveCurrentEpoch / effectiveBalanceIncrement
 
 	// Process source reward / penalty
 	if v.IsPrevEpochAttester && !v.IsSlashed {
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
 		maxAttesterReward := br - proposerReward
-		r += maxAttesterReward / v.InclusionDistance
+		d.InclusionDelayReward = maxAttesterReward / v.InclusionDistance
 
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.SourceReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.SourceReward = rewardNumerator / currentEpochBalance
 
 		}
 	} else {
-		p += br
+		d.SourcePenalty = br
 	}
 
 	// Process target reward / penalty
@@ -105,13 +155,13 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uin
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.TargetReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochTargetAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.TargetReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.TargetPenalty = br
 	}
 
 	// Process head reward / penalty
@@ -119,13 +169,13 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uin
 		if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.HeadReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochHeadAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.HeadReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.HeadPenalty = br
 	}
 
 	// Process finality delay penalty
@@ -134,15 +184,15 @@ func attestationDelta(pBal *Balance, v *Validator, prevEpoch, finalizedEpoch uin
 	if isInInactivityLeak(prevEpoch, finalizedEpoch) {
 		// If validator is performing optimally, this cancels all rewards for a neutral balance.
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
-		p += baseRewardsPerEpoch*br - proposerReward
+		d.InactivityPenalty = baseRewardsPerEpoch*br - proposerReward
 		// Apply an additional penalty to validators that did not vote on the correct target or has been slashed.
 		// Equivalent to the following condition from the spec:
 		// `index not in get_unslashed_attesting_indices(state, matching_target_attestations)`
 		if !v.IsPrevEpochTargetAttester || v.IsSlashed {
-			p += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
+			d.InactivityPenalty += vb * finalityDelay / params.BeaconConfig().InactivityPenaltyQuotient
 		}
 	}
-	return r, p
+	return d
 }
 
 // ProposersDelta computes and returns the rewards and penalties differences for individual validators based on the
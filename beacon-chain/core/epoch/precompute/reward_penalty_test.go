@@ -254,6 +254,81 @@ func TestProcessRewardsAndPenaltiesPrecompute_SlashedInactivePenalty(t *testing.
 	}
 }
 
+func TestAttestationsDeltaComponents(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := uint64(2048)
+	var emptyRoot [32]byte
+	// The chain is in an inactivity leak at slot e * 10.
+	for _, slot := range []uint64{e + 2, e * 10} {
+		prevEpoch := slot/e - 1
+		atts := make([]*pb.PendingAttestation, 3)
+		for i := 0; i < len(atts); i++ {
+			atts[i] = &pb.PendingAttestation{
+				Data: &ethpb.AttestationData{
+					Slot:            prevEpoch * e,
+					Target:          &ethpb.Checkpoint{Epoch: prevEpoch, Root: emptyRoot[:]},
+					Source:          &ethpb.Checkpoint{Root: emptyRoot[:]},
+					BeaconBlockRoot: emptyRoot[:],
+				},
+				AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+				InclusionDelay:  2,
+			}
+		}
+		base := buildState(slot, validatorCount)
+		base.PreviousEpochAttestations = atts
+		state, err := state.InitializeFromProto(base)
+		require.NoError(t, err)
+		vs := state.Validators()
+		vs[1413].Slashed = true
+		require.NoError(t, state.SetValidators(vs))
+
+		vp, bp, err := New(context.Background(), state)
+		require.NoError(t, err)
+		vp, bp, err = ProcessAttestations(context.Background(), state, vp, bp)
+		require.NoError(t, err)
+		rewards, penalties, err := AttestationsDelta(state, bp, vp)
+		require.NoError(t, err)
+		deltas, err := AttestationsDeltaComponents(state, bp, vp)
+		require.NoError(t, err)
+		require.Equal(t, len(rewards), len(deltas))
+		for i, d := range deltas {
+			assert.Equal(t, rewards[i], d.Reward(), "Unexpected reward for validator with index %d", i)
+			assert.Equal(t, penalties[i], d.Penalty(), "Unexpected penalty for validator with index %d", i)
+		}
+
+		leak := slot == e*10
+		attester, absent := -1, -1
+		for i, v := range vp {
+			if v.IsPrevEpochAttester && !v.IsSlashed && attester == -1 {
+				attester = i
+			}
+			if !v.IsPrevEpochAttester && absent == -1 {
+				absent = i
+			}
+		}
+		require.NotEqual(t, -1, attester)
+		require.NotEqual(t, -1, absent)
+		br, err := epoch.BaseReward(state, uint64(attester))
+		require.NoError(t, err)
+		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
+		assert.Equal(t, (br-proposerReward)/2, deltas[attester].InclusionDelayReward)
+		assert.Equal(t, uint64(0), deltas[attester].SourcePenalty)
+		assert.Equal(t, leak, deltas[attester].InactivityPenalty > 0)
+		if leak {
+			// Optimal participation is compensated with the full base reward during a leak.
+			assert.Equal(t, br, deltas[attester].SourceReward)
+		}
+
+		// Slashed and absent validators are penalized for each vote.
+		for _, i := range []int{1413, absent} {
+			assert.Equal(t, br, deltas[i].SourcePenalty)
+			assert.Equal(t, br, deltas[i].TargetPenalty)
+			assert.Equal(t, br, deltas[i].HeadPenalty)
+			assert.Equal(t, uint64(0), deltas[i].Reward())
+		}
+	}
+}
+
 func buildState(slot, validatorCount uint64) *pb.BeaconState {
 	validators := make([]*ethpb.Validator, validatorCount)
 	for i := 0; i < len(validators); i++ {
@@ -17,6 +17,10 @@ const (
 	// Reorg is an event sent when the new head state's slot after a block
 	// transition is lower than its previous head state slot value.
 	Reorg
+	// NewHead is sent when the head of the canonical chain changes.
+	NewHead
+	// FinalizedCheckpoint is sent when the node finalizes a new checkpoint.
+	FinalizedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -55,4 +59,37 @@ type ReorgData struct {
 	NewSlot uint64
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot uint64
+	// Depth is the number of slots between the old head and the common
+	// ancestor of the old and new head.
+	Depth uint64
+	// OldHeadBlock is the root of the head block before the reorg.
+	OldHeadBlock [32]byte
+	// NewHeadBlock is the root of the head block after the reorg.
+	NewHeadBlock [32]byte
+	// OldHeadState is the root of the head state before the reorg.
+	OldHeadState [32]byte
+	// NewHeadState is the root of the head state after the reorg.
+	NewHeadState [32]byte
+}
+
+// NewHeadData is the data sent with NewHead events.
+type NewHeadData struct {
+	// Slot is the slot of the new head block.
+	Slot uint64
+	// BlockRoot is the root of the new head block.
+	BlockRoot [32]byte
+	// StateRoot is the root of the new head state.
+	StateRoot [32]byte
+	// EpochTransition is true if the new head is in a later epoch than the previous head.
+	EpochTransition bool
+}
+
+// FinalizedCheckpointData is the data sent with FinalizedCheckpoint events.
+type FinalizedCheckpointData struct {
+	// Epoch is the epoch of the finalized checkpoint.
+	Epoch uint64
+	// BlockRoot is the root of the finalized checkpoint block.
+	BlockRoot [32]byte
+	// StateRoot is the state root of the finalized checkpoint block.
+	StateRoot [32]byte
 }
@@ -2,6 +2,7 @@ package db
 
 import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -28,5 +29,21 @@ var DatabaseCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "migrate",
+			Description: `migrates the database to the storage engine given with --db-engine, ` +
+				`verifying that the migrated database holds exactly the same data`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				featureconfig.DatabaseEngineFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := migrate(cliCtx); err != nil {
+					logrus.Fatalf("Could not migrate database: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -0,0 +1,141 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+const boltAllocSize = 8 * 1024 * 1024
+
+var _ Engine = (*boltEngine)(nil)
+
+// boltEngine is the BoltDB storage engine.
+type boltEngine struct {
+	db *bolt.DB
+}
+
+// OpenBolt opens the BoltDB database file at the given path.
+func OpenBolt(path string) (Engine, error) {
+	db, err := bolt.Open(path, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, InitialMmapSize: 10e6})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, ErrDatabaseLocked
+		}
+		return nil, err
+	}
+	db.AllocSize = boltAllocSize
+	return &boltEngine{db: db}, nil
+}
+
+// Kind of the storage engine.
+func (e *boltEngine) Kind() Kind {
+	return Bolt
+}
+
+// Path of the database file.
+func (e *boltEngine) Path() string {
+	return e.db.Path()
+}
+
+// View runs the function in a read-only bolt transaction.
+func (e *boltEngine) View(fn func(tx Tx) error) error {
+	return e.db.View(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx: tx})
+	})
+}
+
+// Update runs the function in a read-write bolt transaction.
+func (e *boltEngine) Update(fn func(tx Tx) error) error {
+	return e.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx: tx})
+	})
+}
+
+// Collector returns a prometheus collector specifically configured for boltdb.
+func (e *boltEngine) Collector() prometheus.Collector {
+	return prombolt.New("boltDB", e.db)
+}
+
+// Close the bolt database.
+func (e *boltEngine) Close() error {
+	return e.db.Close()
+}
+
+// boltTx and boltBucket only hold a pointer, so wrapping the bolt types in the engine
+// interfaces does not allocate.
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t boltTx) Bucket(name []byte) Bucket {
+	b := t.tx.Bucket(name)
+	if b == nil {
+		return nil
+	}
+	return boltBucket{b: b}
+}
+
+func (t boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, boltError(err)
+	}
+	return boltBucket{b: b}, nil
+}
+
+func (t boltTx) DeleteBucket(name []byte) error {
+	return boltError(t.tx.DeleteBucket(name))
+}
+
+func (t boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return fn(name, boltBucket{b: b})
+	})
+}
+
+type boltBucket struct {
+	b *bolt.Bucket
+}
+
+func (b boltBucket) Get(key []byte) []byte {
+	return b.b.Get(key)
+}
+
+func (b boltBucket) Put(key []byte, value []byte) error {
+	return boltError(b.b.Put(key, value))
+}
+
+func (b boltBucket) Delete(key []byte) error {
+	return boltError(b.b.Delete(key))
+}
+
+func (b boltBucket) Cursor() Cursor {
+	return b.b.Cursor()
+}
+
+func (b boltBucket) ForEach(fn func(k, v []byte) error) error {
+	return b.b.ForEach(fn)
+}
+
+// boltError translates the bolt errors to their engine counterparts.
+func boltError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, bolt.ErrTxNotWritable):
+		return ErrTxNotWritable
+	case errors.Is(err, bolt.ErrBucketNotFound):
+		return ErrBucketNotFound
+	case errors.Is(err, bolt.ErrBucketNameRequired):
+		return ErrBucketNameRequired
+	case errors.Is(err, bolt.ErrKeyRequired):
+		return ErrKeyRequired
+	default:
+		return err
+	}
+}
@@ -0,0 +1,92 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// copyBatchSize is the number of keys written per read-write transaction of the destination
+// engine when copying a database, to bound the memory used by large buckets.
+const copyBatchSize = 10000
+
+// Copy copies every bucket of the source engine into the destination engine, overwriting the
+// keys the destination already has.
+func Copy(ctx context.Context, src, dst Engine) error {
+	return src.View(func(srcTx Tx) error {
+		return srcTx.ForEach(func(name []byte, srcBkt Bucket) error {
+			if err := dst.Update(func(dstTx Tx) error {
+				_, err := dstTx.CreateBucketIfNotExists(name)
+				return err
+			}); err != nil {
+				return errors.Wrapf(err, "could not create bucket %s", name)
+			}
+			c := srcBkt.Cursor()
+			k, v := c.First()
+			for k != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if err := dst.Update(func(dstTx Tx) error {
+					dstBkt := dstTx.Bucket(name)
+					for i := 0; i < copyBatchSize && k != nil; i++ {
+						if err := dstBkt.Put(k, v); err != nil {
+							return err
+						}
+						k, v = c.Next()
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrapf(err, "could not copy bucket %s", name)
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// Verify checks that both engines hold exactly the same buckets and key-value pairs.
+func Verify(ctx context.Context, src, dst Engine) error {
+	return src.View(func(srcTx Tx) error {
+		return dst.View(func(dstTx Tx) error {
+			if err := srcTx.ForEach(func(name []byte, srcBkt Bucket) error {
+				dstBkt := dstTx.Bucket(name)
+				if dstBkt == nil {
+					return errors.Errorf("bucket %s is missing", name)
+				}
+				return verifyBucket(ctx, name, srcBkt, dstBkt)
+			}); err != nil {
+				return err
+			}
+			return dstTx.ForEach(func(name []byte, _ Bucket) error {
+				if srcTx.Bucket(name) == nil {
+					return errors.Errorf("bucket %s is unexpected", name)
+				}
+				return nil
+			})
+		})
+	})
+}
+
+func verifyBucket(ctx context.Context, name []byte, srcBkt, dstBkt Bucket) error {
+	srcCursor, dstCursor := srcBkt.Cursor(), dstBkt.Cursor()
+	srcKey, srcValue := srcCursor.First()
+	dstKey, dstValue := dstCursor.First()
+	for srcKey != nil || dstKey != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		switch {
+		case dstKey == nil || (srcKey != nil && bytes.Compare(srcKey, dstKey) < 0):
+			return errors.Errorf("key %#x of bucket %s is missing", srcKey, name)
+		case srcKey == nil || bytes.Compare(srcKey, dstKey) > 0:
+			return errors.Errorf("key %#x of bucket %s is unexpected", dstKey, name)
+		case !bytes.Equal(srcValue, dstValue):
+			return errors.Errorf("value of key %#x of bucket %s differs", srcKey, name)
+		}
+		srcKey, srcValue = srcCursor.Next()
+		dstKey, dstValue = dstCursor.Next()
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestCopyAndVerify(t *testing.T) {
+	ctx := context.Background()
+	src := setupEngine(t, Bolt)
+	dst := setupEngine(t, LevelDB)
+
+	// More keys than fit in a single batch.
+	numKeys := copyBatchSize + 10
+	require.NoError(t, src.Update(func(tx Tx) error {
+		blocks, err := tx.CreateBucketIfNotExists([]byte("blocks"))
+		require.NoError(t, err)
+		for i := 0; i < numKeys; i++ {
+			require.NoError(t, blocks.Put([]byte(fmt.Sprintf("%08d", i)), []byte(fmt.Sprintf("block %d", i))))
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte("empty"))
+		return err
+	}))
+
+	require.NoError(t, Copy(ctx, src, dst))
+	require.NoError(t, Verify(ctx, src, dst))
+	require.NoError(t, dst.View(func(tx Tx) error {
+		assert.NotNil(t, tx.Bucket([]byte("empty")))
+		assert.DeepEqual(t, []byte("block 42"), tx.Bucket([]byte("blocks")).Get([]byte("00000042")))
+		return nil
+	}))
+
+	// Any difference fails the verification.
+	require.NoError(t, dst.Update(func(tx Tx) error {
+		return tx.Bucket([]byte("blocks")).Put([]byte("00000042"), []byte("other block"))
+	}))
+	assert.ErrorContains(t, "value of key 0x3030303030303432 of bucket blocks differs", Verify(ctx, src, dst))
+	require.NoError(t, dst.Update(func(tx Tx) error {
+		return tx.Bucket([]byte("blocks")).Delete([]byte("00000042"))
+	}))
+	assert.ErrorContains(t, "key 0x3030303030303432 of bucket blocks is missing", Verify(ctx, src, dst))
+	require.NoError(t, Copy(ctx, src, dst))
+	require.NoError(t, Verify(ctx, src, dst))
+	require.NoError(t, dst.Update(func(tx Tx) error {
+		return tx.Bucket([]byte("blocks")).Put([]byte("extra"), []byte("block"))
+	}))
+	assert.ErrorContains(t, "key 0x6578747261 of bucket blocks is unexpected", Verify(ctx, src, dst))
+	require.NoError(t, dst.Update(func(tx Tx) error {
+		if err := tx.Bucket([]byte("blocks")).Delete([]byte("extra")); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists([]byte("extra"))
+		return err
+	}))
+	assert.ErrorContains(t, "bucket extra is unexpected", Verify(ctx, src, dst))
+	require.NoError(t, dst.Update(func(tx Tx) error {
+		return tx.DeleteBucket([]byte("empty"))
+	}))
+	assert.ErrorContains(t, "bucket empty is missing", Verify(ctx, src, dst))
+}
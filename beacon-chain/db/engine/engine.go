@@ -0,0 +1,118 @@
+// Package engine defines the storage engines the beacon node database can be persisted with.
+// An engine exposes buckets of sorted key-value pairs through read-only and read-write
+// transactions, following the semantics of BoltDB, which was the only engine of the database
+// before this abstraction was introduced.
+package engine
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Kind identifies a storage engine.
+type Kind string
+
+const (
+	// Bolt is the BoltDB storage engine, storing the whole database in a single memory mapped file.
+	Bolt Kind = "bolt"
+	// LevelDB is the LevelDB storage engine, storing the database in a log-structured merge tree
+	// which is compacted in the background.
+	LevelDB Kind = "leveldb"
+)
+
+// Kinds lists the supported storage engines.
+var Kinds = []Kind{Bolt, LevelDB}
+
+var (
+	// ErrDatabaseLocked is returned when the database is already opened by another process.
+	ErrDatabaseLocked = errors.New("cannot obtain database lock, database may be in use by another process")
+	// ErrTxNotWritable is returned when writing in a read-only transaction.
+	ErrTxNotWritable = errors.New("tx not writable")
+	// ErrBucketNotFound is returned when deleting a bucket which does not exist.
+	ErrBucketNotFound = errors.New("bucket not found")
+	// ErrBucketNameRequired is returned when creating a bucket with an empty name.
+	ErrBucketNameRequired = errors.New("bucket name required")
+	// ErrKeyRequired is returned when writing a value with an empty key.
+	ErrKeyRequired = errors.New("key required")
+)
+
+// Engine is a key-value store organized in buckets.
+type Engine interface {
+	// Kind of the storage engine.
+	Kind() Kind
+	// Path of the file or directory the engine persists the database in.
+	Path() string
+	// View runs the function in a read-only transaction.
+	View(fn func(tx Tx) error) error
+	// Update runs the function in a read-write transaction, which is committed if the function
+	// returns no error and rolled back otherwise.
+	Update(fn func(tx Tx) error) error
+	// Collector returns a prometheus collector of the engine metrics.
+	Collector() prometheus.Collector
+	// Close the engine, releasing all of its resources.
+	Close() error
+}
+
+// Tx is a transaction of an engine. A transaction and the buckets, cursors and values obtained
+// from it must not be used once the transaction is done.
+type Tx interface {
+	// Bucket returns the bucket with the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	// CreateBucketIfNotExists creates the bucket with the given name if it does not exist yet.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	// DeleteBucket deletes the bucket with the given name along with all of its keys.
+	DeleteBucket(name []byte) error
+	// ForEach calls the function for every bucket, in the byte order of their names.
+	ForEach(fn func(name []byte, b Bucket) error) error
+}
+
+// Bucket is a collection of key-value pairs sorted by key.
+type Bucket interface {
+	// Get returns the value of the key, or nil if the key does not exist.
+	Get(key []byte) []byte
+	// Put sets the value of the key.
+	Put(key []byte, value []byte) error
+	// Delete removes the key from the bucket, which is a no-op if the key does not exist.
+	Delete(key []byte) error
+	// Cursor returns a cursor to iterate over the bucket.
+	Cursor() Cursor
+	// ForEach calls the function for every key-value pair of the bucket, in the byte order of the keys.
+	ForEach(fn func(k, v []byte) error) error
+}
+
+// Cursor iterates over the key-value pairs of a bucket in the byte order of the keys. All of its
+// methods return a nil key once the cursor is past either end of the bucket.
+type Cursor interface {
+	// First moves the cursor to the first key of the bucket.
+	First() (key []byte, value []byte)
+	// Last moves the cursor to the last key of the bucket.
+	Last() (key []byte, value []byte)
+	// Next moves the cursor to the next key.
+	Next() (key []byte, value []byte)
+	// Prev moves the cursor to the previous key.
+	Prev() (key []byte, value []byte)
+	// Seek moves the cursor to the first key greater than or equal to the given key.
+	Seek(seek []byte) (key []byte, value []byte)
+}
+
+// ParseKind returns the engine kind with the given name.
+func ParseKind(name string) (Kind, error) {
+	for _, kind := range Kinds {
+		if string(kind) == name {
+			return kind, nil
+		}
+	}
+	return "", errors.Errorf("unknown database engine %q, expected one of %v", name, Kinds)
+}
+
+// Open opens the engine of the given kind at the given path, creating the database if it does not exist.
+func Open(kind Kind, path string) (Engine, error) {
+	switch kind {
+	case Bolt:
+		return OpenBolt(path)
+	case LevelDB:
+		return OpenLevelDB(path)
+	default:
+		return nil, errors.Errorf("unknown database engine %q", kind)
+	}
+}
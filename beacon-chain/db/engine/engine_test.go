@@ -0,0 +1,265 @@
+package engine
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupEngine(t *testing.T, kind Kind) Engine {
+	path := filepath.Join(t.TempDir(), "db")
+	e, err := Open(kind, path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, e.Close())
+	})
+	return e
+}
+
+func collect(c Cursor) []string {
+	var keys []string
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		keys = append(keys, string(k))
+	}
+	return keys
+}
+
+func TestParseKind(t *testing.T) {
+	kind, err := ParseKind("leveldb")
+	require.NoError(t, err)
+	assert.Equal(t, LevelDB, kind)
+	_, err = ParseKind("rocksdb")
+	assert.ErrorContains(t, "unknown database engine", err)
+}
+
+func TestEngine_Buckets(t *testing.T) {
+	for _, kind := range Kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			e := setupEngine(t, kind)
+			assert.Equal(t, kind, e.Kind())
+			require.NoError(t, e.Update(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket([]byte("a")))
+				for _, name := range []string{"b", "a", "ab"} {
+					if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+						return err
+					}
+				}
+				_, err := tx.CreateBucketIfNotExists(nil)
+				assert.Equal(t, ErrBucketNameRequired, err)
+				return nil
+			}))
+			require.NoError(t, e.View(func(tx Tx) error {
+				var names []string
+				require.NoError(t, tx.ForEach(func(name []byte, b Bucket) error {
+					names = append(names, string(name))
+					return nil
+				}))
+				assert.DeepEqual(t, []string{"a", "ab", "b"}, names)
+				_, err := tx.CreateBucketIfNotExists([]byte("c"))
+				assert.Equal(t, ErrTxNotWritable, err)
+				return nil
+			}))
+
+			// The keys of a bucket are not mixed with the keys of a bucket with a longer name.
+			require.NoError(t, e.Update(func(tx Tx) error {
+				require.NoError(t, tx.Bucket([]byte("a")).Put([]byte("bc"), []byte("1")))
+				require.NoError(t, tx.Bucket([]byte("ab")).Put([]byte("c"), []byte("2")))
+				return nil
+			}))
+			require.NoError(t, e.View(func(tx Tx) error {
+				assert.DeepEqual(t, []string{"bc"}, collect(tx.Bucket([]byte("a")).Cursor()))
+				assert.DeepEqual(t, []string{"c"}, collect(tx.Bucket([]byte("ab")).Cursor()))
+				return nil
+			}))
+
+			require.NoError(t, e.Update(func(tx Tx) error {
+				require.NoError(t, tx.DeleteBucket([]byte("a")))
+				assert.Equal(t, ErrBucketNotFound, tx.DeleteBucket([]byte("a")))
+				assert.Equal(t, nil, tx.Bucket([]byte("a")))
+				return nil
+			}))
+			require.NoError(t, e.Update(func(tx Tx) error {
+				b, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				assert.Equal(t, 0, len(collect(b.Cursor())))
+				return nil
+			}))
+		})
+	}
+}
+
+func TestEngine_GetPutDelete(t *testing.T) {
+	for _, kind := range Kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			e := setupEngine(t, kind)
+			name := []byte("bucket")
+			require.NoError(t, e.Update(func(tx Tx) error {
+				b, err := tx.CreateBucketIfNotExists(name)
+				require.NoError(t, err)
+				require.NoError(t, b.Put([]byte("k1"), []byte("v1")))
+				require.NoError(t, b.Put([]byte("k2"), []byte{}))
+				assert.Equal(t, ErrKeyRequired, b.Put(nil, []byte("v")))
+				// Writes are visible within the transaction.
+				assert.DeepEqual(t, []byte("v1"), b.Get([]byte("k1")))
+				return nil
+			}))
+			require.NoError(t, e.View(func(tx Tx) error {
+				b := tx.Bucket(name)
+				assert.DeepEqual(t, []byte("v1"), b.Get([]byte("k1")))
+				assert.NotNil(t, b.Get([]byte("k2")))
+				assert.Equal(t, 0, len(b.Get([]byte("k2"))))
+				assert.Equal(t, true, b.Get([]byte("k3")) == nil)
+				assert.Equal(t, ErrTxNotWritable, b.Put([]byte("k3"), []byte("v3")))
+				assert.Equal(t, ErrTxNotWritable, b.Delete([]byte("k1")))
+				return nil
+			}))
+
+			// A failed transaction is rolled back.
+			errRollback := errors.New("rollback")
+			err := e.Update(func(tx Tx) error {
+				b := tx.Bucket(name)
+				require.NoError(t, b.Put([]byte("k1"), []byte("v2")))
+				require.NoError(t, b.Delete([]byte("k2")))
+				return errRollback
+			})
+			assert.Equal(t, errRollback, err)
+			require.NoError(t, e.View(func(tx Tx) error {
+				b := tx.Bucket(name)
+				assert.DeepEqual(t, []byte("v1"), b.Get([]byte("k1")))
+				assert.NotNil(t, b.Get([]byte("k2")))
+				return nil
+			}))
+
+			require.NoError(t, e.Update(func(tx Tx) error {
+				b := tx.Bucket(name)
+				require.NoError(t, b.Delete([]byte("k2")))
+				require.NoError(t, b.Delete([]byte("missing")))
+				assert.Equal(t, true, b.Get([]byte("k2")) == nil)
+				return nil
+			}))
+			require.NoError(t, e.View(func(tx Tx) error {
+				assert.Equal(t, true, tx.Bucket(name).Get([]byte("k2")) == nil)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestEngine_Cursor(t *testing.T) {
+	for _, kind := range Kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			e := setupEngine(t, kind)
+			name := []byte("bucket")
+			require.NoError(t, e.Update(func(tx Tx) error {
+				b, err := tx.CreateBucketIfNotExists(name)
+				require.NoError(t, err)
+				for _, k := range []string{"b", "d", "f", "h"} {
+					require.NoError(t, b.Put([]byte(k), []byte("v"+k)))
+				}
+				return nil
+			}))
+
+			check := func(t *testing.T, b Bucket, expected []string) {
+				assert.DeepEqual(t, expected, collect(b.Cursor()))
+
+				c := b.Cursor()
+				var reversed []string
+				for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+					reversed = append(reversed, string(k))
+				}
+				for i := range expected {
+					assert.Equal(t, expected[len(expected)-1-i], reversed[i])
+				}
+
+				var forEach []string
+				require.NoError(t, b.ForEach(func(k, v []byte) error {
+					assert.Equal(t, "v"+string(k), string(v))
+					forEach = append(forEach, string(k))
+					return nil
+				}))
+				assert.DeepEqual(t, expected, forEach)
+			}
+
+			require.NoError(t, e.View(func(tx Tx) error {
+				b := tx.Bucket(name)
+				check(t, b, []string{"b", "d", "f", "h"})
+				c := b.Cursor()
+				k, v := c.Seek([]byte("c"))
+				assert.Equal(t, "d", string(k))
+				assert.Equal(t, "vd", string(v))
+				k, _ = c.Seek([]byte("f"))
+				assert.Equal(t, "f", string(k))
+				k, _ = c.Prev()
+				assert.Equal(t, "d", string(k))
+				k, _ = c.Seek([]byte("i"))
+				assert.Equal(t, true, k == nil)
+				return nil
+			}))
+
+			// Within a read-write transaction, cursors see the pending writes.
+			require.NoError(t, e.Update(func(tx Tx) error {
+				b := tx.Bucket(name)
+				require.NoError(t, b.Put([]byte("a"), []byte("va")))
+				require.NoError(t, b.Put([]byte("e"), []byte("ve")))
+				require.NoError(t, b.Delete([]byte("f")))
+				require.NoError(t, b.Delete([]byte("h")))
+				require.NoError(t, b.Put([]byte("d"), []byte("vd")))
+				check(t, b, []string{"a", "b", "d", "e"})
+				c := b.Cursor()
+				k, _ := c.Seek([]byte("f"))
+				assert.Equal(t, true, k == nil)
+				k, _ = c.Seek([]byte("c"))
+				assert.Equal(t, "d", string(k))
+				k, _ = c.Next()
+				assert.Equal(t, "e", string(k))
+				k, _ = c.Prev()
+				assert.Equal(t, "d", string(k))
+
+				// Deleting while iterating.
+				for k, _ := c.First(); k != nil; k, _ = c.Next() {
+					if string(k) == "b" || string(k) == "e" {
+						require.NoError(t, b.Delete(k))
+					}
+				}
+				check(t, b, []string{"a", "d"})
+				return nil
+			}))
+			require.NoError(t, e.View(func(tx Tx) error {
+				check(t, tx.Bucket(name), []string{"a", "d"})
+				return nil
+			}))
+		})
+	}
+}
+
+func TestEngine_Reopen(t *testing.T) {
+	for _, kind := range Kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "db")
+			e, err := Open(kind, path)
+			require.NoError(t, err)
+			assert.Equal(t, path, e.Path())
+			require.NoError(t, e.Update(func(tx Tx) error {
+				b, err := tx.CreateBucketIfNotExists([]byte("bucket"))
+				if err != nil {
+					return err
+				}
+				return b.Put([]byte("k"), []byte("v"))
+			}))
+			require.NoError(t, e.Close())
+
+			e, err = Open(kind, path)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, e.Close())
+			}()
+			require.NoError(t, e.View(func(tx Tx) error {
+				assert.DeepEqual(t, []byte("v"), tx.Bucket([]byte("bucket")).Get([]byte("k")))
+				return nil
+			}))
+		})
+	}
+}
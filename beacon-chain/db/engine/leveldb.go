@@ -0,0 +1,512 @@
+package engine
+
+import (
+	"bytes"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// LevelDB has no notion of buckets, they are emulated with key prefixes. The names of the
+// buckets are registered under the bucket names prefix, while the keys of a bucket are
+// stored under the data prefix followed by the length and the name of their bucket, so that
+// the keys of a bucket are contiguous and sorted like in a bolt bucket.
+var (
+	levelDBBucketNamesPrefix = []byte{0}
+	levelDBDataPrefix        = []byte{1}
+)
+
+// The pending writes of a read-write transaction are kept in memory, their values being
+// prefixed with a marker telling apart the written keys from the deleted ones.
+const (
+	levelDBDeletedMarker byte = 0
+	levelDBWrittenMarker byte = 1
+)
+
+const (
+	levelDBBlockCacheCapacity = 64 * opt.MiB
+	levelDBWriteBuffer        = 32 * opt.MiB
+	levelDBOpenFilesCacheSize = 512
+	levelDBBloomFilterBits    = 10
+	maxLevelDBBucketNameSize  = 255
+)
+
+var _ Engine = (*levelDBEngine)(nil)
+
+// levelDBEngine is the LevelDB storage engine.
+type levelDBEngine struct {
+	db   *leveldb.DB
+	path string
+	// writeLock serializes the read-write transactions, like bolt does.
+	writeLock sync.Mutex
+}
+
+// OpenLevelDB opens the LevelDB database in the directory at the given path.
+func OpenLevelDB(path string) (Engine, error) {
+	db, err := leveldb.OpenFile(path, &opt.Options{
+		BlockCacheCapacity:     levelDBBlockCacheCapacity,
+		WriteBuffer:            levelDBWriteBuffer,
+		OpenFilesCacheCapacity: levelDBOpenFilesCacheSize,
+		Filter:                 filter.NewBloomFilter(levelDBBloomFilterBits),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &levelDBEngine{db: db, path: path}, nil
+}
+
+// Kind of the storage engine.
+func (e *levelDBEngine) Kind() Kind {
+	return LevelDB
+}
+
+// Path of the database directory.
+func (e *levelDBEngine) Path() string {
+	return e.path
+}
+
+// View runs the function on a snapshot of the database.
+func (e *levelDBEngine) View(fn func(tx Tx) error) error {
+	snapshot, err := e.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+	tx := &levelDBTx{snapshot: snapshot}
+	defer tx.release()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.err
+}
+
+// Update runs the function on a snapshot of the database, on top of which the writes of the
+// transaction are kept in memory. The writes are then applied atomically in a single batch.
+// LevelDB transactions are not used, as each of them writes a table to disk.
+func (e *levelDBEngine) Update(fn func(tx Tx) error) error {
+	e.writeLock.Lock()
+	defer e.writeLock.Unlock()
+	snapshot, err := e.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+	tx := &levelDBTx{snapshot: snapshot, writes: memdb.New(comparer.DefaultComparer, 0)}
+	defer tx.release()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if tx.err != nil {
+		return tx.err
+	}
+	if tx.writes.Len() == 0 {
+		return nil
+	}
+	batch := new(leveldb.Batch)
+	it := tx.writes.NewIterator(nil)
+	defer it.Release()
+	for it.Next() {
+		if it.Value()[0] == levelDBDeletedMarker {
+			batch.Delete(it.Key())
+		} else {
+			batch.Put(it.Key(), it.Value()[1:])
+		}
+	}
+	return e.db.Write(batch, nil)
+}
+
+// Collector returns a prometheus collector of the LevelDB statistics.
+func (e *levelDBEngine) Collector() prometheus.Collector {
+	return &levelDBCollector{db: e.db}
+}
+
+// Close the LevelDB database. Closing a closed database is a no-op, like with bolt.
+func (e *levelDBEngine) Close() error {
+	if err := e.db.Close(); err != nil && !errors.Is(err, leveldb.ErrClosed) {
+		return err
+	}
+	return nil
+}
+
+type levelDBTx struct {
+	snapshot *leveldb.Snapshot
+	// writes is nil in read-only transactions.
+	writes    *memdb.DB
+	iterators []iterator.Iterator
+	// err records the first read error, as reads do not return errors in the engine interface.
+	err error
+}
+
+func (t *levelDBTx) Bucket(name []byte) Bucket {
+	if _, ok := t.get(levelDBBucketNameKey(name)); !ok {
+		return nil
+	}
+	return &levelDBBucket{tx: t, prefix: levelDBBucketPrefix(name)}
+}
+
+func (t *levelDBTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if len(name) == 0 {
+		return nil, ErrBucketNameRequired
+	}
+	if len(name) > maxLevelDBBucketNameSize {
+		return nil, errors.Errorf("bucket name is longer than %d bytes", maxLevelDBBucketNameSize)
+	}
+	if err := t.put(levelDBBucketNameKey(name), []byte{}); err != nil {
+		return nil, err
+	}
+	return &levelDBBucket{tx: t, prefix: levelDBBucketPrefix(name)}, nil
+}
+
+func (t *levelDBTx) DeleteBucket(name []byte) error {
+	if t.writes == nil {
+		return ErrTxNotWritable
+	}
+	if t.Bucket(name) == nil {
+		return ErrBucketNotFound
+	}
+	c := t.cursor(levelDBBucketPrefix(name))
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if err := t.delete(c.key); err != nil {
+			return err
+		}
+	}
+	return t.delete(levelDBBucketNameKey(name))
+}
+
+func (t *levelDBTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	c := t.cursor(levelDBBucketNamesPrefix)
+	for name, _ := c.First(); name != nil; name, _ = c.Next() {
+		if err := fn(name, &levelDBBucket{tx: t, prefix: levelDBBucketPrefix(name)}); err != nil {
+			return err
+		}
+	}
+	return t.err
+}
+
+// get returns the value of the raw key, looking up the pending writes of the transaction first.
+func (t *levelDBTx) get(key []byte) ([]byte, bool) {
+	if t.writes != nil {
+		if value, err := t.writes.Get(key); err == nil {
+			if value[0] == levelDBDeletedMarker {
+				return nil, false
+			}
+			return copyBytes(value[1:]), true
+		}
+	}
+	value, err := t.snapshot.Get(key, nil)
+	if err != nil {
+		if !errors.Is(err, leveldb.ErrNotFound) {
+			t.setErr(err)
+		}
+		return nil, false
+	}
+	return value, true
+}
+
+func (t *levelDBTx) put(key, value []byte) error {
+	if t.writes == nil {
+		return ErrTxNotWritable
+	}
+	marked := make([]byte, 1+len(value))
+	marked[0] = levelDBWrittenMarker
+	copy(marked[1:], value)
+	return t.writes.Put(key, marked)
+}
+
+func (t *levelDBTx) delete(key []byte) error {
+	if t.writes == nil {
+		return ErrTxNotWritable
+	}
+	return t.writes.Put(key, []byte{levelDBDeletedMarker})
+}
+
+// cursor returns a cursor over the raw keys with the given prefix.
+func (t *levelDBTx) cursor(prefix []byte) *levelDBCursor {
+	c := &levelDBCursor{tx: t, prefix: prefix}
+	c.snapshotIt = t.snapshot.NewIterator(util.BytesPrefix(prefix), nil)
+	t.iterators = append(t.iterators, c.snapshotIt)
+	if t.writes != nil {
+		c.writesIt = t.writes.NewIterator(util.BytesPrefix(prefix))
+		t.iterators = append(t.iterators, c.writesIt)
+	}
+	return c
+}
+
+func (t *levelDBTx) setErr(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+func (t *levelDBTx) release() {
+	for _, it := range t.iterators {
+		it.Release()
+	}
+}
+
+type levelDBBucket struct {
+	tx     *levelDBTx
+	prefix []byte
+}
+
+func (b *levelDBBucket) Get(key []byte) []byte {
+	value, _ := b.tx.get(b.key(key))
+	return value
+}
+
+func (b *levelDBBucket) Put(key []byte, value []byte) error {
+	if len(key) == 0 {
+		return ErrKeyRequired
+	}
+	return b.tx.put(b.key(key), value)
+}
+
+func (b *levelDBBucket) Delete(key []byte) error {
+	return b.tx.delete(b.key(key))
+}
+
+func (b *levelDBBucket) Cursor() Cursor {
+	return b.tx.cursor(b.prefix)
+}
+
+func (b *levelDBBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.tx.cursor(b.prefix)
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return b.tx.err
+}
+
+func (b *levelDBBucket) key(key []byte) []byte {
+	k := make([]byte, len(b.prefix)+len(key))
+	copy(k, b.prefix)
+	copy(k[len(b.prefix):], key)
+	return k
+}
+
+// levelDBCursor iterates over the raw keys with a prefix. In read-only transactions, it
+// simply moves an iterator of the snapshot. In read-write transactions, it merges the keys
+// of the snapshot with the pending writes of the transaction, which may change while
+// iterating, so both iterators are positioned again from the current key at every move.
+type levelDBCursor struct {
+	tx         *levelDBTx
+	prefix     []byte
+	snapshotIt iterator.Iterator
+	writesIt   iterator.Iterator
+	// key is the raw key the cursor is at, nil once past either end.
+	key []byte
+}
+
+func (c *levelDBCursor) First() ([]byte, []byte) {
+	if c.writesIt == nil {
+		return c.item(c.snapshotIt.First())
+	}
+	return c.forward(c.snapshotIt.First(), c.writesIt.First())
+}
+
+func (c *levelDBCursor) Last() ([]byte, []byte) {
+	if c.writesIt == nil {
+		return c.item(c.snapshotIt.Last())
+	}
+	return c.backward(c.snapshotIt.Last(), c.writesIt.Last())
+}
+
+func (c *levelDBCursor) Next() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	if c.writesIt == nil {
+		return c.item(c.snapshotIt.Next())
+	}
+	after := append(append([]byte{}, c.key...), 0)
+	return c.forward(c.snapshotIt.Seek(after), c.writesIt.Seek(after))
+}
+
+func (c *levelDBCursor) Prev() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	if c.writesIt == nil {
+		return c.item(c.snapshotIt.Prev())
+	}
+	return c.backward(seekBefore(c.snapshotIt, c.key), seekBefore(c.writesIt, c.key))
+}
+
+func (c *levelDBCursor) Seek(seek []byte) ([]byte, []byte) {
+	key := append(append([]byte{}, c.prefix...), seek...)
+	if c.writesIt == nil {
+		return c.item(c.snapshotIt.Seek(key))
+	}
+	return c.forward(c.snapshotIt.Seek(key), c.writesIt.Seek(key))
+}
+
+// forward moves to the smallest key of both iterators, the pending writes shadowing the
+// snapshot, skipping the deleted keys.
+func (c *levelDBCursor) forward(snapshotOk, writesOk bool) ([]byte, []byte) {
+	for {
+		if !writesOk {
+			return c.item(snapshotOk)
+		}
+		if snapshotOk && bytes.Compare(c.snapshotIt.Key(), c.writesIt.Key()) < 0 {
+			return c.item(true)
+		}
+		if c.writesIt.Value()[0] == levelDBWrittenMarker {
+			return c.pendingItem()
+		}
+		after := append(append([]byte{}, c.writesIt.Key()...), 0)
+		snapshotOk, writesOk = c.snapshotIt.Seek(after), c.writesIt.Seek(after)
+	}
+}
+
+// backward moves to the greatest key of both iterators, the pending writes shadowing the
+// snapshot, skipping the deleted keys.
+func (c *levelDBCursor) backward(snapshotOk, writesOk bool) ([]byte, []byte) {
+	for {
+		if !writesOk {
+			return c.item(snapshotOk)
+		}
+		if snapshotOk && bytes.Compare(c.snapshotIt.Key(), c.writesIt.Key()) > 0 {
+			return c.item(true)
+		}
+		if c.writesIt.Value()[0] == levelDBWrittenMarker {
+			return c.pendingItem()
+		}
+		before := append([]byte{}, c.writesIt.Key()...)
+		snapshotOk, writesOk = seekBefore(c.snapshotIt, before), seekBefore(c.writesIt, before)
+	}
+}
+
+// item returns copies of the key and value the snapshot iterator is at, as iterators reuse
+// their buffers.
+func (c *levelDBCursor) item(ok bool) ([]byte, []byte) {
+	if !ok {
+		if err := c.snapshotIt.Error(); err != nil {
+			c.tx.setErr(err)
+		}
+		c.key = nil
+		return nil, nil
+	}
+	c.key = copyBytes(c.snapshotIt.Key())
+	return c.key[len(c.prefix):], copyBytes(c.snapshotIt.Value())
+}
+
+// pendingItem returns copies of the key and value the pending writes iterator is at.
+func (c *levelDBCursor) pendingItem() ([]byte, []byte) {
+	c.key = copyBytes(c.writesIt.Key())
+	return c.key[len(c.prefix):], copyBytes(c.writesIt.Value()[1:])
+}
+
+// seekBefore moves the iterator to the greatest key lower than the given key.
+func seekBefore(it iterator.Iterator, key []byte) bool {
+	if it.Seek(key) {
+		return it.Prev()
+	}
+	return it.Last()
+}
+
+func levelDBBucketNameKey(name []byte) []byte {
+	return append(append([]byte{}, levelDBBucketNamesPrefix...), name...)
+}
+
+func levelDBBucketPrefix(name []byte) []byte {
+	prefix := make([]byte, 0, len(levelDBDataPrefix)+1+len(name))
+	prefix = append(prefix, levelDBDataPrefix...)
+	prefix = append(prefix, byte(len(name)))
+	return append(prefix, name...)
+}
+
+// copyBytes returns a copy of the slice which is never nil, so that empty values can be told
+// apart from missing ones.
+func copyBytes(b []byte) []byte {
+	return append([]byte{}, b...)
+}
+
+var (
+	levelDBWriteDelayCountDesc = prometheus.NewDesc(
+		"leveldb_write_delay_count",
+		"The number of writes delayed by compactions.",
+		nil, nil,
+	)
+	levelDBWriteDelaySecondsDesc = prometheus.NewDesc(
+		"leveldb_write_delay_seconds",
+		"The time writes were delayed by compactions.",
+		nil, nil,
+	)
+	levelDBWritePausedDesc = prometheus.NewDesc(
+		"leveldb_write_paused",
+		"1 if writes are paused until a compaction completes.",
+		nil, nil,
+	)
+	levelDBIOReadBytesDesc = prometheus.NewDesc(
+		"leveldb_io_read_bytes",
+		"The number of bytes read from disk.",
+		nil, nil,
+	)
+	levelDBIOWriteBytesDesc = prometheus.NewDesc(
+		"leveldb_io_write_bytes",
+		"The number of bytes written to disk.",
+		nil, nil,
+	)
+	levelDBLevelSizeBytesDesc = prometheus.NewDesc(
+		"leveldb_level_size_bytes",
+		"The size of the tables of each level.",
+		[]string{"level"}, nil,
+	)
+	levelDBCompactionsDesc = prometheus.NewDesc(
+		"leveldb_compactions",
+		"The number of compactions by kind.",
+		[]string{"kind"}, nil,
+	)
+)
+
+// levelDBCollector collects the LevelDB statistics, most notably the write delays caused by
+// compactions.
+type levelDBCollector struct {
+	db *leveldb.DB
+}
+
+// Describe the LevelDB metrics.
+func (c *levelDBCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- levelDBWriteDelayCountDesc
+	ch <- levelDBWriteDelaySecondsDesc
+	ch <- levelDBWritePausedDesc
+	ch <- levelDBIOReadBytesDesc
+	ch <- levelDBIOWriteBytesDesc
+	ch <- levelDBLevelSizeBytesDesc
+	ch <- levelDBCompactionsDesc
+}
+
+// Collect the LevelDB metrics.
+func (c *levelDBCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := &leveldb.DBStats{}
+	if err := c.db.Stats(stats); err != nil {
+		return
+	}
+	paused := 0.0
+	if stats.WritePaused {
+		paused = 1
+	}
+	ch <- prometheus.MustNewConstMetric(levelDBWriteDelayCountDesc, prometheus.CounterValue, float64(stats.WriteDelayCount))
+	ch <- prometheus.MustNewConstMetric(levelDBWriteDelaySecondsDesc, prometheus.CounterValue, stats.WriteDelayDuration.Seconds())
+	ch <- prometheus.MustNewConstMetric(levelDBWritePausedDesc, prometheus.GaugeValue, paused)
+	ch <- prometheus.MustNewConstMetric(levelDBIOReadBytesDesc, prometheus.CounterValue, float64(stats.IORead))
+	ch <- prometheus.MustNewConstMetric(levelDBIOWriteBytesDesc, prometheus.CounterValue, float64(stats.IOWrite))
+	for level, size := range stats.LevelSizes {
+		ch <- prometheus.MustNewConstMetric(levelDBLevelSizeBytesDesc, prometheus.GaugeValue, float64(size), strconv.Itoa(level))
+	}
+	ch <- prometheus.MustNewConstMetric(levelDBCompactionsDesc, prometheus.CounterValue, float64(stats.MemComp), "memory")
+	ch <- prometheus.MustNewConstMetric(levelDBCompactionsDesc, prometheus.CounterValue, float64(stats.Level0Comp), "level0")
+	ch <- prometheus.MustNewConstMetric(levelDBCompactionsDesc, prometheus.CounterValue, float64(stats.NonLevel0Comp), "non_level0")
+	ch <- prometheus.MustNewConstMetric(levelDBCompactionsDesc, prometheus.CounterValue, float64(stats.SeekComp), "seek")
+}
@@ -24,6 +24,8 @@ type ReadOnlyDatabase interface {
 	BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error)
 	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 	GenesisBlock(ctx context.Context) (*eth.SignedBeaconBlock, error)
+	OriginBlockRoot(ctx context.Context) ([32]byte, error)
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error)
 	HighestSlotBlocksBelow(ctx context.Context, slot uint64) ([]*eth.SignedBeaconBlock, error)
@@ -53,6 +55,11 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	// Fork choice operations.
+	ForkChoiceStore(ctx context.Context) (*db.ForkChoiceStore, error)
+	// Archived state diff operations.
+	StateDiff(ctx context.Context, slot uint64) (*db.StateDiff, error)
+	HasStateDiff(ctx context.Context, slot uint64) bool
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -63,6 +70,9 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveOriginBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfilledBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
 	// State related methods.
 	SaveState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error
@@ -82,6 +92,10 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Fork choice operations.
+	SaveForkChoiceStore(ctx context.Context, store *db.ForkChoiceStore) error
+	// Archived state diff operations.
+	SaveStateDiff(ctx context.Context, diff *db.StateDiff) error
 
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
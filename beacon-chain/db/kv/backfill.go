@@ -0,0 +1,127 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// BackfillBlockRoot returns the root of the lowest block of the contiguous chain of blocks
+// leading to the finalized checkpoint, as saved by the backfill service. A zero root is
+// returned when the backfill service has not run yet.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+	var root [32]byte
+	err := s.db.View(func(tx engine.Tx) error {
+		copy(root[:], tx.Bucket(blocksBucket).Get(backfillBlockRootKey))
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlockRoot to the db.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx engine.Tx) error {
+		return tx.Bucket(blocksBucket).Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
+
+// SaveBackfilledBlocks saves a batch of blocks preceding the current backfill block root. The blocks
+// must be sorted by increasing slot and linked by their parent roots, the last one being the parent
+// of the backfill block. The blocks are indexed as finalized when the backfill block is, and the
+// backfill block root moves to the first block of the batch, all in a single transaction.
+func (s *Store) SaveBackfilledBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfilledBlocks")
+	defer span.End()
+
+	if len(blocks) == 0 {
+		return nil
+	}
+	roots := make([][32]byte, len(blocks))
+	encs := make([][]byte, len(blocks))
+	for i, blk := range blocks {
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if i > 0 && !bytes.Equal(blk.Block.ParentRoot, roots[i-1][:]) {
+			return errors.Errorf("block %#x is not a child of block %#x", root, roots[i-1])
+		}
+		enc, err := encode(ctx, blk)
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+		encs[i] = enc
+	}
+
+	err := s.db.Update(func(tx engine.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		backfillRoot := bkt.Get(backfillBlockRootKey)
+		if backfillRoot == nil {
+			return errors.New("no backfill block root in the database")
+		}
+		enc := bkt.Get(backfillRoot)
+		if enc == nil {
+			return errors.Errorf("backfill block %#x not found in the database", backfillRoot)
+		}
+		backfillBlock := &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, enc, backfillBlock); err != nil {
+			return err
+		}
+		last := roots[len(roots)-1]
+		if !bytes.Equal(backfillBlock.Block.ParentRoot, last[:]) {
+			return errors.Errorf("block %#x is not the parent of the backfill block %#x", last, backfillRoot)
+		}
+
+		for i, blk := range blocks {
+			if bkt.Get(roots[i][:]) != nil {
+				continue
+			}
+			indicesByBucket := createBlockIndicesFromBlock(ctx, blk.Block)
+			if err := updateValueForIndices(ctx, indicesByBucket, roots[i][:], tx); err != nil {
+				return errors.Wrap(err, "could not update DB indices")
+			}
+			if err := bkt.Put(roots[i][:], encs[i]); err != nil {
+				return err
+			}
+		}
+
+		// The ancestors of a finalized block are finalized.
+		finalizedBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		if finalizedBkt.Get(backfillRoot) != nil {
+			for i, blk := range blocks {
+				childRoot := backfillRoot
+				if i+1 < len(roots) {
+					childRoot = roots[i+1][:]
+				}
+				container := &dbpb.FinalizedBlockRootContainer{
+					ParentRoot: blk.Block.ParentRoot,
+					ChildRoot:  childRoot,
+				}
+				enc, err := encode(ctx, container)
+				if err != nil {
+					return err
+				}
+				if err := finalizedBkt.Put(roots[i][:], enc); err != nil {
+					return err
+				}
+			}
+		}
+
+		return bkt.Put(backfillBlockRootKey, roots[0][:])
+	})
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+	}
+	return err
+}
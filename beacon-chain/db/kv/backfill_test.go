@@ -0,0 +1,90 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_BackfillBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	root, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root)
+
+	want := bytesutil.ToBytes32([]byte("backfill"))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, want))
+	root, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, root)
+}
+
+func TestStore_SaveBackfilledBlocks(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blks := makeBlocks(t, 0, 10, bytesutil.ToBytes32([]byte("genesis")))
+	roots := make([][32]byte, len(blks))
+	for i, blk := range blks {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = root
+	}
+	// The node started from the block at index 5 and only has its descendants.
+	require.NoError(t, db.SaveBlocks(ctx, blks[5:]))
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, roots[5]))
+	require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), roots[5]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[5][:]}))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, roots[5]))
+
+	require.NoError(t, db.SaveBackfilledBlocks(ctx, blks[2:5]))
+	backfillRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[2], backfillRoot)
+	for i := 2; i < 5; i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]), "Block at index %d was not saved", i)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[i]), "Block at index %d was not considered finalized", i)
+	}
+	retrieved, _, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(blks[2].Block.Slot).SetEndSlot(blks[4].Block.Slot))
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(retrieved))
+
+	require.NoError(t, db.SaveBackfilledBlocks(ctx, blks[:2]))
+	backfillRoot, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], backfillRoot)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[0]))
+}
+
+func TestStore_SaveBackfilledBlocks_Invalid(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blks := makeBlocks(t, 0, 10, bytesutil.ToBytes32([]byte("genesis")))
+	require.NoError(t, db.SaveBlocks(ctx, blks[5:]))
+	originRoot, err := blks[5].Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	err = db.SaveBackfilledBlocks(ctx, blks[2:5])
+	assert.ErrorContains(t, "no backfill block root", err)
+
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, originRoot))
+	err = db.SaveBackfilledBlocks(ctx, []*ethpb.SignedBeaconBlock{blks[2], blks[4]})
+	assert.ErrorContains(t, "is not a child of block", err)
+	err = db.SaveBackfilledBlocks(ctx, blks[1:4])
+	assert.ErrorContains(t, "is not the parent of the backfill block", err)
+
+	root, err := blks[2].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, false, db.HasBlock(ctx, root))
+	backfillRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, originRoot, backfillRoot)
+}
@@ -6,10 +6,9 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -17,6 +16,7 @@ const backupsDirectoryName = "backups"
 
 // Backup the database to the datadir backup directory.
 // Example for backup at slot 345: $DATADIR/backups/prysm_beacondb_at_slot_0000345.backup
+// Backups are bolt database files whatever the storage engine of the database.
 func (s *Store) Backup(ctx context.Context, outputDir string) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Backup")
 	defer span.End()
@@ -45,11 +45,7 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 	backupPath := path.Join(backupsDir, fmt.Sprintf("prysm_beacondb_at_slot_%07d.backup", head.Block.Slot))
 	logrus.WithField("prefix", "db").WithField("backup", backupPath).Info("Writing backup database.")
 
-	copyDB, err := bolt.Open(
-		backupPath,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout},
-	)
+	copyDB, err := engine.OpenBolt(backupPath)
 	if err != nil {
 		return err
 	}
@@ -59,16 +55,5 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 		}
 	}()
 
-	return s.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
-			logrus.Debugf("Copying bucket %s\n", name)
-			return copyDB.Update(func(tx2 *bolt.Tx) error {
-				b2, err := tx2.CreateBucketIfNotExists(name)
-				if err != nil {
-					return err
-				}
-				return b.ForEach(b2.Put)
-			})
-		})
-	})
+	return engine.Copy(ctx, s.db, copyDB)
 }
@@ -164,6 +164,44 @@ func TestStore_IsFinalizedChildBlock(t *testing.T) {
 	}
 }
 
+func TestStore_IsFinalizedBlock_Origin(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	db := setupDB(t)
+	ctx := context.Background()
+
+	// The node starts from an origin block whose ancestors are not in the database.
+	blks := makeBlocks(t, slotsPerEpoch*2, slotsPerEpoch*3, bytesutil.ToBytes32([]byte("unknown parent")))
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	originRoot, err := blks[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginBlockRoot(ctx, originRoot))
+	savedRoot, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, originRoot, savedRoot)
+
+	st := testutil.NewBeaconState()
+	require.NoError(t, db.SaveState(ctx, st, originRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: originRoot[:]}))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, originRoot))
+
+	root, err := blks[slotsPerEpoch*2-1].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 4, Root: root[:]}))
+	for i := uint64(0); i < slotsPerEpoch*2; i++ {
+		root, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at index %d was not considered finalized in the index", i)
+	}
+}
+
+func TestStore_OriginBlockRoot_NotSet(t *testing.T) {
+	db := setupDB(t)
+	root, err := db.OriginBlockRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root)
+}
+
 func sszRootOrDie(t *testing.T, block *ethpb.SignedBeaconBlock) []byte {
 	root, err := block.Block.HashTreeRoot()
 	require.NoError(t, err)
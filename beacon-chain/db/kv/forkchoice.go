@@ -0,0 +1,52 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveForkChoiceStore saves the persisted form of the fork choice store, replacing the previous one.
+func (s *Store) SaveForkChoiceStore(ctx context.Context, store *db.ForkChoiceStore) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceStore")
+	defer span.End()
+
+	if store == nil {
+		err := errors.New("cannot save nil fork choice store")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	enc, err := proto.Marshal(store)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	err = s.db.Update(func(tx engine.Tx) error {
+		return tx.Bucket(forkChoiceBucket).Put(forkChoiceStoreKey, enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// ForkChoiceStore retrieves the persisted form of the fork choice store, nil if it was never saved.
+func (s *Store) ForkChoiceStore(ctx context.Context) (*db.ForkChoiceStore, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceStore")
+	defer span.End()
+
+	var store *db.ForkChoiceStore
+	err := s.db.View(func(tx engine.Tx) error {
+		enc := tx.Bucket(forkChoiceBucket).Get(forkChoiceStoreKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		store = &db.ForkChoiceStore{}
+		return proto.Unmarshal(enc, store)
+	})
+	return store, err
+}
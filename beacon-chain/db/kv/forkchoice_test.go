@@ -0,0 +1,43 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_ForkChoiceStore_CanSaveRetrieve(t *testing.T) {
+	store := setupDB(t)
+	ctx := context.Background()
+
+	retrieved, err := store.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*db.ForkChoiceStore)(nil), retrieved)
+	assert.ErrorContains(t, "cannot save nil fork choice store", store.SaveForkChoiceStore(ctx, nil))
+
+	fc := &db.ForkChoiceStore{
+		JustifiedEpoch: 2,
+		FinalizedEpoch: 1,
+		FinalizedRoot:  make([]byte, 32),
+		Nodes: []*db.ForkChoiceNode{
+			{Slot: 32, Root: []byte{'a'}, Parent: ^uint64(0), Weight: 10, BestChild: 1, BestDescendant: 1},
+			{Slot: 33, Root: []byte{'b'}, Parent: 0, Weight: 10, BestChild: ^uint64(0), BestDescendant: ^uint64(0)},
+		},
+		Votes:    []*db.ForkChoiceVote{{CurrentRoot: []byte{'b'}, NextRoot: []byte{'b'}, NextEpoch: 1}},
+		Balances: []uint64{10},
+	}
+	require.NoError(t, store.SaveForkChoiceStore(ctx, fc))
+	retrieved, err = store.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, fc, retrieved)
+
+	fc.JustifiedEpoch = 3
+	fc.Nodes = fc.Nodes[:1]
+	require.NoError(t, store.SaveForkChoiceStore(ctx, fc))
+	retrieved, err = store.ForkChoiceStore(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, fc, retrieved)
+}
@@ -1,21 +1,20 @@
-// Package kv defines a bolt-db, key-value store implementation
-// of the Database interface defined by a Prysm beacon node.
+// Package kv defines a key-value store implementation of the Database
+// interface defined by a Prysm beacon node, persisted with one of the
+// storage engines of the engine package.
 package kv
 
 import (
 	"os"
 	"path"
-	"time"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
-	prombolt "github.com/prysmaticlabs/prombbolt"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
-	bolt "go.etcd.io/bbolt"
 )
 
 var _ iface.Database = (*Store)(nil)
@@ -27,10 +26,11 @@ const (
 	NumOfVotes = 1 << 20
 	// BeaconNodeDbDirName is the name of the directory containing the beacon node database.
 	BeaconNodeDbDirName = "beaconchaindata"
-	// DatabaseFileName is the name of the beacon node database.
+	// DatabaseFileName is the name of the beacon node database file when using the bolt engine.
 	DatabaseFileName = "beaconchain.db"
-
-	boltAllocSize = 8 * 1024 * 1024
+	// LevelDBDirName is the name of the directory containing the beacon node database when using
+	// the LevelDB engine.
+	LevelDBDirName = "beaconchain.ldb"
 )
 
 // BlockCacheSize specifies 1000 slots worth of blocks cached, which
@@ -38,18 +38,20 @@ const (
 var BlockCacheSize = int64(1 << 21)
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for eth2.
+// using a storage engine as the underlying persistent kv-store for eth2.
 type Store struct {
-	db                  *bolt.DB
+	db                  engine.Engine
 	databasePath        string
 	blockCache          *ristretto.Cache
 	validatorIndexCache *ristretto.Cache
 	stateSummaryCache   *cache.StateSummaryCache
 }
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
+// The storage engine is the one of the existing database in the directory,
+// or the one configured with the feature flags for a new database.
 func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*Store, error) {
 	hasDir, err := fileutil.HasDir(dirPath)
 	if err != nil {
@@ -60,15 +62,14 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			return nil, err
 		}
 	}
-	datafile := path.Join(dirPath, DatabaseFileName)
-	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, InitialMmapSize: 10e6})
+	kind, err := engineKind(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	db, err := engine.Open(kind, EnginePath(dirPath, kind))
 	if err != nil {
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
 		return nil, err
 	}
-	boltDB.AllocSize = boltAllocSize
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,           // number of keys to track frequency of (1000).
 		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
@@ -88,14 +89,14 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 	}
 
 	kv := &Store{
-		db:                  boltDB,
+		db:                  db,
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorIndexCache: validatorCache,
 		stateSummaryCache:   stateSummaryCache,
 	}
 
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if err := kv.db.Update(func(tx engine.Tx) error {
 		return createBuckets(
 			tx,
 			attestationsBucket,
@@ -108,6 +109,8 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			checkpointBucket,
 			powchainBucket,
 			stateSummaryBucket,
+			forkChoiceBucket,
+			stateDiffBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -127,7 +130,7 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 		return nil, err
 	}
 
-	err = prometheus.Register(createBoltCollector(kv.db))
+	err = prometheus.Register(kv.db.Collector())
 
 	return kv, err
 }
@@ -137,16 +140,16 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
-	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
+	prometheus.Unregister(s.db.Collector())
+	if err := os.RemoveAll(s.db.Path()); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying storage engine.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	prometheus.Unregister(s.db.Collector())
 	return s.db.Close()
 }
 
@@ -155,7 +158,75 @@ func (s *Store) DatabasePath() string {
 	return s.databasePath
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+// EnginePath returns the path of the database of the given storage engine in the directory.
+func EnginePath(dirPath string, kind engine.Kind) string {
+	if kind == engine.LevelDB {
+		return path.Join(dirPath, LevelDBDirName)
+	}
+	return path.Join(dirPath, DatabaseFileName)
+}
+
+// ExistingEngines returns the storage engines which have a database in the directory.
+func ExistingEngines(dirPath string) ([]engine.Kind, error) {
+	var kinds []engine.Kind
+	if fileutil.FileExists(EnginePath(dirPath, engine.Bolt)) {
+		kinds = append(kinds, engine.Bolt)
+	}
+	hasDir, err := fileutil.HasDir(EnginePath(dirPath, engine.LevelDB))
+	if err != nil {
+		return nil, err
+	}
+	if hasDir {
+		kinds = append(kinds, engine.LevelDB)
+	}
+	return kinds, nil
+}
+
+// engineKind determines the storage engine of the database in the directory. An existing
+// database keeps its engine until it is migrated to another one, while a new database is
+// created with the configured engine, bolt by default. Once migrated, the directory holds a
+// database of each engine, so the engine must be configured until the source one is removed.
+func engineKind(dirPath string) (engine.Kind, error) {
+	existing, err := ExistingEngines(dirPath)
+	if err != nil {
+		return "", err
+	}
+	if featureconfig.Get().DatabaseEngine == "" {
+		if len(existing) > 1 {
+			return "", errors.Errorf(
+				"databases of the %s and %s engines exist in %s, set --%s to the engine to use or remove the unused database",
+				existing[0],
+				existing[1],
+				dirPath,
+				featureconfig.DatabaseEngineFlag.Name,
+			)
+		}
+		if len(existing) > 0 {
+			return existing[0], nil
+		}
+		return engine.Bolt, nil
+	}
+	kind, err := engine.ParseKind(featureconfig.Get().DatabaseEngine)
+	if err != nil {
+		return "", err
+	}
+	if len(existing) == 0 {
+		return kind, nil
+	}
+	for _, k := range existing {
+		if k == kind {
+			return kind, nil
+		}
+	}
+	return "", errors.Errorf(
+		"the database in %s uses the %s engine, run the db migrate command to migrate it to the %s engine",
+		dirPath,
+		existing[0],
+		kind,
+	)
+}
+
+func createBuckets(tx engine.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
@@ -163,8 +234,3 @@ func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 	}
 	return nil
 }
-
-// createBoltCollector returns a prometheus collector specifically configured for boltdb.
-func createBoltCollector(db *bolt.DB) prometheus.Collector {
-	return prombolt.New("boltDB", db)
-}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -16,3 +19,47 @@ func setupDB(t testing.TB) *Store {
 	})
 	return db
 }
+
+func TestNewKVStore_Engine(t *testing.T) {
+	dirPath := t.TempDir()
+	newStore := func() (*Store, error) {
+		return NewKVStore(dirPath, cache.NewStateSummaryCache())
+	}
+
+	// A new database uses the configured engine.
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{DatabaseEngine: string(engine.LevelDB)})
+	defer resetCfg()
+	db, err := newStore()
+	require.NoError(t, err)
+	assert.Equal(t, engine.LevelDB, db.db.Kind())
+	require.NoError(t, db.Close())
+
+	// An existing database keeps its engine when none is configured.
+	featureconfig.Init(&featureconfig.Flags{})
+	db, err = newStore()
+	require.NoError(t, err)
+	assert.Equal(t, engine.LevelDB, db.db.Kind())
+	require.NoError(t, db.Close())
+
+	// An existing database cannot be opened with another engine.
+	featureconfig.Init(&featureconfig.Flags{DatabaseEngine: string(engine.Bolt)})
+	_, err = newStore()
+	assert.ErrorContains(t, "uses the leveldb engine, run the db migrate command", err)
+
+	featureconfig.Init(&featureconfig.Flags{DatabaseEngine: "rocksdb"})
+	_, err = newStore()
+	assert.ErrorContains(t, "unknown database engine", err)
+
+	// Once migrated, the engine to use must be configured while both databases exist.
+	boltDB, err := engine.Open(engine.Bolt, EnginePath(dirPath, engine.Bolt))
+	require.NoError(t, err)
+	require.NoError(t, boltDB.Close())
+	featureconfig.Init(&featureconfig.Flags{})
+	_, err = newStore()
+	assert.ErrorContains(t, "databases of the bolt and leveldb engines exist", err)
+	featureconfig.Init(&featureconfig.Flags{DatabaseEngine: string(engine.LevelDB)})
+	db, err = newStore()
+	require.NoError(t, err)
+	assert.Equal(t, engine.LevelDB, db.db.Kind())
+	require.NoError(t, db.Close())
+}
@@ -3,12 +3,12 @@ package kv
 import (
 	"context"
 
-	bolt "go.etcd.io/bbolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 )
 
 var migrationCompleted = []byte("done")
 
-type migration func(*bolt.Tx) error
+type migration func(engine.Tx) error
 
 var migrations = []migration{
 	migrateArchivedIndex,
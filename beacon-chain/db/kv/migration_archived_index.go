@@ -5,13 +5,13 @@ import (
 	"context"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	bolt "go.etcd.io/bbolt"
 )
 
 var migrationArchivedIndex0Key = []byte("archive_index_0")
 
-func migrateArchivedIndex(tx *bolt.Tx) error {
+func migrateArchivedIndex(tx engine.Tx) error {
 	mb := tx.Bucket(migrationsBucket)
 	if b := mb.Get(migrationArchivedIndex0Key); bytes.Equal(b, migrationCompleted) {
 		return nil // Migration already completed.
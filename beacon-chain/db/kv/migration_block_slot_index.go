@@ -4,13 +4,13 @@ import (
 	"bytes"
 	"strconv"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	bolt "go.etcd.io/bbolt"
 )
 
 var migrationBlockSlotIndex0Key = []byte("block_slot_index_0")
 
-func migrateBlockSlotIndex(tx *bolt.Tx) error {
+func migrateBlockSlotIndex(tx engine.Tx) error {
 	mb := tx.Bucket(migrationsBucket)
 	if b := mb.Get(migrationBlockSlotIndex0Key); bytes.Equal(b, migrationCompleted) {
 		return nil // Migration already completed.
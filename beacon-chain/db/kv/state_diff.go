@@ -0,0 +1,67 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateDiff saves the diff producing the archived state of an epoch boundary slot, indexed by that slot.
+func (s *Store) SaveStateDiff(ctx context.Context, diff *db.StateDiff) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	if diff == nil {
+		err := errors.New("cannot save nil state diff")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	enc, err := encode(ctx, diff)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	err = s.db.Update(func(tx engine.Tx) error {
+		return tx.Bucket(stateDiffBucket).Put(bytesutil.Uint64ToBytesBigEndian(diff.Slot), enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// StateDiff retrieves the diff producing the archived state of the given slot, nil if there is none.
+func (s *Store) StateDiff(ctx context.Context, slot uint64) (*db.StateDiff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+
+	var diff *db.StateDiff
+	err := s.db.View(func(tx engine.Tx) error {
+		enc := tx.Bucket(stateDiffBucket).Get(bytesutil.Uint64ToBytesBigEndian(slot))
+		if len(enc) == 0 {
+			return nil
+		}
+		diff = &db.StateDiff{}
+		return decode(ctx, enc, diff)
+	})
+	traceutil.AnnotateError(span, err)
+	return diff, err
+}
+
+// HasStateDiff checks if the diff producing the archived state of the given slot exists in the db.
+func (s *Store) HasStateDiff(ctx context.Context, slot uint64) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+
+	var exists bool
+	if err := s.db.View(func(tx engine.Tx) error {
+		exists = tx.Bucket(stateDiffBucket).Get(bytesutil.Uint64ToBytesBigEndian(slot)) != nil
+		return nil
+	}); err != nil { // This view never returns an error, but we'll handle anyway for sanity.
+		panic(err)
+	}
+	return exists
+}
@@ -0,0 +1,37 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_StateDiff_CanSaveRetrieve(t *testing.T) {
+	store := setupDB(t)
+	ctx := context.Background()
+
+	retrieved, err := store.StateDiff(ctx, 64)
+	require.NoError(t, err)
+	assert.Equal(t, (*db.StateDiff)(nil), retrieved)
+	assert.Equal(t, false, store.HasStateDiff(ctx, 64))
+	assert.ErrorContains(t, "cannot save nil state diff", store.SaveStateDiff(ctx, nil))
+
+	diff := &db.StateDiff{
+		Slot:          64,
+		BaseSlot:      32,
+		BlockRoots:    []*db.IndexedBytes{{Index: 1, Value: []byte{'a'}}},
+		Validators:    []*db.IndexedValidator{{Index: 2, Validator: &ethpb.Validator{EffectiveBalance: 10}}},
+		BalanceDeltas: []*db.IndexedInt64{{Index: 0, Value: -1}, {Index: 2, Value: 10}},
+		Slashings:     []*db.IndexedUint64{{Index: 3, Value: 5}},
+	}
+	require.NoError(t, store.SaveStateDiff(ctx, diff))
+	assert.Equal(t, true, store.HasStateDiff(ctx, 64))
+	assert.Equal(t, false, store.HasStateDiff(ctx, 32))
+	retrieved, err = store.StateDiff(ctx, 64)
+	require.NoError(t, err)
+	assert.DeepEqual(t, diff, retrieved)
+}
@@ -3,8 +3,8 @@ package kv
 import (
 	"context"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -21,7 +21,7 @@ func (s *Store) SaveStateSummaries(ctx context.Context, summaries []*pb.StateSum
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateSummaries")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx engine.Tx) error {
 		bucket := tx.Bucket(stateSummaryBucket)
 		for _, summary := range summaries {
 			enc, err := encode(ctx, summary)
@@ -70,7 +70,7 @@ func (s *Store) stateSummaryBytes(ctx context.Context, blockRoot [32]byte) ([]by
 	defer span.End()
 
 	var enc []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx engine.Tx) error {
 		bucket := tx.Bucket(stateSummaryBucket)
 		enc = bucket.Get(blockRoot[:])
 		return nil
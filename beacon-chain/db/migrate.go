@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+func migrate(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	target, err := engine.ParseKind(cliCtx.String(featureconfig.DatabaseEngineFlag.Name))
+	if err != nil {
+		return err
+	}
+	return migrateEngine(cliCtx.Context, path.Join(dataDir, kv.BeaconNodeDbDirName), target)
+}
+
+// migrateEngine copies the database in the directory into a new database of the target storage
+// engine, then verifies that both databases hold exactly the same data. The source database is
+// left untouched, so that it can be removed by the user once the beacon node runs fine with the
+// target engine. Until then, the beacon node must be started with the engine to use.
+func migrateEngine(ctx context.Context, dirPath string, target engine.Kind) error {
+	existing, err := kv.ExistingEngines(dirPath)
+	if err != nil {
+		return err
+	}
+	var source engine.Kind
+	for _, kind := range existing {
+		if kind == target {
+			return errors.Errorf("a %s database already exists at %s", target, kv.EnginePath(dirPath, target))
+		}
+		source = kind
+	}
+	if source == "" {
+		return errors.Errorf("no database to migrate in %s", dirPath)
+	}
+
+	src, err := engine.Open(source, kv.EnginePath(dirPath, source))
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s database", source)
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			logrus.WithError(err).Errorf("Failed to close %s database", source)
+		}
+	}()
+	dst, err := engine.Open(target, kv.EnginePath(dirPath, target))
+	if err != nil {
+		return errors.Wrapf(err, "could not create %s database", target)
+	}
+	migrated := false
+	defer func() {
+		if err := dst.Close(); err != nil {
+			logrus.WithError(err).Errorf("Failed to close %s database", target)
+		}
+		// Do not leave a partial database behind, which would be picked up by the beacon node.
+		if !migrated {
+			if err := os.RemoveAll(dst.Path()); err != nil {
+				logrus.WithError(err).Errorf("Failed to remove partial %s database", target)
+			}
+		}
+	}()
+
+	log := logrus.WithFields(logrus.Fields{
+		"source": src.Path(),
+		"target": dst.Path(),
+	})
+	log.Infof("Copying %s database into %s database, this may take a while", source, target)
+	if err := engine.Copy(ctx, src, dst); err != nil {
+		return errors.Wrap(err, "could not copy database")
+	}
+	log.Info("Verifying migrated database")
+	if err := engine.Verify(ctx, src, dst); err != nil {
+		return errors.Wrap(err, "migrated database does not match the source database")
+	}
+	migrated = true
+	log.Infof(
+		"Migration completed successfully, start the beacon node with --%s=%s. The %s database can be removed afterwards",
+		featureconfig.DatabaseEngineFlag.Name,
+		target,
+		source,
+	)
+	return nil
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func TestMigrate(t *testing.T) {
+	logHook := logTest.NewGlobal()
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	dbPath := path.Join(dataDir, kv.BeaconNodeDbDirName)
+
+	boltDB, err := kv.NewKVStore(dbPath, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	head := testutil.NewBeaconBlock()
+	head.Block.Slot = 5000
+	require.NoError(t, boltDB.SaveBlock(ctx, head))
+	root, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, boltDB.SaveState(ctx, testutil.NewBeaconState(), root))
+	require.NoError(t, boltDB.SaveHeadBlockRoot(ctx, root))
+	require.NoError(t, boltDB.Close())
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, "", "")
+	set.String(featureconfig.DatabaseEngineFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	require.NoError(t, set.Set(featureconfig.DatabaseEngineFlag.Name, string(engine.LevelDB)))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, migrate(cliCtx))
+	require.LogsContain(t, logHook, "Migration completed successfully")
+	kinds, err := kv.ExistingEngines(dbPath)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []engine.Kind{engine.Bolt, engine.LevelDB}, kinds)
+
+	// Migrating again is refused, as the target database exists.
+	assert.ErrorContains(t, "a leveldb database already exists", migrate(cliCtx))
+
+	// The beacon node does not pick one of the databases without a configured engine.
+	_, err = kv.NewKVStore(dbPath, cache.NewStateSummaryCache())
+	assert.ErrorContains(t, "databases of the bolt and leveldb engines exist", err)
+
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{DatabaseEngine: string(engine.LevelDB)})
+	defer resetCfg()
+	levelDB, err := kv.NewKVStore(dbPath, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, levelDB.Close())
+	}()
+	headBlock, err := levelDB.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5000), headBlock.Block.Slot)
+}
+
+func TestMigrate_NoDatabase(t *testing.T) {
+	err := migrateEngine(context.Background(), t.TempDir(), engine.LevelDB)
+	assert.ErrorContains(t, "no database to migrate", err)
+}
@@ -0,0 +1,52 @@
+package export
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// Cursor is the progress of the export, saved to a file so that the export resumes where it
+// stopped after a restart or a crash.
+type Cursor struct {
+	// BlockRoot and BlockSlot are the root and slot of the last exported block, the root is empty
+	// before the first block is exported.
+	BlockRoot string `json:"block_root"`
+	BlockSlot uint64 `json:"block_slot"`
+	// FinalizedEpoch is the epoch of the last exported finalized checkpoint.
+	FinalizedEpoch uint64 `json:"finalized_epoch"`
+	// NextSummaryEpoch is the epoch of the next validator summary to export.
+	NextSummaryEpoch uint64 `json:"next_summary_epoch"`
+}
+
+// loadCursor reads the cursor from path, returning an empty cursor if the file does not exist.
+func loadCursor(path string) (*Cursor, error) {
+	if !fileutil.FileExists(path) {
+		return &Cursor{}, nil
+	}
+	enc, err := fileutil.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read export cursor")
+	}
+	c := &Cursor{}
+	if err := json.Unmarshal(enc, c); err != nil {
+		return nil, errors.Wrap(err, "could not decode export cursor")
+	}
+	return c, nil
+}
+
+// saveCursor writes the cursor to a temporary file renamed to path, so that a crash never leaves a
+// partially written cursor.
+func saveCursor(path string, c *Cursor) error {
+	enc, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := fileutil.WriteFile(tmp, enc); err != nil {
+		return errors.Wrap(err, "could not write export cursor")
+	}
+	return os.Rename(tmp, path)
+}
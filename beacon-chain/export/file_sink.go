@@ -0,0 +1,52 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// FileSink appends the records to a file as newline delimited JSON.
+type FileSink struct {
+	path string
+	file *os.File
+}
+
+// NewFileSink opens the file at path for appending, creating it if needed.
+func NewFileSink(path string) (*FileSink, error) {
+	expanded, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(expanded, os.O_APPEND|os.O_CREATE|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open export file")
+	}
+	return &FileSink{path: expanded, file: f}, nil
+}
+
+// Name of the file sink.
+func (s *FileSink) Name() string {
+	return "file " + s.path
+}
+
+// Write appends the record to the file and syncs it to disk.
+func (s *FileSink) Write(_ context.Context, record *Record) error {
+	enc, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(append(enc, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,71 @@
+// +build kafka_enabled
+
+package export
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	_ "gopkg.in/confluentinc/confluent-kafka-go.v1/kafka/librdkafka" // Required for c++ kafka library.
+)
+
+// KafkaSink publishes the records to the Kafka topic named after their type, keyed by slot.
+type KafkaSink struct {
+	servers  string
+	producer *kafka.Producer
+}
+
+// NewKafkaSink connects a producer to the Kafka bootstrap servers.
+func NewKafkaSink(servers string) (Sink, error) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": servers})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create kafka producer")
+	}
+	return &KafkaSink{servers: servers, producer: p}, nil
+}
+
+// Name of the Kafka sink.
+func (s *KafkaSink) Name() string {
+	return "kafka " + s.servers
+}
+
+// Write publishes the record and waits for its delivery.
+func (s *KafkaSink) Write(ctx context.Context, record *Record) error {
+	enc, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, record.Slot)
+	topic := record.Type
+	delivery := make(chan kafka.Event, 1)
+	if err := s.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: kafka.PartitionAny,
+		},
+		Value: enc,
+		Key:   key,
+	}, delivery); err != nil {
+		return err
+	}
+	select {
+	case e := <-delivery:
+		msg, ok := e.(*kafka.Message)
+		if !ok {
+			return errors.Errorf("unexpected kafka delivery event %v", e)
+		}
+		return msg.TopicPartition.Error
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close the Kafka producer.
+func (s *KafkaSink) Close() error {
+	s.producer.Close()
+	return nil
+}
@@ -0,0 +1,12 @@
+// +build !kafka_enabled
+
+package export
+
+import (
+	"errors"
+)
+
+// NewKafkaSink is not available in builds without the kafka_enabled tag, which links librdkafka.
+func NewKafkaSink(_ string) (Sink, error) {
+	return nil, errors.New("kafka export is not supported by this build, build with the kafka_enabled tag")
+}
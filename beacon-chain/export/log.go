@@ -0,0 +1,7 @@
+package export
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "export")
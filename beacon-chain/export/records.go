@@ -0,0 +1,122 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/golang/protobuf/jsonpb"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+var marshaler = &jsonpb.Marshaler{}
+
+func newRecord(typ string, slot uint64, data interface{}) (*Record, error) {
+	enc, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Record{
+		Type:  typ,
+		Slot:  slot,
+		Epoch: helpers.SlotToEpoch(slot),
+		Data:  enc,
+	}, nil
+}
+
+func blockRecord(root [32]byte, blk *ethpb.SignedBeaconBlock) (*Record, error) {
+	buf := bytes.NewBuffer(nil)
+	if err := marshaler.Marshal(buf, blk); err != nil {
+		return nil, err
+	}
+	return newRecord(BlockRecord, blk.Block.Slot, &BlockData{
+		Root:  encodeRoot(root),
+		Block: buf.Bytes(),
+	})
+}
+
+func finalizedCheckpointRecord(epoch uint64, blk *ethpb.BeaconBlock) (*Record, error) {
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	slot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	record, err := newRecord(FinalizedCheckpointRecord, slot, &FinalizedCheckpointData{
+		BlockRoot: encodeRoot(root),
+		StateRoot: encodeRoot(bytesutil.ToBytes32(blk.StateRoot)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	record.Epoch = epoch
+	return record, nil
+}
+
+// validatorSummary summarizes the epoch from the state after the block of the given root, advanced
+// to the last slot of the following epoch.
+func (s *Service) validatorSummary(ctx context.Context, root [32]byte, epoch uint64) (*Record, error) {
+	st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	nextStart, err := helpers.StartSlot(epoch + 2)
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() < nextStart-1 {
+		st, err = state.ProcessSlots(ctx, st.Copy(), nextStart-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &ValidatorSummaryData{
+		ActiveBalance:          bp.ActivePrevEpoch,
+		SourceAttestingBalance: bp.PrevEpochAttested,
+		TargetAttestingBalance: bp.PrevEpochTargetAttested,
+		HeadAttestingBalance:   bp.PrevEpochHeadAttested,
+	}
+	if bp.ActivePrevEpoch > 0 {
+		data.ParticipationRate = float64(bp.PrevEpochTargetAttested) / float64(bp.ActivePrevEpoch)
+	}
+	for i, v := range vp {
+		val, err := st.ValidatorAtIndexReadOnly(uint64(i))
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case v.IsActivePrevEpoch:
+			data.ActiveValidators++
+		case epoch < val.ActivationEpoch():
+			data.PendingValidators++
+		default:
+			data.ExitedValidators++
+		}
+		if v.IsSlashed {
+			data.SlashedValidators++
+		}
+	}
+	for _, balance := range st.Balances() {
+		data.TotalBalance += balance
+	}
+	slot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return newRecord(ValidatorSummaryRecord, slot, data)
+}
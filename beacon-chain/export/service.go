@@ -0,0 +1,472 @@
+// Package export streams chain data from the beacon node to pluggable sinks: the canonical blocks,
+// the reorgs orphaning exported blocks, the finalized checkpoints and a per-epoch summary of the
+// validators. The progress of the export is checkpointed so that it resumes after a restart.
+package export
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// batchSize is the number of slots of finalized blocks read from the database at once.
+const batchSize = 64
+
+// Config to set up the chain data export.
+type Config struct {
+	BeaconDB            db.ReadOnlyDatabase
+	StateGen            *stategen.State
+	HeadFetcher         blockchain.HeadFetcher
+	FinalizationFetcher blockchain.FinalizationFetcher
+	StateNotifier       statefeed.Notifier
+	Sinks               []Sink
+	// CursorPath is the file the progress of the export is saved to.
+	CursorPath string
+	// StartEpoch is the epoch a new export starts from. If nil, a new export starts from the
+	// finalized checkpoint, so that it does not regenerate the history of the chain.
+	StartEpoch *uint64
+}
+
+// Service exports the chain data to the configured sinks on every new head and finalized
+// checkpoint, catching up from its cursor with the blocks missed while the node was stopped.
+type Service struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	cfg      *Config
+	cursor   *Cursor
+	runError error
+	// exportRequests holds at most one pending export, as every export catches up with the
+	// latest head and finalized checkpoint.
+	exportRequests chan struct{}
+	// exportDone is closed when the export worker exits.
+	exportDone chan struct{}
+}
+
+// NewService configures the chain data export.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:            ctx,
+		cancel:         cancel,
+		cfg:            cfg,
+		exportRequests: make(chan struct{}, 1),
+	}
+}
+
+// Start the chain data export.
+func (s *Service) Start() {
+	cursor, err := loadCursor(s.cfg.CursorPath)
+	if err != nil {
+		log.WithError(err).Error("Could not start chain data export")
+		s.runError = err
+		return
+	}
+	s.cursor = cursor
+	names := make([]string, len(s.cfg.Sinks))
+	for i, sink := range s.cfg.Sinks {
+		names[i] = sink.Name()
+	}
+	log.WithFields(logrus.Fields{
+		"sinks":     names,
+		"blockSlot": cursor.BlockSlot,
+	}).Info("Starting chain data export")
+	s.exportDone = make(chan struct{})
+	go s.exportWorker()
+	go s.run()
+}
+
+// Stop the chain data export and close the sinks once the running export has returned.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.exportDone != nil {
+		<-s.exportDone
+	}
+	var err error
+	for _, sink := range s.cfg.Sinks {
+		if closeErr := sink.Close(); closeErr != nil {
+			log.WithError(closeErr).WithField("sink", sink.Name()).Error("Could not close export sink")
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// Status of the chain data export.
+func (s *Service) Status() error {
+	return s.runError
+}
+
+// run requests an export on every new head and finalized checkpoint. The export runs in its own
+// worker, so a slow sink never blocks the state feed.
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.NewHead && event.Type != statefeed.FinalizedCheckpoint {
+				continue
+			}
+			select {
+			case s.exportRequests <- struct{}{}:
+			default:
+				// An export is already pending, which covers this event.
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+// exportWorker runs the requested exports until the service is stopped.
+func (s *Service) exportWorker() {
+	defer close(s.exportDone)
+	for {
+		select {
+		case <-s.exportRequests:
+			if err := s.export(s.ctx); err != nil {
+				log.WithError(err).Error("Could not export chain data")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// export writes the chain data since the cursor to the sinks and saves the cursor. A failed export
+// stops at the failing record, which is written again by the next export.
+func (s *Service) export(ctx context.Context) error {
+	headBlk, err := s.cfg.HeadFetcher.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if headBlk == nil || headBlk.Block == nil {
+		return nil
+	}
+	headRoot, err := s.cfg.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head root")
+	}
+	finalized := s.cfg.FinalizationFetcher.FinalizedCheckpt()
+	fRoot := bytesutil.ToBytes32(finalized.Root)
+	if fRoot == params.BeaconConfig().ZeroHash {
+		fRoot, err = s.oldestBlockRoot(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	fBlk, err := s.cfg.BeaconDB.Block(ctx, fRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if fBlk == nil || fBlk.Block == nil {
+		return errors.Errorf("finalized block %#x not found", fRoot)
+	}
+
+	defer func() {
+		if err := saveCursor(s.cfg.CursorPath, s.cursor); err != nil {
+			log.WithError(err).Error("Could not save export cursor")
+		}
+	}()
+	if err := s.exportBlocks(ctx, bytesutil.ToBytes32(headRoot), headBlk, fRoot, fBlk.Block.Slot); err != nil {
+		return err
+	}
+	if finalized.Epoch > s.cursor.FinalizedEpoch {
+		record, err := finalizedCheckpointRecord(finalized.Epoch, fBlk.Block)
+		if err != nil {
+			return err
+		}
+		if err := s.write(ctx, record); err != nil {
+			return err
+		}
+		s.cursor.FinalizedEpoch = finalized.Epoch
+	}
+	return nil
+}
+
+type rootedBlock struct {
+	root [32]byte
+	blk  *ethpb.SignedBeaconBlock
+}
+
+// exportBlocks exports the canonical blocks after the cursor, the finalized ones by slot range and
+// the others by walking back from the head. If the last exported block is no longer canonical, a
+// reorg record is exported and the export continues from the common ancestor.
+func (s *Service) exportBlocks(ctx context.Context, headRoot [32]byte, headBlk *ethpb.SignedBeaconBlock, fRoot [32]byte, fSlot uint64) error {
+	var headChain []*rootedBlock
+	onHeadChain := make(map[[32]byte]bool)
+	root, blk := headRoot, headBlk
+	for blk.Block.Slot > fSlot {
+		headChain = append(headChain, &rootedBlock{root: root, blk: blk})
+		onHeadChain[root] = true
+		root = bytesutil.ToBytes32(blk.Block.ParentRoot)
+		var err error
+		blk, err = s.cfg.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return errors.Wrap(err, "could not get block")
+		}
+		if blk == nil || blk.Block == nil {
+			return errors.Errorf("block %#x not found", root)
+		}
+	}
+	canonical := func(root [32]byte, slot uint64) bool {
+		if slot > fSlot {
+			return onHeadChain[root]
+		}
+		return root == fRoot || s.cfg.BeaconDB.IsFinalizedBlock(ctx, root)
+	}
+
+	started := s.cursor.BlockRoot != ""
+	if started {
+		root, err := decodeRoot(s.cursor.BlockRoot)
+		if err != nil {
+			return err
+		}
+		if !canonical(root, s.cursor.BlockSlot) {
+			if err := s.exportReorg(ctx, root, headRoot, headBlk.Block.Slot, canonical); err != nil {
+				return errors.Wrap(err, "could not export reorg")
+			}
+		}
+	}
+
+	if !started {
+		if err := s.startExport(ctx, fRoot); err != nil {
+			return err
+		}
+	}
+	start := s.cursor.BlockSlot + 1
+	for ; start <= fSlot; start += batchSize {
+		end := start + batchSize - 1
+		if end > fSlot {
+			end = fSlot
+		}
+		blks, roots, err := s.cfg.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized blocks")
+		}
+		var batch []*rootedBlock
+		for i, blk := range blks {
+			if canonical(roots[i], blk.Block.Slot) {
+				batch = append(batch, &rootedBlock{root: roots[i], blk: blk})
+			}
+		}
+		sort.Slice(batch, func(i, j int) bool {
+			return batch[i].blk.Block.Slot < batch[j].blk.Block.Slot
+		})
+		for _, b := range batch {
+			if err := s.exportBlock(ctx, b); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := len(headChain) - 1; i >= 0; i-- {
+		if headChain[i].blk.Block.Slot <= s.cursor.BlockSlot {
+			continue
+		}
+		if err := s.exportBlock(ctx, headChain[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startExport exports the first block of a new export: the finalized block, or the oldest
+// available block at or after the configured start epoch. If the start epoch has no blocks yet, the
+// cursor moves before its start slot so that its blocks are exported as they arrive.
+func (s *Service) startExport(ctx context.Context, fRoot [32]byte) error {
+	root := fRoot
+	if s.cfg.StartEpoch != nil {
+		oldestRoot, err := s.oldestBlockRoot(ctx)
+		if err != nil {
+			return err
+		}
+		oldestBlk, err := s.cfg.BeaconDB.Block(ctx, oldestRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get oldest block")
+		}
+		if oldestBlk == nil || oldestBlk.Block == nil {
+			return errors.Errorf("oldest block %#x not found", oldestRoot)
+		}
+		startSlot, err := helpers.StartSlot(*s.cfg.StartEpoch)
+		if err != nil {
+			return err
+		}
+		if startSlot > oldestBlk.Block.Slot {
+			s.cursor.BlockSlot = startSlot - 1
+			s.cursor.NextSummaryEpoch = *s.cfg.StartEpoch
+			return nil
+		}
+		if startSlot < oldestBlk.Block.Slot {
+			log.WithFields(logrus.Fields{
+				"startEpoch": *s.cfg.StartEpoch,
+				"oldestSlot": oldestBlk.Block.Slot,
+			}).Warn("Blocks before the oldest available block can't be exported")
+		}
+		root = oldestRoot
+	}
+	blk, err := s.cfg.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get first exported block")
+	}
+	if blk == nil || blk.Block == nil {
+		return errors.Errorf("block %#x not found", root)
+	}
+	// No summary is exported for the epochs before the first block, whose states would have to be
+	// regenerated from the history before it.
+	s.cursor.NextSummaryEpoch = helpers.SlotToEpoch(blk.Block.Slot)
+	return s.exportBlock(ctx, &rootedBlock{root: root, blk: blk})
+}
+
+// oldestBlockRoot returns the root of the oldest block in the database: the genesis block, or the
+// lowest backfilled block or the checkpoint sync origin if the node did not sync from genesis.
+func (s *Service) oldestBlockRoot(ctx context.Context) ([32]byte, error) {
+	genesis, err := s.cfg.BeaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get genesis block")
+	}
+	if genesis != nil && genesis.Block != nil {
+		return genesis.Block.HashTreeRoot()
+	}
+	root, err := s.cfg.BeaconDB.BackfillBlockRoot(ctx)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get backfill block root")
+	}
+	if root != params.BeaconConfig().ZeroHash {
+		return root, nil
+	}
+	root, err = s.cfg.BeaconDB.OriginBlockRoot(ctx)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get origin block root")
+	}
+	if root == params.BeaconConfig().ZeroHash {
+		return [32]byte{}, errors.New("no genesis or origin block found")
+	}
+	return root, nil
+}
+
+// exportReorg walks back from the last exported block to the canonical chain, exports the reorg
+// and moves the cursor to the common ancestor.
+func (s *Service) exportReorg(ctx context.Context, oldRoot, headRoot [32]byte, headSlot uint64, canonical func([32]byte, uint64) bool) error {
+	oldBlk, err := s.cfg.BeaconDB.Block(ctx, oldRoot)
+	if err != nil {
+		return err
+	}
+	if oldBlk == nil || oldBlk.Block == nil {
+		return errors.Errorf("exported block %#x not found", oldRoot)
+	}
+	root, blk := oldRoot, oldBlk
+	for !canonical(root, blk.Block.Slot) {
+		root = bytesutil.ToBytes32(blk.Block.ParentRoot)
+		blk, err = s.cfg.BeaconDB.Block(ctx, root)
+		if err != nil {
+			return err
+		}
+		if blk == nil || blk.Block == nil {
+			return errors.Errorf("block %#x not found", root)
+		}
+	}
+	data := &ReorgData{
+		OldHeadRoot:        encodeRoot(oldRoot),
+		OldHeadSlot:        oldBlk.Block.Slot,
+		NewHeadRoot:        encodeRoot(headRoot),
+		NewHeadSlot:        headSlot,
+		CommonAncestorRoot: encodeRoot(root),
+		CommonAncestorSlot: blk.Block.Slot,
+		Depth:              oldBlk.Block.Slot - blk.Block.Slot,
+	}
+	record, err := newRecord(ReorgRecord, headSlot, data)
+	if err != nil {
+		return err
+	}
+	if err := s.write(ctx, record); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"oldSlot": data.OldHeadSlot,
+		"newSlot": data.NewHeadSlot,
+		"depth":   data.Depth,
+	}).Debug("Exported chain reorg")
+	s.cursor.BlockRoot = encodeRoot(root)
+	s.cursor.BlockSlot = blk.Block.Slot
+	return nil
+}
+
+// exportBlock exports the block and moves the cursor to it. The first block of an epoch is
+// preceded by the validator summary of the epoch before the previous one, whose attestations
+// cannot be included anymore.
+func (s *Service) exportBlock(ctx context.Context, b *rootedBlock) error {
+	epoch := helpers.SlotToEpoch(b.blk.Block.Slot)
+	if epoch >= s.cursor.NextSummaryEpoch+2 {
+		parentRoot := bytesutil.ToBytes32(b.blk.Block.ParentRoot)
+		// The state before a block can't be regenerated if the history is missing, such as after a
+		// checkpoint sync.
+		if s.cfg.BeaconDB.HasBlock(ctx, parentRoot) {
+			record, err := s.validatorSummary(ctx, parentRoot, epoch-2)
+			if err != nil {
+				return errors.Wrapf(err, "could not summarize epoch %d", epoch-2)
+			}
+			if err := s.write(ctx, record); err != nil {
+				return err
+			}
+		}
+		s.cursor.NextSummaryEpoch = epoch - 1
+	}
+
+	record, err := blockRecord(b.root, b.blk)
+	if err != nil {
+		return err
+	}
+	if err := s.write(ctx, record); err != nil {
+		return err
+	}
+	s.cursor.BlockRoot = encodeRoot(b.root)
+	s.cursor.BlockSlot = b.blk.Block.Slot
+	return nil
+}
+
+// write the record to every sink.
+func (s *Service) write(ctx context.Context, record *Record) error {
+	for _, sink := range s.cfg.Sinks {
+		if err := sink.Write(ctx, record); err != nil {
+			return errors.Wrapf(err, "could not write %s record to %s", record.Type, sink.Name())
+		}
+	}
+	return nil
+}
+
+func encodeRoot(root [32]byte) string {
+	return "0x" + hex.EncodeToString(root[:])
+}
+
+func decodeRoot(s string) ([32]byte, error) {
+	if len(s) < 2 || s[:2] != "0x" {
+		return [32]byte{}, errors.Errorf("invalid root %s", s)
+	}
+	root, err := hex.DecodeString(s[2:])
+	if err != nil || len(root) != 32 {
+		return [32]byte{}, errors.Errorf("invalid root %s", s)
+	}
+	return bytesutil.ToBytes32(root), nil
+}
@@ -0,0 +1,426 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockSink struct {
+	records []*Record
+	err     error
+}
+
+func (s *mockSink) Name() string {
+	return "mock"
+}
+
+func (s *mockSink) Write(_ context.Context, record *Record) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *mockSink) Close() error {
+	return nil
+}
+
+func (s *mockSink) types() []string {
+	types := make([]string, len(s.records))
+	for i, record := range s.records {
+		types[i] = record.Type
+	}
+	return types
+}
+
+func saveBlock(t *testing.T, beaconDB iface.Database, slot uint64, parent [32]byte, graffiti byte) ([32]byte, *ethpb.SignedBeaconBlock) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = parent[:]
+	blk.Block.Body.Graffiti = bytesutil.PadTo([]byte{graffiti}, 32)
+	require.NoError(t, beaconDB.SaveBlock(context.Background(), blk))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return root, blk
+}
+
+func TestService_export(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+	cursorPath := filepath.Join(t.TempDir(), "cursor.json")
+
+	genesis, _ := saveBlock(t, beaconDB, 0, [32]byte{}, 0)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesis))
+	roots := [][32]byte{genesis}
+	var head *ethpb.SignedBeaconBlock
+	for slot := uint64(1); slot <= 10; slot++ {
+		root, blk := saveBlock(t, beaconDB, slot, roots[slot-1], 'a')
+		roots = append(roots, root)
+		head = blk
+	}
+	chain := &mock.ChainService{
+		Root:                roots[10][:],
+		Block:               head,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+	sink := &mockSink{}
+	s := NewService(ctx, &Config{
+		BeaconDB:            beaconDB,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		Sinks:               []Sink{sink},
+		CursorPath:          cursorPath,
+	})
+	s.cursor = &Cursor{}
+
+	// The chain is exported from genesis.
+	require.NoError(t, s.export(ctx))
+	require.Equal(t, 11, len(sink.records))
+	for i, record := range sink.records {
+		assert.Equal(t, BlockRecord, record.Type)
+		assert.Equal(t, uint64(i), record.Slot)
+	}
+	data := &BlockData{}
+	require.NoError(t, json.Unmarshal(sink.records[10].Data, data))
+	assert.Equal(t, encodeRoot(roots[10]), data.Root)
+	assert.Equal(t, uint64(10), s.cursor.BlockSlot)
+
+	// A fork from slot 5 becomes the head.
+	parent := roots[5]
+	for slot := uint64(6); slot <= 8; slot++ {
+		parent, head = saveBlock(t, beaconDB, slot, parent, 'b')
+	}
+	chain.Root = parent[:]
+	chain.Block = head
+	sink.records = nil
+	require.NoError(t, s.export(ctx))
+	assert.DeepEqual(t, []string{ReorgRecord, BlockRecord, BlockRecord, BlockRecord}, sink.types())
+	reorg := &ReorgData{}
+	require.NoError(t, json.Unmarshal(sink.records[0].Data, reorg))
+	assert.Equal(t, encodeRoot(roots[10]), reorg.OldHeadRoot)
+	assert.Equal(t, encodeRoot(roots[5]), reorg.CommonAncestorRoot)
+	assert.Equal(t, uint64(5), reorg.Depth)
+	assert.Equal(t, uint64(6), sink.records[1].Slot)
+
+	// A failed write is retried by the next export.
+	parent, head = saveBlock(t, beaconDB, 9, parent, 'b')
+	chain.Root = parent[:]
+	chain.Block = head
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 1, Root: roots[5][:]}
+	sink.records = nil
+	sink.err = errors.New("unavailable")
+	require.ErrorContains(t, "unavailable", s.export(ctx))
+	assert.Equal(t, uint64(8), s.cursor.BlockSlot)
+
+	// The export resumes from the saved cursor after a restart.
+	sink.err = nil
+	s = NewService(ctx, &Config{
+		BeaconDB:            beaconDB,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		Sinks:               []Sink{sink},
+		CursorPath:          cursorPath,
+	})
+	s.cursor, _ = loadCursor(cursorPath)
+	assert.Equal(t, uint64(8), s.cursor.BlockSlot)
+	require.NoError(t, s.export(ctx))
+	assert.DeepEqual(t, []string{BlockRecord, FinalizedCheckpointRecord}, sink.types())
+	assert.Equal(t, uint64(9), sink.records[0].Slot)
+	assert.Equal(t, uint64(1), sink.records[1].Epoch)
+	assert.Equal(t, uint64(1), s.cursor.FinalizedEpoch)
+}
+
+func TestService_export_StartsFromFinalizedCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+
+	// The node was checkpoint synced, its history before the origin block is missing.
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	origin, _ := saveBlock(t, beaconDB, originSlot, [32]byte{'p'}, 0)
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, origin))
+	parent, head := origin, (*ethpb.SignedBeaconBlock)(nil)
+	for slot := originSlot + 1; slot <= originSlot+5; slot++ {
+		parent, head = saveBlock(t, beaconDB, slot, parent, 'a')
+	}
+	chain := &mock.ChainService{
+		Root:                parent[:],
+		Block:               head,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 2, Root: origin[:]},
+	}
+	sink := &mockSink{}
+	s := NewService(ctx, &Config{
+		BeaconDB:            beaconDB,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		Sinks:               []Sink{sink},
+		CursorPath:          filepath.Join(t.TempDir(), "cursor.json"),
+	})
+	s.cursor = &Cursor{}
+
+	require.NoError(t, s.export(ctx))
+	require.Equal(t, 7, len(sink.records))
+	for i, record := range sink.records[:6] {
+		assert.Equal(t, BlockRecord, record.Type)
+		assert.Equal(t, originSlot+uint64(i), record.Slot)
+	}
+	assert.Equal(t, FinalizedCheckpointRecord, sink.records[6].Type)
+	assert.Equal(t, uint64(2), s.cursor.NextSummaryEpoch)
+}
+
+func TestService_export_StartEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+
+	genesis, _ := saveBlock(t, beaconDB, 0, [32]byte{}, 0)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesis))
+	parent, head := genesis, (*ethpb.SignedBeaconBlock)(nil)
+	lastSlot := params.BeaconConfig().SlotsPerEpoch + 5
+	for slot := uint64(1); slot <= lastSlot; slot++ {
+		parent, head = saveBlock(t, beaconDB, slot, parent, 'a')
+	}
+	chain := &mock.ChainService{
+		Root:                parent[:],
+		Block:               head,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+	newService := func(startEpoch uint64) (*Service, *mockSink) {
+		sink := &mockSink{}
+		s := NewService(ctx, &Config{
+			BeaconDB:            beaconDB,
+			HeadFetcher:         chain,
+			FinalizationFetcher: chain,
+			Sinks:               []Sink{sink},
+			CursorPath:          filepath.Join(t.TempDir(), "cursor.json"),
+			StartEpoch:          &startEpoch,
+		})
+		s.cursor = &Cursor{}
+		return s, sink
+	}
+
+	// The export starts from the first slot of the start epoch.
+	s, sink := newService(1)
+	require.NoError(t, s.export(ctx))
+	require.Equal(t, 6, len(sink.records))
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, sink.records[0].Slot)
+	assert.Equal(t, lastSlot, s.cursor.BlockSlot)
+	assert.Equal(t, uint64(1), s.cursor.NextSummaryEpoch)
+
+	// Nothing is exported before the start epoch is reached.
+	s, sink = newService(2)
+	require.NoError(t, s.export(ctx))
+	assert.Equal(t, 0, len(sink.records))
+	assert.Equal(t, "", s.cursor.BlockRoot)
+	parent, head = saveBlock(t, beaconDB, params.BeaconConfig().SlotsPerEpoch*2, parent, 'a')
+	chain.Root = parent[:]
+	chain.Block = head
+	require.NoError(t, s.export(ctx))
+	require.Equal(t, 1, len(sink.records))
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch*2, sink.records[0].Slot)
+}
+
+func TestService_export_StartEpochBeforeOldestBlock(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	origin, _ := saveBlock(t, beaconDB, originSlot, [32]byte{'p'}, 0)
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, origin))
+	backfilled, _ := saveBlock(t, beaconDB, originSlot-1, [32]byte{'q'}, 0)
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, backfilled))
+	parent, head := saveBlock(t, beaconDB, originSlot+1, origin, 'a')
+	chain := &mock.ChainService{
+		Root:                parent[:],
+		Block:               head,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 2, Root: origin[:]},
+	}
+	startEpoch := uint64(0)
+	sink := &mockSink{}
+	s := NewService(ctx, &Config{
+		BeaconDB:            beaconDB,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		Sinks:               []Sink{sink},
+		CursorPath:          filepath.Join(t.TempDir(), "cursor.json"),
+		StartEpoch:          &startEpoch,
+	})
+	s.cursor = &Cursor{}
+
+	// The export starts from the oldest backfilled block.
+	require.NoError(t, s.export(ctx))
+	require.Equal(t, 4, len(sink.records))
+	assert.DeepEqual(t, []string{BlockRecord, BlockRecord, BlockRecord, FinalizedCheckpointRecord}, sink.types())
+	assert.Equal(t, originSlot-1, sink.records[0].Slot)
+	assert.Equal(t, uint64(1), s.cursor.NextSummaryEpoch)
+}
+
+func TestService_exportValidatorSummary(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, sc := testDB.SetupDB(t)
+	stateGen := stategen.New(beaconDB, sc)
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesis, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesisBlk))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesis))
+	require.NoError(t, stateGen.SaveState(ctx, genesis, st))
+
+	var head *ethpb.SignedBeaconBlock
+	var headRoot [32]byte
+	for slot := uint64(1); slot <= params.BeaconConfig().SlotsPerEpoch*2; slot++ {
+		conf := &testutil.BlockGenConfig{NumAttestations: 1}
+		// Attestations can't be generated for the target of the epoch starting at the block slot.
+		if slot%params.BeaconConfig().SlotsPerEpoch == 0 {
+			conf.NumAttestations = 0
+		}
+		head, err = testutil.GenerateFullBlock(st, keys, conf, slot)
+		require.NoError(t, err)
+		st, err = state.ExecuteStateTransition(ctx, st, head)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, head))
+		headRoot, err = head.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, stateGen.SaveState(ctx, headRoot, st))
+	}
+
+	chain := &mock.ChainService{
+		Root:                headRoot[:],
+		Block:               head,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+	sink := &mockSink{}
+	s := NewService(ctx, &Config{
+		BeaconDB:            beaconDB,
+		StateGen:            stateGen,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		Sinks:               []Sink{sink},
+		CursorPath:          filepath.Join(t.TempDir(), "cursor.json"),
+	})
+	s.cursor = &Cursor{}
+	require.NoError(t, s.export(ctx))
+
+	// The summary of epoch 0 precedes the first block of epoch 2.
+	i := len(sink.records) - 2
+	require.Equal(t, ValidatorSummaryRecord, sink.records[i].Type)
+	assert.Equal(t, uint64(0), sink.records[i].Epoch)
+	summary := &ValidatorSummaryData{}
+	require.NoError(t, json.Unmarshal(sink.records[i].Data, summary))
+	assert.Equal(t, uint64(64), summary.ActiveValidators)
+	assert.Equal(t, 64*params.BeaconConfig().MaxEffectiveBalance, summary.ActiveBalance)
+	assert.Equal(t, true, summary.TargetAttestingBalance > 0)
+	assert.Equal(t, uint64(1), s.cursor.NextSummaryEpoch)
+}
+
+// blockingSink blocks its writes until the context of the export is canceled.
+type blockingSink struct {
+	writing chan struct{}
+	lock    sync.Mutex
+	busy    bool
+	// closedWhileWriting is set if the sink is closed during a write.
+	closedWhileWriting bool
+}
+
+func (s *blockingSink) Name() string {
+	return "blocking"
+}
+
+func (s *blockingSink) Write(ctx context.Context, _ *Record) error {
+	s.lock.Lock()
+	s.busy = true
+	s.lock.Unlock()
+	select {
+	case s.writing <- struct{}{}:
+	default:
+	}
+	<-ctx.Done()
+	s.lock.Lock()
+	s.busy = false
+	s.lock.Unlock()
+	return ctx.Err()
+}
+
+func (s *blockingSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closedWhileWriting = s.busy
+	return nil
+}
+
+func TestService_ExportDoesNotBlockStateFeed(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+	genesis, genesisBlk := saveBlock(t, beaconDB, 0, [32]byte{}, 0)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesis))
+	chain := &mock.ChainService{
+		Root:                genesis[:],
+		Block:               genesisBlk,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]},
+	}
+	sink := &blockingSink{writing: make(chan struct{}, 1)}
+	s := NewService(ctx, &Config{
+		BeaconDB:            beaconDB,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		StateNotifier:       chain.StateNotifier(),
+		Sinks:               []Sink{sink},
+		CursorPath:          filepath.Join(t.TempDir(), "cursor.json"),
+	})
+	s.Start()
+	require.NoError(t, s.Status())
+
+	// Wait for the service to subscribe to the state feed.
+	headEvent := &feed.Event{Type: statefeed.NewHead}
+	for chain.StateNotifier().StateFeed().Send(headEvent) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case <-sink.writing:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Export did not start")
+	}
+
+	// The state feed is not blocked while the export is writing.
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			chain.StateNotifier().StateFeed().Send(&feed.Event{Type: statefeed.FinalizedCheckpoint})
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("State feed blocked by the export")
+	}
+
+	// The sinks are closed once the running export has returned.
+	require.NoError(t, s.Stop())
+	sink.lock.Lock()
+	defer sink.lock.Unlock()
+	assert.Equal(t, false, sink.closedWhileWriting)
+}
@@ -0,0 +1,71 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Types of the exported records, which are also the Kafka topics they are published to.
+const (
+	BlockRecord               = "beacon_block"
+	ReorgRecord               = "chain_reorg"
+	FinalizedCheckpointRecord = "finalized_checkpoint"
+	ValidatorSummaryRecord    = "validator_summary"
+)
+
+// Record is a piece of chain data written to the export sinks.
+type Record struct {
+	Type  string          `json:"type"`
+	Slot  uint64          `json:"slot"`
+	Epoch uint64          `json:"epoch"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// Sink is a destination of the exported records. A record is exported once Write returns
+// without error, a failed record is written again by the next export pass so sinks receive
+// records at least once.
+type Sink interface {
+	// Name of the sink in logs.
+	Name() string
+	Write(ctx context.Context, record *Record) error
+	Close() error
+}
+
+// BlockData is the data of a canonical block record.
+type BlockData struct {
+	Root  string          `json:"root"`
+	Block json.RawMessage `json:"block"`
+}
+
+// ReorgData is the data of a reorg record, sent when previously exported blocks are no longer
+// canonical. The exported blocks after the common ancestor are orphaned.
+type ReorgData struct {
+	OldHeadRoot        string `json:"old_head_root"`
+	OldHeadSlot        uint64 `json:"old_head_slot"`
+	NewHeadRoot        string `json:"new_head_root"`
+	NewHeadSlot        uint64 `json:"new_head_slot"`
+	CommonAncestorRoot string `json:"common_ancestor_root"`
+	CommonAncestorSlot uint64 `json:"common_ancestor_slot"`
+	Depth              uint64 `json:"depth"`
+}
+
+// FinalizedCheckpointData is the data of a finalized checkpoint record.
+type FinalizedCheckpointData struct {
+	BlockRoot string `json:"block_root"`
+	StateRoot string `json:"state_root"`
+}
+
+// ValidatorSummaryData is the data of a validator summary record, summarizing the registry and the
+// attestation participation of an epoch. Balances are in Gwei.
+type ValidatorSummaryData struct {
+	ActiveValidators       uint64  `json:"active_validators"`
+	PendingValidators      uint64  `json:"pending_validators"`
+	ExitedValidators       uint64  `json:"exited_validators"`
+	SlashedValidators      uint64  `json:"slashed_validators"`
+	TotalBalance           uint64  `json:"total_balance"`
+	ActiveBalance          uint64  `json:"active_balance"`
+	SourceAttestingBalance uint64  `json:"source_attesting_balance"`
+	TargetAttestingBalance uint64  `json:"target_attesting_balance"`
+	HeadAttestingBalance   uint64  `json:"head_attesting_balance"`
+	ParticipationRate      float64 `json:"participation_rate"`
+}
@@ -0,0 +1,76 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestFileSink_Write(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.json")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), &Record{Type: BlockRecord, Slot: 1, Data: []byte(`{}`)}))
+	require.NoError(t, sink.Close())
+
+	// Records are appended to an existing file.
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), &Record{Type: ReorgRecord, Slot: 2, Data: []byte(`{}`)}))
+	require.NoError(t, sink.Close())
+
+	enc, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(enc)), "\n")
+	require.Equal(t, 2, len(lines))
+	record := &Record{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), record))
+	assert.Equal(t, ReorgRecord, record.Type)
+	assert.Equal(t, uint64(2), record.Slot)
+}
+
+func TestSocketSink_Write(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "export.sock")
+	sink := NewSocketSink(path)
+	defer func() {
+		require.NoError(t, sink.Close())
+	}()
+
+	// Writing fails while the consumer is not listening.
+	assert.ErrorContains(t, "could not connect to export socket", sink.Write(ctx, &Record{Type: BlockRecord}))
+
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, listener.Close())
+	}()
+	received := make(chan *Record)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			record := &Record{}
+			if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+				return
+			}
+			received <- record
+		}
+	}()
+
+	require.NoError(t, sink.Write(ctx, &Record{Type: BlockRecord, Slot: 3, Data: []byte(`{}`)}))
+	record := <-received
+	assert.Equal(t, BlockRecord, record.Type)
+	assert.Equal(t, uint64(3), record.Slot)
+}
@@ -0,0 +1,72 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const socketWriteTimeout = 10 * time.Second
+
+// SocketSink writes the records as newline delimited JSON to a Unix socket served by the consumer.
+// The connection is established on the first write and established again after a failed write, so
+// the consumer can be restarted independently of the beacon node.
+type SocketSink struct {
+	path string
+	conn net.Conn
+}
+
+// NewSocketSink returns a sink writing to the Unix socket at path.
+func NewSocketSink(path string) *SocketSink {
+	return &SocketSink{path: path}
+}
+
+// Name of the socket sink.
+func (s *SocketSink) Name() string {
+	return "socket " + s.path
+}
+
+// Write the record to the socket, connecting to it first if needed.
+func (s *SocketSink) Write(ctx context.Context, record *Record) error {
+	enc, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if s.conn == nil {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "unix", s.path)
+		if err != nil {
+			return errors.Wrap(err, "could not connect to export socket")
+		}
+		s.conn = conn
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout)); err != nil {
+		return s.reset(err)
+	}
+	if _, err := s.conn.Write(append(enc, '\n')); err != nil {
+		return s.reset(err)
+	}
+	return nil
+}
+
+// Close the connection to the socket.
+func (s *SocketSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// reset closes the connection after a failed write, a partially written record is dropped by the
+// consumer when the connection closes.
+func (s *SocketSink) reset(err error) error {
+	if closeErr := s.Close(); closeErr != nil {
+		log.WithError(closeErr).Debug("Could not close export socket connection")
+	}
+	return err
+}
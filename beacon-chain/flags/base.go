@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
@@ -14,6 +16,12 @@ var (
 		Usage: "A mainchain web3 provider string http endpoint",
 		Value: "",
 	}
+	// FallbackWeb3ProviderFlag provides fallback HTTP access endpoints to an ETH 1.0 RPC.
+	FallbackWeb3ProviderFlag = &cli.StringSliceFlag{
+		Name: "fallback-web3provider",
+		Usage: "A mainchain web3 provider string http endpoint used when the primary --http-web3provider is unhealthy. " +
+			"This flag may be used multiple times, endpoints are tried in the given order.",
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = &cli.StringFlag{
 		Name:  "deposit-contract",
@@ -103,6 +111,14 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// ArchiveStateDiffs saves the diffs between the finalized states of consecutive epochs, to rebuild historical
+	// states without replaying the blocks since the last archived point.
+	ArchiveStateDiffs = &cli.BoolFlag{
+		Name: "archive-state-diffs",
+		Usage: "Enables archive mode, which saves compact diffs between the finalized states of consecutive epochs in the DB. " +
+			"Historical states are rebuilt from the nearest archived state and the diffs in between, which allows a large " +
+			"--slots-per-archive-point while serving fast historical state queries",
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -126,6 +142,24 @@ var (
 		Name:  "disable-sync",
 		Usage: "Starts the beacon node without entering initial sync and instead exits to regular sync immediately.",
 	}
+	// EnableBackfill enables the background backfill of the blocks missing below the lowest
+	// contiguous block in the database.
+	EnableBackfill = &cli.BoolFlag{
+		Name:  "enable-backfill",
+		Usage: "Fetches the historical blocks missing from the database in the background, such as the blocks before a checkpoint sync anchor.",
+	}
+	// BackfillBatchSize specifies the number of slots requested in each backfill batch.
+	BackfillBatchSize = &cli.Uint64Flag{
+		Name:  "backfill-batch-size",
+		Usage: "The number of slots of historical blocks requested from a peer in each backfill batch.",
+		Value: 64,
+	}
+	// BackfillBatchInterval specifies the minimum time between two backfill batches.
+	BackfillBatchInterval = &cli.DurationFlag{
+		Name:  "backfill-batch-interval",
+		Usage: "The minimum time between two backfill batch requests, which limits the load of the backfill on the node.",
+		Value: time.Second,
+	}
 	// EnableDebugRPCEndpoints as /v1/beacon/state.
 	EnableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "enable-debug-rpc-endpoints",
@@ -163,4 +197,54 @@ var (
 		Usage: "Sets the maximum number of headers that a deposit log query can fetch.",
 		Value: uint64(1000),
 	}
+	// ForkChoiceDumpReorgDepth defines the reorg depth above which a snapshot of the fork choice tree is dumped to disk.
+	ForkChoiceDumpReorgDepth = &cli.Uint64Flag{
+		Name: "fork-choice-dump-reorg-depth",
+		Usage: "Dumps a JSON snapshot of the fork choice tree to disk whenever a chain reorg deeper than this many slots occurs. " +
+			"Snapshots can be rendered with the forkchoice-export tool. Disabled if 0",
+		Value: 0,
+	}
+	// ForkChoiceDumpDir defines the directory where the fork choice snapshots of deep reorgs are written.
+	ForkChoiceDumpDir = &cli.StringFlag{
+		Name:  "fork-choice-dump-dir",
+		Usage: "Directory where the fork choice snapshots of deep reorgs are written. Defaults to forkchoice-dumps in the data directory",
+		Value: "",
+	}
+	// ExportFile defines a newline delimited JSON file the chain data is exported to.
+	ExportFile = &cli.StringFlag{
+		Name:  "export-file",
+		Usage: "Exports the canonical blocks, reorgs, finalized checkpoints and per-epoch validator summaries to a newline delimited JSON file",
+	}
+	// ExportSocket defines a Unix socket the chain data is exported to.
+	ExportSocket = &cli.StringFlag{
+		Name:  "export-socket",
+		Usage: "Exports the chain data as newline delimited JSON to the Unix socket at this path, served by the consumer",
+	}
+	// ExportKafkaURL defines the Kafka bootstrap servers the chain data is exported to.
+	ExportKafkaURL = &cli.StringFlag{
+		Name:  "export-kafka-url",
+		Usage: "Exports the chain data to the Kafka topics named after the record types. This field is used for the bootstrap.servers kafka config field, and requires a build with the kafka_enabled tag",
+	}
+	// ExportCursorFile defines the file where the progress of the chain data export is saved.
+	ExportCursorFile = &cli.StringFlag{
+		Name:  "export-cursor-file",
+		Usage: "File where the progress of the chain data export is saved, to resume it after a restart. Defaults to export-cursor.json in the data directory",
+		Value: "",
+	}
+	// ExportStartEpochFlag defines the epoch a new chain data export starts from.
+	ExportStartEpochFlag = &cli.Uint64Flag{
+		Name:  "export-start-epoch",
+		Usage: "Epoch a new chain data export starts from, clamped to the oldest block in the database. By default, a new export starts from the finalized checkpoint",
+	}
+	// EnableSlasherFlag enables slashing detection inside the beacon node.
+	EnableSlasherFlag = &cli.BoolFlag{
+		Name:  "slasher",
+		Usage: "Enables slashing detection inside the beacon node. Slashable attestations and blocks seen by the node are placed in the slashings pool for inclusion in proposed blocks",
+	}
+	// SlasherDirFlag defines the directory of the database of the in-process slasher.
+	SlasherDirFlag = &cli.StringFlag{
+		Name:  "slasher-datadir",
+		Usage: "Directory of the database of the in-process slasher. Defaults to the slasher directory in the data directory",
+		Value: "",
+	}
 )
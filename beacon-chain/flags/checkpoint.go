@@ -0,0 +1,42 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// CheckpointBlockFlag defines a flag for the beacon node to start from a finalized block loaded via file.
+	CheckpointBlockFlag = &cli.StringFlag{
+		Name: "checkpoint-block",
+		Usage: "The finalized block file (.SSZ) to start the beacon node from. Must be used with " +
+			"--checkpoint-state, --checkpoint-epoch and --checkpoint-genesis-state",
+	}
+	// CheckpointStateFlag defines a flag for the beacon node to start from a finalized state loaded via file.
+	CheckpointStateFlag = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "The state file (.SSZ) of the finalized block to start the beacon node from. Must be used with " +
+			"--checkpoint-block, --checkpoint-epoch and --checkpoint-genesis-state",
+	}
+	// CheckpointEpochFlag defines the epoch of the finalized checkpoint loaded via file.
+	CheckpointEpochFlag = &cli.Uint64Flag{
+		Name: "checkpoint-epoch",
+		Usage: "The epoch of the finalized checkpoint whose block is loaded with --checkpoint-block. The block " +
+			"is the last block at or before the start slot of the epoch",
+	}
+	// CheckpointGenesisStateFlag defines a flag to load the genesis state of a beacon node started from a checkpoint.
+	CheckpointGenesisStateFlag = &cli.StringFlag{
+		Name:  "checkpoint-genesis-state",
+		Usage: "The genesis state file (.SSZ) of the chain the checkpoint belongs to",
+	}
+	// CheckpointSyncProviderFlag defines a beacon node to fetch the latest finalized checkpoint from.
+	CheckpointSyncProviderFlag = &cli.StringFlag{
+		Name: "checkpoint-sync-provider",
+		Usage: "A trusted beacon node gRPC endpoint (host:port) to fetch the latest finalized state and block from, " +
+			"instead of syncing from genesis. The beacon node must run with --enable-debug-rpc-endpoints",
+	}
+	// CheckpointSyncProviderCertFlag defines a certificate to connect to the checkpoint sync provider.
+	CheckpointSyncProviderCertFlag = &cli.StringFlag{
+		Name:  "checkpoint-sync-provider-cert",
+		Usage: "Certificate for secure gRPC connection to the checkpoint sync provider",
+	}
+)
@@ -16,6 +16,7 @@ type GlobalFlags struct {
 	MinimumSyncPeers           int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
+	ArchiveStateDiffs          bool
 }
 
 var globalConfig *GlobalFlags
@@ -49,6 +50,10 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 		log.Warn("Subscribing to All Attestation Subnets")
 		cfg.SubscribeToAllSubnets = true
 	}
+	if ctx.Bool(ArchiveStateDiffs.Name) {
+		log.Warn("Saving state diffs of finalized epochs for archive queries. This requires additional storage")
+		cfg.ArchiveStateDiffs = true
+	}
 	cfg.DisableDiscv5 = ctx.Bool(DisableDiscv5.Name)
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
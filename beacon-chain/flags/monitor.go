@@ -0,0 +1,15 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// MonitorValidatorsFlag defines the validators tracked by the validator monitor of the beacon node.
+	MonitorValidatorsFlag = &cli.StringSliceFlag{
+		Name: "monitor-validators",
+		Usage: "Validator indices or 0x prefixed public keys to track in the beacon node. The proposals, " +
+			"included attestations and per-epoch performance, balance and status of the tracked validators " +
+			"are logged and exported as metrics",
+	}
+)
@@ -2,6 +2,7 @@ package forkchoice
 
 import (
 	"context"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 )
@@ -11,6 +12,7 @@ type ForkChoicer interface {
 	HeadRetriever        // to compute head.
 	BlockProcessor       // to track new block for fork choice.
 	AttestationProcessor // to track new attestation for fork choice.
+	ProposerBooster      // to boost timely blocks for fork choice.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
 }
@@ -30,6 +32,12 @@ type AttestationProcessor interface {
 	ProcessAttestation(context.Context, []uint64, [32]byte, uint64)
 }
 
+// ProposerBooster boosts the weight of the timely block of the current slot, and resets the boost on the next slot.
+type ProposerBooster interface {
+	BoostProposerRoot(ctx context.Context, blockSlot uint64, blockRoot [32]byte, genesisTime time.Time)
+	ResetBoostedProposerRoot(ctx context.Context)
+}
+
 // Pruner prunes the fork choice upon new finalization. This is used to keep fork choice sane.
 type Pruner interface {
 	Prune(context.Context, [32]byte) error
@@ -43,5 +51,6 @@ type Getter interface {
 	Store() *protoarray.Store
 	HasParent(root [32]byte) bool
 	AncestorRoot(ctx context.Context, root [32]byte, slot uint64) ([]byte, error)
+	CommonAncestorRoot(ctx context.Context, r1, r2 [32]byte) ([32]byte, uint64, error)
 	IsCanonical(root [32]byte) bool
 }
@@ -0,0 +1,127 @@
+package protoarray
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/emicklei/dot"
+)
+
+// ExportedTree is a snapshot of the fork choice tree, meant to be read by operators while investigating
+// the fork choice of a node, as JSON or as a Graphviz DOT graph.
+type ExportedTree struct {
+	JustifiedEpoch uint64          `json:"justified_epoch"`
+	FinalizedEpoch uint64          `json:"finalized_epoch"`
+	FinalizedRoot  string          `json:"finalized_root"`
+	HeadRoot       string          `json:"head_root,omitempty"`
+	Nodes          []*ExportedNode `json:"nodes"`
+}
+
+// ExportedNode is a block node of an exported fork choice tree, referring to other nodes by root.
+type ExportedNode struct {
+	Slot               uint64 `json:"slot"`
+	Root               string `json:"root"`
+	ParentRoot         string `json:"parent_root,omitempty"`
+	JustifiedEpoch     uint64 `json:"justified_epoch"`
+	FinalizedEpoch     uint64 `json:"finalized_epoch"`
+	Weight             uint64 `json:"weight"`
+	BestChildRoot      string `json:"best_child_root,omitempty"`
+	BestDescendantRoot string `json:"best_descendant_root,omitempty"`
+	Canonical          bool   `json:"canonical"`
+	Graffiti           string `json:"graffiti"`
+}
+
+// Export returns a snapshot of the fork choice tree. The head is the best descendant of the justified
+// node of the last head computation, and the canonical nodes are the ones of the chain leading to it.
+func (s *Store) Export() *ExportedTree {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+
+	rootAt := func(index uint64) string {
+		if index == NonExistentNode || index >= uint64(len(s.nodes)) {
+			return ""
+		}
+		return fmt.Sprintf("%#x", s.nodes[index].root)
+	}
+	tree := &ExportedTree{
+		JustifiedEpoch: s.justifiedEpoch,
+		FinalizedEpoch: s.finalizedEpoch,
+		FinalizedRoot:  fmt.Sprintf("%#x", s.finalizedRoot),
+		Nodes:          make([]*ExportedNode, len(s.nodes)),
+	}
+	canonical := make(map[uint64]bool)
+	if justifiedIndex, ok := s.nodesIndices[s.justifiedRoot]; ok && justifiedIndex < uint64(len(s.nodes)) {
+		headIndex := s.nodes[justifiedIndex].bestDescendant
+		if headIndex == NonExistentNode {
+			headIndex = justifiedIndex
+		}
+		tree.HeadRoot = rootAt(headIndex)
+		for i := headIndex; i != NonExistentNode && i < uint64(len(s.nodes)); i = s.nodes[i].parent {
+			canonical[i] = true
+		}
+	}
+	for i, n := range s.nodes {
+		tree.Nodes[i] = &ExportedNode{
+			Slot:               n.slot,
+			Root:               fmt.Sprintf("%#x", n.root),
+			ParentRoot:         rootAt(n.parent),
+			JustifiedEpoch:     n.justifiedEpoch,
+			FinalizedEpoch:     n.finalizedEpoch,
+			Weight:             n.weight,
+			BestChildRoot:      rootAt(n.bestChild),
+			BestDescendantRoot: rootAt(n.bestDescendant),
+			Canonical:          canonical[uint64(i)],
+			Graffiti:           fmt.Sprintf("%#x", n.graffiti),
+		}
+	}
+	return tree
+}
+
+// JSON returns the indented JSON encoding of the exported tree.
+func (t *ExportedTree) JSON() ([]byte, error) {
+	return json.MarshalIndent(t, "", "  ")
+}
+
+// DOT returns the exported tree as a Graphviz DOT graph, with an edge from every node to its parent.
+// Nodes of the canonical chain and the edges between them are highlighted, and the head is filled.
+func (t *ExportedTree) DOT() string {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	dotNodes := make(map[string]dot.Node, len(t.Nodes))
+	for _, n := range t.Nodes {
+		label := fmt.Sprintf("slot: %d\nroot: %s\nweight: %d gwei\njustified epoch: %d\nfinalized epoch: %d\nbest child: %s",
+			n.Slot, truncateRoot(n.Root), n.Weight, n.JustifiedEpoch, n.FinalizedEpoch, truncateRoot(n.BestChildRoot))
+		dotN := graph.Node(n.Root).Box().Attr("label", label)
+		if n.Canonical {
+			dotN = dotN.Attr("color", "green").Attr("penwidth", "2")
+		}
+		if n.Root == t.HeadRoot {
+			dotN = dotN.Attr("style", "filled").Attr("fillcolor", "palegreen")
+		}
+		dotNodes[n.Root] = dotN
+	}
+	for _, n := range t.Nodes {
+		parent, ok := dotNodes[n.ParentRoot]
+		if !ok {
+			continue
+		}
+		edge := graph.Edge(dotNodes[n.Root], parent)
+		if n.Canonical {
+			edge.Attr("color", "green").Attr("penwidth", "2")
+		}
+	}
+	return graph.String()
+}
+
+// truncateRoot shortens a hex encoded root to its first 6 bytes for display.
+func truncateRoot(root string) string {
+	if root == "" {
+		return "none"
+	}
+	if len(root) > 14 {
+		return root[:14]
+	}
+	return root
+}
@@ -0,0 +1,126 @@
+package protoarray
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_Export(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+
+	//            0
+	//           / \
+	//          1   2
+	//          |
+	//          3
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'a'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{'b'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), indexToHash(1), [32]byte{'c'}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(3), 2)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(2), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{10, 10, 10}, 1)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(3), r)
+
+	tree := f.store.Export()
+	assert.Equal(t, uint64(1), tree.JustifiedEpoch)
+	assert.Equal(t, uint64(1), tree.FinalizedEpoch)
+	assert.Equal(t, fmt.Sprintf("%#x", params.BeaconConfig().ZeroHash), tree.FinalizedRoot)
+	assert.Equal(t, fmt.Sprintf("%#x", indexToHash(3)), tree.HeadRoot)
+	require.Equal(t, 4, len(tree.Nodes))
+
+	genesis := tree.Nodes[0]
+	assert.Equal(t, "", genesis.ParentRoot)
+	// The weight of the zero hash node is never updated.
+	assert.Equal(t, uint64(0), genesis.Weight)
+	assert.Equal(t, fmt.Sprintf("%#x", indexToHash(1)), genesis.BestChildRoot)
+	assert.Equal(t, fmt.Sprintf("%#x", indexToHash(3)), genesis.BestDescendantRoot)
+	assert.Equal(t, true, genesis.Canonical)
+
+	fork := tree.Nodes[2]
+	assert.Equal(t, fmt.Sprintf("%#x", params.BeaconConfig().ZeroHash), fork.ParentRoot)
+	assert.Equal(t, uint64(10), fork.Weight)
+	assert.Equal(t, "", fork.BestChildRoot)
+	assert.Equal(t, false, fork.Canonical)
+
+	head := tree.Nodes[3]
+	assert.Equal(t, uint64(2), head.Slot)
+	assert.Equal(t, fmt.Sprintf("%#x", indexToHash(1)), head.ParentRoot)
+	assert.Equal(t, uint64(20), head.Weight)
+	assert.Equal(t, true, head.Canonical)
+}
+
+func TestStore_Export_Reorg(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+
+	//            0
+	//           / \
+	//          1   2
+	//          |
+	//          3
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'a'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{'b'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), indexToHash(1), [32]byte{'c'}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(3), 2)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(2), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{10, 10, 10}, 1)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(3), r)
+
+	// The votes move to block 2, which becomes the head.
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(2), 3)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{10, 10, 10}, 1)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(2), r)
+
+	tree := f.store.Export()
+	assert.Equal(t, fmt.Sprintf("%#x", indexToHash(2)), tree.HeadRoot)
+	require.Equal(t, 4, len(tree.Nodes))
+	assert.Equal(t, true, tree.Nodes[0].Canonical)
+	assert.Equal(t, false, tree.Nodes[1].Canonical, "Block of the old head chain is canonical")
+	assert.Equal(t, true, tree.Nodes[2].Canonical)
+	assert.Equal(t, false, tree.Nodes[3].Canonical, "Old head is canonical")
+}
+
+func TestExportedTree_JSON(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{}, 1)
+	require.NoError(t, err)
+
+	tree := f.store.Export()
+	enc, err := tree.JSON()
+	require.NoError(t, err)
+	decoded := &ExportedTree{}
+	require.NoError(t, json.Unmarshal(enc, decoded))
+	assert.DeepEqual(t, tree, decoded)
+}
+
+func TestExportedTree_DOT(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 2)
+	_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{10}, 1)
+	require.NoError(t, err)
+
+	graph := f.store.Export().DOT()
+	assert.Equal(t, true, strings.HasPrefix(graph, "digraph"))
+	assert.Equal(t, true, strings.Contains(graph, "rankdir=\"RL\""))
+	assert.Equal(t, 3, strings.Count(graph, "slot: "), "Wrong number of nodes")
+	assert.Equal(t, 2, strings.Count(graph, "->"), "Wrong number of edges")
+	assert.Equal(t, 1, strings.Count(graph, "palegreen"), "Wrong number of heads")
+	// The canonical genesis and head nodes, and the edge between them are highlighted.
+	assert.Equal(t, 3, strings.Count(graph, "color=\"green\""))
+}
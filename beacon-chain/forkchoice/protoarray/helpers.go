@@ -70,6 +70,25 @@ func computeDeltas(
 	return deltas, votes, nil
 }
 
+// This computes the proposer score boost, a percentage of the weight of a committee, which is the total
+// balance of the active validators divided by the number of slots in an epoch. Balances of inactive
+// validators are zero in the justified balances.
+//
+// Spec code:
+//    committee_weight = get_total_active_balance(state) // SLOTS_PER_EPOCH
+//    proposer_score = (committee_weight * PROPOSER_SCORE_BOOST) // 100
+func computeProposerBoostScore(balances []uint64) (uint64, error) {
+	totalActiveBalance := uint64(0)
+	for _, balance := range balances {
+		totalActiveBalance += balance
+	}
+	if totalActiveBalance == 0 {
+		return 0, errNoActiveBalance
+	}
+	committeeWeight := totalActiveBalance / params.BeaconConfig().SlotsPerEpoch
+	return committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100, nil
+}
+
 // This return a copy of the proto array node object.
 func copyNode(node *Node) *Node {
 	if node == nil {
@@ -26,6 +26,8 @@ func (s *Store) head(ctx context.Context, justifiedRoot [32]byte) ([32]byte, err
 		return [32]byte{}, errInvalidJustifiedIndex
 	}
 
+	s.justifiedRoot = justifiedRoot
+
 	justifiedNode := s.nodes[justifiedIndex]
 	bestDescendantIndex := justifiedNode.bestDescendant
 	// If the justified node doesn't have a best descendent,
@@ -149,7 +151,7 @@ func (s *Store) insert(ctx context.Context,
 // and its best child. For each node, it updates the weight with input delta and
 // back propagate the nodes delta to its parents delta. After scoring changes,
 // the best child is then updated along with best descendant.
-func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalizedEpoch uint64, delta []int) error {
+func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalizedEpoch uint64, newBalances []uint64, delta []int) error {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.applyWeightChanges")
 	defer span.End()
 
@@ -164,6 +166,19 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		s.finalizedEpoch = finalizedEpoch
 	}
 
+	// The boost of the previously boosted block is removed, and the boost of the currently
+	// boosted block is added, as a delta which is back propagated like the votes.
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+	proposerScore := uint64(0)
+	if s.proposerBoostRoot != params.BeaconConfig().ZeroHash {
+		var err error
+		proposerScore, err = computeProposerBoostScore(newBalances)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Iterate backwards through all index to node in store.
 	for i := len(s.nodes) - 1; i >= 0; i-- {
 		n := s.nodes[i]
@@ -175,6 +190,12 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		}
 
 		nodeDelta := delta[i]
+		if n.root == s.previousProposerBoostRoot {
+			nodeDelta -= int(s.previousProposerBoostScore)
+		}
+		if n.root == s.proposerBoostRoot {
+			nodeDelta += int(proposerScore)
+		}
 
 		if nodeDelta < 0 {
 			// A node's weight can not be negative but the delta can be negative.
@@ -205,6 +226,9 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		}
 	}
 
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = proposerScore
+
 	return nil
 }
 
@@ -97,7 +97,7 @@ func TestStore_ApplyScoreChanges_InvalidDeltaLength(t *testing.T) {
 	s := &Store{}
 
 	// This will fail because node indices has length of 0, and delta list has a length of 1.
-	err := s.applyWeightChanges(context.Background(), 0, 0, []int{1})
+	err := s.applyWeightChanges(context.Background(), 0, 0, []uint64{}, []int{1})
 	assert.ErrorContains(t, errInvalidDeltaLength.Error(), err)
 }
 
@@ -105,7 +105,7 @@ func TestStore_ApplyScoreChanges_UpdateEpochs(t *testing.T) {
 	s := &Store{}
 
 	// The justified and finalized epochs in Store should be updated to 1 and 1 given the following input.
-	require.NoError(t, s.applyWeightChanges(context.Background(), 1, 1, []int{}))
+	require.NoError(t, s.applyWeightChanges(context.Background(), 1, 1, []uint64{}, []int{}))
 	assert.Equal(t, uint64(1), s.justifiedEpoch, "Did not update justified epoch")
 	assert.Equal(t, uint64(1), s.finalizedEpoch, "Did not update finalized epoch")
 }
@@ -119,7 +119,7 @@ func TestStore_ApplyScoreChanges_UpdateWeightsPositiveDelta(t *testing.T) {
 
 	// Each node gets one unique vote. The weight should look like 103 <- 102 <- 101 because
 	// they get propagated back.
-	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []int{1, 1, 1}))
+	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []uint64{}, []int{1, 1, 1}))
 	assert.Equal(t, uint64(103), s.nodes[0].weight)
 	assert.Equal(t, uint64(102), s.nodes[1].weight)
 	assert.Equal(t, uint64(101), s.nodes[2].weight)
@@ -134,7 +134,7 @@ func TestStore_ApplyScoreChanges_UpdateWeightsNegativeDelta(t *testing.T) {
 
 	// Each node gets one unique vote which contributes to negative delta.
 	// The weight should look like 97 <- 98 <- 99 because they get propagated back.
-	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []int{-1, -1, -1}))
+	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []uint64{}, []int{-1, -1, -1}))
 	assert.Equal(t, uint64(97), s.nodes[0].weight)
 	assert.Equal(t, uint64(98), s.nodes[1].weight)
 	assert.Equal(t, uint64(99), s.nodes[2].weight)
@@ -148,7 +148,7 @@ func TestStore_ApplyScoreChanges_UpdateWeightsMixedDelta(t *testing.T) {
 		{parent: 1, root: [32]byte{'A'}, weight: 100}}}
 
 	// Each node gets one mixed vote. The weight should look like 100 <- 200 <- 250.
-	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []int{-100, -50, 150}))
+	require.NoError(t, s.applyWeightChanges(context.Background(), 0, 0, []uint64{}, []int{-100, -50, 150}))
 	assert.Equal(t, uint64(100), s.nodes[0].weight)
 	assert.Equal(t, uint64(200), s.nodes[1].weight)
 	assert.Equal(t, uint64(250), s.nodes[2].weight)
@@ -501,3 +501,49 @@ func TestStore_UpdateCanonicalNodes_ContextCancelled(t *testing.T) {
 	cancel()
 	require.ErrorContains(t, "context canceled", f.store.updateCanonicalNodes(ctx, [32]byte{'c'}))
 }
+
+func TestStore_CommonAncestorRoot(t *testing.T) {
+	ctx := context.Background()
+	f := &ForkChoice{store: &Store{}}
+	f.store.nodesIndices = map[[32]byte]uint64{}
+	_, _, err := f.CommonAncestorRoot(ctx, [32]byte{'a'}, [32]byte{'b'})
+	assert.ErrorContains(t, "node does not exist", err)
+
+	//   a <- b <- c <- d
+	//         \
+	//          e <------ f
+	f.store.nodes = []*Node{
+		{slot: 1, root: [32]byte{'a'}, parent: NonExistentNode},
+		{slot: 2, root: [32]byte{'b'}, parent: 0},
+		{slot: 3, root: [32]byte{'c'}, parent: 1},
+		{slot: 4, root: [32]byte{'d'}, parent: 2},
+		{slot: 3, root: [32]byte{'e'}, parent: 1},
+		{slot: 6, root: [32]byte{'f'}, parent: 4},
+	}
+	for i, n := range f.store.nodes {
+		f.store.nodesIndices[n.root] = uint64(i)
+	}
+
+	tests := []struct {
+		r1, r2   [32]byte
+		wantRoot [32]byte
+		wantSlot uint64
+	}{
+		{r1: [32]byte{'d'}, r2: [32]byte{'f'}, wantRoot: [32]byte{'b'}, wantSlot: 2},
+		{r1: [32]byte{'f'}, r2: [32]byte{'c'}, wantRoot: [32]byte{'b'}, wantSlot: 2},
+		{r1: [32]byte{'c'}, r2: [32]byte{'e'}, wantRoot: [32]byte{'b'}, wantSlot: 2},
+		{r1: [32]byte{'d'}, r2: [32]byte{'b'}, wantRoot: [32]byte{'b'}, wantSlot: 2},
+		{r1: [32]byte{'a'}, r2: [32]byte{'f'}, wantRoot: [32]byte{'a'}, wantSlot: 1},
+		{r1: [32]byte{'d'}, r2: [32]byte{'d'}, wantRoot: [32]byte{'d'}, wantSlot: 4},
+	}
+	for _, tc := range tests {
+		r, slot, err := f.CommonAncestorRoot(ctx, tc.r1, tc.r2)
+		require.NoError(t, err)
+		assert.Equal(t, tc.wantRoot, r)
+		assert.Equal(t, tc.wantSlot, slot)
+	}
+
+	f.store.nodes[2].parent = 100
+	_, _, err = f.CommonAncestorRoot(ctx, [32]byte{'d'}, [32]byte{'f'})
+	assert.ErrorContains(t, "node index out of range", err)
+}
@@ -0,0 +1,127 @@
+package protoarray
+
+import (
+	"github.com/pkg/errors"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ToProto returns a copy of the fork choice store, votes and balances in their persisted form.
+func (f *ForkChoice) ToProto() *dbpb.ForkChoiceStore {
+	f.votesLock.RLock()
+	defer f.votesLock.RUnlock()
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+	f.store.proposerBoostLock.RLock()
+	defer f.store.proposerBoostLock.RUnlock()
+
+	nodes := make([]*dbpb.ForkChoiceNode, len(f.store.nodes))
+	for i, n := range f.store.nodes {
+		nodes[i] = &dbpb.ForkChoiceNode{
+			Slot:           n.slot,
+			Root:           bytesutil.SafeCopyBytes(n.root[:]),
+			Parent:         n.parent,
+			JustifiedEpoch: n.justifiedEpoch,
+			FinalizedEpoch: n.finalizedEpoch,
+			Weight:         n.weight,
+			BestChild:      n.bestChild,
+			BestDescendant: n.bestDescendant,
+			Graffiti:       bytesutil.SafeCopyBytes(n.graffiti[:]),
+		}
+	}
+	votes := make([]*dbpb.ForkChoiceVote, len(f.votes))
+	for i, v := range f.votes {
+		votes[i] = &dbpb.ForkChoiceVote{
+			CurrentRoot: bytesutil.SafeCopyBytes(v.currentRoot[:]),
+			NextRoot:    bytesutil.SafeCopyBytes(v.nextRoot[:]),
+			NextEpoch:   v.nextEpoch,
+		}
+	}
+	balances := make([]uint64, len(f.balances))
+	copy(balances, f.balances)
+
+	// The boost of the current slot is not persisted as it is reset on the next slot, but the boost
+	// already applied to the node weights is, so it can be removed on the next weight update.
+	return &dbpb.ForkChoiceStore{
+		JustifiedEpoch:             f.store.justifiedEpoch,
+		FinalizedEpoch:             f.store.finalizedEpoch,
+		FinalizedRoot:              bytesutil.SafeCopyBytes(f.store.finalizedRoot[:]),
+		Nodes:                      nodes,
+		Votes:                      votes,
+		Balances:                   balances,
+		PreviousProposerBoostRoot:  bytesutil.SafeCopyBytes(f.store.previousProposerBoostRoot[:]),
+		PreviousProposerBoostScore: f.store.previousProposerBoostScore,
+	}
+}
+
+// InitializeFromProto restores a fork choice store from its persisted form. The structure of the
+// proto array is verified, so that a corrupted store is rejected instead of producing a wrong head.
+// The canonical nodes are recomputed on the next head computation.
+func InitializeFromProto(pb *dbpb.ForkChoiceStore) (*ForkChoice, error) {
+	if pb == nil {
+		return nil, errors.New("nil fork choice store")
+	}
+	if len(pb.FinalizedRoot) != 32 {
+		return nil, errors.Errorf("invalid finalized root length %d", len(pb.FinalizedRoot))
+	}
+	if len(pb.PreviousProposerBoostRoot) != 0 && len(pb.PreviousProposerBoostRoot) != 32 {
+		return nil, errors.Errorf("invalid previous proposer boost root length %d", len(pb.PreviousProposerBoostRoot))
+	}
+	f := New(pb.JustifiedEpoch, pb.FinalizedEpoch, bytesutil.ToBytes32(pb.FinalizedRoot))
+	f.store.previousProposerBoostRoot = bytesutil.ToBytes32(pb.PreviousProposerBoostRoot)
+	f.store.previousProposerBoostScore = pb.PreviousProposerBoostScore
+
+	numNodes := uint64(len(pb.Nodes))
+	f.store.nodes = make([]*Node, numNodes)
+	for i, n := range pb.Nodes {
+		index := uint64(i)
+		if n == nil {
+			return nil, errors.Errorf("nil node at index %d", index)
+		}
+		if len(n.Root) != 32 || len(n.Graffiti) != 32 {
+			return nil, errors.Errorf("invalid root or graffiti length of node at index %d", index)
+		}
+		root := bytesutil.ToBytes32(n.Root)
+		if _, ok := f.store.nodesIndices[root]; ok {
+			return nil, errors.Errorf("duplicate node %#x at index %d", root, index)
+		}
+		// Parents are always inserted before their children, and best children and descendants after them.
+		if n.Parent != NonExistentNode && n.Parent >= index {
+			return nil, errors.Wrapf(errInvalidNodeIndex, "parent %d of node at index %d", n.Parent, index)
+		}
+		if n.BestChild != NonExistentNode && (n.BestChild <= index || n.BestChild >= numNodes) {
+			return nil, errors.Wrapf(errInvalidBestChildIndex, "best child %d of node at index %d", n.BestChild, index)
+		}
+		if n.BestDescendant != NonExistentNode && (n.BestDescendant <= index || n.BestDescendant >= numNodes) {
+			return nil, errors.Wrapf(errInvalidBestDescendantIndex, "best descendant %d of node at index %d", n.BestDescendant, index)
+		}
+		f.store.nodes[i] = &Node{
+			slot:           n.Slot,
+			root:           root,
+			parent:         n.Parent,
+			justifiedEpoch: n.JustifiedEpoch,
+			finalizedEpoch: n.FinalizedEpoch,
+			weight:         n.Weight,
+			bestChild:      n.BestChild,
+			bestDescendant: n.BestDescendant,
+			graffiti:       bytesutil.ToBytes32(n.Graffiti),
+		}
+		f.store.nodesIndices[root] = index
+	}
+
+	f.votes = make([]Vote, len(pb.Votes))
+	for i, v := range pb.Votes {
+		if v == nil || len(v.CurrentRoot) != 32 || len(v.NextRoot) != 32 {
+			return nil, errors.Errorf("invalid vote of validator %d", i)
+		}
+		f.votes[i] = Vote{
+			currentRoot: bytesutil.ToBytes32(v.CurrentRoot),
+			nextRoot:    bytesutil.ToBytes32(v.NextRoot),
+			nextEpoch:   v.NextEpoch,
+		}
+	}
+	f.balances = make([]uint64, len(pb.Balances))
+	copy(f.balances, pb.Balances)
+
+	return f, nil
+}
@@ -0,0 +1,97 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestForkChoice_ToProto_InitializeFromProto(t *testing.T) {
+	ctx := context.Background()
+	balances := []uint64{1, 1, 1}
+	f := setup(1, 1)
+
+	//            0
+	//           / \
+	//          1   2
+	//          |
+	//          3
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'a'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{'b'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), indexToHash(1), [32]byte{'c'}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(2), 2)
+	f.ProcessAttestation(ctx, []uint64{2}, indexToHash(3), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r)
+
+	restored, err := InitializeFromProto(f.ToProto())
+	require.NoError(t, err)
+	assert.DeepEqual(t, f.Nodes(), restored.Nodes())
+	assert.DeepEqual(t, f.votes, restored.votes)
+	assert.DeepEqual(t, f.balances, restored.balances)
+	assert.Equal(t, f.store.justifiedEpoch, restored.store.justifiedEpoch)
+	assert.Equal(t, f.store.finalizedEpoch, restored.store.finalizedEpoch)
+	assert.Equal(t, f.store.finalizedRoot, restored.store.finalizedRoot)
+
+	// The restored store picks the same head without any new vote, and keeps accounting votes.
+	r, err = restored.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r)
+	assert.Equal(t, true, restored.IsCanonical(indexToHash(2)))
+	restored.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(3), 3)
+	r, err = restored.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), r)
+}
+
+func TestInitializeFromProto_Corrupted(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), indexToHash(1), [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 2)
+	_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{1}, 1)
+	require.NoError(t, err)
+
+	_, err = InitializeFromProto(nil)
+	assert.ErrorContains(t, "nil fork choice store", err)
+
+	pb := f.ToProto()
+	pb.FinalizedRoot = []byte{'a'}
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, "invalid finalized root length", err)
+
+	pb = f.ToProto()
+	pb.Nodes[1].Parent = 2
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, errInvalidNodeIndex.Error(), err)
+
+	pb = f.ToProto()
+	pb.Nodes[1].BestChild = 1
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, errInvalidBestChildIndex.Error(), err)
+
+	pb = f.ToProto()
+	pb.Nodes[0].BestDescendant = 3
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, errInvalidBestDescendantIndex.Error(), err)
+
+	pb = f.ToProto()
+	pb.Nodes[2].Root = pb.Nodes[1].Root
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, "duplicate node", err)
+
+	pb = f.ToProto()
+	pb.Nodes[2].Graffiti = nil
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, "invalid root or graffiti length of node at index 2", err)
+
+	pb = f.ToProto()
+	pb.Votes[0].NextRoot = nil
+	_, err = InitializeFromProto(pb)
+	assert.ErrorContains(t, "invalid vote of validator 0", err)
+}
@@ -0,0 +1,209 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// genesisTimeFor returns a genesis time for which the current time is the given duration into the given slot.
+func genesisTimeFor(slot uint64, intoSlot time.Duration) time.Time {
+	sinceGenesis := time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second
+	return timeutils.Now().Add(-sinceGenesis - intoSlot)
+}
+
+// equalBalances returns the balances of 64 validators with the same balance, so that the proposer boost
+// is worth 1.4 votes with the mainnet configuration.
+func equalBalances() []uint64 {
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = 10
+	}
+	return balances
+}
+
+func TestComputeProposerBoostScore(t *testing.T) {
+	score, err := computeProposerBoostScore(equalBalances())
+	require.NoError(t, err)
+	// Committee weight of 640 / 32 = 20, boosted by 70%.
+	assert.Equal(t, uint64(14), score)
+
+	_, err = computeProposerBoostScore([]uint64{0, 0})
+	assert.ErrorContains(t, errNoActiveBalance.Error(), err)
+}
+
+func TestBoostProposerRoot_OnlyTimelyBlocksOfCurrentSlot(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	intervalLength := time.Duration(params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot) * time.Second
+
+	// The block is received after the attestation deadline of its slot.
+	f.BoostProposerRoot(ctx, 3, indexToHash(1), genesisTimeFor(3, intervalLength))
+	assert.Equal(t, params.BeaconConfig().ZeroHash, f.store.proposerBoostRoot)
+
+	// The block is not for the current slot.
+	f.BoostProposerRoot(ctx, 2, indexToHash(1), genesisTimeFor(3, 0))
+	assert.Equal(t, params.BeaconConfig().ZeroHash, f.store.proposerBoostRoot)
+
+	// The chain has not started.
+	f.BoostProposerRoot(ctx, 0, indexToHash(1), timeutils.Now().Add(time.Minute))
+	assert.Equal(t, params.BeaconConfig().ZeroHash, f.store.proposerBoostRoot)
+
+	f.BoostProposerRoot(ctx, 3, indexToHash(1), genesisTimeFor(3, 0))
+	assert.Equal(t, indexToHash(1), f.store.proposerBoostRoot)
+
+	f.ResetBoostedProposerRoot(ctx)
+	assert.Equal(t, params.BeaconConfig().ZeroHash, f.store.proposerBoostRoot)
+}
+
+func TestProposerBoost_WeightIsRemovedAfterReset(t *testing.T) {
+	ctx := context.Background()
+	balances := equalBalances()
+	f := setup(1, 1)
+
+	//         0
+	//        / \
+	//       1   2
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 1)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r)
+
+	// Block 2 is boosted above the vote for block 1.
+	f.BoostProposerRoot(ctx, 1, indexToHash(2), genesisTimeFor(1, 0))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r)
+	assert.Equal(t, uint64(14), f.Node(indexToHash(2)).Weight())
+
+	// Computing the head again in the same slot doesn't apply the boost twice.
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r)
+	assert.Equal(t, uint64(14), f.Node(indexToHash(2)).Weight())
+
+	// On the next slot the boost is removed and the vote decides the head again.
+	f.ResetBoostedProposerRoot(ctx)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r)
+	assert.Equal(t, uint64(0), f.Node(indexToHash(2)).Weight())
+	assert.Equal(t, uint64(10), f.Node(indexToHash(1)).Weight())
+}
+
+// In the ex-ante reorg attack, the proposer of slot 2 withholds its block and attests to it privately,
+// then releases the block and the attestation once the honest block of slot 3 is published, so that
+// the honest block is reorged out by its own parent's sibling.
+func TestProposerBoost_ExAnteReorg(t *testing.T) {
+	ctx := context.Background()
+	balances := equalBalances()
+
+	for _, boost := range []bool{false, true} {
+		f := setup(1, 1)
+
+		//         0
+		//         |
+		//         1 <- slot 1, attested by the honest committee.
+		//        / \
+		//       2   3 <- slot 3, honest block building on 1 as 2 was withheld.
+		//       ^
+		//       slot 2, withheld by the attacker.
+		require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+		f.ProcessAttestation(ctx, []uint64{0, 1}, indexToHash(1), 1)
+		require.NoError(t, f.ProcessBlock(ctx, 3, indexToHash(3), indexToHash(1), [32]byte{}, 1, 1))
+		if boost {
+			f.BoostProposerRoot(ctx, 3, indexToHash(3), genesisTimeFor(3, 0))
+		}
+		r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+		require.NoError(t, err)
+		assert.Equal(t, indexToHash(3), r)
+
+		// The attacker releases block 2 along with its private attestation.
+		require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), indexToHash(1), [32]byte{}, 1, 1))
+		f.ProcessAttestation(ctx, []uint64{2}, indexToHash(2), 1)
+		r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+		require.NoError(t, err)
+		if !boost {
+			// Without the boost the honest block is reorged out.
+			assert.Equal(t, indexToHash(2), r)
+			continue
+		}
+		assert.Equal(t, indexToHash(3), r, "Honest block should not be reorged with the proposer boost")
+
+		// The honest committee of slot 3 attests to block 3, which stays the head once the boost is reset.
+		f.ProcessAttestation(ctx, []uint64{3, 4}, indexToHash(3), 1)
+		f.ResetBoostedProposerRoot(ctx)
+		r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+		require.NoError(t, err)
+		assert.Equal(t, indexToHash(3), r)
+	}
+}
+
+// In the balancing attack, the attacker keeps the honest validators split between two branches of equal
+// weight, releasing withheld votes to tip the balance towards the branch with fewer honest votes.
+func TestProposerBoost_BalancingAttack(t *testing.T) {
+	ctx := context.Background()
+	balances := equalBalances()
+
+	for _, boost := range []bool{false, true} {
+		f := setup(1, 1)
+
+		//         0
+		//        / \
+		//       1   2 <- slot 1, each branch attested by half of the honest committee.
+		//       |
+		//       3 <- slot 2, honest block building on 1.
+		require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+		require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+		f.ProcessAttestation(ctx, []uint64{0, 1, 2, 3}, indexToHash(1), 1)
+		f.ProcessAttestation(ctx, []uint64{4, 5, 6, 7}, indexToHash(2), 1)
+		require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), indexToHash(1), [32]byte{}, 1, 1))
+		if boost {
+			f.BoostProposerRoot(ctx, 2, indexToHash(3), genesisTimeFor(2, 0))
+		}
+
+		// The attacker releases a withheld vote for branch 2 to the honest attesters of slot 2.
+		f.ProcessAttestation(ctx, []uint64{8}, indexToHash(2), 1)
+		r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+		require.NoError(t, err)
+		if !boost {
+			// Without the boost the attacker can keep tipping the balance with a single vote.
+			assert.Equal(t, indexToHash(2), r)
+			continue
+		}
+		assert.Equal(t, indexToHash(3), r, "Timely block should outweigh the withheld vote with the proposer boost")
+
+		// The honest committee of slot 2 attests to block 3, ending the split once the boost is reset.
+		f.ProcessAttestation(ctx, []uint64{9, 10, 11}, indexToHash(3), 1)
+		f.ResetBoostedProposerRoot(ctx)
+		f.ProcessAttestation(ctx, []uint64{12}, indexToHash(2), 1)
+		r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+		require.NoError(t, err)
+		assert.Equal(t, indexToHash(3), r)
+	}
+}
+
+func TestProposerBoost_PersistedBoostIsRemovedAfterRestore(t *testing.T) {
+	ctx := context.Background()
+	balances := equalBalances()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.BoostProposerRoot(ctx, 1, indexToHash(1), genesisTimeFor(1, 0))
+	_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(14), f.Node(indexToHash(1)).Weight())
+
+	restored, err := InitializeFromProto(f.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().ZeroHash, restored.store.proposerBoostRoot)
+	_, err = restored.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), restored.Node(indexToHash(1)).Weight())
+}
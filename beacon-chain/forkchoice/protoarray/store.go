@@ -2,9 +2,11 @@ package protoarray
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"go.opencensus.io/trace"
 )
 
@@ -54,7 +56,7 @@ func (f *ForkChoice) Head(ctx context.Context, justifiedEpoch uint64, justifiedR
 	}
 	f.votes = newVotes
 
-	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
+	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, newBalances, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
 	f.balances = newBalances
@@ -98,6 +100,48 @@ func (f *ForkChoice) ProcessBlock(ctx context.Context, slot uint64, blockRoot, p
 	return f.store.insert(ctx, slot, blockRoot, parentRoot, graffiti, justifiedEpoch, finalizedEpoch)
 }
 
+// BoostProposerRoot boosts the block of the current slot in fork choice if it was received in the first
+// interval of the slot, before the attestation deadline. The boost is applied on the next head computation.
+//
+// Spec code:
+//    # Add proposer score boost if the block is timely
+//    time_into_slot = (store.time - store.genesis_time) % SECONDS_PER_SLOT
+//    is_before_attesting_interval = time_into_slot < SECONDS_PER_SLOT // INTERVALS_PER_SLOT
+//    if get_current_slot(store) == block.slot and is_before_attesting_interval:
+//        store.proposer_boost_root = hash_tree_root(block)
+func (f *ForkChoice) BoostProposerRoot(ctx context.Context, blockSlot uint64, blockRoot [32]byte, genesisTime time.Time) {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.BoostProposerRoot")
+	defer span.End()
+
+	if timeutils.Now().Before(genesisTime) {
+		return
+	}
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	sinceGenesis := uint64(timeutils.Since(genesisTime).Seconds())
+	currentSlot := sinceGenesis / secondsPerSlot
+	timeIntoSlot := sinceGenesis % secondsPerSlot
+	if currentSlot != blockSlot || timeIntoSlot >= secondsPerSlot/params.BeaconConfig().IntervalsPerSlot {
+		return
+	}
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = blockRoot
+}
+
+// ResetBoostedProposerRoot removes the boost of the block boosted in the previous slot, the removal is
+// applied on the next head computation.
+//
+// Spec code:
+//    # Reset store.proposer_boost_root if this is a new slot
+//    if current_slot > previous_slot:
+//        store.proposer_boost_root = Root()
+func (f *ForkChoice) ResetBoostedProposerRoot(ctx context.Context) {
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = [32]byte{}
+}
+
 // Prune prunes the fork choice store with the new finalized root. The store is only pruned if the input
 // root is different than the current store finalized root, and the number of the store has met prune threshold.
 func (f *ForkChoice) Prune(ctx context.Context, finalizedRoot [32]byte) error {
@@ -197,6 +241,46 @@ func (f *ForkChoice) AncestorRoot(ctx context.Context, root [32]byte, slot uint6
 	return f.store.nodes[i].root[:], nil
 }
 
+// CommonAncestorRoot returns the root and slot of the closest common ancestor of the two
+// input block roots. Either root is its own ancestor.
+func (f *ForkChoice) CommonAncestorRoot(ctx context.Context, r1, r2 [32]byte) ([32]byte, uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "protoArray.CommonAncestorRoot")
+	defer span.End()
+
+	f.store.nodesLock.RLock()
+	defer f.store.nodesLock.RUnlock()
+
+	i1, ok := f.store.nodesIndices[r1]
+	if !ok {
+		return [32]byte{}, 0, errors.New("node does not exist")
+	}
+	i2, ok := f.store.nodesIndices[r2]
+	if !ok {
+		return [32]byte{}, 0, errors.New("node does not exist")
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return [32]byte{}, 0, ctx.Err()
+		}
+		if i1 >= uint64(len(f.store.nodes)) || i2 >= uint64(len(f.store.nodes)) {
+			return [32]byte{}, 0, errors.New("node index out of range")
+		}
+		if i1 == i2 {
+			n := f.store.nodes[i1]
+			return n.root, n.slot, nil
+		}
+		// Walk back the branch with the higher slot, or the later inserted node on a tie,
+		// since a parent is always inserted before its children.
+		n1, n2 := f.store.nodes[i1], f.store.nodes[i2]
+		if n1.slot > n2.slot || (n1.slot == n2.slot && i1 > i2) {
+			i1 = n1.parent
+		} else {
+			i2 = n2.parent
+		}
+	}
+}
+
 // PruneThreshold of fork choice store.
 func (s *Store) PruneThreshold() uint64 {
 	return s.pruneThreshold
@@ -12,14 +12,19 @@ type ForkChoice struct {
 
 // Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
 type Store struct {
-	pruneThreshold uint64              // do not prune tree unless threshold is reached.
-	justifiedEpoch uint64              // latest justified epoch in store.
-	finalizedEpoch uint64              // latest finalized epoch in store.
-	finalizedRoot  [32]byte            // latest finalized root in store.
-	nodes          []*Node             // list of block nodes, each node is a representation of one block.
-	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
-	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
-	nodesLock      sync.RWMutex
+	pruneThreshold             uint64              // do not prune tree unless threshold is reached.
+	justifiedEpoch             uint64              // latest justified epoch in store.
+	finalizedEpoch             uint64              // latest finalized epoch in store.
+	finalizedRoot              [32]byte            // latest finalized root in store.
+	justifiedRoot              [32]byte            // justified root of the last head computation.
+	nodes                      []*Node             // list of block nodes, each node is a representation of one block.
+	nodesIndices               map[[32]byte]uint64 // the root of block node and the nodes index in the list.
+	canonicalNodes             map[[32]byte]bool   // the canonical block nodes.
+	proposerBoostRoot          [32]byte            // root of the timely block of the current slot to boost.
+	previousProposerBoostRoot  [32]byte            // root of the block boosted in the last weight update.
+	previousProposerBoostScore uint64              // boost applied to the block boosted in the last weight update.
+	nodesLock                  sync.RWMutex
+	proposerBoostLock          sync.RWMutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
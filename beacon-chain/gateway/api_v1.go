@@ -0,0 +1,490 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/specencoding"
+	"google.golang.org/grpc/status"
+)
+
+// apiV1Prefix is the path prefix under which the standard Eth2 API is served.
+const apiV1Prefix = "/eth/v1/"
+
+// maxRequestBodySize limits the size of the JSON documents accepted by the standard API.
+const maxRequestBodySize = 1 << 22
+
+// apiV1Route is a single standard API endpoint. Path segments enclosed in braces are
+// captured as path parameters.
+type apiV1Route struct {
+	method   string
+	segments []string
+	handler  func(w http.ResponseWriter, r *http.Request, params map[string]string)
+}
+
+// apiV1Handler serves the standard Eth2 beacon node API over HTTP by translating requests
+// into calls to the beacon node's v1 gRPC service, using the standard JSON encoding for
+// requests and responses.
+type apiV1Handler struct {
+	client ethpbv1.BeaconChainClient
+	routes []*apiV1Route
+}
+
+func newAPIV1Handler(client ethpbv1.BeaconChainClient) *apiV1Handler {
+	h := &apiV1Handler{client: client}
+	h.handle(http.MethodGet, "/eth/v1/beacon/genesis", h.getGenesis)
+	h.handle(http.MethodGet, "/eth/v1/beacon/states/{state_id}/root", h.getStateRoot)
+	h.handle(http.MethodGet, "/eth/v1/beacon/states/{state_id}/fork", h.getStateFork)
+	h.handle(http.MethodGet, "/eth/v1/beacon/states/{state_id}/finality_checkpoints", h.getFinalityCheckpoints)
+	h.handle(http.MethodGet, "/eth/v1/beacon/states/{state_id}/validators", h.listValidators)
+	h.handle(http.MethodGet, "/eth/v1/beacon/states/{state_id}/validators/{validator_id}", h.getValidator)
+	h.handle(http.MethodGet, "/eth/v1/beacon/states/{state_id}/validator_balances", h.listValidatorBalances)
+	h.handle(http.MethodGet, "/eth/v1/beacon/states/{state_id}/committees", h.listCommittees)
+	h.handle(http.MethodGet, "/eth/v1/beacon/states/{state_id}/committees/{epoch}", h.listCommittees)
+	h.handle(http.MethodGet, "/eth/v1/beacon/headers", h.listBlockHeaders)
+	h.handle(http.MethodGet, "/eth/v1/beacon/headers/{block_id}", h.getBlockHeader)
+	h.handle(http.MethodPost, "/eth/v1/beacon/blocks", h.submitBlock)
+	h.handle(http.MethodGet, "/eth/v1/beacon/blocks/{block_id}", h.getBlock)
+	h.handle(http.MethodGet, "/eth/v1/beacon/blocks/{block_id}/root", h.getBlockRoot)
+	h.handle(http.MethodGet, "/eth/v1/beacon/blocks/{block_id}/attestations", h.listBlockAttestations)
+	h.handle(http.MethodGet, "/eth/v1/beacon/pool/attestations", h.listPoolAttestations)
+	h.handle(http.MethodPost, "/eth/v1/beacon/pool/attestations", h.submitAttestations)
+	h.handle(http.MethodGet, "/eth/v1/beacon/pool/attester_slashings", h.listPoolAttesterSlashings)
+	h.handle(http.MethodPost, "/eth/v1/beacon/pool/attester_slashings", h.submitAttesterSlashing)
+	h.handle(http.MethodGet, "/eth/v1/beacon/pool/proposer_slashings", h.listPoolProposerSlashings)
+	h.handle(http.MethodPost, "/eth/v1/beacon/pool/proposer_slashings", h.submitProposerSlashing)
+	h.handle(http.MethodGet, "/eth/v1/beacon/pool/voluntary_exits", h.listPoolVoluntaryExits)
+	h.handle(http.MethodPost, "/eth/v1/beacon/pool/voluntary_exits", h.submitVoluntaryExit)
+	h.handle(http.MethodGet, "/eth/v1/config/fork_schedule", h.getForkSchedule)
+	h.handle(http.MethodGet, "/eth/v1/config/spec", h.getSpec)
+	h.handle(http.MethodGet, "/eth/v1/config/deposit_contract", h.getDepositContract)
+	return h
+}
+
+func (h *apiV1Handler) handle(
+	method, path string,
+	handler func(w http.ResponseWriter, r *http.Request, params map[string]string),
+) {
+	h.routes = append(h.routes, &apiV1Route{
+		method:   method,
+		segments: strings.Split(strings.Trim(path, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+// ServeHTTP dispatches the request to the matching route.
+func (h *apiV1Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	methodAllowed := true
+	for _, route := range h.routes {
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+		if route.method != r.Method {
+			methodAllowed = false
+			continue
+		}
+		route.handler(w, r, params)
+		return
+	}
+	if !methodAllowed {
+		writeAPIV1Error(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	writeAPIV1Error(w, http.StatusNotFound, "Endpoint not found")
+}
+
+func (r *apiV1Route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(r.segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, segment := range r.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = segments[i]
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (h *apiV1Handler) getGenesis(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resp, err := h.client.GetGenesis(r.Context(), &ptypes.Empty{})
+	writeAPIV1Response(w, err, func() interface{} { return resp })
+}
+
+func (h *apiV1Handler) getStateRoot(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	stateID, ok := pathID(w, params["state_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.GetStateRoot(r.Context(), &ethpbv1.StateRequest{StateId: stateID})
+	writeAPIV1Response(w, err, func() interface{} {
+		return map[string]interface{}{"root": resp.StateRoot}
+	})
+}
+
+func (h *apiV1Handler) getStateFork(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	stateID, ok := pathID(w, params["state_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.GetStateFork(r.Context(), &ethpbv1.StateRequest{StateId: stateID})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Fork })
+}
+
+func (h *apiV1Handler) getFinalityCheckpoints(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	stateID, ok := pathID(w, params["state_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.GetFinalityCheckpoints(r.Context(), &ethpbv1.StateRequest{StateId: stateID})
+	writeAPIV1Response(w, err, func() interface{} { return resp })
+}
+
+func (h *apiV1Handler) listValidators(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	stateID, ok := pathID(w, params["state_id"])
+	if !ok {
+		return
+	}
+	req := &ethpbv1.StateValidatorsRequest{StateId: stateID, Status: r.URL.Query().Get("status")}
+	for _, id := range queryList(r, "id") {
+		validatorID, ok := pathID(w, id)
+		if !ok {
+			return
+		}
+		req.Id = append(req.Id, validatorID)
+	}
+	resp, err := h.client.ListValidators(r.Context(), req)
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) getValidator(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	stateID, ok := pathID(w, params["state_id"])
+	if !ok {
+		return
+	}
+	validatorID, ok := pathID(w, params["validator_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.GetValidator(r.Context(), &ethpbv1.StateValidatorRequest{
+		StateId:     stateID,
+		ValidatorId: validatorID,
+	})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) listValidatorBalances(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	stateID, ok := pathID(w, params["state_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.ListValidatorBalances(r.Context(), &ethpbv1.ValidatorBalancesRequest{
+		StateId: stateID,
+		Id:      queryList(r, "id"),
+	})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) listCommittees(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	stateID, ok := pathID(w, params["state_id"])
+	if !ok {
+		return
+	}
+	epochParam, ok := params["epoch"]
+	if !ok {
+		epochParam = r.URL.Query().Get("epoch")
+	}
+	req := &ethpbv1.StateCommitteesRequest{StateId: stateID}
+	if !parseUint(w, "epoch", epochParam, &req.Epoch) ||
+		!parseUint(w, "index", r.URL.Query().Get("index"), &req.Index) ||
+		!parseUint(w, "slot", r.URL.Query().Get("slot"), &req.Slot) {
+		return
+	}
+	resp, err := h.client.ListCommittees(r.Context(), req)
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) listBlockHeaders(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	req := &ethpbv1.BlockHeadersRequest{}
+	if !parseUint(w, "slot", r.URL.Query().Get("slot"), &req.Slot) {
+		return
+	}
+	if parentRoot := r.URL.Query().Get("parent_root"); parentRoot != "" {
+		root, err := specencoding.DecodeHex(parentRoot)
+		if err != nil {
+			writeAPIV1Error(w, http.StatusBadRequest, fmt.Sprintf("Invalid parent root: %v", err))
+			return
+		}
+		req.ParentRoot = root
+	}
+	resp, err := h.client.ListBlockHeaders(r.Context(), req)
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) getBlockHeader(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	blockID, ok := pathID(w, params["block_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.GetBlockHeader(r.Context(), &ethpbv1.BlockRequest{BlockId: blockID})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) submitBlock(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	req := &ethpbv1.BeaconBlockContainer{}
+	if !readAPIV1Body(w, r, req) {
+		return
+	}
+	_, err := h.client.SubmitBlock(r.Context(), req)
+	writeAPIV1Empty(w, err)
+}
+
+func (h *apiV1Handler) getBlock(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	blockID, ok := pathID(w, params["block_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.GetBlock(r.Context(), &ethpbv1.BlockRequest{BlockId: blockID})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) getBlockRoot(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	blockID, ok := pathID(w, params["block_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.GetBlockRoot(r.Context(), &ethpbv1.BlockRequest{BlockId: blockID})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) listBlockAttestations(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	blockID, ok := pathID(w, params["block_id"])
+	if !ok {
+		return
+	}
+	resp, err := h.client.ListBlockAttestations(r.Context(), &ethpbv1.BlockRequest{BlockId: blockID})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) listPoolAttestations(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	req := &ethpbv1.AttestationsPoolRequest{}
+	if !parseUint(w, "slot", r.URL.Query().Get("slot"), &req.Slot) ||
+		!parseUint(w, "committee_index", r.URL.Query().Get("committee_index"), &req.CommitteeIndex) {
+		return
+	}
+	resp, err := h.client.ListPoolAttestations(r.Context(), req)
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+// submitAttestations accepts either a single attestation or a list of attestations. Every
+// attestation is submitted even if an earlier one fails, failures are reported by index.
+func (h *apiV1Handler) submitAttestations(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	body, ok := readAPIV1RawBody(w, r)
+	if !ok {
+		return
+	}
+	var atts []*ethpbv1.Attestation
+	if strings.HasPrefix(strings.TrimSpace(string(body)), "[") {
+		if err := specencoding.Decode(body, &atts); err != nil {
+			writeAPIV1Error(w, http.StatusBadRequest, fmt.Sprintf("Could not decode request body: %v", err))
+			return
+		}
+	} else {
+		att := &ethpbv1.Attestation{}
+		if err := specencoding.Decode(body, att); err != nil {
+			writeAPIV1Error(w, http.StatusBadRequest, fmt.Sprintf("Could not decode request body: %v", err))
+			return
+		}
+		atts = append(atts, att)
+	}
+
+	type failure struct {
+		Index   int    `json:"index"`
+		Message string `json:"message"`
+	}
+	var failures []failure
+	for i, att := range atts {
+		if _, err := h.client.SubmitAttestation(r.Context(), att); err != nil {
+			failures = append(failures, failure{Index: i, Message: status.Convert(err).Message()})
+		}
+	}
+	if len(failures) > 0 {
+		writeAPIV1JSON(w, http.StatusBadRequest, map[string]interface{}{
+			"code":     http.StatusBadRequest,
+			"message":  "Some attestations could not be submitted",
+			"failures": failures,
+		})
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *apiV1Handler) listPoolAttesterSlashings(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resp, err := h.client.ListPoolAttesterSlashings(r.Context(), &ptypes.Empty{})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) submitAttesterSlashing(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	req := &ethpbv1.AttesterSlashing{}
+	if !readAPIV1Body(w, r, req) {
+		return
+	}
+	_, err := h.client.SubmitAttesterSlashing(r.Context(), req)
+	writeAPIV1Empty(w, err)
+}
+
+func (h *apiV1Handler) listPoolProposerSlashings(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resp, err := h.client.ListPoolProposerSlashings(r.Context(), &ptypes.Empty{})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) submitProposerSlashing(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	req := &ethpbv1.ProposerSlashing{}
+	if !readAPIV1Body(w, r, req) {
+		return
+	}
+	_, err := h.client.SubmitProposerSlashing(r.Context(), req)
+	writeAPIV1Empty(w, err)
+}
+
+func (h *apiV1Handler) listPoolVoluntaryExits(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resp, err := h.client.ListPoolVoluntaryExits(r.Context(), &ptypes.Empty{})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) submitVoluntaryExit(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	req := &ethpbv1.SignedVoluntaryExit{}
+	if !readAPIV1Body(w, r, req) {
+		return
+	}
+	_, err := h.client.SubmitVoluntaryExit(r.Context(), req)
+	writeAPIV1Empty(w, err)
+}
+
+func (h *apiV1Handler) getForkSchedule(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resp, err := h.client.GetForkSchedule(r.Context(), &ptypes.Empty{})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) getSpec(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resp, err := h.client.GetSpec(r.Context(), &ptypes.Empty{})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+func (h *apiV1Handler) getDepositContract(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	resp, err := h.client.GetDepositContract(r.Context(), &ptypes.Empty{})
+	writeAPIV1Response(w, err, func() interface{} { return resp.Data })
+}
+
+// pathID decodes an identifier given in the request path or query. Hex encoded roots and
+// public keys are passed to the gRPC service as raw bytes, anything else as is.
+func pathID(w http.ResponseWriter, id string) ([]byte, bool) {
+	if !strings.HasPrefix(id, "0x") {
+		return []byte(id), true
+	}
+	b, err := specencoding.DecodeHex(id)
+	if err != nil {
+		writeAPIV1Error(w, http.StatusBadRequest, fmt.Sprintf("Invalid identifier %q: %v", id, err))
+		return nil, false
+	}
+	return b, true
+}
+
+// queryList returns the values of a query parameter given either repeatedly or as a
+// comma separated list.
+func queryList(r *http.Request, key string) []string {
+	var values []string
+	for _, value := range r.URL.Query()[key] {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
+
+func parseUint(w http.ResponseWriter, name, value string, dst *uint64) bool {
+	if value == "" {
+		return true
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		writeAPIV1Error(w, http.StatusBadRequest, fmt.Sprintf("Invalid %s %q", name, value))
+		return false
+	}
+	*dst = n
+	return true
+}
+
+func readAPIV1RawBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	if err != nil {
+		writeAPIV1Error(w, http.StatusBadRequest, fmt.Sprintf("Could not read request body: %v", err))
+		return nil, false
+	}
+	return body, true
+}
+
+func readAPIV1Body(w http.ResponseWriter, r *http.Request, msg interface{}) bool {
+	body, ok := readAPIV1RawBody(w, r)
+	if !ok {
+		return false
+	}
+	if err := specencoding.Decode(body, msg); err != nil {
+		writeAPIV1Error(w, http.StatusBadRequest, fmt.Sprintf("Could not decode request body: %v", err))
+		return false
+	}
+	return true
+}
+
+// writeAPIV1Response writes the data returned by the data function wrapped in the standard
+// response envelope, or the error if the gRPC call failed.
+func writeAPIV1Response(w http.ResponseWriter, err error, data func() interface{}) {
+	if err != nil {
+		writeAPIV1GRPCError(w, err)
+		return
+	}
+	writeAPIV1JSON(w, http.StatusOK, map[string]interface{}{"data": specencoding.Encode(data())})
+}
+
+func writeAPIV1Empty(w http.ResponseWriter, err error) {
+	if err != nil {
+		writeAPIV1GRPCError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func writeAPIV1GRPCError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeAPIV1Error(w, gwruntime.HTTPStatusFromCode(st.Code()), st.Message())
+}
+
+func writeAPIV1Error(w http.ResponseWriter, code int, message string) {
+	writeAPIV1JSON(w, code, map[string]interface{}{
+		"code":    code,
+		"message": message,
+	})
+}
+
+func writeAPIV1JSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("Could not write standard API response")
+	}
+}
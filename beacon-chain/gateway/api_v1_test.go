@@ -0,0 +1,210 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockBeaconChainClient struct {
+	ethpbv1.BeaconChainClient
+	validatorsReq  *ethpbv1.StateValidatorsRequest
+	blockReq       *ethpbv1.BlockRequest
+	submittedAtts  []*ethpbv1.Attestation
+	submittedBlock *ethpbv1.BeaconBlockContainer
+}
+
+func (m *mockBeaconChainClient) GetGenesis(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpbv1.GenesisResponse, error) {
+	return &ethpbv1.GenesisResponse{
+		GenesisTime:           &ptypes.Timestamp{Seconds: 1606824000},
+		GenesisValidatorsRoot: bytesutil.PadTo([]byte{0x4b, 0x36}, 32),
+		GenesisForkVersion:    []byte{0, 0, 0, 0},
+	}, nil
+}
+
+func (m *mockBeaconChainClient) ListValidators(_ context.Context, req *ethpbv1.StateValidatorsRequest, _ ...grpc.CallOption) (*ethpbv1.StateValidatorsResponse, error) {
+	m.validatorsReq = req
+	return &ethpbv1.StateValidatorsResponse{
+		Data: []*ethpbv1.ValidatorContainer{
+			{
+				Index:   12,
+				Balance: 32000000000,
+				Status:  "active_ongoing",
+				Validator: &ethpbv1.Validator{
+					PublicKey:         bytesutil.PadTo([]byte{0xaa}, 48),
+					ExitEpoch:         18446744073709551615,
+					WithdrawableEpoch: 18446744073709551615,
+				},
+			},
+		},
+	}, nil
+}
+
+func (m *mockBeaconChainClient) GetBlockRoot(_ context.Context, req *ethpbv1.BlockRequest, _ ...grpc.CallOption) (*ethpbv1.BlockRootResponse, error) {
+	m.blockReq = req
+	return nil, status.Error(codes.NotFound, "Could not find any blocks with given slot")
+}
+
+func (m *mockBeaconChainClient) SubmitBlock(_ context.Context, req *ethpbv1.BeaconBlockContainer, _ ...grpc.CallOption) (*ptypes.Empty, error) {
+	m.submittedBlock = req
+	return &ptypes.Empty{}, nil
+}
+
+func (m *mockBeaconChainClient) SubmitAttestation(_ context.Context, req *ethpbv1.Attestation, _ ...grpc.CallOption) (*ptypes.Empty, error) {
+	m.submittedAtts = append(m.submittedAtts, req)
+	if req.Data.Slot == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Incorrect attestation signature")
+	}
+	return &ptypes.Empty{}, nil
+}
+
+func serveAPIV1(t *testing.T, h http.Handler, method, target, body string) (int, map[string]interface{}) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Body.Len() == 0 {
+		return rec.Code, nil
+	}
+	var resp map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return rec.Code, resp
+}
+
+func TestAPIV1Handler_GetGenesis(t *testing.T) {
+	h := newAPIV1Handler(&mockBeaconChainClient{})
+	code, resp := serveAPIV1(t, h, http.MethodGet, "/eth/v1/beacon/genesis", "")
+	require.Equal(t, http.StatusOK, code)
+	data, ok := resp["data"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "1606824000", data["genesis_time"])
+	assert.Equal(t, "0x4b36000000000000000000000000000000000000000000000000000000000000", data["genesis_validators_root"])
+	assert.Equal(t, "0x00000000", data["genesis_fork_version"])
+}
+
+func TestAPIV1Handler_ListValidators(t *testing.T) {
+	client := &mockBeaconChainClient{}
+	h := newAPIV1Handler(client)
+	code, resp := serveAPIV1(t, h, http.MethodGet, "/eth/v1/beacon/states/head/validators?id=12,0xaabb&status=active", "")
+	require.Equal(t, http.StatusOK, code)
+
+	assert.DeepEqual(t, []byte("head"), client.validatorsReq.StateId)
+	assert.DeepEqual(t, [][]byte{[]byte("12"), {0xaa, 0xbb}}, client.validatorsReq.Id)
+	assert.Equal(t, "active", client.validatorsReq.Status)
+
+	data, ok := resp["data"].([]interface{})
+	require.Equal(t, true, ok)
+	require.Equal(t, 1, len(data))
+	container := data[0].(map[string]interface{})
+	assert.Equal(t, "12", container["index"])
+	assert.Equal(t, "32000000000", container["balance"])
+	assert.Equal(t, "active_ongoing", container["status"])
+	validator := container["validator"].(map[string]interface{})
+	assert.Equal(t, "18446744073709551615", validator["exit_epoch"])
+	pubkey, ok := validator["pubkey"].(string)
+	require.Equal(t, true, ok, "Expected spec field name pubkey")
+	assert.Equal(t, true, strings.HasPrefix(pubkey, "0xaa00"))
+}
+
+func TestAPIV1Handler_Errors(t *testing.T) {
+	client := &mockBeaconChainClient{}
+	h := newAPIV1Handler(client)
+
+	code, resp := serveAPIV1(t, h, http.MethodGet, "/eth/v1/beacon/blocks/0x0102/root", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "Could not find any blocks with given slot", resp["message"])
+	assert.DeepEqual(t, []byte{1, 2}, client.blockReq.BlockId)
+
+	code, _ = serveAPIV1(t, h, http.MethodGet, "/eth/v1/beacon/blocks/0xzz/root", "")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serveAPIV1(t, h, http.MethodGet, "/eth/v1/beacon/unknown", "")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = serveAPIV1(t, h, http.MethodPost, "/eth/v1/beacon/genesis", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+
+	code, _ = serveAPIV1(t, h, http.MethodGet, "/eth/v1/beacon/pool/attestations?slot=foo", "")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestAPIV1Handler_SubmitBlock(t *testing.T) {
+	client := &mockBeaconChainClient{}
+	h := newAPIV1Handler(client)
+	body := `{
+		"message": {
+			"slot": "5",
+			"proposer_index": "3",
+			"parent_root": "0x0102",
+			"state_root": "0x0304",
+			"body": {
+				"randao_reveal": "0x05",
+				"eth1_data": {"deposit_root": "0x06", "deposit_count": "7", "block_hash": "0x08"},
+				"graffiti": "0x09",
+				"proposer_slashings": [],
+				"attester_slashings": [],
+				"attestations": [],
+				"deposits": [],
+				"voluntary_exits": [{"message": {"epoch": "1", "validator_index": "2"}, "signature": "0x0a"}]
+			}
+		},
+		"signature": "0x0b"
+	}`
+	code, _ := serveAPIV1(t, h, http.MethodPost, "/eth/v1/beacon/blocks", body)
+	require.Equal(t, http.StatusOK, code)
+
+	blk := client.submittedBlock
+	require.NotNil(t, blk)
+	assert.Equal(t, uint64(5), blk.Message.Slot)
+	assert.Equal(t, uint64(3), blk.Message.ProposerIndex)
+	assert.DeepEqual(t, []byte{1, 2}, blk.Message.ParentRoot)
+	assert.Equal(t, uint64(7), blk.Message.Body.Eth1Data.DepositCount)
+	require.Equal(t, 1, len(blk.Message.Body.VoluntaryExits))
+	assert.Equal(t, uint64(2), blk.Message.Body.VoluntaryExits[0].Exit.ValidatorIndex)
+	assert.DeepEqual(t, []byte{0x0b}, blk.Signature)
+
+	code, resp := serveAPIV1(t, h, http.MethodPost, "/eth/v1/beacon/blocks", `{"message": {"slot": "foo"}}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, true, strings.Contains(resp["message"].(string), "message.slot"))
+}
+
+func TestAPIV1Handler_SubmitAttestations(t *testing.T) {
+	client := &mockBeaconChainClient{}
+	h := newAPIV1Handler(client)
+	att := `{
+		"aggregation_bits": "0x0d",
+		"signature": "0x01",
+		"data": {
+			"slot": "%s",
+			"index": "1",
+			"beacon_block_root": "0x02",
+			"source": {"epoch": "0", "root": "0x03"},
+			"target": {"epoch": "0", "root": "0x04"}
+		}
+	}`
+
+	code, _ := serveAPIV1(t, h, http.MethodPost, "/eth/v1/beacon/pool/attestations", strings.Replace(att, "%s", "1", 1))
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 1, len(client.submittedAtts))
+	assert.DeepEqual(t, bitfield.Bitlist{0x0d}, client.submittedAtts[0].AggregationBits)
+
+	body := "[" + strings.Replace(att, "%s", "0", 1) + "," + strings.Replace(att, "%s", "2", 1) + "]"
+	code, resp := serveAPIV1(t, h, http.MethodPost, "/eth/v1/beacon/pool/attestations", body)
+	require.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, 3, len(client.submittedAtts), "Expected every attestation to be submitted")
+	failures := resp["failures"].([]interface{})
+	require.Equal(t, 1, len(failures))
+	assert.Equal(t, float64(0), failures[0].(map[string]interface{})["index"])
+}
@@ -0,0 +1,308 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/specencoding"
+)
+
+// EventsPath is the path of the standard API server-sent events stream.
+const EventsPath = apiV1Prefix + "events"
+
+const (
+	headTopic                = "head"
+	blockTopic               = "block"
+	attestationTopic         = "attestation"
+	voluntaryExitTopic       = "voluntary_exit"
+	finalizedCheckpointTopic = "finalized_checkpoint"
+	chainReorgTopic          = "chain_reorg"
+)
+
+// eventTopics maps each topic to whether its events are read from the operation feed,
+// rather than the state feed.
+var eventTopics = map[string]bool{
+	headTopic:                false,
+	blockTopic:               false,
+	attestationTopic:         true,
+	voluntaryExitTopic:       true,
+	finalizedCheckpointTopic: false,
+	chainReorgTopic:          false,
+}
+
+// eventsBufferSize is the number of events queued for a client before it is considered too
+// slow and disconnected, so a slow client never holds up the feeds.
+const eventsBufferSize = 64
+
+type streamEvent struct {
+	topic string
+	data  interface{}
+}
+
+type headEvent struct {
+	Slot            string `json:"slot"`
+	Block           string `json:"block"`
+	State           string `json:"state"`
+	EpochTransition bool   `json:"epoch_transition"`
+}
+
+type blockEvent struct {
+	Slot  string `json:"slot"`
+	Block string `json:"block"`
+}
+
+type finalizedCheckpointEvent struct {
+	Block string `json:"block"`
+	State string `json:"state"`
+	Epoch string `json:"epoch"`
+}
+
+type chainReorgEvent struct {
+	Slot         string `json:"slot"`
+	Depth        string `json:"depth"`
+	OldHeadBlock string `json:"old_head_block"`
+	NewHeadBlock string `json:"new_head_block"`
+	OldHeadState string `json:"old_head_state"`
+	NewHeadState string `json:"new_head_state"`
+	Epoch        string `json:"epoch"`
+}
+
+// EventsHandler serves the standard API server-sent events stream. Clients pick the
+// events they receive with the topics query parameter, for example
+// /eth/v1/events?topics=head,chain_reorg. Events are read from the beacon node's state
+// and operation feeds, so the handler must run in the beacon node process.
+type EventsHandler struct {
+	stateNotifier     statefeed.Notifier
+	operationNotifier operation.Notifier
+}
+
+// NewEventsHandler returns a handler streaming events published on the given feeds.
+func NewEventsHandler(stateNotifier statefeed.Notifier, operationNotifier operation.Notifier) *EventsHandler {
+	return &EventsHandler{
+		stateNotifier:     stateNotifier,
+		operationNotifier: operationNotifier,
+	}
+}
+
+// ServeHTTP streams the requested topics until the client disconnects.
+func (h *EventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIV1Error(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s not allowed", r.Method))
+		return
+	}
+	topics := make(map[string]bool)
+	for _, topic := range queryList(r, "topics") {
+		if _, ok := eventTopics[topic]; !ok {
+			writeAPIV1Error(w, http.StatusBadRequest, fmt.Sprintf("Invalid topic: %s", topic))
+			return
+		}
+		topics[topic] = true
+	}
+	if len(topics) == 0 {
+		writeAPIV1Error(w, http.StatusBadRequest, "No topics specified")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeAPIV1Error(w, http.StatusInternalServerError, "Streaming is not supported")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	// The events are read from the feeds in their own goroutine and queued for the client,
+	// the queue is closed once the client should be disconnected.
+	events := make(chan *streamEvent, eventsBufferSize)
+	stateSub, opsSub := h.subscribe(topics)
+	go func() {
+		defer close(events)
+		receiveEvents(ctx, topics, stateSub, opsSub, events)
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for e := range events {
+		if err := writeEvent(w, e.topic, e.data); err != nil {
+			log.WithError(err).Debug("Could not write event")
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// eventSubscription is a subscription to one of the feeds read by the events stream, nil if
+// none of the requested topics are read from the feed.
+type eventSubscription struct {
+	channel chan *feed.Event
+	sub     event.Subscription
+}
+
+func newEventSubscription(f *event.Feed) *eventSubscription {
+	s := &eventSubscription{channel: make(chan *feed.Event, 1)}
+	s.sub = f.Subscribe(s.channel)
+	return s
+}
+
+func (s *eventSubscription) events() <-chan *feed.Event {
+	if s == nil {
+		return nil
+	}
+	return s.channel
+}
+
+func (s *eventSubscription) err() <-chan error {
+	if s == nil {
+		return nil
+	}
+	return s.sub.Err()
+}
+
+func (s *eventSubscription) unsubscribe() {
+	if s != nil {
+		s.sub.Unsubscribe()
+	}
+}
+
+// subscribe subscribes to the feeds the requested topics are read from.
+func (h *EventsHandler) subscribe(topics map[string]bool) (stateSub, opsSub *eventSubscription) {
+	for topic := range topics {
+		if eventTopics[topic] {
+			if opsSub == nil {
+				opsSub = newEventSubscription(h.operationNotifier.OperationFeed())
+			}
+		} else if stateSub == nil {
+			stateSub = newEventSubscription(h.stateNotifier.StateFeed())
+		}
+	}
+	return stateSub, opsSub
+}
+
+// receiveEvents queues the events of the requested topics until the context is done, a
+// subscription fails or the client does not keep up with the events.
+func receiveEvents(ctx context.Context, topics map[string]bool, stateSub, opsSub *eventSubscription, events chan<- *streamEvent) {
+	defer stateSub.unsubscribe()
+	defer opsSub.unsubscribe()
+	for {
+		var topic string
+		var data interface{}
+		select {
+		case e := <-stateSub.events():
+			topic, data = stateEventData(e)
+		case e := <-opsSub.events():
+			topic, data = operationEventData(e)
+		case <-stateSub.err():
+			return
+		case <-opsSub.err():
+			return
+		case <-ctx.Done():
+			return
+		}
+		if data == nil || !topics[topic] {
+			continue
+		}
+		select {
+		case events <- &streamEvent{topic: topic, data: data}:
+		default:
+			log.WithField("topic", topic).Debug("Events client is too slow, disconnecting")
+			return
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, topic string, data interface{}) error {
+	enc, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", topic, enc)
+	return err
+}
+
+// stateEventData returns the topic and standard API payload of a state feed event, or a
+// nil payload if the event is not exposed.
+func stateEventData(event *feed.Event) (string, interface{}) {
+	switch data := event.Data.(type) {
+	case *statefeed.NewHeadData:
+		return headTopic, &headEvent{
+			Slot:            strconv.FormatUint(data.Slot, 10),
+			Block:           fmt.Sprintf("%#x", data.BlockRoot),
+			State:           fmt.Sprintf("%#x", data.StateRoot),
+			EpochTransition: data.EpochTransition,
+		}
+	case *statefeed.BlockProcessedData:
+		return blockTopic, &blockEvent{
+			Slot:  strconv.FormatUint(data.Slot, 10),
+			Block: fmt.Sprintf("%#x", data.BlockRoot),
+		}
+	case *statefeed.FinalizedCheckpointData:
+		return finalizedCheckpointTopic, &finalizedCheckpointEvent{
+			Block: fmt.Sprintf("%#x", data.BlockRoot),
+			State: fmt.Sprintf("%#x", data.StateRoot),
+			Epoch: strconv.FormatUint(data.Epoch, 10),
+		}
+	case *statefeed.ReorgData:
+		return chainReorgTopic, &chainReorgEvent{
+			Slot:         strconv.FormatUint(data.NewSlot, 10),
+			Depth:        strconv.FormatUint(data.Depth, 10),
+			OldHeadBlock: fmt.Sprintf("%#x", data.OldHeadBlock),
+			NewHeadBlock: fmt.Sprintf("%#x", data.NewHeadBlock),
+			OldHeadState: fmt.Sprintf("%#x", data.OldHeadState),
+			NewHeadState: fmt.Sprintf("%#x", data.NewHeadState),
+			Epoch:        strconv.FormatUint(helpers.SlotToEpoch(data.NewSlot), 10),
+		}
+	default:
+		return "", nil
+	}
+}
+
+// operationEventData returns the topic and standard API payload of an operation feed
+// event, or a nil payload if the event is not exposed.
+func operationEventData(event *feed.Event) (string, interface{}) {
+	switch data := event.Data.(type) {
+	case *operation.UnAggregatedAttReceivedData:
+		return attestationTopic, attestationEventData(data.Attestation)
+	case *operation.AggregatedAttReceivedData:
+		if data.Attestation == nil {
+			return "", nil
+		}
+		return attestationTopic, attestationEventData(data.Attestation.Aggregate)
+	case *operation.ExitReceivedData:
+		if data.Exit == nil {
+			return "", nil
+		}
+		exit, err := migration.V1Alpha1ExitToV1(data.Exit)
+		if err != nil {
+			log.WithError(err).Debug("Could not convert voluntary exit")
+			return "", nil
+		}
+		return voluntaryExitTopic, specencoding.Encode(exit)
+	default:
+		return "", nil
+	}
+}
+
+func attestationEventData(att *ethpb.Attestation) interface{} {
+	if att == nil {
+		return nil
+	}
+	v1Att, err := migration.V1Alpha1AttestationToV1(att)
+	if err != nil {
+		log.WithError(err).Debug("Could not convert attestation")
+		return nil
+	}
+	return specencoding.Encode(v1Att)
+}
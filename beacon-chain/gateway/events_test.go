@@ -0,0 +1,181 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockNotifier struct {
+	stateFeed     event.Feed
+	operationFeed event.Feed
+}
+
+func (m *mockNotifier) StateFeed() *event.Feed {
+	return &m.stateFeed
+}
+
+func (m *mockNotifier) OperationFeed() *event.Feed {
+	return &m.operationFeed
+}
+
+// sendUntilReceived repeatedly sends the event until a subscriber picks it up, as the
+// handler subscribes to the feed asynchronously from the client's point of view.
+func sendUntilReceived(t *testing.T, f *event.Feed, e *feed.Event) {
+	for i := 0; i < 100; i++ {
+		if f.Send(e) > 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("No subscriber received the event")
+}
+
+func readEvent(t *testing.T, r *bufio.Reader) (string, map[string]interface{}) {
+	var topic string
+	var data map[string]interface{}
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			topic = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &data))
+		case line == "":
+			return topic, data
+		}
+	}
+}
+
+func TestEventsHandler_InvalidRequests(t *testing.T) {
+	h := NewEventsHandler(&mockNotifier{}, &mockNotifier{})
+
+	code, _ := serveAPIV1(t, h, http.MethodGet, EventsPath, "")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, resp := serveAPIV1(t, h, http.MethodGet, EventsPath+"?topics=head,foo", "")
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "Invalid topic: foo", resp["message"])
+
+	code, _ = serveAPIV1(t, h, http.MethodPost, EventsPath+"?topics=head", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+}
+
+func TestEventsHandler_StreamsRequestedTopics(t *testing.T) {
+	notifier := &mockNotifier{}
+	srv := httptest.NewServer(NewEventsHandler(notifier, notifier))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + EventsPath + "?topics=chain_reorg&topics=attestation")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	r := bufio.NewReader(resp.Body)
+
+	// Head events are not requested and must be skipped.
+	sendUntilReceived(t, &notifier.stateFeed, &feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{Slot: 5},
+	})
+	sendUntilReceived(t, &notifier.stateFeed, &feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{
+			NewSlot:      40,
+			OldSlot:      41,
+			Depth:        3,
+			OldHeadBlock: [32]byte{'a'},
+			NewHeadBlock: [32]byte{'b'},
+		},
+	})
+	topic, data := readEvent(t, r)
+	assert.Equal(t, chainReorgTopic, topic)
+	assert.Equal(t, "40", data["slot"])
+	assert.Equal(t, "3", data["depth"])
+	assert.Equal(t, "1", data["epoch"])
+	assert.Equal(t, "0x6100000000000000000000000000000000000000000000000000000000000000", data["old_head_block"])
+	assert.Equal(t, "0x6200000000000000000000000000000000000000000000000000000000000000", data["new_head_block"])
+
+	att := testutil.NewAttestation()
+	att.Data.Slot = 7
+	sendUntilReceived(t, &notifier.operationFeed, &feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{Attestation: att},
+	})
+	topic, data = readEvent(t, r)
+	assert.Equal(t, attestationTopic, topic)
+	attData, ok := data["data"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "7", attData["slot"])
+}
+
+func TestEventsHandler_SubscribesToRequestedFeeds(t *testing.T) {
+	notifier := &mockNotifier{}
+	srv := httptest.NewServer(NewEventsHandler(notifier, notifier))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + EventsPath + "?topics=head")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The stream is opened once the handler subscribed to the feeds.
+	assert.Equal(t, 1, notifier.stateFeed.Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{Slot: 5},
+	}))
+	assert.Equal(t, 0, notifier.operationFeed.Send(&feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{Attestation: testutil.NewAttestation()},
+	}))
+	topic, data := readEvent(t, bufio.NewReader(resp.Body))
+	assert.Equal(t, headTopic, topic)
+	assert.Equal(t, "5", data["slot"])
+}
+
+func TestReceiveEvents_DisconnectsSlowClient(t *testing.T) {
+	notifier := &mockNotifier{}
+	topics := map[string]bool{headTopic: true}
+	stateSub, opsSub := NewEventsHandler(notifier, notifier).subscribe(topics)
+	events := make(chan *streamEvent, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		receiveEvents(context.Background(), topics, stateSub, opsSub, events)
+	}()
+
+	// The client does not read the events, the second one does not fit in its queue.
+	for i := 0; i < 2; i++ {
+		notifier.stateFeed.Send(&feed.Event{
+			Type: statefeed.NewHead,
+			Data: &statefeed.NewHeadData{Slot: uint64(i)},
+		})
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Slow client was not disconnected")
+	}
+	assert.Equal(t, 1, len(events))
+	// The subscriptions are released with the client.
+	assert.Equal(t, 0, notifier.stateFeed.Send(&feed.Event{Type: statefeed.NewHead, Data: &statefeed.NewHeadData{}}))
+}
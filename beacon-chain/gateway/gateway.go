@@ -10,6 +10,7 @@ import (
 	"time"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -63,6 +64,9 @@ func (g *Gateway) Start() {
 		ethpb.RegisterBeaconChainHandler,
 		ethpb.RegisterBeaconNodeValidatorHandler,
 		pbrpc.RegisterHealthHandler,
+		pbrpc.RegisterLivenessHandler,
+		pbrpc.RegisterPeerManagerHandler,
+		pbrpc.RegisterRewardsHandler,
 	}
 	if g.enableDebugRPCEndpoints {
 		handlers = append(handlers, pbrpc.RegisterDebugHandler)
@@ -76,6 +80,7 @@ func (g *Gateway) Start() {
 	}
 
 	g.mux.Handle("/", gwmux)
+	g.mux.Handle(apiV1Prefix, newAPIV1Handler(ethpbv1.NewBeaconChainClient(conn)))
 
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
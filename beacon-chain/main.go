@@ -29,6 +29,7 @@ import (
 var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.FallbackWeb3ProviderFlag,
 	flags.RPCHost,
 	flags.RPCPort,
 	flags.CertFlag,
@@ -42,6 +43,10 @@ var appFlags = []cli.Flag{
 	flags.SetGCPercent,
 	flags.HeadSync,
 	flags.DisableSync,
+	flags.EnableBackfill,
+	flags.BackfillBatchSize,
+	flags.BackfillBatchInterval,
+	flags.MonitorValidatorsFlag,
 	flags.DisableDiscv5,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
@@ -50,13 +55,29 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.ArchiveStateDiffs,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
+	flags.CheckpointBlockFlag,
+	flags.CheckpointStateFlag,
+	flags.CheckpointEpochFlag,
+	flags.CheckpointGenesisStateFlag,
+	flags.CheckpointSyncProviderFlag,
+	flags.CheckpointSyncProviderCertFlag,
 	flags.Eth1HeaderReqLimit,
+	flags.ForkChoiceDumpReorgDepth,
+	flags.ForkChoiceDumpDir,
+	flags.ExportFile,
+	flags.ExportSocket,
+	flags.ExportKafkaURL,
+	flags.ExportCursorFile,
+	flags.ExportStartEpochFlag,
+	flags.EnableSlasherFlag,
+	flags.SlasherDirFlag,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -65,6 +86,7 @@ var appFlags = []cli.Flag{
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
+	cmd.TrustedPeers,
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
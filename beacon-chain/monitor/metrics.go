@@ -0,0 +1,89 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	attestedGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "attested",
+			Help:      "1 if the tracked validator attestation of the previous epoch was included in the chain",
+		},
+		[]string{"validator_index"},
+	)
+	inclusionDistanceGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "inclusion_distance",
+			Help:      "The inclusion distance of the tracked validator attestation of the previous epoch",
+		},
+		[]string{"validator_index"},
+	)
+	correctlyVotedSourceGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "correctly_voted_source",
+			Help:      "1 if the tracked validator voted for the correct source in the previous epoch",
+		},
+		[]string{"validator_index"},
+	)
+	correctlyVotedTargetGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "correctly_voted_target",
+			Help:      "1 if the tracked validator voted for the correct target in the previous epoch",
+		},
+		[]string{"validator_index"},
+	)
+	correctlyVotedHeadGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "correctly_voted_head",
+			Help:      "1 if the tracked validator voted for the correct head in the previous epoch",
+		},
+		[]string{"validator_index"},
+	)
+	balanceGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "balance_gwei",
+			Help:      "The balance of the tracked validator at the end of the epoch",
+		},
+		[]string{"validator_index"},
+	)
+	balanceChangeGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "balance_change_gwei",
+			Help:      "The balance change of the tracked validator over the last epoch",
+		},
+		[]string{"validator_index"},
+	)
+	statusGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "status",
+			Help:      "The tracked validator status: 0 UNKNOWN, 2 PENDING, 3 ACTIVE, 4 EXITING, 5 SLASHING, 6 EXITED",
+		},
+		[]string{"validator_index"},
+	)
+	proposedBlocksCounterVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "proposed_blocks_total",
+			Help:      "The number of blocks proposed by the tracked validator processed by the beacon node",
+		},
+		[]string{"validator_index"},
+	)
+	includedAttestationsCounterVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "included_attestations_total",
+			Help:      "The number of attestations of the tracked validator included in blocks processed by the beacon node",
+		},
+		[]string{"validator_index"},
+	)
+)
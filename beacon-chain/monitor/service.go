@@ -0,0 +1,339 @@
+// Package monitor tracks the duties of a set of validators from the blocks processed by the beacon
+// node, logging and exporting as Prometheus metrics their proposals, included attestations and a
+// per-epoch summary of their attestation performance, balance and status.
+package monitor
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// Config to set up the validator monitor.
+type Config struct {
+	Indices       []uint64
+	PublicKeys    [][48]byte
+	BeaconDB      db.ReadOnlyDatabase
+	StateGen      *stategen.State
+	StateNotifier statefeed.Notifier
+	SyncChecker   sync.Checker
+}
+
+// Service tracks the validators given by index or public key.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	// tracked is the set of tracked validator indices.
+	tracked map[uint64]bool
+	// pending are the public keys of the tracked validators not yet in the registry.
+	pending [][48]byte
+	// balances are the balances of the tracked validators at the end of the last summarized epoch.
+	balances map[uint64]uint64
+	// lastEpoch is the epoch of the last processed block.
+	lastEpoch uint64
+	started   bool
+}
+
+// NewService configures the validator monitor.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	tracked := make(map[uint64]bool, len(cfg.Indices))
+	for _, idx := range cfg.Indices {
+		tracked[idx] = true
+	}
+	return &Service{
+		ctx:      ctx,
+		cancel:   cancel,
+		cfg:      cfg,
+		tracked:  tracked,
+		pending:  cfg.PublicKeys,
+		balances: make(map[uint64]uint64),
+	}
+}
+
+// Start the validator monitor.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"indices":    len(s.cfg.Indices),
+		"publicKeys": len(s.cfg.PublicKeys),
+	}).Info("Starting validator monitor")
+	go s.run()
+}
+
+// Stop the validator monitor.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the validator monitor.
+func (s *Service) Status() error {
+	return nil
+}
+
+// ParseValidators splits a list of validator indices and 0x prefixed hex encoded public keys.
+func ParseValidators(values []string) ([]uint64, [][48]byte, error) {
+	var indices []uint64
+	var pubkeys [][48]byte
+	for _, value := range values {
+		if strings.HasPrefix(value, "0x") {
+			pubkey, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+			if err != nil || len(pubkey) != params.BeaconConfig().BLSPubkeyLength {
+				return nil, nil, errors.Errorf("invalid validator public key %s", value)
+			}
+			pubkeys = append(pubkeys, bytesutil.ToBytes48(pubkey))
+			continue
+		}
+		idx, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, nil, errors.Errorf("invalid validator index %s", value)
+		}
+		indices = append(indices, idx)
+	}
+	return indices, pubkeys, nil
+}
+
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				log.Error("Event feed data is not type *statefeed.BlockProcessedData")
+				continue
+			}
+			// Blocks processed during initial sync are old, monitoring starts with the head.
+			if s.cfg.SyncChecker.Syncing() {
+				continue
+			}
+			if err := s.processBlock(s.ctx, data.BlockRoot); err != nil {
+				log.WithError(err).Error("Could not monitor block")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		}
+	}
+}
+
+// processBlock logs the proposal and the attestations of tracked validators in the block. The first
+// block of an epoch also triggers the summary of the epoch before the previous one, whose
+// attestations cannot be included anymore.
+func (s *Service) processBlock(ctx context.Context, root [32]byte) error {
+	blk, err := s.cfg.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get block")
+	}
+	if blk == nil || blk.Block == nil {
+		return errors.Errorf("block %#x not found", root)
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get post state")
+	}
+	s.resolvePending(st)
+
+	epoch := helpers.SlotToEpoch(blk.Block.Slot)
+	if s.started && epoch > s.lastEpoch {
+		parentState, err := s.cfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(blk.Block.ParentRoot))
+		if err != nil {
+			return errors.Wrap(err, "could not get parent state")
+		}
+		if err := s.summarizeEpoch(ctx, parentState); err != nil {
+			return errors.Wrap(err, "could not summarize epoch")
+		}
+	}
+	if !s.started || epoch > s.lastEpoch {
+		s.lastEpoch = epoch
+		s.started = true
+	}
+
+	if s.tracked[blk.Block.ProposerIndex] {
+		proposedBlocksCounterVec.WithLabelValues(label(blk.Block.ProposerIndex)).Inc()
+		log.WithFields(logrus.Fields{
+			"validatorIndex": blk.Block.ProposerIndex,
+			"slot":           blk.Block.Slot,
+			"root":           fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+			"attestations":   len(blk.Block.Body.Attestations),
+			"deposits":       len(blk.Block.Body.Deposits),
+		}).Info("Proposed block was processed")
+	}
+	return s.processAttestations(blk.Block, st)
+}
+
+func (s *Service) processAttestations(blk *ethpb.BeaconBlock, st *stateTrie.BeaconState) error {
+	for _, att := range blk.Body.Attestations {
+		committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return errors.Wrap(err, "could not get attestation committee")
+		}
+		for _, idx := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+			if !s.tracked[idx] {
+				continue
+			}
+			includedAttestationsCounterVec.WithLabelValues(label(idx)).Inc()
+			log.WithFields(logrus.Fields{
+				"validatorIndex":    idx,
+				"slot":              att.Data.Slot,
+				"inclusionSlot":     blk.Slot,
+				"inclusionDistance": blk.Slot - att.Data.Slot,
+				"sourceEpoch":       att.Data.Source.Epoch,
+				"targetEpoch":       att.Data.Target.Epoch,
+			}).Debug("Attestation was included in a processed block")
+		}
+	}
+	return nil
+}
+
+// summarizeEpoch logs and exports the performance of the tracked validators during the previous
+// epoch of the state.
+func (s *Service) summarizeEpoch(ctx context.Context, st *stateTrie.BeaconState) error {
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return err
+	}
+	vp, _, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return err
+	}
+	prevEpoch := helpers.PrevEpoch(st)
+	currentEpoch := helpers.CurrentEpoch(st)
+	for _, idx := range s.trackedIndices() {
+		if idx >= uint64(len(vp)) {
+			continue
+		}
+		val, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return err
+		}
+		status := validatorStatus(val, currentEpoch)
+		statusGaugeVec.WithLabelValues(label(idx)).Set(float64(status))
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			return err
+		}
+		balanceGaugeVec.WithLabelValues(label(idx)).Set(float64(balance))
+		fields := logrus.Fields{
+			"validatorIndex": idx,
+			"epoch":          prevEpoch,
+			"status":         status.String(),
+			"balance":        balance,
+		}
+		if prevBalance, ok := s.balances[idx]; ok {
+			change := int64(balance) - int64(prevBalance)
+			balanceChangeGaugeVec.WithLabelValues(label(idx)).Set(float64(change))
+			fields["balanceChange"] = change
+		}
+		s.balances[idx] = balance
+		if !vp[idx].IsActivePrevEpoch {
+			log.WithFields(fields).Info("Tracked validator was not active in the previous epoch")
+			continue
+		}
+
+		v := vp[idx]
+		setBool(attestedGaugeVec, idx, v.IsPrevEpochAttester)
+		setBool(correctlyVotedSourceGaugeVec, idx, v.IsPrevEpochAttester)
+		setBool(correctlyVotedTargetGaugeVec, idx, v.IsPrevEpochTargetAttester)
+		setBool(correctlyVotedHeadGaugeVec, idx, v.IsPrevEpochHeadAttester)
+		fields["attested"] = v.IsPrevEpochAttester
+		if v.IsPrevEpochAttester {
+			inclusionDistanceGaugeVec.WithLabelValues(label(idx)).Set(float64(v.InclusionDistance))
+			fields["inclusionSlot"] = v.InclusionSlot
+			fields["inclusionDistance"] = v.InclusionDistance
+			fields["correctlyVotedSource"] = v.IsPrevEpochAttester
+			fields["correctlyVotedTarget"] = v.IsPrevEpochTargetAttester
+			fields["correctlyVotedHead"] = v.IsPrevEpochHeadAttester
+		}
+		log.WithFields(fields).Info("Previous epoch summary of tracked validator")
+	}
+	return nil
+}
+
+// resolvePending tracks the validators given by public key once they are in the registry.
+func (s *Service) resolvePending(st *stateTrie.BeaconState) {
+	pending := s.pending[:0]
+	for _, pubkey := range s.pending {
+		idx, ok := st.ValidatorIndexByPubkey(pubkey)
+		if !ok {
+			pending = append(pending, pubkey)
+			continue
+		}
+		s.tracked[idx] = true
+		log.WithFields(logrus.Fields{
+			"publicKey":      fmt.Sprintf("%#x", bytesutil.Trunc(pubkey[:])),
+			"validatorIndex": idx,
+		}).Info("Tracking validator")
+	}
+	s.pending = pending
+}
+
+func (s *Service) trackedIndices() []uint64 {
+	indices := make([]uint64, 0, len(s.tracked))
+	for idx := range s.tracked {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	return indices
+}
+
+func validatorStatus(val stateTrie.ReadOnlyValidator, epoch uint64) ethpb.ValidatorStatus {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	switch {
+	case epoch < val.ActivationEpoch():
+		return ethpb.ValidatorStatus_PENDING
+	case epoch < val.ExitEpoch() && val.Slashed():
+		return ethpb.ValidatorStatus_SLASHING
+	case epoch < val.ExitEpoch() && val.ExitEpoch() != farFutureEpoch:
+		return ethpb.ValidatorStatus_EXITING
+	case epoch < val.ExitEpoch():
+		return ethpb.ValidatorStatus_ACTIVE
+	default:
+		return ethpb.ValidatorStatus_EXITED
+	}
+}
+
+func label(idx uint64) string {
+	return strconv.FormatUint(idx, 10)
+}
+
+func setBool(gauge *prometheus.GaugeVec, idx uint64, value bool) {
+	if value {
+		gauge.WithLabelValues(label(idx)).Set(1)
+	} else {
+		gauge.WithLabelValues(label(idx)).Set(0)
+	}
+}
@@ -0,0 +1,115 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestParseValidators(t *testing.T) {
+	pubkey := fmt.Sprintf("%#x", make([]byte, 48))
+	indices, pubkeys, err := ParseValidators([]string{"1", pubkey, "42"})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 42}, indices)
+	assert.DeepEqual(t, [][48]byte{{}}, pubkeys)
+
+	_, _, err = ParseValidators([]string{"0x1234"})
+	assert.ErrorContains(t, "invalid validator public key 0x1234", err)
+	_, _, err = ParseValidators([]string{"-1"})
+	assert.ErrorContains(t, "invalid validator index -1", err)
+}
+
+func TestService_processBlock(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB, sc := testDB.SetupDB(t)
+	stateGen := stategen.New(beaconDB, sc)
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	genesis, err := testutil.NewBeaconBlock().Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, testutil.NewBeaconBlock()))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesis))
+	require.NoError(t, stateGen.SaveState(ctx, genesis, st))
+
+	// Track validator 1 by index and validator 2 by public key.
+	pubkey := st.PubkeyAtIndex(2)
+	s := NewService(ctx, &Config{
+		Indices:    []uint64{1},
+		PublicKeys: [][48]byte{pubkey, {'u', 'n', 'k', 'n', 'o', 'w', 'n'}},
+		BeaconDB:   beaconDB,
+		StateGen:   stateGen,
+	})
+
+	proposed := false
+	for slot := uint64(1); slot <= params.BeaconConfig().SlotsPerEpoch*2+1; slot++ {
+		conf := &testutil.BlockGenConfig{NumAttestations: 1}
+		// Attestations can't be generated for the target of the epoch starting at the block slot.
+		if slot%params.BeaconConfig().SlotsPerEpoch == 0 {
+			conf.NumAttestations = 0
+		}
+		blk, err := testutil.GenerateFullBlock(st, keys, conf, slot)
+		require.NoError(t, err)
+		st, err = state.ExecuteStateTransition(ctx, st, blk)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, stateGen.SaveState(ctx, root, st))
+		require.NoError(t, s.processBlock(ctx, root))
+		if blk.Block.ProposerIndex == 1 || blk.Block.ProposerIndex == 2 {
+			proposed = true
+		}
+	}
+
+	assert.Equal(t, true, s.tracked[1])
+	assert.Equal(t, true, s.tracked[2])
+	assert.Equal(t, 1, len(s.pending))
+	require.LogsContain(t, hook, "Tracking validator")
+	require.LogsContain(t, hook, "Previous epoch summary of tracked validator")
+	require.LogsContain(t, hook, "validatorIndex=1")
+	require.LogsContain(t, hook, "validatorIndex=2")
+	require.LogsContain(t, hook, "balanceChange=")
+	require.LogsContain(t, hook, "status=ACTIVE")
+	require.LogsContain(t, hook, "attested=true")
+	require.LogsContain(t, hook, "correctlyVotedTarget=true")
+	if proposed {
+		require.LogsContain(t, hook, "Proposed block was processed")
+	} else {
+		require.LogsDoNotContain(t, hook, "Proposed block was processed")
+	}
+}
+
+func TestValidatorStatus(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 1)
+	val, err := st.ValidatorAtIndexReadOnly(0)
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVE", validatorStatus(val, 0).String())
+
+	v := st.Validators()[0]
+	v.ActivationEpoch = 2
+	require.NoError(t, st.UpdateValidatorAtIndex(0, v))
+	val, err = st.ValidatorAtIndexReadOnly(0)
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", validatorStatus(val, 1).String())
+	v.ExitEpoch = 5
+	require.NoError(t, st.UpdateValidatorAtIndex(0, v))
+	val, err = st.ValidatorAtIndexReadOnly(0)
+	require.NoError(t, err)
+	assert.Equal(t, "EXITING", validatorStatus(val, 3).String())
+	assert.Equal(t, "EXITED", validatorStatus(val, 5).String())
+	v.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(0, v))
+	val, err = st.ValidatorAtIndexReadOnly(0)
+	require.NoError(t, err)
+	assert.Equal(t, "SLASHING", validatorStatus(val, 3).String())
+}
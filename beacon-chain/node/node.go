@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -22,19 +23,24 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/export"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
+	"github.com/prysmaticlabs/prysm/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
@@ -48,6 +54,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
+	slasherdb "github.com/prysmaticlabs/prysm/slasher/db"
+	slasherkv "github.com/prysmaticlabs/prysm/slasher/db/kv"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 	"gopkg.in/yaml.v2"
@@ -77,6 +85,7 @@ type BeaconNode struct {
 	opFeed            *event.Feed
 	forkChoiceStore   forkchoice.ForkChoicer
 	stateGen          *stategen.State
+	slasherDB         slasherdb.Database
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
@@ -173,6 +182,10 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.startFromCheckpoint(cliCtx); err != nil {
+		return nil, err
+	}
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
@@ -205,6 +218,28 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Bool(flags.EnableBackfill.Name) {
+		if err := beacon.registerBackfillService(cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(cliCtx.StringSlice(flags.MonitorValidatorsFlag.Name)) > 0 {
+		if err := beacon.registerValidatorMonitorService(cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := beacon.registerExportService(cliCtx); err != nil {
+		return nil, err
+	}
+
+	if cliCtx.Bool(flags.EnableSlasherFlag.Name) {
+		if err := beacon.registerSlasherService(cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -281,6 +316,11 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if b.slasherDB != nil {
+		if err := b.slasherDB.Close(); err != nil {
+			log.Errorf("Failed to close slasher database: %v", err)
+		}
+	}
 	close(b.stop)
 }
 
@@ -340,6 +380,55 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// startFromCheckpoint seeds an empty database with a finalized checkpoint loaded from files or
+// fetched from a trusted beacon node, so that the node syncs forward from there.
+func (b *BeaconNode) startFromCheckpoint(cliCtx *cli.Context) error {
+	provider := cliCtx.String(flags.CheckpointSyncProviderFlag.Name)
+	blockPath := cliCtx.String(flags.CheckpointBlockFlag.Name)
+	statePath := cliCtx.String(flags.CheckpointStateFlag.Name)
+	genesisStatePath := cliCtx.String(flags.CheckpointGenesisStateFlag.Name)
+	fromFiles := blockPath != "" || statePath != ""
+	if provider == "" && !fromFiles {
+		return nil
+	}
+	if provider != "" && fromFiles {
+		return fmt.Errorf("--%s can not be used with --%s or --%s",
+			flags.CheckpointSyncProviderFlag.Name, flags.CheckpointBlockFlag.Name, flags.CheckpointStateFlag.Name)
+	}
+	epoch := cliCtx.Uint64(flags.CheckpointEpochFlag.Name)
+	if fromFiles && (blockPath == "" || statePath == "" || genesisStatePath == "" || epoch == 0) {
+		return fmt.Errorf("--%s, --%s, --%s and --%s must all be set to start from a checkpoint file",
+			flags.CheckpointBlockFlag.Name, flags.CheckpointStateFlag.Name, flags.CheckpointEpochFlag.Name,
+			flags.CheckpointGenesisStateFlag.Name)
+	}
+
+	genesisBlock, err := b.db.GenesisBlock(b.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block")
+	}
+	if genesisBlock != nil {
+		log.Warn("Database is already initialized, ignoring checkpoint sync flags")
+		return nil
+	}
+
+	var anchor *checkpoint.Anchor
+	if provider != "" {
+		log.WithField("provider", provider).Info("Fetching checkpoint from beacon node")
+		anchor, err = checkpoint.FromNode(
+			b.ctx,
+			provider,
+			cliCtx.String(flags.CheckpointSyncProviderCertFlag.Name),
+			cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		)
+	} else {
+		anchor, err = checkpoint.FromFiles(blockPath, statePath, genesisStatePath, epoch)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not load checkpoint")
+	}
+	return anchor.Save(b.ctx, b.db)
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db, b.stateSummaryCache)
 }
@@ -387,6 +476,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
 		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
 		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		TrustedPeers:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.TrustedPeers.Name)),
 		BootstrapNodeAddr: bootnodeAddrs,
 		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
 		DataDir:           datadir,
@@ -445,22 +535,29 @@ func (b *BeaconNode) registerBlockchainService() error {
 		return err
 	}
 
+	dumpDir := b.cliCtx.String(flags.ForkChoiceDumpDir.Name)
+	if dumpDir == "" {
+		dumpDir = filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "forkchoice-dumps")
+	}
+
 	maxRoutines := b.cliCtx.Int(cmd.MaxGoroutines.Name)
 	blockchainService, err := blockchain.NewService(b.ctx, &blockchain.Config{
-		BeaconDB:          b.db,
-		DepositCache:      b.depositCache,
-		ChainStartFetcher: web3Service,
-		AttPool:           b.attestationPool,
-		ExitPool:          b.exitPool,
-		SlashingPool:      b.slashingsPool,
-		P2p:               b.fetchP2P(),
-		MaxRoutines:       maxRoutines,
-		StateNotifier:     b,
-		ForkChoiceStore:   b.forkChoiceStore,
-		OpsService:        opsService,
-		StateGen:          b.stateGen,
-		WspBlockRoot:      bRoot,
-		WspEpoch:          epoch,
+		BeaconDB:            b.db,
+		DepositCache:        b.depositCache,
+		ChainStartFetcher:   web3Service,
+		AttPool:             b.attestationPool,
+		ExitPool:            b.exitPool,
+		SlashingPool:        b.slashingsPool,
+		P2p:                 b.fetchP2P(),
+		MaxRoutines:         maxRoutines,
+		StateNotifier:       b,
+		ForkChoiceStore:     b.forkChoiceStore,
+		OpsService:          opsService,
+		StateGen:            b.stateGen,
+		WspBlockRoot:        bRoot,
+		WspEpoch:            epoch,
+		ForkChoiceDumpDepth: b.cliCtx.Uint64(flags.ForkChoiceDumpReorgDepth.Name),
+		ForkChoiceDumpDir:   dumpDir,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -481,13 +578,14 @@ func (b *BeaconNode) registerPOWChainService() error {
 		log.Fatalf("Invalid deposit contract address given: %s", depAddress)
 	}
 
+	endpoints := append([]string{b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name)}, b.cliCtx.StringSlice(flags.FallbackWeb3ProviderFlag.Name)...)
 	if b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name) == "" {
 		log.Error("No ETH1 node specified to run with the beacon node. Please consider running your own ETH1 node for better uptime, security, and decentralization of ETH2. Visit https://docs.prylabs.network/docs/prysm-usage/setup-eth1 for more information.")
 		log.Error("You will need to specify --http-web3provider to attach an eth1 node to the prysm node. Without an eth1 node block proposals for your validator will be affected and the beacon node will not be able to initialize the genesis state.")
 	}
 
 	cfg := &powchain.Web3ServiceConfig{
-		HTTPEndPoint:       b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name),
+		HTTPEndpoints:      endpoints,
 		DepositContract:    common.HexToAddress(depAddress),
 		BeaconDB:           b.db,
 		DepositCache:       b.depositCache,
@@ -569,6 +667,128 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService(cliCtx *cli.Context) error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		P2P:           b.fetchP2P(),
+		DB:            b.db,
+		Chain:         chainService,
+		InitialSync:   initSync,
+		BatchSize:     cliCtx.Uint64(flags.BackfillBatchSize.Name),
+		BatchInterval: cliCtx.Duration(flags.BackfillBatchInterval.Name),
+	})
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerValidatorMonitorService(cliCtx *cli.Context) error {
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	indices, pubkeys, err := monitor.ParseValidators(cliCtx.StringSlice(flags.MonitorValidatorsFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not parse validators to monitor")
+	}
+	ms := monitor.NewService(b.ctx, &monitor.Config{
+		Indices:       indices,
+		PublicKeys:    pubkeys,
+		BeaconDB:      b.db,
+		StateGen:      b.stateGen,
+		StateNotifier: b,
+		SyncChecker:   initSync,
+	})
+	return b.services.RegisterService(ms)
+}
+
+func (b *BeaconNode) registerExportService(cliCtx *cli.Context) error {
+	var sinks []export.Sink
+	if path := cliCtx.String(flags.ExportFile.Name); path != "" {
+		sink, err := export.NewFileSink(path)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+	if path := cliCtx.String(flags.ExportSocket.Name); path != "" {
+		sinks = append(sinks, export.NewSocketSink(path))
+	}
+	if servers := cliCtx.String(flags.ExportKafkaURL.Name); servers != "" {
+		sink, err := export.NewKafkaSink(servers)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	cursorPath := cliCtx.String(flags.ExportCursorFile.Name)
+	if cursorPath == "" {
+		cursorPath = filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), "export-cursor.json")
+	}
+	var startEpoch *uint64
+	if cliCtx.IsSet(flags.ExportStartEpochFlag.Name) {
+		epoch := cliCtx.Uint64(flags.ExportStartEpochFlag.Name)
+		startEpoch = &epoch
+	}
+	es := export.NewService(b.ctx, &export.Config{
+		BeaconDB:            b.db,
+		StateGen:            b.stateGen,
+		HeadFetcher:         chainService,
+		FinalizationFetcher: chainService,
+		StateNotifier:       b,
+		Sinks:               sinks,
+		CursorPath:          cursorPath,
+		StartEpoch:          startEpoch,
+	})
+	return b.services.RegisterService(es)
+}
+
+func (b *BeaconNode) registerSlasherService(cliCtx *cli.Context) error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	dbPath := cliCtx.String(flags.SlasherDirFlag.Name)
+	if dbPath == "" {
+		dbPath = filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), slasherkv.SlasherDbDirName)
+	}
+	log.WithField("database-path", dbPath).Info("Checking slasher DB")
+	d, err := slasherdb.NewDB(dbPath, &slasherkv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	b.slasherDB = d
+
+	s := slasher.NewService(b.ctx, &slasher.Config{
+		SlasherDB:           d,
+		BeaconDB:            b.db,
+		HeadFetcher:         chainService,
+		AttPreStateFetcher:  chainService,
+		StateNotifier:       b,
+		BlockNotifier:       b,
+		AttestationNotifier: b,
+		SlashingPool:        b.slashingsPool,
+	})
+	return b.services.RegisterService(s)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -696,12 +916,15 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	gatewayAddress := fmt.Sprintf("%s:%d", gatewayHost, gatewayPort)
 	allowedOrigins := strings.Split(b.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	// The events stream reads from the node's feeds directly as it has no gRPC counterpart.
+	mux := http.NewServeMux()
+	mux.Handle(gateway.EventsPath, gateway.NewEventsHandler(b, b))
 	return b.services.RegisterService(
 		gateway.New(
 			b.ctx,
 			selfAddress,
 			gatewayAddress,
-			nil, /*optional mux*/
+			mux,
 			allowedOrigins,
 			enableDebugRPCEndpoints,
 			b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
@@ -0,0 +1,63 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// auditLogFile is the file in the data directory the peer management actions are appended to.
+const auditLogFile = "peer-audit.log"
+
+// audit records a peer management action in the log and, if the node has a data directory, in the
+// audit log file as a line of JSON. Failed actions are recorded with their error.
+func (s *Service) audit(action string, fields logrus.Fields, err error) {
+	entry := log.WithFields(fields).WithField("action", action)
+	if err != nil {
+		entry.WithError(err).Warn("Peer management action failed")
+	} else {
+		entry.Info("Peer management action")
+	}
+	if s.cfg.DataDir == "" {
+		return
+	}
+
+	record := map[string]string{
+		"time":   time.Now().UTC().Format(time.RFC3339Nano),
+		"action": action,
+	}
+	for k, v := range fields {
+		record[k] = fmt.Sprint(v)
+	}
+	if err != nil {
+		record["error"] = err.Error()
+	}
+	enc, err := json.Marshal(record)
+	if err != nil {
+		log.WithError(err).Error("Could not encode audit record")
+		return
+	}
+
+	s.auditLock.Lock()
+	defer s.auditLock.Unlock()
+	f, err := os.OpenFile(
+		filepath.Join(s.cfg.DataDir, auditLogFile),
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		params.BeaconIoConfig().ReadWritePermissions,
+	)
+	if err != nil {
+		log.WithError(err).Error("Could not open audit log")
+		return
+	}
+	if _, err := f.Write(append(enc, '\n')); err != nil {
+		log.WithError(err).Error("Could not write audit log")
+	}
+	if err := f.Close(); err != nil {
+		log.WithError(err).Error("Could not close audit log")
+	}
+}
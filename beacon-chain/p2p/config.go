@@ -11,6 +11,7 @@ type Config struct {
 	EnableUPnP          bool
 	DisableDiscv5       bool
 	StaticPeers         []string
+	TrustedPeers        []string
 	BootstrapNodeAddr   []string
 	Discv5BootStrapAddr []string
 	RelayNodeAddr       string
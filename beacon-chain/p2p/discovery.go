@@ -285,6 +285,13 @@ func (s *Service) isPeerAtLimit(inbound bool) bool {
 		maxPeers += highWatermarkBuffer
 	}
 	activePeers := len(s.Peers().Active())
+	// Trusted peers do not count towards the limit.
+	for _, pid := range s.Peers().Trusted() {
+		if s.host.Network().Connectedness(pid) == network.Connected {
+			numOfConns--
+			activePeers--
+		}
+	}
 
 	return activePeers >= maxPeers || numOfConns >= maxPeers
 }
@@ -76,6 +76,15 @@ type PeerManager interface {
 	RefreshENR()
 	FindPeersWithSubnet(ctx context.Context, index uint64) (bool, error)
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
+	AddTrustedPeer(addr string) (peer.ID, error)
+	RemoveTrustedPeer(pid peer.ID) error
+	AddPeer(ctx context.Context, addr string) (peer.ID, error)
+	DisconnectPeer(pid peer.ID) error
+	BanPeer(pid peer.ID) error
+	UnbanPeer(pid peer.ID) error
+	BanSubnet(cidr string) error
+	UnbanSubnet(cidr string) error
+	BannedSubnets() []string
 }
 
 // Sender abstracts the sending functionality from libp2p.
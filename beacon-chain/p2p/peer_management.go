@@ -0,0 +1,262 @@
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	filter "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+)
+
+// bansFile is the file in the data directory the peer and subnet bans are saved to.
+const bansFile = "peer-bans.json"
+
+// storedBans is the encoding of the bans in the bans file.
+type storedBans struct {
+	Peers   []string `json:"peers"`
+	Subnets []string `json:"subnets"`
+}
+
+// AddPeer dials a peer given by its multiaddr or ENR.
+func (s *Service) AddPeer(ctx context.Context, addr string) (pid peer.ID, err error) {
+	defer func() {
+		s.audit("add_peer", logrus.Fields{"peer": pid, "addr": addr}, err)
+	}()
+	info, record, err := s.parsePeerAddr(addr)
+	if err != nil {
+		return "", err
+	}
+	if s.peers.IsBanned(info.ID) || s.isBannedAddr(info.Addrs[0]) {
+		return "", errors.New("peer is banned")
+	}
+	s.peers.Add(record, info.ID, info.Addrs[0], network.DirOutbound)
+	if err := s.connectWithPeer(ctx, *info); err != nil {
+		return "", errors.Wrap(err, "could not connect with peer")
+	}
+	return info.ID, nil
+}
+
+// DisconnectPeer closes the connections to the peer.
+func (s *Service) DisconnectPeer(pid peer.ID) (err error) {
+	defer func() {
+		s.audit("disconnect_peer", logrus.Fields{"peer": pid}, err)
+	}()
+	if s.host.Network().Connectedness(pid) != network.Connected {
+		return errors.New("peer is not connected")
+	}
+	return s.Disconnect(pid)
+}
+
+// BanPeer bans the peer, disconnecting it. Banned peers are refused both inbound and outbound
+// connections until they are unbanned, and are no longer trusted.
+func (s *Service) BanPeer(pid peer.ID) (err error) {
+	defer func() {
+		s.audit("ban_peer", logrus.Fields{"peer": pid}, err)
+	}()
+	if pid == s.PeerID() {
+		return errors.New("cannot ban the node itself")
+	}
+	if s.peers.IsBanned(pid) {
+		return errors.New("peer is already banned")
+	}
+	s.peers.Ban(pid)
+	s.peers.RemoveTrusted(pid)
+	if err := s.saveBans(); err != nil {
+		return err
+	}
+	if s.host.Network().Connectedness(pid) == network.Connected {
+		if err := s.Disconnect(pid); err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Could not disconnect banned peer")
+		}
+	}
+	return nil
+}
+
+// UnbanPeer lifts the ban of the peer.
+func (s *Service) UnbanPeer(pid peer.ID) (err error) {
+	defer func() {
+		s.audit("unban_peer", logrus.Fields{"peer": pid}, err)
+	}()
+	if !s.peers.IsBanned(pid) {
+		return errors.New("peer is not banned")
+	}
+	s.peers.Unban(pid)
+	return s.saveBans()
+}
+
+// BanSubnet bans an IP address or a CIDR subnet, disconnecting the peers connected from it.
+// Banned subnets are kept apart from the deny list of the configuration, which they never change.
+func (s *Service) BanSubnet(cidr string) (err error) {
+	defer func() {
+		s.audit("ban_subnet", logrus.Fields{"subnet": cidr}, err)
+	}()
+	ipNet, err := parseSubnet(cidr)
+	if err != nil {
+		return err
+	}
+	s.bansLock.Lock()
+	if _, ok := s.bannedSubnets[ipNet.String()]; ok {
+		s.bansLock.Unlock()
+		return errors.New("subnet is already banned")
+	}
+	s.bannedSubnets[ipNet.String()] = ipNet
+	s.bansLock.Unlock()
+	if err := s.saveBans(); err != nil {
+		return err
+	}
+
+	for _, conn := range s.host.Network().Conns() {
+		ip, err := manet.ToIP(conn.RemoteMultiaddr())
+		if err != nil || !ipNet.Contains(ip) {
+			continue
+		}
+		if err := s.Disconnect(conn.RemotePeer()); err != nil {
+			log.WithError(err).WithField("peer", conn.RemotePeer()).Debug("Could not disconnect banned peer")
+		}
+	}
+	return nil
+}
+
+// UnbanSubnet lifts the ban of an IP address or a CIDR subnet banned with BanSubnet. Addresses
+// denied by the configuration stay denied.
+func (s *Service) UnbanSubnet(cidr string) (err error) {
+	defer func() {
+		s.audit("unban_subnet", logrus.Fields{"subnet": cidr}, err)
+	}()
+	ipNet, err := parseSubnet(cidr)
+	if err != nil {
+		return err
+	}
+	s.bansLock.Lock()
+	if _, ok := s.bannedSubnets[ipNet.String()]; !ok {
+		s.bansLock.Unlock()
+		return errors.New("subnet is not banned")
+	}
+	delete(s.bannedSubnets, ipNet.String())
+	s.bansLock.Unlock()
+	return s.saveBans()
+}
+
+// BannedSubnets returns the subnets banned with BanSubnet in CIDR notation.
+func (s *Service) BannedSubnets() []string {
+	s.bansLock.Lock()
+	defer s.bansLock.Unlock()
+	subnets := make([]string, 0, len(s.bannedSubnets))
+	for subnet := range s.bannedSubnets {
+		subnets = append(subnets, subnet)
+	}
+	sort.Strings(subnets)
+	return subnets
+}
+
+func (s *Service) isBannedAddr(addr filter.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	s.bansLock.Lock()
+	defer s.bansLock.Unlock()
+	for _, ipNet := range s.bannedSubnets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSubnet parses a CIDR subnet, or a single IP address as the subnet of only that address.
+func parseSubnet(cidr string) (*net.IPNet, error) {
+	if !strings.Contains(cidr, "/") {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return nil, errors.Errorf("invalid IP address %s", cidr)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid subnet")
+	}
+	return ipNet, nil
+}
+
+func (s *Service) bansPath() string {
+	return filepath.Join(s.cfg.DataDir, bansFile)
+}
+
+// loadBans applies the bans saved in the bans file.
+func (s *Service) loadBans() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	enc, err := ioutil.ReadFile(s.bansPath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	stored := &storedBans{}
+	if err := json.Unmarshal(enc, stored); err != nil {
+		return errors.Wrap(err, "could not decode bans")
+	}
+	for _, id := range stored.Peers {
+		pid, err := peer.Decode(id)
+		if err != nil {
+			return errors.Wrapf(err, "invalid banned peer %s", id)
+		}
+		s.peers.Ban(pid)
+	}
+	s.bansLock.Lock()
+	defer s.bansLock.Unlock()
+	for _, cidr := range stored.Subnets {
+		ipNet, err := parseSubnet(cidr)
+		if err != nil {
+			return err
+		}
+		s.bannedSubnets[ipNet.String()] = ipNet
+	}
+	log.WithField("peers", len(stored.Peers)).WithField("subnets", len(stored.Subnets)).Info("Loaded bans")
+	return nil
+}
+
+// saveBans saves the bans to the bans file. The bans are read under the file lock, so that
+// concurrent saves never interleave and the last one written holds the latest bans.
+func (s *Service) saveBans() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	s.bansFileLock.Lock()
+	defer s.bansFileLock.Unlock()
+	stored := &storedBans{
+		Peers:   make([]string, 0),
+		Subnets: s.BannedSubnets(),
+	}
+	for _, pid := range s.peers.Banned() {
+		stored.Peers = append(stored.Peers, pid.String())
+	}
+	sort.Strings(stored.Peers)
+	enc, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	tmp := s.bansPath() + ".tmp"
+	if err := fileutil.WriteFile(tmp, enc); err != nil {
+		return errors.Wrap(err, "could not save bans")
+	}
+	return errors.Wrap(os.Rename(tmp, s.bansPath()), "could not save bans")
+}
@@ -0,0 +1,190 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockConnMultiaddrs struct {
+	remote multiaddr.Multiaddr
+}
+
+func (m *mockConnMultiaddrs) LocalMultiaddr() multiaddr.Multiaddr {
+	return nil
+}
+
+func (m *mockConnMultiaddrs) RemoteMultiaddr() multiaddr.Multiaddr {
+	return m.remote
+}
+
+func TestService_Bans(t *testing.T) {
+	dataDir := t.TempDir()
+	s := newPeerStoreService(t, dataDir)
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	addr, err := multiaddr.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	conn := &mockConnMultiaddrs{remote: addr}
+
+	// A banned trusted peer is no longer trusted.
+	_, err = s.AddTrustedPeer("/ip4/213.202.254.180/tcp/13000/p2p/" + pid.String())
+	require.NoError(t, err)
+	require.NoError(t, s.BanPeer(pid))
+	assert.ErrorContains(t, "peer is already banned", s.BanPeer(pid))
+	assert.ErrorContains(t, "cannot ban the node itself", s.BanPeer(s.PeerID()))
+	assert.Equal(t, false, s.peers.IsTrusted(pid))
+	assert.Equal(t, true, s.peers.IsBad(pid))
+	assert.Equal(t, false, s.InterceptPeerDial(pid))
+	assert.Equal(t, false, s.InterceptSecured(0, pid, conn))
+	_, err = s.AddTrustedPeer("/ip4/213.202.254.180/tcp/13000/p2p/" + pid.String())
+	assert.ErrorContains(t, "peer is banned", err)
+
+	assert.Equal(t, true, s.InterceptAccept(conn))
+	require.NoError(t, s.BanSubnet("213.202.254.0/24"))
+	assert.ErrorContains(t, "subnet is already banned", s.BanSubnet("213.202.254.1/24"))
+	assert.ErrorContains(t, "invalid IP address", s.BanSubnet("foo"))
+	require.NoError(t, s.BanSubnet("2001:db8::1"))
+	assert.Equal(t, false, s.InterceptAccept(conn))
+	_, err = s.AddPeer(context.Background(), "/ip4/213.202.254.181/tcp/13000/p2p/16Uiu2HAm7yD5fhhw1Kihg5Qa8TC9aSVEfpUJHtHBkXfTJ8qTVcgb")
+	assert.ErrorContains(t, "peer is banned", err)
+	assert.DeepEqual(t, []string{"2001:db8::1/128", "213.202.254.0/24"}, s.BannedSubnets())
+
+	// Bans are restored after a restart.
+	s = newPeerStoreService(t, dataDir)
+	require.NoError(t, s.loadBans())
+	assert.Equal(t, true, s.peers.IsBanned(pid))
+	assert.DeepEqual(t, []string{"2001:db8::1/128", "213.202.254.0/24"}, s.BannedSubnets())
+	assert.Equal(t, false, s.InterceptAccept(conn))
+
+	require.NoError(t, s.UnbanPeer(pid))
+	assert.ErrorContains(t, "peer is not banned", s.UnbanPeer(pid))
+	require.NoError(t, s.UnbanSubnet("213.202.254.0/24"))
+	assert.ErrorContains(t, "subnet is not banned", s.UnbanSubnet("213.202.254.0/24"))
+	assert.Equal(t, false, s.peers.IsBad(pid))
+	assert.Equal(t, true, s.InterceptPeerDial(pid))
+	assert.Equal(t, true, s.InterceptAccept(conn))
+
+	s = newPeerStoreService(t, dataDir)
+	require.NoError(t, s.loadBans())
+	assert.Equal(t, false, s.peers.IsBanned(pid))
+	assert.DeepEqual(t, []string{"2001:db8::1/128"}, s.BannedSubnets())
+}
+
+func TestService_Bans_ConcurrentSaves(t *testing.T) {
+	dataDir := t.TempDir()
+	s := newPeerStoreService(t, dataDir)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, s.BanSubnet(fmt.Sprintf("10.0.%d.0/24", i)))
+		}(i)
+	}
+	wg.Wait()
+
+	// The bans file holds every ban once all the saves have returned.
+	s = newPeerStoreService(t, dataDir)
+	require.NoError(t, s.loadBans())
+	assert.Equal(t, 20, len(s.BannedSubnets()))
+}
+
+func TestService_UnbanSubnet_KeepsDenyList(t *testing.T) {
+	s := newPeerStoreService(t, t.TempDir())
+	addrFilter, err := configureFilter(&Config{DenyListCIDR: []string{"213.202.254.0/24"}})
+	require.NoError(t, err)
+	s.addrFilter = addrFilter
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	denied, err := multiaddr.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	banned, err := multiaddr.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	require.NoError(t, err)
+
+	require.NoError(t, s.BanSubnet("10.0.0.0/8"))
+	assert.Equal(t, false, s.InterceptAddrDial(pid, banned))
+	assert.Equal(t, false, s.InterceptAccept(&mockConnMultiaddrs{remote: banned}))
+	require.NoError(t, s.UnbanSubnet("10.0.0.0/8"))
+	assert.Equal(t, true, s.InterceptAddrDial(pid, banned))
+
+	// A subnet of the configured deny list is denied whether it was banned and unbanned or not.
+	require.NoError(t, s.BanSubnet("213.202.254.0/24"))
+	require.NoError(t, s.UnbanSubnet("213.202.254.0/24"))
+	assert.Equal(t, false, s.InterceptAddrDial(pid, denied))
+	assert.Equal(t, false, s.InterceptAccept(&mockConnMultiaddrs{remote: denied}))
+	assert.ErrorContains(t, "subnet is not banned", s.UnbanSubnet("213.202.254.0/24"))
+}
+
+func TestService_DisconnectPeer(t *testing.T) {
+	s := newPeerStoreService(t, "")
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	assert.ErrorContains(t, "peer is not connected", s.DisconnectPeer(pid))
+}
+
+func TestService_Audit(t *testing.T) {
+	dataDir := t.TempDir()
+	s := newPeerStoreService(t, dataDir)
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	require.NoError(t, s.BanPeer(pid))
+	assert.NotNil(t, s.UnbanSubnet("10.0.0.0/8"))
+
+	f, err := os.Open(filepath.Join(dataDir, auditLogFile))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	var records []map[string]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		record := make(map[string]string)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, "ban_peer", records[0]["action"])
+	assert.Equal(t, pid.String(), records[0]["peer"])
+	assert.Equal(t, "", records[0]["error"])
+	assert.Equal(t, "unban_subnet", records[1]["action"])
+	assert.Equal(t, "10.0.0.0/8", records[1]["subnet"])
+	assert.Equal(t, "subnet is not banned", records[1]["error"])
+}
+
+func TestParseSubnet(t *testing.T) {
+	tests := []struct {
+		cidr string
+		want string
+		err  string
+	}{
+		{cidr: "192.168.1.7", want: "192.168.1.7/32"},
+		{cidr: "192.168.1.7/24", want: "192.168.1.0/24"},
+		{cidr: "2001:db8::1", want: "2001:db8::1/128"},
+		{cidr: "2001:db8::/32", want: "2001:db8::/32"},
+		{cidr: "192.168.1", err: "invalid IP address"},
+		{cidr: "192.168.1.7/33", err: "invalid subnet"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cidr, func(t *testing.T) {
+			ipNet, err := parseSubnet(tt.cidr)
+			if tt.err != "" {
+				assert.ErrorContains(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ipNet.String())
+		})
+	}
+}
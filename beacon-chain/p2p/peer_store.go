@@ -0,0 +1,296 @@
+package p2p
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// peerStoreFile is the file in the data directory the known peers are saved to.
+	peerStoreFile = "peerstore.json"
+	// peerStoreSaveInterval is the interval between saves of the known peers.
+	peerStoreSaveInterval = 5 * time.Minute
+)
+
+// storedPeer is the encoding of a known peer in the peer store file.
+type storedPeer struct {
+	ID              string `json:"id"`
+	Address         string `json:"address"`
+	Outbound        bool   `json:"outbound,omitempty"`
+	Enr             string `json:"enr,omitempty"`
+	TrustedAddress  string `json:"trusted_address,omitempty"`
+	BadResponses    int    `json:"bad_responses,omitempty"`
+	ProcessedBlocks uint64 `json:"processed_blocks,omitempty"`
+}
+
+// AddTrustedPeer adds a trusted peer from its multiaddr or ENR and dials it. Trusted peers are always
+// re-dialed, are exempt from pruning and from the peer limit and are saved in the peer store.
+func (s *Service) AddTrustedPeer(addr string) (pid peer.ID, err error) {
+	defer func() {
+		s.audit("add_trusted_peer", logrus.Fields{"peer": pid, "addr": addr}, err)
+	}()
+	info, record, err := s.parsePeerAddr(addr)
+	if err != nil {
+		return "", err
+	}
+	if s.peers.IsBanned(info.ID) {
+		return "", errors.New("peer is banned")
+	}
+	if record != nil {
+		s.peers.Add(record, info.ID, info.Addrs[0], network.DirOutbound)
+	}
+	s.peers.AddTrusted(info.ID, info.Addrs[0])
+
+	if s.started {
+		go func() {
+			if err := s.connectWithPeer(s.ctx, *info); err != nil {
+				log.WithError(err).WithField("peer", info.ID).Debug("Could not connect with trusted peer")
+			}
+		}()
+		s.savePeerStore()
+	}
+	return info.ID, nil
+}
+
+// parsePeerAddr parses a multiaddr including the peer ID, or an ENR. The ENR record is returned for
+// ENRs.
+func (s *Service) parsePeerAddr(addr string) (*peer.AddrInfo, *enr.Record, error) {
+	var record *enr.Record
+	var multiAddr ma.Multiaddr
+	var err error
+	if strings.HasPrefix(addr, "enr:") {
+		node, err := enode.Parse(enode.ValidSchemes, addr)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not parse ENR")
+		}
+		record = node.Record()
+		multiAddr, err = convertToSingleMultiAddr(node)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		multiAddr, err = multiAddrFromString(addr)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not parse multiaddr")
+		}
+	}
+	info, err := peer.AddrInfoFromP2pAddr(multiAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(info.Addrs) == 0 {
+		return nil, nil, errors.New("peer address has no transport")
+	}
+	if info.ID == s.PeerID() {
+		return nil, nil, errors.New("cannot add the node itself as a peer")
+	}
+	return info, record, nil
+}
+
+// RemoveTrustedPeer removes the peer from the trusted peers. The peer stays connected, but becomes
+// subject to pruning and the peer limit again.
+func (s *Service) RemoveTrustedPeer(pid peer.ID) (err error) {
+	defer func() {
+		s.audit("remove_trusted_peer", logrus.Fields{"peer": pid}, err)
+	}()
+	if !s.peers.IsTrusted(pid) {
+		return errors.New("peer is not trusted")
+	}
+	s.peers.RemoveTrusted(pid)
+	if s.started {
+		s.savePeerStore()
+	}
+	return nil
+}
+
+// ensureTrustedPeerConnections dials the trusted peers which are not connected.
+func (s *Service) ensureTrustedPeerConnections() {
+	for _, pid := range s.peers.Trusted() {
+		if s.host.Network().Connectedness(pid) == network.Connected {
+			continue
+		}
+		addr, err := s.peers.TrustedAddress(pid)
+		if err != nil {
+			continue
+		}
+		info := &peer.AddrInfo{ID: pid, Addrs: []ma.Multiaddr{addr}}
+		if err := connectWithTimeout(s.ctx, s.host, info); err != nil {
+			log.WithField("peer", pid).WithError(err).Debug("Failed to reconnect to trusted peer")
+		}
+	}
+}
+
+// redialStoredPeers dials the best of the peers loaded from the peer store, up to the peer limit.
+// Only peers with a dialable address, which is the address from their ENR or the address of an
+// outbound connection, are dialed.
+func (s *Service) redialStoredPeers(records []*peers.PeerRecord) {
+	var infos []peer.AddrInfo
+	for _, record := range records {
+		if len(infos) >= int(s.cfg.MaxPeers) {
+			break
+		}
+		if record.TrustedAddress != nil || s.peers.IsBad(record.ID) {
+			continue
+		}
+		var addr ma.Multiaddr
+		if record.Enr != nil {
+			node, err := enode.New(enode.ValidSchemes, record.Enr)
+			if err != nil {
+				continue
+			}
+			addr, err = convertToSingleMultiAddr(node)
+			if err != nil {
+				continue
+			}
+			addr = addr.Decapsulate(ma.StringCast("/p2p/" + record.ID.String()))
+		} else if record.Direction == network.DirOutbound {
+			addr = record.Address
+		} else {
+			continue
+		}
+		infos = append(infos, peer.AddrInfo{ID: record.ID, Addrs: []ma.Multiaddr{addr}})
+	}
+	log.WithField("peers", len(infos)).Debug("Dialing peers from the peer store")
+	for _, info := range infos {
+		go func(info peer.AddrInfo) {
+			if err := s.connectWithPeer(s.ctx, info); err != nil {
+				log.WithError(err).Tracef("Could not connect with peer %s", info.String())
+			}
+		}(info)
+	}
+}
+
+func (s *Service) peerStorePath() string {
+	return filepath.Join(s.cfg.DataDir, peerStoreFile)
+}
+
+// loadPeerStore restores the peers saved in the peer store file, returning their records.
+func (s *Service) loadPeerStore() ([]*peers.PeerRecord, error) {
+	if s.cfg.DataDir == "" {
+		return nil, nil
+	}
+	enc, err := ioutil.ReadFile(s.peerStorePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var stored []*storedPeer
+	if err := json.Unmarshal(enc, &stored); err != nil {
+		return nil, errors.Wrap(err, "could not decode peer store")
+	}
+	records := make([]*peers.PeerRecord, 0, len(stored))
+	for _, sp := range stored {
+		record, err := decodeStoredPeer(sp)
+		if err != nil {
+			log.WithError(err).WithField("peer", sp.ID).Debug("Skipping invalid peer in the peer store")
+			continue
+		}
+		records = append(records, record)
+	}
+	s.peers.Restore(records)
+	log.WithField("peers", len(records)).Info("Loaded peers from the peer store")
+	return records, nil
+}
+
+// savePeerStore saves the known peers to the peer store file.
+func (s *Service) savePeerStore() {
+	if s.cfg.DataDir == "" {
+		return
+	}
+	records := s.peers.Records()
+	stored := make([]*storedPeer, 0, len(records))
+	for _, record := range records {
+		stored = append(stored, encodeStoredPeer(record))
+	}
+	enc, err := json.Marshal(stored)
+	if err != nil {
+		log.WithError(err).Error("Could not encode peer store")
+		return
+	}
+	// Write to a temporary file first, so a crash never leaves a partially written store behind.
+	tmp := s.peerStorePath() + ".tmp"
+	if err := fileutil.WriteFile(tmp, enc); err != nil {
+		log.WithError(err).Error("Could not save peer store")
+		return
+	}
+	if err := os.Rename(tmp, s.peerStorePath()); err != nil {
+		log.WithError(err).Error("Could not save peer store")
+	}
+}
+
+func encodeStoredPeer(record *peers.PeerRecord) *storedPeer {
+	sp := &storedPeer{
+		ID:              record.ID.String(),
+		Address:         record.Address.String(),
+		Outbound:        record.Direction == network.DirOutbound,
+		BadResponses:    record.BadResponses,
+		ProcessedBlocks: record.ProcessedBlocks,
+	}
+	// Records which can't be encoded, such as unsigned placeholder records, are not saved.
+	if record.Enr != nil {
+		if enrString, err := SerializeENR(record.Enr); err == nil {
+			sp.Enr = enrString
+		}
+	}
+	if record.TrustedAddress != nil {
+		sp.TrustedAddress = record.TrustedAddress.String()
+	}
+	return sp
+}
+
+func decodeStoredPeer(sp *storedPeer) (*peers.PeerRecord, error) {
+	pid, err := peer.Decode(sp.ID)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := ma.NewMultiaddr(sp.Address)
+	if err != nil {
+		return nil, err
+	}
+	record := &peers.PeerRecord{
+		ID:              pid,
+		Address:         addr,
+		Direction:       network.DirInbound,
+		BadResponses:    sp.BadResponses,
+		ProcessedBlocks: sp.ProcessedBlocks,
+	}
+	if sp.Outbound {
+		record.Direction = network.DirOutbound
+	}
+	if sp.Enr != "" {
+		enc, err := base64.URLEncoding.DecodeString(sp.Enr)
+		if err != nil {
+			return nil, err
+		}
+		record.Enr = &enr.Record{}
+		if err := rlp.Decode(bytes.NewReader(enc), record.Enr); err != nil {
+			return nil, err
+		}
+	}
+	if sp.TrustedAddress != "" {
+		record.TrustedAddress, err = ma.NewMultiaddr(sp.TrustedAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
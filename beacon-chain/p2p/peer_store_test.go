@@ -0,0 +1,149 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func newPeerStoreService(t *testing.T, dataDir string) *Service {
+	h, err := libp2p.New(context.Background(), libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.Close())
+	})
+	addrFilter, err := configureFilter(&Config{})
+	require.NoError(t, err)
+	return &Service{
+		ctx:           context.Background(),
+		cfg:           &Config{DataDir: dataDir, MaxPeers: 30},
+		host:          h,
+		addrFilter:    addrFilter,
+		ipLimiter:     leakybucket.NewCollector(ipLimit, ipBurst, false),
+		bannedSubnets: make(map[string]*net.IPNet),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 30,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold: 5,
+				},
+			},
+		}),
+	}
+}
+
+func TestService_AddTrustedPeer(t *testing.T) {
+	s := newPeerStoreService(t, "")
+
+	_, err := s.AddTrustedPeer("/ip4/213.202.254.180/tcp/13000")
+	assert.ErrorContains(t, "could not parse multiaddr", err)
+	_, err = s.AddTrustedPeer("enr:foo")
+	assert.ErrorContains(t, "could not parse ENR", err)
+	_, err = s.AddTrustedPeer("/ip4/213.202.254.180/tcp/13000/p2p/" + s.PeerID().String())
+	assert.ErrorContains(t, "cannot add the node itself as a peer", err)
+
+	pid, err := s.AddTrustedPeer("/ip4/213.202.254.180/tcp/13000/p2p/16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	assert.Equal(t, "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", pid.String())
+	addr, err := s.peers.TrustedAddress(pid)
+	require.NoError(t, err)
+	assert.Equal(t, "/ip4/213.202.254.180/tcp/13000", addr.String())
+
+	// Trusted peers are also added from their ENR.
+	_, pkey := createAddrAndPrivKey(t)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, pkey)
+	localNode.Set(enr.IP(net.ParseIP("213.202.254.181")))
+	localNode.Set(enr.TCP(13000))
+	pid, err = s.AddTrustedPeer(localNode.Node().String())
+	require.NoError(t, err)
+	assert.Equal(t, true, s.peers.IsTrusted(pid))
+	record, err := s.peers.ENR(pid)
+	require.NoError(t, err)
+	assert.NotNil(t, record)
+
+	require.NoError(t, s.RemoveTrustedPeer(pid))
+	assert.Equal(t, false, s.peers.IsTrusted(pid))
+	assert.ErrorContains(t, "peer is not trusted", s.RemoveTrustedPeer(pid))
+}
+
+func TestService_PeerStore(t *testing.T) {
+	dataDir := t.TempDir()
+	s := newPeerStoreService(t, dataDir)
+
+	// Nothing is loaded before the first save.
+	records, err := s.loadPeerStore()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+
+	trusted, err := s.AddTrustedPeer("/ip4/213.202.254.180/tcp/13000/p2p/16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	_, pkey := createAddrAndPrivKey(t)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, pkey)
+	localNode.Set(enr.IP(net.ParseIP("213.202.254.181")))
+	localNode.Set(enr.TCP(13000))
+	outbound, err := peer.IDFromPublicKey(convertToInterfacePubkey(&pkey.PublicKey))
+	require.NoError(t, err)
+	outboundAddr, err := multiaddr.NewMultiaddr("/ip4/213.202.254.181/tcp/13000")
+	require.NoError(t, err)
+	s.peers.Add(localNode.Node().Record(), outbound, outboundAddr, network.DirOutbound)
+	s.peers.Scorers().BadResponsesScorer().Increment(outbound)
+
+	_, pkey = createAddrAndPrivKey(t)
+	inbound, err := peer.IDFromPublicKey(convertToInterfacePubkey(&pkey.PublicKey))
+	require.NoError(t, err)
+	inboundAddr, err := multiaddr.NewMultiaddr("/ip4/213.202.254.182/tcp/40312")
+	require.NoError(t, err)
+	s.peers.Add(new(enr.Record), inbound, inboundAddr, network.DirInbound)
+	s.peers.SetConnectionState(inbound, peers.PeerConnected)
+	// Peers without an address are not saved.
+	addPeer(t, s.peers, peers.PeerConnected)
+
+	s.savePeerStore()
+
+	s = newPeerStoreService(t, dataDir)
+	records, err = s.loadPeerStore()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, trusted, records[0].ID)
+	assert.Equal(t, 3, len(s.peers.All()))
+
+	assert.Equal(t, true, s.peers.IsTrusted(trusted))
+	addr, err := s.peers.TrustedAddress(trusted)
+	require.NoError(t, err)
+	assert.Equal(t, "/ip4/213.202.254.180/tcp/13000", addr.String())
+
+	count, err := s.peers.Scorers().BadResponsesScorer().Count(outbound)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	direction, err := s.peers.Direction(outbound)
+	require.NoError(t, err)
+	assert.Equal(t, network.DirOutbound, direction)
+	record, err := s.peers.ENR(outbound)
+	require.NoError(t, err)
+	require.NotNil(t, record)
+	assert.Equal(t, localNode.Node().Record().Seq(), record.Seq())
+
+	state, err := s.peers.ConnectionState(inbound)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, state)
+	direction, err = s.peers.Direction(inbound)
+	require.NoError(t, err)
+	assert.Equal(t, network.DirInbound, direction)
+}
@@ -47,6 +47,9 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	// TrustedAddress is the address trusted peers are dialed at, nil for other peers.
+	TrustedAddress ma.Multiaddr
+	Banned         bool
 	// Chain related data.
 	MetaData                  *pb.MetaData
 	ChainState                *pb.Status
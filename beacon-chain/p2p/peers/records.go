@@ -0,0 +1,81 @@
+package peers
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+)
+
+// PeerRecord is the part of the status of a known peer that outlives the node process.
+type PeerRecord struct {
+	ID              peer.ID
+	Address         ma.Multiaddr
+	Direction       network.Direction
+	Enr             *enr.Record
+	TrustedAddress  ma.Multiaddr
+	BadResponses    int
+	ProcessedBlocks uint64
+}
+
+// Records returns the records of all peers with a known address, trusted peers first followed by
+// the others in descending order of their score.
+func (p *Status) Records() []*PeerRecord {
+	scores := make(map[peer.ID]float64)
+	for _, pid := range p.All() {
+		scores[pid] = p.scorers.Score(pid)
+	}
+
+	p.store.RLock()
+	records := make([]*PeerRecord, 0, len(p.store.Peers()))
+	for pid, peerData := range p.store.Peers() {
+		if peerData.Address == nil {
+			continue
+		}
+		records = append(records, &PeerRecord{
+			ID:              pid,
+			Address:         peerData.Address,
+			Direction:       peerData.Direction,
+			Enr:             peerData.Enr,
+			TrustedAddress:  peerData.TrustedAddress,
+			BadResponses:    peerData.BadResponses,
+			ProcessedBlocks: peerData.ProcessedBlocks,
+		})
+	}
+	p.store.RUnlock()
+
+	sort.Slice(records, func(i, j int) bool {
+		iTrusted, jTrusted := records[i].TrustedAddress != nil, records[j].TrustedAddress != nil
+		if iTrusted != jTrusted {
+			return iTrusted
+		}
+		return scores[records[i].ID] > scores[records[j].ID]
+	})
+	return records
+}
+
+// Restore adds the peers of the given records as disconnected peers. Peers which are already known
+// keep their current state.
+func (p *Status) Restore(records []*PeerRecord) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	for _, record := range records {
+		if _, ok := p.store.PeerData(record.ID); ok {
+			continue
+		}
+		p.store.SetPeerData(record.ID, &peerdata.PeerData{
+			Address:         record.Address,
+			Direction:       record.Direction,
+			ConnState:       PeerDisconnected,
+			Enr:             record.Enr,
+			TrustedAddress:  record.TrustedAddress,
+			BadResponses:    record.BadResponses,
+			ProcessedBlocks: record.ProcessedBlocks,
+		})
+		p.addIpToTracker(record.ID)
+	}
+}
@@ -0,0 +1,144 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func newTestStatus(peerLimit int) *peers.Status {
+	return peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: peerLimit,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+}
+
+func TestStatus_Trusted(t *testing.T) {
+	p := newTestStatus(30)
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	pid := createPeer(t, p, nil)
+	other := createPeer(t, p, nil)
+
+	p.AddTrusted(pid, addr)
+	assert.Equal(t, true, p.IsTrusted(pid))
+	assert.Equal(t, false, p.IsTrusted(other))
+	assert.DeepEqual(t, []peer.ID{pid}, p.Trusted())
+	trustedAddr, err := p.TrustedAddress(pid)
+	require.NoError(t, err)
+	assert.Equal(t, addr, trustedAddr)
+	// The trusted address is used as the peer address until the peer connects.
+	peerAddr, err := p.Address(pid)
+	require.NoError(t, err)
+	assert.Equal(t, addr, peerAddr)
+
+	otherAddr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/40312")
+	require.NoError(t, err)
+	assert.Equal(t, true, p.IsTrustedAddr(otherAddr))
+
+	// Trusted peers are never bad.
+	p.Scorers().BadResponsesScorer().Increment(pid)
+	p.Scorers().BadResponsesScorer().Increment(pid)
+	assert.Equal(t, false, p.IsBad(pid))
+
+	p.RemoveTrusted(pid)
+	assert.Equal(t, false, p.IsTrusted(pid))
+	assert.Equal(t, true, p.IsBad(pid))
+	assert.Equal(t, false, p.IsTrustedAddr(otherAddr))
+	_, err = p.TrustedAddress(pid)
+	assert.ErrorContains(t, "peer unknown", err)
+}
+
+func TestStatus_PruneKeepsTrusted(t *testing.T) {
+	p := newTestStatus(0)
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	trusted := addPeer(t, p, peers.PeerDisconnected)
+	p.AddTrusted(trusted, addr)
+	for i := 0; i < p.MaxPeerLimit()+10; i++ {
+		addPeer(t, p, peers.PeerDisconnected)
+	}
+
+	p.Prune()
+	assert.Equal(t, p.MaxPeerLimit(), len(p.All()))
+	assert.Equal(t, true, p.IsTrusted(trusted))
+}
+
+func TestStatus_RecordsRestore(t *testing.T) {
+	p := newTestStatus(30)
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	good := createPeer(t, p, addr)
+	bad := createPeer(t, p, addr)
+	trusted := createPeer(t, p, addr)
+	p.AddTrusted(trusted, addr)
+	// Peers without an address are not recorded.
+	createPeer(t, p, nil)
+	p.Scorers().BadResponsesScorer().Increment(bad)
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(good, 64)
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(bad, 0)
+
+	records := p.Records()
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, trusted, records[0].ID)
+	assert.Equal(t, addr, records[0].TrustedAddress)
+	assert.Equal(t, good, records[1].ID)
+	assert.Equal(t, uint64(64), records[1].ProcessedBlocks)
+	assert.Equal(t, bad, records[2].ID)
+	assert.Equal(t, 1, records[2].BadResponses)
+
+	restored := newTestStatus(30)
+	known := createPeer(t, restored, addr)
+	records = append(records, &peers.PeerRecord{ID: known, Address: addr, Direction: network.DirOutbound, BadResponses: 2})
+	restored.Restore(records)
+	assert.Equal(t, 4, len(restored.All()))
+	assert.Equal(t, true, restored.IsTrusted(trusted))
+	count, err := restored.Scorers().BadResponsesScorer().Count(bad)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	state, err := restored.ConnectionState(good)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, state)
+	// Known peers keep their state.
+	assert.Equal(t, false, restored.IsBad(known))
+	direction, err := restored.Direction(known)
+	require.NoError(t, err)
+	assert.Equal(t, network.DirUnknown, direction)
+}
+
+func TestStatus_Banned(t *testing.T) {
+	p := newTestStatus(0)
+	addr, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	banned := addPeer(t, p, peers.PeerDisconnected)
+	p.Ban(banned)
+	assert.Equal(t, true, p.IsBanned(banned))
+	assert.Equal(t, true, p.IsBad(banned))
+	assert.DeepEqual(t, []peer.ID{banned}, p.Banned())
+
+	// Bans take precedence over trust.
+	p.AddTrusted(banned, addr)
+	assert.Equal(t, true, p.IsBad(banned))
+
+	// Banned peers are never pruned.
+	for i := 0; i < p.MaxPeerLimit()+10; i++ {
+		addPeer(t, p, peers.PeerDisconnected)
+	}
+	p.Prune()
+	assert.Equal(t, true, p.IsBanned(banned))
+
+	p.Unban(banned)
+	assert.Equal(t, false, p.IsBanned(banned))
+	assert.Equal(t, 0, len(p.Banned()))
+}
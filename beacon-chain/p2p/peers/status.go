@@ -281,10 +281,131 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 
 // IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+// Banned peers are always bad, other trusted peers are never considered bad.
 func (p *Status) IsBad(pid peer.ID) bool {
+	if p.IsBanned(pid) {
+		return true
+	}
+	if p.IsTrusted(pid) {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
 }
 
+// AddTrusted marks the peer as trusted, to be dialed at the given address. Trusted peers are always
+// re-dialed, are never pruned and are not counted towards the peer limit.
+func (p *Status) AddTrusted(pid peer.ID, address ma.Multiaddr) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.TrustedAddress = address
+	if peerData.Address == nil {
+		peerData.Address = address
+		p.addIpToTracker(pid)
+	}
+}
+
+// RemoveTrusted unmarks the peer as trusted.
+func (p *Status) RemoveTrusted(pid peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		peerData.TrustedAddress = nil
+	}
+}
+
+// IsTrusted returns whether the peer is trusted.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return peerData.TrustedAddress != nil
+	}
+	return false
+}
+
+// TrustedAddress returns the address the trusted peer is dialed at.
+func (p *Status) TrustedAddress(pid peer.ID) (ma.Multiaddr, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok && peerData.TrustedAddress != nil {
+		return peerData.TrustedAddress, nil
+	}
+	return nil, peerdata.ErrPeerUnknown
+}
+
+// Trusted returns the trusted peers.
+func (p *Status) Trusted() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	peers := make([]peer.ID, 0)
+	for pid, peerData := range p.store.Peers() {
+		if peerData.TrustedAddress != nil {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
+
+// IsTrustedAddr returns whether the address has the IP of a trusted peer.
+func (p *Status) IsTrustedAddr(address ma.Multiaddr) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	for _, peerData := range p.store.Peers() {
+		if peerData.TrustedAddress != nil && sameIP(peerData.TrustedAddress, address) {
+			return true
+		}
+	}
+	return false
+}
+
+// Ban bans the peer. Banned peers are bad and are never pruned.
+func (p *Status) Ban(pid peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.Banned = true
+}
+
+// Unban lifts the ban of the peer.
+func (p *Status) Unban(pid peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		peerData.Banned = false
+	}
+}
+
+// IsBanned returns whether the peer is banned.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return peerData.Banned
+	}
+	return false
+}
+
+// Banned returns the banned peers.
+func (p *Status) Banned() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	peers := make([]peer.ID, 0)
+	for pid, peerData := range p.store.Peers() {
+		if peerData.Banned {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
+
 // NextValidTime gets the earliest possible time it is to contact/dial
 // a peer again. This is used to back-off from peers in the event
 // they are 'full' or have banned us.
@@ -438,7 +559,7 @@ func (p *Status) Prune() {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && peerData.TrustedAddress == nil && !peerData.Banned && notBadPeer(peerData) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -6,6 +6,7 @@ package p2p
 import (
 	"context"
 	"crypto/ecdsa"
+	"net"
 	"sync"
 	"time"
 
@@ -83,6 +84,10 @@ type Service struct {
 	host                  host.Host
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
+	bannedSubnets         map[string]*net.IPNet
+	bansLock              sync.Mutex
+	bansFileLock          sync.Mutex
+	auditLock             sync.Mutex
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -100,6 +105,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(GossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
+		bannedSubnets: make(map[string]*net.IPNet),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -170,6 +176,10 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 			},
 		},
 	})
+	if err := s.loadBans(); err != nil {
+		log.WithError(err).Error("Failed to load bans")
+		return nil, err
+	}
 
 	return s, nil
 }
@@ -215,8 +225,21 @@ func (s *Service) Start() {
 		go s.listenForNewNodes()
 	}
 
+	storedPeers, err := s.loadPeerStore()
+	if err != nil {
+		log.WithError(err).Error("Could not load peer store")
+	}
+	for _, addr := range s.cfg.TrustedPeers {
+		if _, err := s.AddTrustedPeer(addr); err != nil {
+			log.WithError(err).Errorf("Could not add trusted peer %s", addr)
+		}
+	}
+
 	s.started = true
 
+	go s.ensureTrustedPeerConnections()
+	s.redialStoredPeers(storedPeers)
+
 	if len(s.cfg.StaticPeers) > 0 {
 		addrs, err := peersFromStringAddrs(s.cfg.StaticPeers)
 		if err != nil {
@@ -228,7 +251,9 @@ func (s *Service) Start() {
 	// Periodic functions.
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
+		s.ensureTrustedPeerConnections()
 	})
+	runutil.RunEvery(s.ctx, peerStoreSaveInterval, s.savePeerStore)
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
@@ -255,6 +280,9 @@ func (s *Service) Start() {
 // Stop the p2p service and terminate all peer connections.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if s.started {
+		s.savePeerStore()
+	}
 	s.started = false
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
@@ -64,6 +64,51 @@ func (p *FakeP2P) FindPeersWithSubnet(_ context.Context, _ uint64) (bool, error)
 // RefreshENR mocks the p2p func.
 func (p *FakeP2P) RefreshENR() {}
 
+// AddTrustedPeer mocks the p2p func.
+func (p *FakeP2P) AddTrustedPeer(_ string) (peer.ID, error) {
+	return "fake", nil
+}
+
+// RemoveTrustedPeer mocks the p2p func.
+func (p *FakeP2P) RemoveTrustedPeer(_ peer.ID) error {
+	return nil
+}
+
+// AddPeer mocks the p2p func.
+func (p *FakeP2P) AddPeer(_ context.Context, _ string) (peer.ID, error) {
+	return "fake", nil
+}
+
+// DisconnectPeer mocks the p2p func.
+func (p *FakeP2P) DisconnectPeer(_ peer.ID) error {
+	return nil
+}
+
+// BanPeer mocks the p2p func.
+func (p *FakeP2P) BanPeer(_ peer.ID) error {
+	return nil
+}
+
+// UnbanPeer mocks the p2p func.
+func (p *FakeP2P) UnbanPeer(_ peer.ID) error {
+	return nil
+}
+
+// BanSubnet mocks the p2p func.
+func (p *FakeP2P) BanSubnet(_ string) error {
+	return nil
+}
+
+// UnbanSubnet mocks the p2p func.
+func (p *FakeP2P) UnbanSubnet(_ string) error {
+	return nil
+}
+
+// BannedSubnets mocks the p2p func.
+func (p *FakeP2P) BannedSubnets() []string {
+	return nil
+}
+
 // LeaveTopic -- fake.
 func (p *FakeP2P) LeaveTopic(_ string) error {
 	return nil
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
 )
 
 // MockPeerManager is mock of the PeerManager interface.
@@ -38,6 +39,59 @@ func (m MockPeerManager) ENR() *enr.Record {
 // RefreshENR .
 func (m MockPeerManager) RefreshENR() {}
 
+// AddTrustedPeer .
+func (m *MockPeerManager) AddTrustedPeer(addr string) (peer.ID, error) {
+	maddr, err := multiaddr.NewMultiaddr(addr)
+	if err != nil {
+		return "", err
+	}
+	info, err := peer.AddrInfoFromP2pAddr(maddr)
+	if err != nil {
+		return "", err
+	}
+	return info.ID, nil
+}
+
+// RemoveTrustedPeer .
+func (m *MockPeerManager) RemoveTrustedPeer(peer.ID) error {
+	return nil
+}
+
+// AddPeer .
+func (m *MockPeerManager) AddPeer(_ context.Context, addr string) (peer.ID, error) {
+	return m.AddTrustedPeer(addr)
+}
+
+// DisconnectPeer .
+func (m *MockPeerManager) DisconnectPeer(peer.ID) error {
+	return nil
+}
+
+// BanPeer .
+func (m *MockPeerManager) BanPeer(peer.ID) error {
+	return nil
+}
+
+// UnbanPeer .
+func (m *MockPeerManager) UnbanPeer(peer.ID) error {
+	return nil
+}
+
+// BanSubnet .
+func (m *MockPeerManager) BanSubnet(string) error {
+	return nil
+}
+
+// UnbanSubnet .
+func (m *MockPeerManager) UnbanSubnet(string) error {
+	return nil
+}
+
+// BannedSubnets .
+func (m *MockPeerManager) BannedSubnets() []string {
+	return nil
+}
+
 // FindPeersWithSubnet .
 func (m MockPeerManager) FindPeersWithSubnet(_ context.Context, _ uint64) (bool, error) {
 	return true, nil
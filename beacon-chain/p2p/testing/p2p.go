@@ -5,6 +5,7 @@ package testing
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -40,6 +41,7 @@ type TestP2P struct {
 	Digest          [4]byte
 	peers           *peers.Status
 	LocalMetadata   *pb.MetaData
+	Subnets         []string
 }
 
 // NewTestP2P initializes a new p2p test service.
@@ -321,6 +323,79 @@ func (p *TestP2P) FindPeersWithSubnet(_ context.Context, _ uint64) (bool, error)
 // RefreshENR mocks the p2p func.
 func (p *TestP2P) RefreshENR() {}
 
+// AddTrustedPeer marks the peer of the multiaddr as trusted in the peer status.
+func (p *TestP2P) AddTrustedPeer(addr string) (peer.ID, error) {
+	maddr, err := multiaddr.NewMultiaddr(addr)
+	if err != nil {
+		return "", err
+	}
+	info, err := peer.AddrInfoFromP2pAddr(maddr)
+	if err != nil {
+		return "", err
+	}
+	p.peers.AddTrusted(info.ID, info.Addrs[0])
+	return info.ID, nil
+}
+
+// RemoveTrustedPeer unmarks the peer as trusted in the peer status.
+func (p *TestP2P) RemoveTrustedPeer(pid peer.ID) error {
+	p.peers.RemoveTrusted(pid)
+	return nil
+}
+
+// AddPeer adds the peer of the multiaddr to the peer status.
+func (p *TestP2P) AddPeer(_ context.Context, addr string) (peer.ID, error) {
+	maddr, err := multiaddr.NewMultiaddr(addr)
+	if err != nil {
+		return "", err
+	}
+	info, err := peer.AddrInfoFromP2pAddr(maddr)
+	if err != nil {
+		return "", err
+	}
+	p.peers.Add(nil, info.ID, info.Addrs[0], network.DirOutbound)
+	return info.ID, nil
+}
+
+// DisconnectPeer closes the connections to the peer.
+func (p *TestP2P) DisconnectPeer(pid peer.ID) error {
+	return p.Disconnect(pid)
+}
+
+// BanPeer bans the peer in the peer status.
+func (p *TestP2P) BanPeer(pid peer.ID) error {
+	p.peers.Ban(pid)
+	return nil
+}
+
+// UnbanPeer unbans the peer in the peer status.
+func (p *TestP2P) UnbanPeer(pid peer.ID) error {
+	p.peers.Unban(pid)
+	return nil
+}
+
+// BanSubnet adds the subnet to the banned subnets.
+func (p *TestP2P) BanSubnet(cidr string) error {
+	p.Subnets = append(p.Subnets, cidr)
+	return nil
+}
+
+// UnbanSubnet removes the subnet from the banned subnets.
+func (p *TestP2P) UnbanSubnet(cidr string) error {
+	for i, subnet := range p.Subnets {
+		if subnet == cidr {
+			p.Subnets = append(p.Subnets[:i], p.Subnets[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("subnet is not banned")
+}
+
+// BannedSubnets returns the banned subnets.
+func (p *TestP2P) BannedSubnets() []string {
+	return p.Subnets
+}
+
 // ForkDigest mocks the p2p func.
 func (p *TestP2P) ForkDigest() ([4]byte, error) {
 	return p.Digest, nil
@@ -32,7 +32,7 @@ func (s *Service) BlockExists(ctx context.Context, hash common.Hash) (bool, *big
 		return true, hdrInfo.Number, nil
 	}
 	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
-	header, err := s.eth1DataFetcher.HeaderByHash(ctx, hash)
+	header, err := s.currDataFetcher().HeaderByHash(ctx, hash)
 	if err != nil {
 		return false, big.NewInt(0), errors.Wrap(err, "could not query block with given hash")
 	}
@@ -73,13 +73,14 @@ func (s *Service) BlockHashByHeight(ctx context.Context, height *big.Int) (commo
 	}
 	span.AddAttributes(trace.BoolAttribute("headerCacheHit", false))
 
-	if s.eth1DataFetcher == nil {
+	fetcher := s.currDataFetcher()
+	if fetcher == nil {
 		err := errors.New("nil eth1DataFetcher")
 		traceutil.AnnotateError(span, err)
 		return [32]byte{}, err
 	}
 
-	header, err := s.eth1DataFetcher.HeaderByNumber(ctx, height)
+	header, err := fetcher.HeaderByNumber(ctx, height)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, fmt.Sprintf("could not query header with height %d", height.Uint64()))
 	}
@@ -93,13 +94,14 @@ func (s *Service) BlockHashByHeight(ctx context.Context, height *big.Int) (commo
 func (s *Service) BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockTimeByHeight")
 	defer span.End()
-	if s.eth1DataFetcher == nil {
+	fetcher := s.currDataFetcher()
+	if fetcher == nil {
 		err := errors.New("nil eth1DataFetcher")
 		traceutil.AnnotateError(span, err)
 		return 0, err
 	}
 
-	header, err := s.eth1DataFetcher.HeaderByNumber(ctx, height)
+	header, err := fetcher.HeaderByNumber(ctx, height)
 	if err != nil {
 		return 0, errors.Wrap(err, fmt.Sprintf("could not query block with height %d", height.Uint64()))
 	}
@@ -113,8 +115,10 @@ func (s *Service) BlockNumberByTimestamp(ctx context.Context, time uint64) (*big
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockByTimestamp")
 	defer span.End()
 
+	s.lock.RLock()
 	latestBlkHeight := s.latestEth1Data.BlockHeight
 	latestBlkTime := s.latestEth1Data.BlockTime
+	s.lock.RUnlock()
 
 	if time > latestBlkTime {
 		return nil, errors.New("provided time is later than the current eth1 head")
@@ -219,7 +223,7 @@ func (s *Service) retrieveHeaderInfo(ctx context.Context, bNum uint64) (*headerI
 		return nil, err
 	}
 	if !exists {
-		blk, err := s.eth1DataFetcher.HeaderByNumber(ctx, bn)
+		blk, err := s.currDataFetcher().HeaderByNumber(ctx, bn)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,122 @@
+package powchain
+
+import (
+	"fmt"
+	"time"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	activeEndpointIndex = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_active_endpoint_index",
+		Help: "The index of the eth1 endpoint in use, 0 being the primary endpoint",
+	})
+	endpointHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_healthy",
+		Help: "Whether the eth1 endpoint passed its last health check (1) or not (0), by endpoint index",
+	}, []string{"index"})
+	endpointFailoverCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_endpoint_failovers_total",
+		Help: "The number of times the service switched to another eth1 endpoint",
+	})
+)
+
+// Number of blocks an eth1 endpoint may trail the latest known eth1 head
+// before it is considered unhealthy.
+var eth1HeadLagThreshold = uint64(10)
+
+// Age of the head block of an eth1 endpoint after which the endpoint is considered stalled
+// and the service falls back to the next endpoint.
+var eth1HeadStaleThreshold = 5 * time.Minute
+
+// Period at which the primary eth1 endpoint is checked while using a fallback.
+var primaryEndpointCheckPeriod = 1 * time.Minute
+
+// currHTTPEndpoint returns the eth1 endpoint currently used by the service.
+func (s *Service) currHTTPEndpoint() string {
+	return s.httpEndpoints[s.currEndpoint()]
+}
+
+// currEndpoint returns the index of the eth1 endpoint currently used by the service.
+func (s *Service) currEndpoint() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.currEndpointIndex
+}
+
+func (s *Service) setEndpoint(index int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if index != s.currEndpointIndex {
+		endpointFailoverCount.Inc()
+	}
+	s.currEndpointIndex = index
+	activeEndpointIndex.Set(float64(index))
+}
+
+// fallbackToNextEndpoint switches to the endpoint following the current one,
+// wrapping around to the primary endpoint after the last one.
+func (s *Service) fallbackToNextEndpoint() {
+	if len(s.httpEndpoints) <= 1 {
+		return
+	}
+	s.setEndpoint((s.currEndpoint() + 1) % len(s.httpEndpoints))
+	log.WithFields(logrus.Fields{
+		"endpoint": s.currHTTPEndpoint(),
+	}).Info("Falling back to next eth1 endpoint")
+}
+
+// checkPrimaryEndpoint dials the primary endpoint while a fallback endpoint is in use,
+// returning its connection once it is healthy and synced again, and nil otherwise.
+func (s *Service) checkPrimaryEndpoint() *endpointConnection {
+	if s.currEndpoint() == 0 {
+		return nil
+	}
+	conn, err := s.dialEndpoint(0)
+	if err != nil {
+		log.WithError(err).Debug("Primary eth1 endpoint is still unavailable")
+		return nil
+	}
+	return conn
+}
+
+// checkPrimaryEndpointInBackground checks the primary endpoint and hands the result over to the
+// run loop. The dial is bound to the service context, and a connection which can no longer be
+// handed over because the service stopped is closed.
+func (s *Service) checkPrimaryEndpointInBackground(conns chan<- *endpointConnection) {
+	conn := s.checkPrimaryEndpoint()
+	select {
+	case conns <- conn:
+	case <-s.ctx.Done():
+		if conn != nil {
+			conn.close()
+		}
+	}
+}
+
+// switchToPrimaryEndpoint switches back to the dialed primary endpoint.
+func (s *Service) switchToPrimaryEndpoint(conn *endpointConnection) {
+	s.useEndpoint(0, conn)
+	log.WithFields(logrus.Fields{
+		"endpoint": s.currHTTPEndpoint(),
+	}).Info("Switched back to primary eth1 endpoint")
+}
+
+// checkHeadStaleness returns an error if the head block of the eth1 endpoint in use is older
+// than the stale threshold while other endpoints are configured, as an endpoint which still
+// answers may have stopped following the eth1 chain.
+func (s *Service) checkHeadStaleness(head *gethTypes.Header) error {
+	if len(s.httpEndpoints) <= 1 {
+		return nil
+	}
+	headTime := time.Unix(int64(head.Time), 0)
+	if age := timeutils.Now().Sub(headTime); age > eth1HeadStaleThreshold {
+		return fmt.Errorf("eth1 head block %d is %v old", head.Number.Uint64(), age.Round(time.Second))
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// fakeEth1Node answers the JSON-RPC calls made when dialing an eth1 endpoint.
+type fakeEth1Node struct {
+	chainID   uint64
+	networkID uint64
+	head      uint64
+	syncing   bool
+}
+
+type fakeEthAPI struct {
+	node *fakeEth1Node
+}
+
+func (api *fakeEthAPI) Syncing() (interface{}, error) {
+	if api.node.syncing {
+		return map[string]hexutil.Uint64{"currentBlock": 1, "highestBlock": 2}, nil
+	}
+	return false, nil
+}
+
+func (api *fakeEthAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetUint64(api.node.chainID))
+}
+
+func (api *fakeEthAPI) GetBlockByNumber(_ string, _ bool) *gethTypes.Header {
+	return &gethTypes.Header{
+		Number:     new(big.Int).SetUint64(api.node.head),
+		Difficulty: big.NewInt(1),
+	}
+}
+
+type fakeNetAPI struct {
+	node *fakeEth1Node
+}
+
+func (api *fakeNetAPI) Version() string {
+	return strconv.FormatUint(api.node.networkID, 10)
+}
+
+func newFakeEth1Server(t *testing.T, node *fakeEth1Node) *httptest.Server {
+	srv := gethRPC.NewServer()
+	require.NoError(t, srv.RegisterName("eth", &fakeEthAPI{node: node}))
+	require.NoError(t, srv.RegisterName("net", &fakeNetAPI{node: node}))
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		httpSrv.Close()
+		srv.Stop()
+	})
+	return httpSrv
+}
+
+// newFakeEth1WebsocketServer serves the fake eth1 node over a websocket, returning its URL.
+func newFakeEth1WebsocketServer(t *testing.T, node *fakeEth1Node) string {
+	srv := gethRPC.NewServer()
+	require.NoError(t, srv.RegisterName("eth", &fakeEthAPI{node: node}))
+	require.NoError(t, srv.RegisterName("net", &fakeNetAPI{node: node}))
+	httpSrv := httptest.NewServer(srv.WebsocketHandler([]string{"*"}))
+	t.Cleanup(func() {
+		httpSrv.Close()
+		srv.Stop()
+	})
+	return "ws" + strings.TrimPrefix(httpSrv.URL, "http")
+}
+
+func healthyEth1Node(head uint64) *fakeEth1Node {
+	return &fakeEth1Node{
+		chainID:   params.BeaconNetworkConfig().ChainID,
+		networkID: params.BeaconNetworkConfig().NetworkID,
+		head:      head,
+	}
+}
+
+func TestNewService_IgnoresEmptyEndpoints(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	s, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints: []string{"", "http://a", "", "http://b"},
+		BeaconDB:      beaconDB,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"http://a", "http://b"}, s.httpEndpoints)
+	assert.Equal(t, "http://a", s.currHTTPEndpoint())
+}
+
+func TestFallbackToNextEndpoint(t *testing.T) {
+	s := &Service{httpEndpoints: []string{"http://a", "http://b", "http://c"}}
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, "http://b", s.currHTTPEndpoint())
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, "http://c", s.currHTTPEndpoint())
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, "http://a", s.currHTTPEndpoint())
+
+	single := &Service{httpEndpoints: []string{"http://a"}}
+	single.fallbackToNextEndpoint()
+	assert.Equal(t, "http://a", single.currHTTPEndpoint())
+}
+
+func TestConnectToPowChain_HealthChecks(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+
+	wrongChain := healthyEth1Node(100)
+	wrongChain.chainID++
+	syncing := healthyEth1Node(100)
+	syncing.syncing = true
+	tests := []struct {
+		name    string
+		node    *fakeEth1Node
+		wantErr string
+	}{
+		{name: "healthy", node: healthyEth1Node(100)},
+		{name: "within lag threshold", node: healthyEth1Node(95)},
+		{name: "syncing", node: syncing, wantErr: "eth1 node has not finished syncing yet"},
+		{name: "wrong chain id", node: wrongChain, wantErr: "eth1 node using incorrect chain id"},
+		{name: "lagging", node: healthyEth1Node(50), wantErr: "eth1 node is lagging behind"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newFakeEth1Server(t, tt.node)
+			s, err := NewService(context.Background(), &Web3ServiceConfig{
+				HTTPEndpoints: []string{srv.URL},
+				BeaconDB:      beaconDB,
+			})
+			require.NoError(t, err)
+			s.latestEth1Data.BlockHeight = 100
+			err = s.connectToPowChain()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				assert.Equal(t, nil, s.eth1DataFetcher)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, s.eth1DataFetcher)
+		})
+	}
+}
+
+func TestCheckPrimaryEndpoint(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	primary := healthyEth1Node(100)
+	primary.syncing = true
+	primarySrv := newFakeEth1Server(t, primary)
+	fallbackSrv := newFakeEth1Server(t, healthyEth1Node(100))
+
+	s, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints: []string{primarySrv.URL, fallbackSrv.URL},
+		BeaconDB:      beaconDB,
+	})
+	require.NoError(t, err)
+
+	// The primary endpoint is unhealthy, so the service moves to the fallback.
+	require.ErrorContains(t, "eth1 node has not finished syncing yet", s.connectToPowChain())
+	s.fallbackToNextEndpoint()
+	require.NoError(t, s.connectToPowChain())
+	assert.Equal(t, fallbackSrv.URL, s.currHTTPEndpoint())
+
+	assert.Equal(t, (*endpointConnection)(nil), s.checkPrimaryEndpoint(), "Dialed unhealthy primary endpoint")
+	assert.Equal(t, fallbackSrv.URL, s.currHTTPEndpoint())
+
+	// Once the primary endpoint recovers, the service switches back to it.
+	primary.syncing = false
+	conn := s.checkPrimaryEndpoint()
+	require.NotNil(t, conn)
+	assert.Equal(t, fallbackSrv.URL, s.currHTTPEndpoint(), "Switched endpoint before the connection was used")
+	s.switchToPrimaryEndpoint(conn)
+	assert.Equal(t, primarySrv.URL, s.currHTTPEndpoint())
+}
+
+func TestCheckPrimaryEndpoint_ClosesOldClients(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	primary := healthyEth1Node(100)
+	primary.syncing = true
+	primarySrv := newFakeEth1Server(t, primary)
+	fallbackURL := newFakeEth1WebsocketServer(t, healthyEth1Node(100))
+
+	s, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints: []string{primarySrv.URL, fallbackURL},
+		BeaconDB:      beaconDB,
+	})
+	require.NoError(t, err)
+	s.fallbackToNextEndpoint()
+	require.NoError(t, s.connectToPowChain())
+	fallbackClient, ok := s.rpcClient.(*gethRPC.Client)
+	require.Equal(t, true, ok)
+
+	// The endpoint, its clients and the latest block are read while the primary endpoint is checked.
+	primary.syncing = false
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			s.currHTTPEndpoint()
+			s.LatestBlockHeight()
+			s.currDataFetcher()
+			s.currRPCClient()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := uint64(0); i < 100; i++ {
+			s.processBlockHeader(&gethTypes.Header{Number: new(big.Int).SetUint64(90 + i%10)})
+		}
+	}()
+	conn := s.checkPrimaryEndpoint()
+	require.NotNil(t, conn)
+	s.switchToPrimaryEndpoint(conn)
+	wg.Wait()
+	assert.Equal(t, primarySrv.URL, s.currHTTPEndpoint())
+
+	// The clients of the fallback endpoint are closed.
+	assert.ErrorContains(t, "client is closed", fallbackClient.Call(nil, "eth_chainId"))
+}
+
+func TestCheckPrimaryEndpointInBackground_StopsWithService(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	release := make(chan struct{})
+	defer close(release)
+	// The primary endpoint never answers, so its dial stays pending.
+	primarySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer primarySrv.Close()
+	fallbackSrv := newFakeEth1Server(t, healthyEth1Node(100))
+
+	s, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints: []string{primarySrv.URL, fallbackSrv.URL},
+		BeaconDB:      beaconDB,
+	})
+	require.NoError(t, err)
+	s.fallbackToNextEndpoint()
+	require.NoError(t, s.connectToPowChain())
+
+	conns := make(chan *endpointConnection)
+	done := make(chan struct{})
+	go func() {
+		s.checkPrimaryEndpointInBackground(conns)
+		close(done)
+	}()
+	require.NoError(t, s.Stop())
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Primary endpoint check did not stop with the service")
+	}
+}
+
+func TestCheckPrimaryEndpointInBackground_NotHandedOverAfterStop(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	primarySrv := newFakeEth1Server(t, healthyEth1Node(100))
+	fallbackSrv := newFakeEth1Server(t, healthyEth1Node(100))
+
+	s, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints: []string{primarySrv.URL, fallbackSrv.URL},
+		BeaconDB:      beaconDB,
+	})
+	require.NoError(t, err)
+	s.fallbackToNextEndpoint()
+	require.NoError(t, s.connectToPowChain())
+
+	// Nothing receives the dialed connection, as the run loop has exited.
+	conns := make(chan *endpointConnection)
+	done := make(chan struct{})
+	go func() {
+		s.checkPrimaryEndpointInBackground(conns)
+		close(done)
+	}()
+	require.NoError(t, s.Stop())
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Primary endpoint check did not stop with the service")
+	}
+	assert.Equal(t, fallbackSrv.URL, s.currHTTPEndpoint())
+}
+
+func TestCheckHeadStaleness(t *testing.T) {
+	s := &Service{httpEndpoints: []string{"http://a", "http://b"}}
+	now := uint64(timeutils.Now().Unix())
+	fresh := &gethTypes.Header{Number: big.NewInt(100), Time: now}
+	assert.NoError(t, s.checkHeadStaleness(fresh))
+	stale := &gethTypes.Header{Number: big.NewInt(100), Time: now - uint64(2*eth1HeadStaleThreshold.Seconds())}
+	assert.ErrorContains(t, "eth1 head block 100 is", s.checkHeadStaleness(stale))
+
+	// A single endpoint has nothing to fall back to.
+	single := &Service{httpEndpoints: []string{"http://a"}}
+	assert.NoError(t, single.checkHeadStaleness(stale))
+}
@@ -49,7 +49,7 @@ func (s *Service) ProcessETH1Block(ctx context.Context, blkNum *big.Int) error {
 		FromBlock: blkNum,
 		ToBlock:   blkNum,
 	}
-	logs, err := s.httpLogger.FilterLogs(ctx, query)
+	logs, err := s.currLogFilterer().FilterLogs(ctx, query)
 	if err != nil {
 		return err
 	}
@@ -250,7 +250,7 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 	}
 	// To store all blocks.
 	headersMap := make(map[uint64]*gethTypes.Header)
-	rawLogCount, err := s.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	rawLogCount, err := s.currDepositContractCaller().GetDepositCount(&bind.CallOpts{})
 	if err != nil {
 		return err
 	}
@@ -298,7 +298,7 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 			query.ToBlock = big.NewInt(int64(latestFollowHeight))
 			end = latestFollowHeight
 		}
-		logs, err := s.httpLogger.FilterLogs(ctx, query)
+		logs, err := s.currLogFilterer().FilterLogs(ctx, query)
 		if err != nil {
 			return err
 		}
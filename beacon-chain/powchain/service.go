@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"reflect"
 	"runtime/debug"
+	"strconv"
 	"sync"
 	"time"
 
@@ -129,11 +130,13 @@ type Service struct {
 	isRunning               bool
 	depositContractAddress  common.Address
 	processingLock          sync.RWMutex
+	lock                    sync.RWMutex // guards the current endpoint, its clients and the latest eth1 block.
 	ctx                     context.Context
 	cancel                  context.CancelFunc
 	headerChan              chan *gethTypes.Header
 	headTicker              *time.Ticker
-	httpEndpoint            string
+	httpEndpoints           []string // ordered eth1 endpoints, the first one being the primary.
+	currEndpointIndex       int
 	stateNotifier           statefeed.Notifier
 	httpLogger              bind.ContractFilterer
 	eth1DataFetcher         RPCDataFetcher
@@ -154,7 +157,7 @@ type Service struct {
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
 type Web3ServiceConfig struct {
-	HTTPEndPoint       string
+	HTTPEndpoints      []string
 	DepositContract    common.Address
 	BeaconDB           db.HeadAccessDatabase
 	DepositCache       *depositcache.DepositCache
@@ -183,11 +186,18 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		eth1HeaderReqLimit = defaultEth1HeaderReqLimit
 	}
 
+	endpoints := make([]string, 0, len(config.HTTPEndpoints))
+	for _, endpoint := range config.HTTPEndpoints {
+		if endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
 	s := &Service{
-		ctx:          ctx,
-		cancel:       cancel,
-		headerChan:   make(chan *gethTypes.Header),
-		httpEndpoint: config.HTTPEndPoint,
+		ctx:           ctx,
+		cancel:        cancel,
+		headerChan:    make(chan *gethTypes.Header),
+		httpEndpoints: endpoints,
 		latestEth1Data: &protodb.LatestETH1Data{
 			BlockHeight:        0,
 			BlockTime:          0,
@@ -237,7 +247,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 func (s *Service) Start() {
 	// If the chain has not started already and we don't have access to eth1 nodes, we will not be
 	// able to generate the genesis state.
-	if !s.chainStartData.Chainstarted && s.httpEndpoint == "" {
+	if !s.chainStartData.Chainstarted && len(s.httpEndpoints) == 0 {
 		// check for genesis state before shutting down the node,
 		// if a genesis state exists, we can continue on.
 		genState, err := s.beaconDB.GenesisState(s.ctx)
@@ -250,9 +260,10 @@ func (s *Service) Start() {
 	}
 
 	// Exit early if eth1 endpoint is not set.
-	if s.httpEndpoint == "" {
+	if len(s.httpEndpoints) == 0 {
 		return
 	}
+	activeEndpointIndex.Set(0)
 	go func() {
 		s.waitForConnection()
 		if s.ctx.Err() != nil {
@@ -329,7 +340,13 @@ func (s *Service) DepositTrie() *trieutil.SparseMerkleTrie {
 
 // LatestBlockHeight in the ETH1.0 chain.
 func (s *Service) LatestBlockHeight() *big.Int {
-	return big.NewInt(int64(s.latestEth1Data.BlockHeight))
+	return big.NewInt(int64(s.latestBlockHeight()))
+}
+
+func (s *Service) latestBlockHeight() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.latestEth1Data.BlockHeight
 }
 
 // LatestBlockHash in the ETH1.0 chain.
@@ -342,7 +359,7 @@ func (s *Service) LatestBlockHash() common.Hash {
 func (s *Service) AreAllDepositsProcessed() (bool, error) {
 	s.processingLock.RLock()
 	defer s.processingLock.RUnlock()
-	countByte, err := s.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	countByte, err := s.currDepositContractCaller().GetDepositCount(&bind.CallOpts{})
 	if err != nil {
 		return false, errors.Wrap(err, "could not get deposit count")
 	}
@@ -358,33 +375,98 @@ func (s *Service) AreAllDepositsProcessed() (bool, error) {
 // SECONDS_PER_ETH1_BLOCK * ETH1_FOLLOW_DISTANCE <= current_unix_time
 func (s *Service) followBlockHeight(ctx context.Context) (uint64, error) {
 	latestValidBlock := uint64(0)
-	if s.latestEth1Data.BlockHeight > params.BeaconConfig().Eth1FollowDistance {
-		latestValidBlock = s.latestEth1Data.BlockHeight - params.BeaconConfig().Eth1FollowDistance
+	latestBlockHeight := s.latestBlockHeight()
+	if latestBlockHeight > params.BeaconConfig().Eth1FollowDistance {
+		latestValidBlock = latestBlockHeight - params.BeaconConfig().Eth1FollowDistance
 	}
 	return latestValidBlock, nil
 }
 
 func (s *Service) connectToPowChain() error {
-	httpClient, rpcClient, err := s.dialETH1Nodes()
+	return s.connectToEndpoint(s.currEndpoint())
+}
+
+// endpointConnection holds the clients of a dialed eth1 endpoint.
+type endpointConnection struct {
+	httpClient            *ethclient.Client
+	rpcClient             *gethRPC.Client
+	depositContractCaller *contracts.DepositContractCaller
+}
+
+func (c *endpointConnection) close() {
+	c.rpcClient.Close()
+	c.httpClient.Close()
+}
+
+// connectToEndpoint dials the endpoint at the given index of the configured endpoints and,
+// if it is healthy and synced, makes it the endpoint used by the service. The clients of the
+// previous endpoint are closed.
+func (s *Service) connectToEndpoint(index int) error {
+	conn, err := s.dialEndpoint(index)
+	if err != nil {
+		return err
+	}
+	s.useEndpoint(index, conn)
+	return nil
+}
+
+// dialEndpoint dials the endpoint at the given index of the configured endpoints, and
+// checks that it is healthy and synced.
+func (s *Service) dialEndpoint(index int) (*endpointConnection, error) {
+	httpClient, rpcClient, err := s.dialETH1Nodes(s.httpEndpoints[index])
 	if err != nil {
-		return errors.Wrap(err, "could not dial eth1 nodes")
+		endpointHealthy.WithLabelValues(strconv.Itoa(index)).Set(0)
+		return nil, errors.Wrap(err, "could not dial eth1 nodes")
 	}
+	closeClients := func() {
+		rpcClient.Close()
+		httpClient.Close()
+	}
+	synced, err := eth1NodeSynced(s.ctx, httpClient)
+	if err != nil {
+		closeClients()
+		endpointHealthy.WithLabelValues(strconv.Itoa(index)).Set(0)
+		return nil, errors.Wrap(err, "could not check sync status of eth1 node")
+	}
+	if !synced {
+		closeClients()
+		endpointHealthy.WithLabelValues(strconv.Itoa(index)).Set(0)
+		return nil, errors.New("eth1 node has not finished syncing yet")
+	}
+	endpointHealthy.WithLabelValues(strconv.Itoa(index)).Set(1)
 
 	depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, httpClient)
 	if err != nil {
-		return errors.Wrap(err, "could not create deposit contract caller")
+		closeClients()
+		return nil, errors.Wrap(err, "could not create deposit contract caller")
 	}
 
 	if httpClient == nil || rpcClient == nil || depositContractCaller == nil {
-		return errors.New("eth1 client is nil")
+		return nil, errors.New("eth1 client is nil")
 	}
+	return &endpointConnection{
+		httpClient:            httpClient,
+		rpcClient:             rpcClient,
+		depositContractCaller: depositContractCaller,
+	}, nil
+}
 
-	s.initializeConnection(httpClient, rpcClient, depositContractCaller)
-	return nil
+// useEndpoint makes the dialed endpoint at the given index the endpoint used by the service,
+// closing the clients of the previous endpoint.
+func (s *Service) useEndpoint(index int, conn *endpointConnection) {
+	oldHTTPClient, oldRPCClient := s.currDataFetcher(), s.currRPCClient()
+	s.setEndpoint(index)
+	s.initializeConnection(conn.httpClient, conn.rpcClient, conn.depositContractCaller)
+	if c, ok := oldRPCClient.(*gethRPC.Client); ok && c != conn.rpcClient {
+		c.Close()
+	}
+	if c, ok := oldHTTPClient.(*ethclient.Client); ok && c != conn.httpClient {
+		c.Close()
+	}
 }
 
-func (s *Service) dialETH1Nodes() (*ethclient.Client, *gethRPC.Client, error) {
-	httpRPCClient, err := gethRPC.Dial(s.httpEndpoint)
+func (s *Service) dialETH1Nodes(endpoint string) (*ethclient.Client, *gethRPC.Client, error) {
+	httpRPCClient, err := gethRPC.DialContext(s.ctx, endpoint)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -395,15 +477,6 @@ func (s *Service) dialETH1Nodes() (*ethclient.Client, *gethRPC.Client, error) {
 		httpRPCClient.Close()
 		httpClient.Close()
 	}
-	syncProg, err := httpClient.SyncProgress(s.ctx)
-	if err != nil {
-		closeClients()
-		return nil, nil, err
-	}
-	if syncProg != nil {
-		closeClients()
-		return nil, nil, errors.New("eth1 node has not finished syncing yet")
-	}
 	// Make a simple call to ensure we are actually connected to a working node.
 	cID, err := httpClient.ChainID(s.ctx)
 	if err != nil {
@@ -423,6 +496,16 @@ func (s *Service) dialETH1Nodes() (*ethclient.Client, *gethRPC.Client, error) {
 		closeClients()
 		return nil, nil, fmt.Errorf("eth1 node using incorrect network id, %d != %d", nID.Uint64(), params.BeaconNetworkConfig().NetworkID)
 	}
+	// Ensure the node is not trailing the latest eth1 head seen through any endpoint.
+	header, err := httpClient.HeaderByNumber(s.ctx, nil)
+	if err != nil {
+		closeClients()
+		return nil, nil, err
+	}
+	if latestBlockHeight := s.latestBlockHeight(); header.Number.Uint64()+eth1HeadLagThreshold < latestBlockHeight {
+		closeClients()
+		return nil, nil, fmt.Errorf("eth1 node is lagging behind, head block %d < %d", header.Number.Uint64(), latestBlockHeight)
+	}
 
 	return httpClient, httpRPCClient, nil
 }
@@ -432,12 +515,43 @@ func (s *Service) initializeConnection(
 	rpcClient *gethRPC.Client,
 	contractCaller *contracts.DepositContractCaller,
 ) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	s.httpLogger = httpClient
 	s.eth1DataFetcher = httpClient
 	s.depositContractCaller = contractCaller
 	s.rpcClient = rpcClient
 }
 
+// currDataFetcher returns the eth1 data fetcher of the endpoint currently used by the service.
+func (s *Service) currDataFetcher() RPCDataFetcher {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.eth1DataFetcher
+}
+
+// currLogFilterer returns the log filterer of the endpoint currently used by the service.
+func (s *Service) currLogFilterer() bind.ContractFilterer {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.httpLogger
+}
+
+// currRPCClient returns the rpc client of the endpoint currently used by the service.
+func (s *Service) currRPCClient() RPCClient {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.rpcClient
+}
+
+// currDepositContractCaller returns the deposit contract caller of the endpoint currently
+// used by the service.
+func (s *Service) currDepositContractCaller() *contracts.DepositContractCaller {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.depositContractCaller
+}
+
 func (s *Service) waitForConnection() {
 	errConnect := s.connectToPowChain()
 	if errConnect == nil {
@@ -446,7 +560,7 @@ func (s *Service) waitForConnection() {
 		if synced {
 			s.connectedETH1 = true
 			log.WithFields(logrus.Fields{
-				"endpoint": s.httpEndpoint,
+				"endpoint": s.currHTTPEndpoint(),
 			}).Info("Connected to eth1 proof-of-work chain")
 			return
 		}
@@ -455,8 +569,9 @@ func (s *Service) waitForConnection() {
 		}
 	}
 	if errConnect != nil {
-		log.WithError(errConnect).Error("Could not connect to powchain endpoint")
+		log.WithError(errConnect).WithField("endpoint", s.currHTTPEndpoint()).Error("Could not connect to powchain endpoint")
 	}
+	s.fallbackToNextEndpoint()
 	// Use a custom logger to only log errors
 	// once in  a while.
 	logCounter := 0
@@ -475,21 +590,24 @@ func (s *Service) waitForConnection() {
 			errConnect := s.connectToPowChain()
 			if errConnect != nil {
 				errorLogger(errConnect, "Could not connect to powchain endpoint")
+				s.fallbackToNextEndpoint()
 				continue
 			}
 			synced, errSynced := s.isEth1NodeSynced()
 			if errSynced != nil {
 				errorLogger(errSynced, "Could not check sync status of eth1 chain")
+				s.fallbackToNextEndpoint()
 				continue
 			}
 			if synced {
 				s.connectedETH1 = true
 				log.WithFields(logrus.Fields{
-					"endpoint": s.httpEndpoint,
+					"endpoint": s.currHTTPEndpoint(),
 				}).Info("Connected to eth1 proof-of-work chain")
 				return
 			}
 			log.Debug("Eth1 node is currently syncing")
+			s.fallbackToNextEndpoint()
 		case <-s.ctx.Done():
 			log.Debug("Received cancelled context,closing existing powchain service")
 			return
@@ -500,17 +618,23 @@ func (s *Service) waitForConnection() {
 // checks if the eth1 node is healthy and ready to serve before
 // fetching data from  it.
 func (s *Service) isEth1NodeSynced() (bool, error) {
-	syncProg, err := s.eth1DataFetcher.SyncProgress(s.ctx)
+	return eth1NodeSynced(s.ctx, s.currDataFetcher())
+}
+
+func eth1NodeSynced(ctx context.Context, fetcher RPCDataFetcher) (bool, error) {
+	syncProg, err := fetcher.SyncProgress(ctx)
 	if err != nil {
 		return false, err
 	}
 	return syncProg == nil, nil
 }
 
-// Reconnect to eth1 node in case of any failure.
+// Reconnect to eth1 node in case of any failure. The next configured
+// endpoint is tried first, if there is any.
 func (s *Service) retryETH1Node(err error) {
 	s.runError = err
 	s.connectedETH1 = false
+	s.fallbackToNextEndpoint()
 	// Back off for a while before
 	// resuming dialing the eth1 node.
 	time.Sleep(backOffPeriod)
@@ -569,13 +693,20 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 func (s *Service) processBlockHeader(header *gethTypes.Header) {
 	defer safelyHandlePanic()
 	blockNumberGauge.Set(float64(header.Number.Int64()))
+	s.setLatestBlock(header)
+	log.WithFields(logrus.Fields{
+		"blockNumber": header.Number.Uint64(),
+		"blockHash":   header.Hash().Hex(),
+	}).Debug("Latest eth1 chain event")
+}
+
+// setLatestBlock sets the latest eth1 block seen by the service.
+func (s *Service) setLatestBlock(header *gethTypes.Header) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	s.latestEth1Data.BlockHeight = header.Number.Uint64()
 	s.latestEth1Data.BlockHash = header.Hash().Bytes()
 	s.latestEth1Data.BlockTime = header.Time
-	log.WithFields(logrus.Fields{
-		"blockNumber": s.latestEth1Data.BlockHeight,
-		"blockHash":   hexutil.Encode(s.latestEth1Data.BlockHash),
-	}).Debug("Latest eth1 chain event")
 }
 
 // batchRequestHeaders requests the block range specified in the arguments. Instead of requesting
@@ -600,7 +731,7 @@ func (s *Service) batchRequestHeaders(startBlock, endBlock uint64) ([]*gethTypes
 		headers = append(headers, header)
 		errors = append(errors, err)
 	}
-	ioErr := s.rpcClient.BatchCall(elems)
+	ioErr := s.currRPCClient().BatchCall(elems)
 	if ioErr != nil {
 		return nil, ioErr
 	}
@@ -654,7 +785,7 @@ func (s *Service) handleETH1FollowDistance() {
 	// logs for the powchain service to process. Also is a potential
 	// failure condition as would mean we have not respected the protocol
 	// threshold.
-	if s.latestEth1Data.LastRequestedBlock == s.latestEth1Data.BlockHeight {
+	if s.latestEth1Data.LastRequestedBlock == s.latestBlockHeight() {
 		log.Error("Beacon node is not respecting the follow distance")
 		return
 	}
@@ -678,16 +809,14 @@ func (s *Service) initPOWService() {
 			return
 		default:
 			ctx := s.ctx
-			header, err := s.eth1DataFetcher.HeaderByNumber(ctx, nil)
+			header, err := s.currDataFetcher().HeaderByNumber(ctx, nil)
 			if err != nil {
 				log.Errorf("Unable to retrieve latest ETH1.0 chain header: %v", err)
 				s.retryETH1Node(err)
 				continue
 			}
 
-			s.latestEth1Data.BlockHeight = header.Number.Uint64()
-			s.latestEth1Data.BlockHash = header.Hash().Bytes()
-			s.latestEth1Data.BlockTime = header.Time
+			s.setLatestBlock(header)
 
 			if err := s.processPastLogs(ctx); err != nil {
 				log.Errorf("Unable to process past logs %v", err)
@@ -714,6 +843,11 @@ func (s *Service) run(done <-chan struct{}) {
 
 	chainstartTicker := time.NewTicker(logPeriod)
 	defer chainstartTicker.Stop()
+	primaryEndpointTicker := time.NewTicker(primaryEndpointCheckPeriod)
+	defer primaryEndpointTicker.Stop()
+	// The primary endpoint is dialed in the background, so it does not stall head processing.
+	primaryEndpointConn := make(chan *endpointConnection)
+	checkingPrimaryEndpoint := false
 
 	for {
 		select {
@@ -724,14 +858,35 @@ func (s *Service) run(done <-chan struct{}) {
 			log.Debug("Context closed, exiting goroutine")
 			return
 		case <-s.headTicker.C:
-			head, err := s.eth1DataFetcher.HeaderByNumber(s.ctx, nil)
+			head, err := s.currDataFetcher().HeaderByNumber(s.ctx, nil)
 			if err != nil {
 				log.WithError(err).Debug("Could not fetch latest eth1 header")
 				s.retryETH1Node(err)
 				continue
 			}
 			s.processBlockHeader(head)
+			if err := s.checkHeadStaleness(head); err != nil {
+				log.WithError(err).WithField("endpoint", s.currHTTPEndpoint()).Warn("Eth1 endpoint is stalled")
+				s.retryETH1Node(err)
+				continue
+			}
 			s.handleETH1FollowDistance()
+		case <-primaryEndpointTicker.C:
+			if checkingPrimaryEndpoint || s.currEndpoint() == 0 {
+				continue
+			}
+			checkingPrimaryEndpoint = true
+			go s.checkPrimaryEndpointInBackground(primaryEndpointConn)
+		case conn := <-primaryEndpointConn:
+			checkingPrimaryEndpoint = false
+			if conn == nil {
+				continue
+			}
+			if s.ctx.Err() != nil {
+				conn.close()
+				continue
+			}
+			s.switchToPrimaryEndpoint(conn)
 		case <-chainstartTicker.C:
 			if s.chainStartData.Chainstarted {
 				chainstartTicker.Stop()
@@ -0,0 +1,108 @@
+package beacon
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorRewards retrieves the components of the rewards and penalties the requested validators
+// earned for their attestations of the given epoch, along with the proposer rewards earned for including
+// attestations of the epoch. The rewards and penalties of an epoch are applied in the epoch transition at
+// the end of the next epoch, so they are computed the same way from the state at the last slot of the
+// next epoch, which is regenerated for historical epochs.
+func (bs *Server) GetValidatorRewards(
+	ctx context.Context,
+	req *pbrpc.ValidatorRewardsRequest,
+) (*pbrpc.ValidatorRewardsResponse, error) {
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	// The epoch must end before the current one, checked without overflowing the requested epoch.
+	if currentEpoch == 0 || req.Epoch >= currentEpoch-1 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve rewards for epoch %d before the end of the next epoch, current epoch %d",
+			req.Epoch,
+			currentEpoch,
+		)
+	}
+
+	startSlot, err := helpers.StartSlot(req.Epoch + 2)
+	if err != nil {
+		return nil, err
+	}
+	st, err := bs.StateGen.StateBySlot(ctx, startSlot-1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	// The state is modified by the justification and finalization processing.
+	st = st.Copy()
+
+	vp, bp, err := precompute.New(ctx, st)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not set up pre compute instance: %v", err)
+	}
+	vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not pre compute attestations: %v", err)
+	}
+	// Justification and finalization are processed before the rewards and penalties in the epoch
+	// transition, and the finalized checkpoint determines whether the chain is in an inactivity leak.
+	st, err = precompute.ProcessJustificationAndFinalizationPreCompute(st, bp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process justification: %v", err)
+	}
+	deltas, err := precompute.AttestationsDeltaComponents(st, bp, vp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get attestation deltas: %v", err)
+	}
+	proposerRewards, err := precompute.ProposersDelta(st, bp, vp)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get proposer deltas: %v", err)
+	}
+
+	indices := make([]uint64, 0, len(req.PublicKeys)+len(req.Indices))
+	for _, pubKey := range req.PublicKeys {
+		index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator with public key %#x in epoch %d", pubKey, req.Epoch)
+		}
+		indices = append(indices, index)
+	}
+	indices = append(indices, req.Indices...)
+
+	balances := st.Balances()
+	rewards := make([]*pbrpc.ValidatorRewardsResponse_ValidatorRewards, len(indices))
+	for i, index := range indices {
+		if index >= uint64(len(deltas)) {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator with index %d in epoch %d", index, req.Epoch)
+		}
+		d := deltas[index]
+		balanceAfter := helpers.IncreaseBalanceWithVal(balances[index], d.Reward()+proposerRewards[index])
+		balanceAfter = helpers.DecreaseBalanceWithVal(balanceAfter, d.Penalty())
+		pubKey := st.PubkeyAtIndex(index)
+		rewards[i] = &pbrpc.ValidatorRewardsResponse_ValidatorRewards{
+			PublicKey:            pubKey[:],
+			Index:                index,
+			SourceReward:         d.SourceReward,
+			SourcePenalty:        d.SourcePenalty,
+			TargetReward:         d.TargetReward,
+			TargetPenalty:        d.TargetPenalty,
+			HeadReward:           d.HeadReward,
+			HeadPenalty:          d.HeadPenalty,
+			InclusionDelayReward: d.InclusionDelayReward,
+			InactivityPenalty:    d.InactivityPenalty,
+			ProposerReward:       proposerRewards[index],
+			BalanceBefore:        balances[index],
+			BalanceAfter:         balanceAfter,
+		}
+	}
+	return &pbrpc.ValidatorRewardsResponse{
+		Epoch:   req.Epoch,
+		Rewards: rewards,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package beacon
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func TestServer_GetValidatorRewards_CannotRequestUnfinishedEpoch(t *testing.T) {
+	slots := 2 * params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{
+			Genesis: timeutils.Now().Add(time.Duration(-1*int64(slots)) * time.Second),
+		},
+	}
+
+	_, err := bs.GetValidatorRewards(context.Background(), &pbrpc.ValidatorRewardsRequest{Epoch: 1})
+	assert.ErrorContains(t, "Cannot retrieve rewards for epoch 1 before the end of the next epoch, current epoch 2", err)
+
+	// The requested epoch must not wrap around past the current epoch.
+	_, err = bs.GetValidatorRewards(context.Background(), &pbrpc.ValidatorRewardsRequest{Epoch: math.MaxUint64})
+	assert.ErrorContains(t, "Cannot retrieve rewards for epoch 18446744073709551615", err)
+
+	bs.GenesisTimeFetcher = &mock.ChainService{Genesis: timeutils.Now()}
+	_, err = bs.GetValidatorRewards(context.Background(), &pbrpc.ValidatorRewardsRequest{Epoch: 0})
+	assert.ErrorContains(t, "Cannot retrieve rewards for epoch 0 before the end of the next epoch, current epoch 0", err)
+}
+
+func TestServer_GetValidatorRewards(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	helpers.ClearCache()
+	ctx := context.Background()
+	beaconDB, sc := dbTest.SetupDB(t)
+	stateGen := stategen.New(beaconDB, sc)
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, st, genesisRoot))
+	require.NoError(t, stateGen.SaveState(ctx, genesisRoot, st))
+
+	// Build a chain up to the last slot of epoch 1, at the end of which the rewards of epoch 0 are applied.
+	lastSlot := 2*params.BeaconConfig().SlotsPerEpoch - 1
+	for slot := uint64(1); slot <= lastSlot; slot++ {
+		conf := &testutil.BlockGenConfig{NumAttestations: 1}
+		// Attestations can't be generated for the target of the epoch starting at the block slot.
+		if slot%params.BeaconConfig().SlotsPerEpoch == 0 {
+			conf.NumAttestations = 0
+		}
+		blk, err := testutil.GenerateFullBlock(st, keys, conf, slot)
+		require.NoError(t, err)
+		st, err = state.ExecuteStateTransition(ctx, st, blk)
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, stateGen.SaveState(ctx, root, st))
+	}
+
+	slots := 3 * params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot
+	bs := &Server{
+		BeaconDB: beaconDB,
+		StateGen: stateGen,
+		GenesisTimeFetcher: &mock.ChainService{
+			Genesis: timeutils.Now().Add(time.Duration(-1*int64(slots)) * time.Second),
+		},
+	}
+	pubKey := st.PubkeyAtIndex(3)
+	res, err := bs.GetValidatorRewards(ctx, &pbrpc.ValidatorRewardsRequest{
+		Epoch:      0,
+		PublicKeys: [][]byte{pubKey[:]},
+		Indices:    []uint64{0, 1, 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.Epoch)
+	require.Equal(t, 4, len(res.Rewards))
+	assert.Equal(t, uint64(3), res.Rewards[0].Index)
+	assert.DeepEqual(t, pubKey[:], res.Rewards[0].PublicKey)
+
+	// The reported balances match the ones computed by the epoch transition.
+	want := st.Copy()
+	vp, bp, err := precompute.New(ctx, want)
+	require.NoError(t, err)
+	vp, bp, err = precompute.ProcessAttestations(ctx, want, vp, bp)
+	require.NoError(t, err)
+	want, err = precompute.ProcessJustificationAndFinalizationPreCompute(want, bp)
+	require.NoError(t, err)
+	want, err = precompute.ProcessRewardsAndPenaltiesPrecompute(want, bp, vp)
+	require.NoError(t, err)
+	for _, r := range res.Rewards {
+		assert.Equal(t, st.Balances()[r.Index], r.BalanceBefore)
+		assert.Equal(t, want.Balances()[r.Index], r.BalanceAfter)
+		reward := r.SourceReward + r.TargetReward + r.HeadReward + r.InclusionDelayReward + r.ProposerReward
+		penalty := r.SourcePenalty + r.TargetPenalty + r.HeadPenalty + r.InactivityPenalty
+		assert.Equal(t, true, reward+penalty > 0, "Validator %d has neither rewards nor penalties", r.Index)
+		assert.Equal(t, r.BalanceBefore+reward-penalty, r.BalanceAfter)
+		assert.Equal(t, uint64(0), r.InactivityPenalty)
+	}
+
+	_, err = bs.GetValidatorRewards(ctx, &pbrpc.ValidatorRewardsRequest{Epoch: 0, Indices: []uint64{64}})
+	assert.ErrorContains(t, "Could not find validator with index 64 in epoch 0", err)
+	_, err = bs.GetValidatorRewards(ctx, &pbrpc.ValidatorRewardsRequest{Epoch: 0, PublicKeys: [][]byte{{'a'}}})
+	assert.ErrorContains(t, "Could not find validator with public key", err)
+}
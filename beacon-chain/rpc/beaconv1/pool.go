@@ -2,57 +2,289 @@ package beaconv1
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	ptypes "github.com/gogo/protobuf/types"
-
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ListPoolAttestations retrieves attestations known by the node but
-// not necessarily incorporated into any block.
+// not necessarily incorporated into any block. Attestations can be filtered
+// by slot and committee index, a zero value disables the respective filter.
 func (bs *Server) ListPoolAttestations(ctx context.Context, req *ethpb.AttestationsPoolRequest) (*ethpb.AttestationsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListPoolAttestations")
+	defer span.End()
+
+	atts := bs.AttestationsPool.AggregatedAttestations()
+	unaggAtts, err := bs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	atts = append(atts, unaggAtts...)
+
+	filtered := make([]*ethpb.Attestation, 0, len(atts))
+	for _, att := range atts {
+		if req.Slot != 0 && att.Data.Slot != req.Slot {
+			continue
+		}
+		if req.CommitteeIndex != 0 && att.Data.CommitteeIndex != req.CommitteeIndex {
+			continue
+		}
+		v1Att, err := migration.V1Alpha1AttestationToV1(att)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert attestation: %v", err)
+		}
+		filtered = append(filtered, v1Att)
+	}
+
+	return &ethpb.AttestationsPoolResponse{Data: filtered}, nil
 }
 
 // SubmitAttestation submits Attestation object to node. If attestation passes all validation
 // constraints, node MUST publish attestation on appropriate subnet.
 func (bs *Server) SubmitAttestation(ctx context.Context, req *ethpb.Attestation) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitAttestation")
+	defer span.End()
+
+	if req.Data == nil || req.Data.Source == nil || req.Data.Target == nil {
+		return nil, status.Error(codes.InvalidArgument, "Attestation data is incomplete")
+	}
+	if _, err := bls.SignatureFromBytes(req.Signature); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Incorrect attestation signature")
+	}
+	att, err := migration.V1AttestationToV1Alpha1(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert attestation: %v", err)
+	}
+	if helpers.SlotToEpoch(att.Data.Slot) != att.Data.Target.Epoch {
+		return nil, status.Error(codes.InvalidArgument, "Attestation target epoch does not match its slot")
+	}
+	// Peers penalize the node for gossiping invalid attestations, so the attestation is checked
+	// against the state of its target epoch before it is broadcast or pooled.
+	targetState, err := bs.AttestationReceiver.AttestationPreState(ctx, att)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get attestation target state: %v", err)
+	}
+	if err := verifyAttestation(ctx, targetState, att); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid attestation: %v", err)
+	}
+
+	bs.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{
+			Attestation: att,
+		},
+	})
+
+	// Determine subnet to broadcast attestation to.
+	vals, err := bs.ChainInfoFetcher.HeadValidatorsIndices(ctx, att.Data.Target.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head validator indices: %v", err)
+	}
+	subnet := helpers.ComputeSubnetFromCommitteeAndSlot(uint64(len(vals)), att.Data.CommitteeIndex, att.Data.Slot)
+	if err := bs.Broadcaster.BroadcastAttestation(ctx, subnet, att); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast attestation: %v", err)
+	}
+
+	attCopy := stateTrie.CopyAttestation(att)
+	if helpers.IsAggregated(attCopy) {
+		err = bs.AttestationsPool.SaveAggregatedAttestation(attCopy)
+	} else {
+		err = bs.AttestationsPool.SaveUnaggregatedAttestation(attCopy)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save attestation in pool: %v", err)
+	}
+
+	return &ptypes.Empty{}, nil
+}
+
+// verifyAttestation runs the committee checks of blocks.ProcessAttestationNoVerifySignature that
+// don't depend on the block including the attestation, then verifies its signature.
+func verifyAttestation(ctx context.Context, targetState *stateTrie.BeaconState, att *ethpb_alpha.Attestation) error {
+	activeValidatorCount, err := helpers.ActiveValidatorCount(targetState, att.Data.Target.Epoch)
+	if err != nil {
+		return err
+	}
+	if c := helpers.SlotCommitteeCount(activeValidatorCount); att.Data.CommitteeIndex >= c {
+		return fmt.Errorf("committee index %d >= committee count %d", att.Data.CommitteeIndex, c)
+	}
+	if err := helpers.VerifyAttestationBitfieldLengths(targetState, att); err != nil {
+		return errors.Wrap(err, "could not verify attestation bitfields")
+	}
+	return blocks.VerifyAttestationSignature(ctx, targetState, att)
 }
 
 // ListPoolAttesterSlashings retrieves attester slashings known by the node but
 // not necessarily incorporated into any block.
 func (bs *Server) ListPoolAttesterSlashings(ctx context.Context, req *ptypes.Empty) (*ethpb.AttesterSlashingsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListPoolAttesterSlashings")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	sourceSlashings := bs.SlashingsPool.PendingAttesterSlashings(ctx, headState, true /* return unlimited slashings */)
+
+	slashings := make([]*ethpb.AttesterSlashing, len(sourceSlashings))
+	for i, s := range sourceSlashings {
+		slashings[i], err = migration.V1Alpha1AttSlashingToV1(s)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert attester slashing: %v", err)
+		}
+	}
+
+	return &ethpb.AttesterSlashingsPoolResponse{Data: slashings}, nil
 }
 
 // SubmitAttesterSlashing submits AttesterSlashing object to node's pool and
 // if passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitAttesterSlashing(ctx context.Context, req *ethpb.AttesterSlashing) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitAttesterSlashing")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	slashing, err := migration.V1AttSlashingToV1Alpha1(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert attester slashing: %v", err)
+	}
+	// Inserting into the pool verifies the slashing against the head state.
+	if err := bs.SlashingsPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not insert attester slashing into pool: %v", err)
+	}
+	if err := bs.Broadcaster.Broadcast(ctx, slashing); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast attester slashing: %v", err)
+	}
+
+	return &ptypes.Empty{}, nil
 }
 
 // ListPoolProposerSlashings retrieves proposer slashings known by the node
 // but not necessarily incorporated into any block.
 func (bs *Server) ListPoolProposerSlashings(ctx context.Context, req *ptypes.Empty) (*ethpb.ProposerSlashingPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListPoolProposerSlashings")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	sourceSlashings := bs.SlashingsPool.PendingProposerSlashings(ctx, headState, true /* return unlimited slashings */)
+
+	slashings := make([]*ethpb.ProposerSlashing, len(sourceSlashings))
+	for i, s := range sourceSlashings {
+		slashings[i], err = migration.V1Alpha1ProposerSlashingToV1(s)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert proposer slashing: %v", err)
+		}
+	}
+
+	return &ethpb.ProposerSlashingPoolResponse{Data: slashings}, nil
 }
 
 // SubmitProposerSlashing submits AttesterSlashing object to node's pool and if
 // passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitProposerSlashing(ctx context.Context, req *ethpb.ProposerSlashing) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitProposerSlashing")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	slashing, err := migration.V1ProposerSlashingToV1Alpha1(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert proposer slashing: %v", err)
+	}
+	// Inserting into the pool verifies the slashing against the head state.
+	if err := bs.SlashingsPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not insert proposer slashing into pool: %v", err)
+	}
+	if err := bs.Broadcaster.Broadcast(ctx, slashing); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast proposer slashing: %v", err)
+	}
+
+	return &ptypes.Empty{}, nil
 }
 
 // ListPoolVoluntaryExits retrieves voluntary exits known by the node but
 // not necessarily incorporated into any block.
 func (bs *Server) ListPoolVoluntaryExits(ctx context.Context, req *ptypes.Empty) (*ethpb.VoluntaryExitsPoolResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListPoolVoluntaryExits")
+	defer span.End()
+
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	sourceExits := bs.VoluntaryExitsPool.PendingExits(headState, headState.Slot(), true /* return unlimited exits */)
+
+	exits := make([]*ethpb.SignedVoluntaryExit, len(sourceExits))
+	for i, e := range sourceExits {
+		exits[i], err = migration.V1Alpha1ExitToV1(e)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert voluntary exit: %v", err)
+		}
+	}
+
+	return &ethpb.VoluntaryExitsPoolResponse{Data: exits}, nil
 }
 
 // SubmitVoluntaryExit submits SignedVoluntaryExit object to node's pool
 // and if passes validation node MUST broadcast it to network.
 func (bs *Server) SubmitVoluntaryExit(ctx context.Context, req *ethpb.SignedVoluntaryExit) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.SubmitVoluntaryExit")
+	defer span.End()
+
+	if req.Exit == nil {
+		return nil, status.Error(codes.InvalidArgument, "Voluntary exit does not exist")
+	}
+	if len(req.Signature) != params.BeaconConfig().BLSSignatureLength {
+		return nil, status.Error(codes.InvalidArgument, "Invalid signature provided")
+	}
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	exit, err := migration.V1ExitToV1Alpha1(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert voluntary exit: %v", err)
+	}
+	val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Validator index exceeds validator set length")
+	}
+	if err := blocks.VerifyExitAndSignature(val, headState.Slot(), headState.Fork(), exit, headState.GenesisValidatorRoot()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid voluntary exit: %v", err)
+	}
+
+	bs.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.ExitReceived,
+		Data: &operation.ExitReceivedData{
+			Exit: exit,
+		},
+	})
+	bs.VoluntaryExitsPool.InsertVoluntaryExit(ctx, headState, exit)
+	if err := bs.Broadcaster.Broadcast(ctx, exit); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast voluntary exit: %v", err)
+	}
+
+	return &ptypes.Empty{}, nil
 }
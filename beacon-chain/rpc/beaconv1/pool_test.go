@@ -0,0 +1,265 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestListPoolAttestations(t *testing.T) {
+	ctx := context.Background()
+	pool := attestations.NewPool()
+	newAtt := func(slot, committeeIndex uint64, bits bitfield.Bitlist) *ethpb_alpha.Attestation {
+		att := testutil.NewAttestation()
+		att.Data.Slot = slot
+		att.Data.CommitteeIndex = committeeIndex
+		att.AggregationBits = bits
+		return att
+	}
+	require.NoError(t, pool.SaveAggregatedAttestation(newAtt(1, 1, bitfield.Bitlist{0b1101})))
+	require.NoError(t, pool.SaveAggregatedAttestation(newAtt(1, 2, bitfield.Bitlist{0b1101})))
+	require.NoError(t, pool.SaveUnaggregatedAttestation(newAtt(2, 1, bitfield.Bitlist{0b1001})))
+	require.NoError(t, pool.SaveUnaggregatedAttestation(newAtt(3, 2, bitfield.Bitlist{0b1001})))
+	bs := &Server{AttestationsPool: pool}
+
+	tests := []struct {
+		name           string
+		slot           uint64
+		committeeIndex uint64
+		want           int
+	}{
+		{name: "no filter", want: 4},
+		{name: "slot", slot: 1, want: 2},
+		{name: "committee index", committeeIndex: 2, want: 2},
+		{name: "slot and committee index", slot: 3, committeeIndex: 2, want: 1},
+		{name: "no match", slot: 4, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := bs.ListPoolAttestations(ctx, &ethpb.AttestationsPoolRequest{
+				Slot:           tt.slot,
+				CommitteeIndex: tt.committeeIndex,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, len(resp.Data))
+			for _, att := range resp.Data {
+				if tt.slot != 0 {
+					assert.Equal(t, tt.slot, att.Data.Slot)
+				}
+				if tt.committeeIndex != 0 {
+					assert.Equal(t, tt.committeeIndex, att.Data.CommitteeIndex)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitAttestation(t *testing.T) {
+	ctx := context.Background()
+	headState, keys := testutil.DeterministicGenesisState(t, 64)
+	chainService := &mock.ChainService{State: headState}
+	broadcaster := &mockp2p.MockBroadcaster{}
+	pool := attestations.NewPool()
+	bs := &Server{
+		ChainInfoFetcher:    chainService,
+		AttestationReceiver: chainService,
+		AttestationNotifier: chainService.OperationNotifier(),
+		Broadcaster:         broadcaster,
+		AttestationsPool:    pool,
+	}
+
+	atts, err := testutil.GenerateAttestations(headState, keys, 1, 1, false)
+	require.NoError(t, err)
+	att, err := migration.V1Alpha1AttestationToV1(atts[0])
+	require.NoError(t, err)
+
+	_, err = bs.SubmitAttestation(ctx, att)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+	assert.Equal(t, 1, pool.AggregatedAttestationCount()+pool.UnaggregatedAttestationCount())
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		invalid, err := migration.V1Alpha1AttestationToV1(atts[0])
+		require.NoError(t, err)
+		invalid.Signature = make([]byte, 2)
+		_, err = bs.SubmitAttestation(ctx, invalid)
+		assert.ErrorContains(t, "Incorrect attestation signature", err)
+	})
+
+	t.Run("Mismatched target epoch", func(t *testing.T) {
+		invalid, err := migration.V1Alpha1AttestationToV1(atts[0])
+		require.NoError(t, err)
+		invalid.Data.Target.Epoch = 5
+		_, err = bs.SubmitAttestation(ctx, invalid)
+		assert.ErrorContains(t, "Attestation target epoch does not match its slot", err)
+	})
+
+	t.Run("Wrong signature", func(t *testing.T) {
+		broadcaster := &mockp2p.MockBroadcaster{}
+		pool := attestations.NewPool()
+		bs := &Server{
+			ChainInfoFetcher:    chainService,
+			AttestationReceiver: chainService,
+			AttestationNotifier: chainService.OperationNotifier(),
+			Broadcaster:         broadcaster,
+			AttestationsPool:    pool,
+		}
+		invalid, err := migration.V1Alpha1AttestationToV1(atts[0])
+		require.NoError(t, err)
+		invalid.Signature = keys[0].Sign([]byte("forged")).Marshal()
+		_, err = bs.SubmitAttestation(ctx, invalid)
+		assert.ErrorContains(t, "Invalid attestation", err)
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+		assert.Equal(t, 0, pool.AggregatedAttestationCount()+pool.UnaggregatedAttestationCount())
+	})
+
+	t.Run("Committee index out of range", func(t *testing.T) {
+		invalid, err := migration.V1Alpha1AttestationToV1(atts[0])
+		require.NoError(t, err)
+		invalid.Data.CommitteeIndex = 100
+		_, err = bs.SubmitAttestation(ctx, invalid)
+		assert.ErrorContains(t, "committee index 100 >= committee count", err)
+	})
+}
+
+func TestAttesterSlashings(t *testing.T) {
+	ctx := context.Background()
+	headState, keys := testutil.DeterministicGenesisState(t, 64)
+	broadcaster := &mockp2p.MockBroadcaster{}
+	bs := &Server{
+		ChainInfoFetcher: &mock.ChainService{State: headState},
+		SlashingsPool:    slashings.NewPool(),
+		Broadcaster:      broadcaster,
+	}
+
+	resp, err := bs.ListPoolAttesterSlashings(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.Data))
+
+	slashing, err := testutil.GenerateAttesterSlashingForValidator(headState, keys[5], 5)
+	require.NoError(t, err)
+	v1Slashing, err := migration.V1Alpha1AttSlashingToV1(slashing)
+	require.NoError(t, err)
+	_, err = bs.SubmitAttesterSlashing(ctx, v1Slashing)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+
+	resp, err = bs.ListPoolAttesterSlashings(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, v1Slashing, resp.Data[0])
+
+	t.Run("Invalid slashing", func(t *testing.T) {
+		invalid, err := migration.V1Alpha1AttSlashingToV1(slashing)
+		require.NoError(t, err)
+		invalid.Attestation_2 = invalid.Attestation_1
+		_, err = bs.SubmitAttesterSlashing(ctx, invalid)
+		assert.ErrorContains(t, "Could not insert attester slashing into pool", err)
+	})
+}
+
+func TestProposerSlashings(t *testing.T) {
+	ctx := context.Background()
+	headState, keys := testutil.DeterministicGenesisState(t, 64)
+	broadcaster := &mockp2p.MockBroadcaster{}
+	bs := &Server{
+		ChainInfoFetcher: &mock.ChainService{State: headState},
+		SlashingsPool:    slashings.NewPool(),
+		Broadcaster:      broadcaster,
+	}
+
+	resp, err := bs.ListPoolProposerSlashings(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.Data))
+
+	slashing, err := testutil.GenerateProposerSlashingForValidator(headState, keys[7], 7)
+	require.NoError(t, err)
+	v1Slashing, err := migration.V1Alpha1ProposerSlashingToV1(slashing)
+	require.NoError(t, err)
+	_, err = bs.SubmitProposerSlashing(ctx, v1Slashing)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+
+	resp, err = bs.ListPoolProposerSlashings(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, v1Slashing, resp.Data[0])
+}
+
+func TestVoluntaryExits(t *testing.T) {
+	ctx := context.Background()
+	headState, keys := testutil.DeterministicGenesisState(t, 64)
+	epoch := params.BeaconConfig().ShardCommitteePeriod + 1
+	require.NoError(t, headState.SetSlot(epoch*params.BeaconConfig().SlotsPerEpoch))
+	chainService := &mock.ChainService{State: headState}
+	broadcaster := &mockp2p.MockBroadcaster{}
+	bs := &Server{
+		ChainInfoFetcher:    chainService,
+		AttestationNotifier: chainService.OperationNotifier(),
+		VoluntaryExitsPool:  voluntaryexits.NewPool(),
+		Broadcaster:         broadcaster,
+	}
+
+	resp, err := bs.ListPoolVoluntaryExits(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.Data))
+
+	exit := &ethpb_alpha.SignedVoluntaryExit{
+		Exit: &ethpb_alpha.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: 3,
+		},
+	}
+	exit.Signature, err = helpers.ComputeDomainAndSign(headState, epoch, exit.Exit, params.BeaconConfig().DomainVoluntaryExit, keys[3])
+	require.NoError(t, err)
+	v1Exit, err := migration.V1Alpha1ExitToV1(exit)
+	require.NoError(t, err)
+	_, err = bs.SubmitVoluntaryExit(ctx, v1Exit)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+
+	resp, err = bs.ListPoolVoluntaryExits(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, v1Exit, resp.Data[0])
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		invalid, err := migration.V1Alpha1ExitToV1(exit)
+		require.NoError(t, err)
+		invalid.Exit.ValidatorIndex = 4
+		_, err = bs.SubmitVoluntaryExit(ctx, invalid)
+		assert.ErrorContains(t, "Invalid voluntary exit", err)
+	})
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		invalid, err := migration.V1Alpha1ExitToV1(exit)
+		require.NoError(t, err)
+		invalid.Exit.ValidatorIndex = 1000
+		_, err = bs.SubmitVoluntaryExit(ctx, invalid)
+		assert.ErrorContains(t, "Validator index exceeds validator set length", err)
+	})
+
+	t.Run("Missing signature", func(t *testing.T) {
+		_, err = bs.SubmitVoluntaryExit(ctx, &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{ValidatorIndex: 3},
+			Signature: bytesutil.PadTo([]byte{1}, 10),
+		})
+		assert.ErrorContains(t, "Invalid signature provided", err)
+	})
+}
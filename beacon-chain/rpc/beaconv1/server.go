@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
@@ -34,12 +35,14 @@ type Server struct {
 	BlockFetcher        powchain.POWBlockFetcher
 	GenesisTimeFetcher  blockchain.TimeFetcher
 	BlockReceiver       blockchain.BlockReceiver
+	AttestationReceiver blockchain.AttestationReceiver
 	StateNotifier       statefeed.Notifier
 	BlockNotifier       blockfeed.Notifier
 	AttestationNotifier operation.Notifier
 	Broadcaster         p2p.Broadcaster
 	AttestationsPool    attestations.Pool
 	SlashingsPool       *slashings.Pool
+	VoluntaryExitsPool  *voluntaryexits.Pool
 	CanonicalStateChan  chan *pbp2p.BeaconState
 	ChainStartChan      chan time.Time
 	StateGen            *stategen.State
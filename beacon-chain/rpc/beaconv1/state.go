@@ -1,30 +1,220 @@
 package beaconv1
 
 import (
+	"bytes"
 	"context"
-	"errors"
+	"encoding/hex"
+	"strconv"
+	"strings"
 
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetGenesis retrieves details of the chain's genesis which can be used to identify chain.
 func (bs *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.GenesisResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetGenesis")
+	defer span.End()
+
+	genesisTime := bs.GenesisTimeFetcher.GenesisTime()
+	if genesisTime.IsZero() {
+		return nil, status.Error(codes.NotFound, "Chain genesis info is not yet known")
+	}
+	validatorRoot := bs.ChainInfoFetcher.GenesisValidatorRoot()
+	if bytes.Equal(validatorRoot[:], params.BeaconConfig().ZeroHash[:]) {
+		return nil, status.Error(codes.NotFound, "Chain genesis info is not yet known")
+	}
+
+	return &ethpb.GenesisResponse{
+		GenesisTime: &ptypes.Timestamp{
+			Seconds: genesisTime.Unix(),
+			Nanos:   0,
+		},
+		GenesisValidatorsRoot: validatorRoot[:],
+		GenesisForkVersion:    params.BeaconConfig().GenesisForkVersion,
+	}, nil
 }
 
 // GetStateRoot calculates HashTreeRoot for state with given 'stateId'. If stateId is root, same value will be returned.
 func (bs *Server) GetStateRoot(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateRootResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetStateRoot")
+	defer span.End()
+
+	st, err := bs.stateFromID(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash state: %v", err)
+	}
+
+	return &ethpb.StateRootResponse{
+		StateRoot: root[:],
+	}, nil
 }
 
 // GetStateFork returns Fork object for state with given 'stateId'.
 func (bs *Server) GetStateFork(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateForkResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetStateFork")
+	defer span.End()
+
+	st, err := bs.stateFromID(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	fork := st.Fork()
+
+	return &ethpb.StateForkResponse{
+		Fork: &ethpb.Fork{
+			PreviousVersion: fork.PreviousVersion,
+			CurrentVersion:  fork.CurrentVersion,
+			Epoch:           fork.Epoch,
+		},
+	}, nil
 }
 
 // GetFinalityCheckpoints returns finality checkpoints for state with given 'stateId'. In case finality is
 // not yet achieved, checkpoint should return epoch 0 and ZERO_HASH as root.
 func (bs *Server) GetFinalityCheckpoints(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateFinalityCheckpointResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetFinalityCheckpoints")
+	defer span.End()
+
+	st, err := bs.stateFromID(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethpb.StateFinalityCheckpointResponse{
+		PreviousJustified: migration.V1Alpha1CheckpointToV1(st.PreviousJustifiedCheckpoint()),
+		CurrentJustified:  migration.V1Alpha1CheckpointToV1(st.CurrentJustifiedCheckpoint()),
+		Finalized:         migration.V1Alpha1CheckpointToV1(st.FinalizedCheckpoint()),
+	}, nil
+}
+
+// stateFromID resolves a state identifier into a beacon state. Valid identifiers are
+// "head", "genesis", "finalized", "justified", a decimal slot number, or a state root
+// given either as 32 raw bytes or as a 0x-prefixed hex string. The returned error is
+// always a gRPC status error so callers can return it as is.
+func (bs *Server) stateFromID(ctx context.Context, stateID []byte) (*state.BeaconState, error) {
+	var st *state.BeaconState
+	var err error
+	stateIDString := strings.ToLower(string(stateID))
+	switch stateIDString {
+	case "head":
+		st, err = bs.ChainInfoFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+		}
+	case "genesis":
+		st, err = bs.BeaconDB.GenesisState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get genesis state: %v", err)
+		}
+	case "finalized":
+		finalized := bs.ChainInfoFetcher.FinalizedCheckpt()
+		st, err = bs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(finalized.Root))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get finalized state: %v", err)
+		}
+	case "justified":
+		justified := bs.ChainInfoFetcher.CurrentJustifiedCheckpt()
+		st, err = bs.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(justified.Root))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get justified state: %v", err)
+		}
+	default:
+		if root, ok := decodeRootID(stateID); ok {
+			st, err = bs.stateByStateRoot(ctx, root)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			slot, err := strconv.ParseUint(stateIDString, 10, 64)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID %q: %v", stateID, err)
+			}
+			currentSlot := bs.GenesisTimeFetcher.CurrentSlot()
+			if slot > currentSlot {
+				return nil, status.Errorf(
+					codes.InvalidArgument,
+					"Cannot retrieve state for a future slot, current slot %d, requesting %d",
+					currentSlot,
+					slot,
+				)
+			}
+			st, err = bs.StateGen.StateBySlot(ctx, slot)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get state for slot %d: %v", slot, err)
+			}
+		}
+	}
+	if st == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find state for state ID %q", stateID)
+	}
+	return st, nil
+}
+
+// stateByStateRoot looks up a state by its state root. The state root is matched against
+// the head state and against the historical state roots kept in the head state, the slot
+// of a matching historical root is then used to regenerate the state.
+func (bs *Server) stateByStateRoot(ctx context.Context, stateRoot [32]byte) (*state.BeaconState, error) {
+	headState, err := bs.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.NotFound, "Head state is not yet known")
+	}
+	headStateRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash head state: %v", err)
+	}
+	if headStateRoot == stateRoot {
+		return headState, nil
+	}
+	// The state roots vector holds the roots of the last SLOTS_PER_HISTORICAL_ROOT slots,
+	// walk it backwards from the slot before head.
+	headSlot := headState.Slot()
+	historyLength := params.BeaconConfig().SlotsPerHistoricalRoot
+	for i := uint64(1); i <= historyLength && i <= headSlot; i++ {
+		slot := headSlot - i
+		root, err := headState.StateRootAtIndex(slot % historyLength)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get state root at slot %d: %v", slot, err)
+		}
+		if !bytes.Equal(root, stateRoot[:]) {
+			continue
+		}
+		st, err := bs.StateGen.StateBySlot(ctx, slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get state for slot %d: %v", slot, err)
+		}
+		return st, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "Could not find state with root %#x", stateRoot)
+}
+
+// decodeRootID returns the 32 byte root encoded in an identifier, which is either the raw
+// root bytes or a 0x-prefixed hex string.
+func decodeRootID(id []byte) ([32]byte, bool) {
+	if len(id) == 32 {
+		return bytesutil.ToBytes32(id), true
+	}
+	idString := string(id)
+	if len(idString) == 66 && strings.HasPrefix(idString, "0x") {
+		root, err := hex.DecodeString(idString[2:])
+		if err != nil {
+			return [32]byte{}, false
+		}
+		return bytesutil.ToBytes32(root), true
+	}
+	return [32]byte{}, false
 }
@@ -0,0 +1,182 @@
+package beaconv1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetGenesis(t *testing.T) {
+	ctx := context.Background()
+	genesis := time.Date(2020, 12, 1, 12, 0, 0, 0, time.UTC)
+	validatorsRoot := [32]byte{1, 2, 3}
+
+	t.Run("OK", func(t *testing.T) {
+		chainService := &mock.ChainService{Genesis: genesis, ValidatorsRoot: validatorsRoot}
+		bs := &Server{
+			GenesisTimeFetcher: chainService,
+			ChainInfoFetcher:   chainService,
+		}
+		resp, err := bs.GetGenesis(ctx, &ptypes.Empty{})
+		require.NoError(t, err)
+		assert.Equal(t, genesis.Unix(), resp.GenesisTime.Seconds)
+		assert.DeepEqual(t, validatorsRoot[:], resp.GenesisValidatorsRoot)
+		assert.DeepEqual(t, params.BeaconConfig().GenesisForkVersion, resp.GenesisForkVersion)
+	})
+
+	t.Run("No genesis time", func(t *testing.T) {
+		chainService := &mock.ChainService{ValidatorsRoot: validatorsRoot}
+		bs := &Server{
+			GenesisTimeFetcher: chainService,
+			ChainInfoFetcher:   chainService,
+		}
+		_, err := bs.GetGenesis(ctx, &ptypes.Empty{})
+		assert.ErrorContains(t, "Chain genesis info is not yet known", err)
+	})
+
+	t.Run("No genesis validators root", func(t *testing.T) {
+		chainService := &mock.ChainService{Genesis: genesis}
+		bs := &Server{
+			GenesisTimeFetcher: chainService,
+			ChainInfoFetcher:   chainService,
+		}
+		_, err := bs.GetGenesis(ctx, &ptypes.Empty{})
+		assert.ErrorContains(t, "Chain genesis info is not yet known", err)
+	})
+}
+
+func TestGetStateRoot(t *testing.T) {
+	db, sc := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genState, _ := testutil.DeterministicGenesisState(t, 64)
+	genBlk := testutil.NewBeaconBlock()
+	genRoot, err := genBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genBlk))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genRoot))
+	require.NoError(t, db.SaveState(ctx, genState, genRoot))
+	genStateRoot, err := genState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	headState := genState.Copy()
+	require.NoError(t, headState.SetSlot(10))
+	headStateRoot, err := headState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	chainService := &mock.ChainService{State: headState, Genesis: time.Now().Add(-10 * secondsPerSlot)}
+	bs := &Server{
+		BeaconDB:           db,
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		StateGen:           stategen.New(db, sc),
+	}
+
+	tests := []struct {
+		name    string
+		stateID []byte
+		want    [32]byte
+		wantErr string
+	}{
+		{
+			name:    "head",
+			stateID: []byte("head"),
+			want:    headStateRoot,
+		},
+		{
+			name:    "genesis",
+			stateID: []byte("genesis"),
+			want:    genStateRoot,
+		},
+		{
+			name:    "root",
+			stateID: headStateRoot[:],
+			want:    headStateRoot,
+		},
+		{
+			name:    "hex root",
+			stateID: []byte(fmt.Sprintf("%#x", headStateRoot)),
+			want:    headStateRoot,
+		},
+		{
+			name:    "unknown root",
+			stateID: bytesutil.PadTo([]byte("foo"), 32),
+			wantErr: "Could not find state with root",
+		},
+		{
+			name:    "future slot",
+			stateID: []byte("1000000"),
+			wantErr: "Cannot retrieve state for a future slot",
+		},
+		{
+			name:    "invalid",
+			stateID: []byte("foo"),
+			wantErr: "Invalid state ID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := bs.GetStateRoot(ctx, &ethpb.StateRequest{StateId: tt.stateID})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want[:], resp.StateRoot)
+		})
+	}
+}
+
+func TestGetStateFork(t *testing.T) {
+	ctx := context.Background()
+	fork := &pbp2p.Fork{
+		PreviousVersion: []byte{1, 2, 3, 4},
+		CurrentVersion:  []byte{5, 6, 7, 8},
+		Epoch:           100,
+	}
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetFork(fork))
+
+	bs := &Server{ChainInfoFetcher: &mock.ChainService{State: headState}}
+	resp, err := bs.GetStateFork(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.DeepEqual(t, fork.PreviousVersion, resp.Fork.PreviousVersion)
+	assert.DeepEqual(t, fork.CurrentVersion, resp.Fork.CurrentVersion)
+	assert.Equal(t, fork.Epoch, resp.Fork.Epoch)
+}
+
+func TestGetFinalityCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	headState := testutil.NewBeaconState()
+	previousJustified := &ethpb_alpha.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte("previous"), 32)}
+	currentJustified := &ethpb_alpha.Checkpoint{Epoch: 3, Root: bytesutil.PadTo([]byte("current"), 32)}
+	finalized := &ethpb_alpha.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("finalized"), 32)}
+	require.NoError(t, headState.SetPreviousJustifiedCheckpoint(previousJustified))
+	require.NoError(t, headState.SetCurrentJustifiedCheckpoint(currentJustified))
+	require.NoError(t, headState.SetFinalizedCheckpoint(finalized))
+
+	bs := &Server{ChainInfoFetcher: &mock.ChainService{State: headState}}
+	resp, err := bs.GetFinalityCheckpoints(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, previousJustified.Epoch, resp.PreviousJustified.Epoch)
+	assert.DeepEqual(t, previousJustified.Root, resp.PreviousJustified.Root)
+	assert.Equal(t, currentJustified.Epoch, resp.CurrentJustified.Epoch)
+	assert.DeepEqual(t, currentJustified.Root, resp.CurrentJustified.Root)
+	assert.Equal(t, finalized.Epoch, resp.Finalized.Epoch)
+	assert.DeepEqual(t, finalized.Root, resp.Finalized.Root)
+}
@@ -2,27 +2,314 @@ package beaconv1
 
 import (
 	"context"
-	"errors"
+	"encoding/hex"
+	"strconv"
+	"strings"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Validator statuses as defined by the standard API.
+const (
+	statusPendingInitialized = "pending_initialized"
+	statusPendingQueued      = "pending_queued"
+	statusActiveOngoing      = "active_ongoing"
+	statusActiveExiting      = "active_exiting"
+	statusActiveSlashed      = "active_slashed"
+	statusExitedUnslashed    = "exited_unslashed"
+	statusExitedSlashed      = "exited_slashed"
+	statusWithdrawalPossible = "withdrawal_possible"
+	statusWithdrawalDone     = "withdrawal_done"
 )
 
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
 func (bs *Server) GetValidator(ctx context.Context, req *ethpb.StateValidatorRequest) (*ethpb.StateValidatorResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetValidator")
+	defer span.End()
+
+	if len(req.ValidatorId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Validator ID is required")
+	}
+	st, err := bs.stateFromID(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := validatorIndexFromID(st, req.ValidatorId)
+	if err != nil {
+		return nil, err
+	}
+	container, err := validatorContainer(st, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethpb.StateValidatorResponse{Data: container}, nil
 }
 
 // ListValidators returns filterable list of validators with their balance, status and index.
 func (bs *Server) ListValidators(ctx context.Context, req *ethpb.StateValidatorsRequest) (*ethpb.StateValidatorsResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListValidators")
+	defer span.End()
+
+	st, err := bs.stateFromID(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+
+	var indices []uint64
+	if len(req.Id) == 0 {
+		indices = make([]uint64, st.NumValidators())
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+	} else {
+		indices = make([]uint64, 0, len(req.Id))
+		for _, id := range req.Id {
+			idx, err := validatorIndexFromID(st, id)
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, idx)
+		}
+	}
+
+	statusFilter := strings.ToLower(req.Status)
+	containers := make([]*ethpb.ValidatorContainer, 0, len(indices))
+	for _, idx := range indices {
+		container, err := validatorContainer(st, idx)
+		if err != nil {
+			return nil, err
+		}
+		if !statusMatchesFilter(container.Status, statusFilter) {
+			continue
+		}
+		containers = append(containers, container)
+	}
+
+	return &ethpb.StateValidatorsResponse{Data: containers}, nil
 }
 
 // ListValidatorBalances returns a filterable list of validator balances.
 func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.ValidatorBalancesRequest) (*ethpb.ValidatorBalancesResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListValidatorBalances")
+	defer span.End()
+
+	st, err := bs.stateFromID(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := st.Balances()
+	if len(req.Id) == 0 {
+		resp := make([]*ethpb.ValidatorBalance, len(balances))
+		for i, balance := range balances {
+			resp[i] = &ethpb.ValidatorBalance{Index: uint64(i), Balance: balance}
+		}
+		return &ethpb.ValidatorBalancesResponse{Data: resp}, nil
+	}
+
+	resp := make([]*ethpb.ValidatorBalance, 0, len(req.Id))
+	for _, id := range req.Id {
+		idx, err := validatorIndexFromID(st, []byte(id))
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, &ethpb.ValidatorBalance{Index: idx, Balance: balances[idx]})
+	}
+	return &ethpb.ValidatorBalancesResponse{Data: resp}, nil
 }
 
 // ListCommittees retrieves the committees for the given state at the given epoch.
+// As proto3 cannot distinguish an unset field from its zero value, an epoch of 0 defaults
+// to the epoch of the requested state, and the slot and index filters only apply when non-zero.
 func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommitteesRequest) (*ethpb.StateCommitteesResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListCommittees")
+	defer span.End()
+
+	st, err := bs.stateFromID(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+
+	stateEpoch := helpers.CurrentEpoch(st)
+	epoch := req.Epoch
+	if epoch == 0 {
+		epoch = stateEpoch
+	}
+	if epoch > stateEpoch+params.BeaconConfig().MinSeedLookahead {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve committees for epoch %d from a state at epoch %d",
+			epoch,
+			stateEpoch,
+		)
+	}
+	if req.Slot != 0 && helpers.SlotToEpoch(req.Slot) != epoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Slot %d is not in epoch %d", req.Slot, epoch)
+	}
+
+	committeesBySlot, err := committeesForEpoch(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	committees := make([]*ethpb.Committee, 0)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if req.Slot != 0 && slot != req.Slot {
+			continue
+		}
+		for index, committee := range committeesBySlot[slot] {
+			if req.Index != 0 && uint64(index) != req.Index {
+				continue
+			}
+			committees = append(committees, &ethpb.Committee{
+				Index:      uint64(index),
+				Slot:       slot,
+				Validators: committee,
+			})
+		}
+	}
+
+	return &ethpb.StateCommitteesResponse{Data: committees}, nil
+}
+
+// committeesForEpoch computes the beacon committees of the given epoch from the state.
+// Committees are keyed by slot, with the committee index being the position in the slice.
+func committeesForEpoch(st *state.BeaconState, epoch uint64) (map[uint64][][]uint64, error) {
+	seed, err := helpers.Seed(st, epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get seed: %v", err)
+	}
+	activeIndices, err := helpers.ActiveValidatorIndices(st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active indices: %v", err)
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	countAtSlot := helpers.SlotCommitteeCount(uint64(len(activeIndices)))
+	committeesBySlot := make(map[uint64][][]uint64, params.BeaconConfig().SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		committees := make([][]uint64, countAtSlot)
+		for committeeIndex := uint64(0); committeeIndex < countAtSlot; committeeIndex++ {
+			committee, err := helpers.BeaconCommittee(activeIndices, seed, slot, committeeIndex)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute committee for slot %d: %v", slot, err)
+			}
+			committees[committeeIndex] = committee
+		}
+		committeesBySlot[slot] = committees
+	}
+	return committeesBySlot, nil
+}
+
+// validatorIndexFromID resolves a validator identifier, which is either a decimal validator
+// index or a public key given as 48 raw bytes or a 0x-prefixed hex string.
+func validatorIndexFromID(st *state.BeaconState, id []byte) (uint64, error) {
+	pubKey, isPubKey, err := decodePubKeyID(id)
+	if err != nil {
+		return 0, err
+	}
+	if isPubKey {
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			return 0, status.Errorf(codes.NotFound, "Could not find validator with public key %#x", pubKey)
+		}
+		return idx, nil
+	}
+	idx, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid validator ID %q: %v", id, err)
+	}
+	if idx >= uint64(st.NumValidators()) {
+		return 0, status.Errorf(codes.NotFound, "Could not find validator with index %d", idx)
+	}
+	return idx, nil
+}
+
+// decodePubKeyID returns the public key encoded in a validator identifier, if any.
+func decodePubKeyID(id []byte) ([]byte, bool, error) {
+	if len(id) == params.BeaconConfig().BLSPubkeyLength {
+		return id, true, nil
+	}
+	idString := string(id)
+	if !strings.HasPrefix(idString, "0x") {
+		return nil, false, nil
+	}
+	pubKey, err := hex.DecodeString(idString[2:])
+	if err != nil || len(pubKey) != params.BeaconConfig().BLSPubkeyLength {
+		return nil, false, status.Errorf(codes.InvalidArgument, "Invalid validator public key %q", idString)
+	}
+	return pubKey, true, nil
+}
+
+// validatorContainer builds the response container of the validator at the given index.
+func validatorContainer(st *state.BeaconState, idx uint64) (*ethpb.ValidatorContainer, error) {
+	v, err := st.ValidatorAtIndex(idx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get validator at index %d: %v", idx, err)
+	}
+	balance, err := st.BalanceAtIndex(idx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get balance at index %d: %v", idx, err)
+	}
+	return &ethpb.ValidatorContainer{
+		Index:     idx,
+		Balance:   balance,
+		Status:    validatorStatus(v, balance, helpers.CurrentEpoch(st)),
+		Validator: migration.V1Alpha1ValidatorToV1(v),
+	}, nil
+}
+
+// validatorStatus returns the standard API status of a validator at the given epoch.
+func validatorStatus(v *ethpb_alpha.Validator, balance, epoch uint64) string {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	switch {
+	case v.ActivationEpoch > epoch:
+		if v.ActivationEligibilityEpoch == farFutureEpoch {
+			return statusPendingInitialized
+		}
+		return statusPendingQueued
+	case epoch < v.ExitEpoch:
+		if v.ExitEpoch == farFutureEpoch {
+			return statusActiveOngoing
+		}
+		if v.Slashed {
+			return statusActiveSlashed
+		}
+		return statusActiveExiting
+	case epoch < v.WithdrawableEpoch:
+		if v.Slashed {
+			return statusExitedSlashed
+		}
+		return statusExitedUnslashed
+	default:
+		if balance == 0 {
+			return statusWithdrawalDone
+		}
+		return statusWithdrawalPossible
+	}
+}
+
+// statusMatchesFilter reports whether a validator status satisfies the requested filter. The
+// filter may be a full status such as "active_ongoing" or a general one such as "active".
+func statusMatchesFilter(validatorStatus, filter string) bool {
+	if filter == "" || validatorStatus == filter {
+		return true
+	}
+	return strings.HasPrefix(validatorStatus, filter+"_")
 }
@@ -0,0 +1,298 @@
+package beaconv1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetValidator(t *testing.T) {
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	bs := &Server{ChainInfoFetcher: &mock.ChainService{State: headState}}
+
+	t.Run("By index", func(t *testing.T) {
+		resp, err := bs.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte("15"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(15), resp.Data.Index)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, resp.Data.Balance)
+		assert.Equal(t, statusActiveOngoing, resp.Data.Status)
+		pubKey := headState.PubkeyAtIndex(15)
+		assert.DeepEqual(t, pubKey[:], resp.Data.Validator.PublicKey)
+	})
+
+	t.Run("By public key", func(t *testing.T) {
+		pubKey := headState.PubkeyAtIndex(20)
+		resp, err := bs.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte(fmt.Sprintf("%#x", pubKey)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(20), resp.Data.Index)
+		assert.DeepEqual(t, pubKey[:], resp.Data.Validator.PublicKey)
+	})
+
+	t.Run("Unknown index", func(t *testing.T) {
+		_, err := bs.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte("64"),
+		})
+		assert.ErrorContains(t, "Could not find validator with index 64", err)
+	})
+
+	t.Run("Unknown public key", func(t *testing.T) {
+		_, err := bs.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: make([]byte, 48),
+		})
+		assert.ErrorContains(t, "Could not find validator with public key", err)
+	})
+
+	t.Run("Missing validator ID", func(t *testing.T) {
+		_, err := bs.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head")})
+		assert.ErrorContains(t, "Validator ID is required", err)
+	})
+}
+
+func TestListValidators(t *testing.T) {
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	exitedValidator, err := headState.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	exitedValidator.ExitEpoch = 0
+	exitedValidator.WithdrawableEpoch = params.BeaconConfig().FarFutureEpoch
+	require.NoError(t, headState.UpdateValidatorAtIndex(3, exitedValidator))
+	bs := &Server{ChainInfoFetcher: &mock.ChainService{State: headState}}
+
+	t.Run("All validators", func(t *testing.T) {
+		resp, err := bs.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head")})
+		require.NoError(t, err)
+		require.Equal(t, 64, len(resp.Data))
+		for i, container := range resp.Data {
+			assert.Equal(t, uint64(i), container.Index)
+		}
+	})
+
+	t.Run("By IDs", func(t *testing.T) {
+		pubKey := headState.PubkeyAtIndex(7)
+		resp, err := bs.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Id:      [][]byte{[]byte("2"), pubKey[:]},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, uint64(2), resp.Data[0].Index)
+		assert.Equal(t, uint64(7), resp.Data[1].Index)
+	})
+
+	t.Run("By status", func(t *testing.T) {
+		resp, err := bs.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Status:  "exited",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, uint64(3), resp.Data[0].Index)
+		assert.Equal(t, statusExitedUnslashed, resp.Data[0].Status)
+
+		resp, err = bs.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Status:  statusActiveOngoing,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 63, len(resp.Data))
+	})
+}
+
+func TestListValidatorBalances(t *testing.T) {
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = uint64(i) * 1000
+	}
+	require.NoError(t, headState.SetBalances(balances))
+	bs := &Server{ChainInfoFetcher: &mock.ChainService{State: headState}}
+
+	t.Run("All balances", func(t *testing.T) {
+		resp, err := bs.ListValidatorBalances(ctx, &ethpb.ValidatorBalancesRequest{StateId: []byte("head")})
+		require.NoError(t, err)
+		require.Equal(t, 64, len(resp.Data))
+		for i, balance := range resp.Data {
+			assert.Equal(t, uint64(i), balance.Index)
+			assert.Equal(t, balances[i], balance.Balance)
+		}
+	})
+
+	t.Run("By IDs", func(t *testing.T) {
+		pubKey := headState.PubkeyAtIndex(9)
+		resp, err := bs.ListValidatorBalances(ctx, &ethpb.ValidatorBalancesRequest{
+			StateId: []byte("head"),
+			Id:      []string{"4", fmt.Sprintf("%#x", pubKey)},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, uint64(4), resp.Data[0].Index)
+		assert.Equal(t, balances[4], resp.Data[0].Balance)
+		assert.Equal(t, uint64(9), resp.Data[1].Index)
+		assert.Equal(t, balances[9], resp.Data[1].Balance)
+	})
+}
+
+func TestListCommittees(t *testing.T) {
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	bs := &Server{ChainInfoFetcher: &mock.ChainService{State: headState}}
+	epoch := helpers.CurrentEpoch(headState)
+
+	t.Run("All committees", func(t *testing.T) {
+		resp, err := bs.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head")})
+		require.NoError(t, err)
+		require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(resp.Data))
+		seen := make(map[uint64]bool)
+		for _, committee := range resp.Data {
+			assert.Equal(t, epoch, helpers.SlotToEpoch(committee.Slot))
+			for _, idx := range committee.Validators {
+				assert.Equal(t, false, seen[idx], "validator %d assigned twice", idx)
+				seen[idx] = true
+			}
+		}
+		assert.Equal(t, 64, len(seen))
+	})
+
+	t.Run("By slot", func(t *testing.T) {
+		resp, err := bs.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Slot: 4})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, uint64(4), resp.Data[0].Slot)
+		want, err := helpers.BeaconCommitteeFromState(headState, 4, 0)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, resp.Data[0].Validators)
+	})
+
+	t.Run("Slot outside epoch", func(t *testing.T) {
+		slot := params.BeaconConfig().SlotsPerEpoch * 3
+		_, err := bs.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Slot: slot})
+		assert.ErrorContains(t, "Slot "+strconv.FormatUint(slot, 10)+" is not in epoch", err)
+	})
+
+	t.Run("Epoch too far in the future", func(t *testing.T) {
+		_, err := bs.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: epoch + 2})
+		assert.ErrorContains(t, "Cannot retrieve committees for epoch", err)
+	})
+}
+
+func TestValidatorStatus(t *testing.T) {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	tests := []struct {
+		name      string
+		validator *ethpb_alpha.Validator
+		balance   uint64
+		want      string
+	}{
+		{
+			name: "pending initialized",
+			validator: &ethpb_alpha.Validator{
+				ActivationEligibilityEpoch: farFutureEpoch,
+				ActivationEpoch:            farFutureEpoch,
+				ExitEpoch:                  farFutureEpoch,
+				WithdrawableEpoch:          farFutureEpoch,
+			},
+			want: statusPendingInitialized,
+		},
+		{
+			name: "pending queued",
+			validator: &ethpb_alpha.Validator{
+				ActivationEligibilityEpoch: 2,
+				ActivationEpoch:            farFutureEpoch,
+				ExitEpoch:                  farFutureEpoch,
+				WithdrawableEpoch:          farFutureEpoch,
+			},
+			want: statusPendingQueued,
+		},
+		{
+			name: "active ongoing",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         farFutureEpoch,
+				WithdrawableEpoch: farFutureEpoch,
+			},
+			want: statusActiveOngoing,
+		},
+		{
+			name: "active exiting",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         20,
+				WithdrawableEpoch: 40,
+			},
+			want: statusActiveExiting,
+		},
+		{
+			name: "active slashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         20,
+				WithdrawableEpoch: 40,
+				Slashed:           true,
+			},
+			want: statusActiveSlashed,
+		},
+		{
+			name: "exited unslashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 40,
+			},
+			want: statusExitedUnslashed,
+		},
+		{
+			name: "exited slashed",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 40,
+				Slashed:           true,
+			},
+			want: statusExitedSlashed,
+		},
+		{
+			name: "withdrawal possible",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 6,
+			},
+			balance: 1,
+			want:    statusWithdrawalPossible,
+		},
+		{
+			name: "withdrawal done",
+			validator: &ethpb_alpha.Validator{
+				ActivationEpoch:   3,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 6,
+			},
+			want: statusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validatorStatus(tt.validator, tt.balance, 10))
+		})
+	}
+}
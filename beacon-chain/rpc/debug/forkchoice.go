@@ -6,6 +6,8 @@ import (
 
 	ptypes "github.com/gogo/protobuf/types"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetProtoArrayForkChoice returns proto array fork choice store.
@@ -42,3 +44,21 @@ func (ds *Server) GetProtoArrayForkChoice(_ context.Context, _ *ptypes.Empty) (*
 		Indices:         indices,
 	}, nil
 }
+
+// GetForkChoiceExport returns the proto array fork choice tree exported as JSON or as a Graphviz DOT graph,
+// including the weights, checkpoints and best children of the nodes, and highlighting the canonical chain.
+func (ds *Server) GetForkChoiceExport(_ context.Context, req *pbrpc.ForkChoiceExportRequest) (*pbrpc.ForkChoiceExportResponse, error) {
+	tree := ds.HeadFetcher.ProtoArrayStore().Export()
+	switch req.Format {
+	case pbrpc.ForkChoiceExportRequest_JSON:
+		enc, err := tree.JSON()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not encode fork choice tree: %v", err)
+		}
+		return &pbrpc.ForkChoiceExportResponse{Data: string(enc)}, nil
+	case pbrpc.ForkChoiceExportRequest_DOT:
+		return &pbrpc.ForkChoiceExportResponse{Data: tree.DOT()}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown export format %v", req.Format)
+	}
+}
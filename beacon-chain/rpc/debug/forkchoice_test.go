@@ -2,11 +2,13 @@ package debug
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	ptypes "github.com/gogo/protobuf/types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -20,3 +22,26 @@ func TestServer_GetForkChoice(t *testing.T) {
 	assert.Equal(t, store.JustifiedEpoch(), res.JustifiedEpoch, "Did not get wanted justified epoch")
 	assert.Equal(t, store.FinalizedEpoch(), res.FinalizedEpoch, "Did not get wanted finalized epoch")
 }
+
+func TestServer_GetForkChoiceExport(t *testing.T) {
+	ctx := context.Background()
+	f := protoarray.New(0, 0, [32]byte{'a'})
+	require.NoError(t, f.ProcessBlock(ctx, 0, [32]byte{'a'}, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	_, err := f.Head(ctx, 0, [32]byte{'a'}, []uint64{}, 0)
+	require.NoError(t, err)
+	bs := &Server{HeadFetcher: &mock.ChainService{ForkChoiceStore: f.Store()}}
+
+	res, err := bs.GetForkChoiceExport(ctx, &pbrpc.ForkChoiceExportRequest{Format: pbrpc.ForkChoiceExportRequest_JSON})
+	require.NoError(t, err)
+	tree := &protoarray.ExportedTree{}
+	require.NoError(t, json.Unmarshal([]byte(res.Data), tree))
+	assert.DeepEqual(t, f.Store().Export(), tree)
+
+	res, err = bs.GetForkChoiceExport(ctx, &pbrpc.ForkChoiceExportRequest{Format: pbrpc.ForkChoiceExportRequest_DOT})
+	require.NoError(t, err)
+	assert.Equal(t, f.Store().Export().DOT(), res.Data)
+
+	_, err = bs.GetForkChoiceExport(ctx, &pbrpc.ForkChoiceExportRequest{Format: 2})
+	assert.ErrorContains(t, "Unknown export format", err)
+}
@@ -0,0 +1,152 @@
+package node
+
+import (
+	"context"
+	"sort"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTrustedPeers lists the trusted peers of the node.
+func (ns *Server) ListTrustedPeers(_ context.Context, _ *ptypes.Empty) (*pbrpc.TrustedPeers, error) {
+	pids := ns.PeersFetcher.Peers().Trusted()
+	res := make([]*pbrpc.TrustedPeer, 0, len(pids))
+	for _, pid := range pids {
+		trustedPeer, err := ns.trustedPeer(pid)
+		if err != nil {
+			continue
+		}
+		res = append(res, trustedPeer)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].PeerId < res[j].PeerId
+	})
+	return &pbrpc.TrustedPeers{Peers: res}, nil
+}
+
+// AddTrustedPeer adds a trusted peer from its multiaddr or ENR and dials it.
+func (ns *Server) AddTrustedPeer(_ context.Context, req *pbrpc.AddPeerRequest) (*pbrpc.TrustedPeer, error) {
+	if req.Addr == "" {
+		return nil, status.Error(codes.InvalidArgument, "Peer address is required")
+	}
+	pid, err := ns.PeerManager.AddTrustedPeer(req.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not add trusted peer: %v", err)
+	}
+	trustedPeer, err := ns.trustedPeer(pid)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve trusted peer: %v", err)
+	}
+	return trustedPeer, nil
+}
+
+// RemoveTrustedPeer removes the peer from the trusted peers, without disconnecting it.
+func (ns *Server) RemoveTrustedPeer(_ context.Context, req *pbrpc.PeerRequest) (*ptypes.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if !ns.PeersFetcher.Peers().IsTrusted(pid) {
+		return nil, status.Error(codes.NotFound, "Requested peer is not trusted")
+	}
+	if err := ns.PeerManager.RemoveTrustedPeer(pid); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not remove trusted peer: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// AddPeer dials a peer given by its multiaddr or ENR.
+func (ns *Server) AddPeer(ctx context.Context, req *pbrpc.AddPeerRequest) (*pbrpc.AddPeerResponse, error) {
+	if req.Addr == "" {
+		return nil, status.Error(codes.InvalidArgument, "Peer address is required")
+	}
+	pid, err := ns.PeerManager.AddPeer(ctx, req.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not add peer: %v", err)
+	}
+	return &pbrpc.AddPeerResponse{PeerId: pid.String()}, nil
+}
+
+// DisconnectPeer disconnects a connected peer.
+func (ns *Server) DisconnectPeer(_ context.Context, req *pbrpc.PeerRequest) (*ptypes.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if err := ns.PeerManager.DisconnectPeer(pid); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not disconnect peer: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// ListBans lists the banned peers and subnets.
+func (ns *Server) ListBans(_ context.Context, _ *ptypes.Empty) (*pbrpc.Bans, error) {
+	banned := ns.PeersFetcher.Peers().Banned()
+	pids := make([]string, len(banned))
+	for i, pid := range banned {
+		pids[i] = pid.String()
+	}
+	sort.Strings(pids)
+	return &pbrpc.Bans{
+		PeerIds: pids,
+		Subnets: ns.PeerManager.BannedSubnets(),
+	}, nil
+}
+
+// Ban bans a peer by its ID, or an IP address or subnet.
+func (ns *Server) Ban(_ context.Context, req *pbrpc.BanRequest) (*ptypes.Empty, error) {
+	if err := ns.applyBan(req, ns.PeerManager.BanPeer, ns.PeerManager.BanSubnet); err != nil {
+		return nil, err
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// Unban lifts the ban of a peer, or of an IP address or subnet.
+func (ns *Server) Unban(_ context.Context, req *pbrpc.BanRequest) (*ptypes.Empty, error) {
+	if err := ns.applyBan(req, ns.PeerManager.UnbanPeer, ns.PeerManager.UnbanSubnet); err != nil {
+		return nil, err
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// applyBan applies the peer or the subnet function to the target of the ban request.
+func (ns *Server) applyBan(req *pbrpc.BanRequest, peerFn func(peer.ID) error, subnetFn func(string) error) error {
+	if (req.PeerId == "") == (req.Ip == "") {
+		return status.Error(codes.InvalidArgument, "Exactly one of peer id and ip is required")
+	}
+	if req.Ip != "" {
+		if err := subnetFn(req.Ip); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "Could not update ban: %v", err)
+		}
+		return nil
+	}
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if err := peerFn(pid); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "Could not update ban: %v", err)
+	}
+	return nil
+}
+
+func (ns *Server) trustedPeer(pid peer.ID) (*pbrpc.TrustedPeer, error) {
+	addr, err := ns.PeersFetcher.Peers().TrustedAddress(pid)
+	if err != nil {
+		return nil, err
+	}
+	connState, err := ns.PeersFetcher.Peers().ConnectionState(pid)
+	if err != nil {
+		return nil, err
+	}
+	return &pbrpc.TrustedPeer{
+		PeerId:    pid.String(),
+		Address:   addr.String(),
+		Connected: connState == peers.PeerConnected,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package node
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNodeServer_TrustedPeers(t *testing.T) {
+	ctx := context.Background()
+	p2p := mockP2p.NewTestP2P(t)
+	ns := &Server{
+		PeersFetcher: p2p,
+		PeerManager:  p2p,
+	}
+	pid := "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+
+	_, err := ns.AddTrustedPeer(ctx, &pbrpc.AddPeerRequest{})
+	assert.ErrorContains(t, "Peer address is required", err)
+	_, err = ns.AddTrustedPeer(ctx, &pbrpc.AddPeerRequest{Addr: "/ip4/213.202.254.180/tcp/13000"})
+	assert.ErrorContains(t, "Could not add trusted peer", err)
+
+	res, err := ns.AddTrustedPeer(ctx, &pbrpc.AddPeerRequest{Addr: "/ip4/213.202.254.180/tcp/13000/p2p/" + pid})
+	require.NoError(t, err)
+	assert.Equal(t, pid, res.PeerId)
+	assert.Equal(t, "/ip4/213.202.254.180/tcp/13000", res.Address)
+	assert.Equal(t, false, res.Connected)
+
+	list, err := ns.ListTrustedPeers(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list.Peers))
+	assert.Equal(t, pid, list.Peers[0].PeerId)
+
+	_, err = ns.RemoveTrustedPeer(ctx, &pbrpc.PeerRequest{PeerId: pid})
+	require.NoError(t, err)
+	list, err = ns.ListTrustedPeers(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(list.Peers))
+
+	_, err = ns.RemoveTrustedPeer(ctx, &pbrpc.PeerRequest{PeerId: pid})
+	assert.ErrorContains(t, "Requested peer is not trusted", err)
+	_, err = ns.RemoveTrustedPeer(ctx, &pbrpc.PeerRequest{PeerId: "foo"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+}
+
+func TestNodeServer_AddPeer(t *testing.T) {
+	ctx := context.Background()
+	p2p := mockP2p.NewTestP2P(t)
+	ns := &Server{
+		PeersFetcher: p2p,
+		PeerManager:  p2p,
+	}
+	pid := "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+
+	_, err := ns.AddPeer(ctx, &pbrpc.AddPeerRequest{})
+	assert.ErrorContains(t, "Peer address is required", err)
+	res, err := ns.AddPeer(ctx, &pbrpc.AddPeerRequest{Addr: "/ip4/213.202.254.180/tcp/13000/p2p/" + pid})
+	require.NoError(t, err)
+	assert.Equal(t, pid, res.PeerId)
+
+	_, err = ns.DisconnectPeer(ctx, &pbrpc.PeerRequest{PeerId: pid})
+	require.NoError(t, err)
+	_, err = ns.DisconnectPeer(ctx, &pbrpc.PeerRequest{PeerId: "foo"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+}
+
+func TestNodeServer_Bans(t *testing.T) {
+	ctx := context.Background()
+	p2p := mockP2p.NewTestP2P(t)
+	ns := &Server{
+		PeersFetcher: p2p,
+		PeerManager:  p2p,
+	}
+	pid := "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+
+	_, err := ns.Ban(ctx, &pbrpc.BanRequest{})
+	assert.ErrorContains(t, "Exactly one of peer id and ip is required", err)
+	_, err = ns.Ban(ctx, &pbrpc.BanRequest{PeerId: pid, Ip: "10.0.0.1"})
+	assert.ErrorContains(t, "Exactly one of peer id and ip is required", err)
+	_, err = ns.Ban(ctx, &pbrpc.BanRequest{PeerId: "foo"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+
+	_, err = ns.Ban(ctx, &pbrpc.BanRequest{PeerId: pid})
+	require.NoError(t, err)
+	_, err = ns.Ban(ctx, &pbrpc.BanRequest{Ip: "10.0.0.0/8"})
+	require.NoError(t, err)
+	bans, err := ns.ListBans(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{pid}, bans.PeerIds)
+	assert.DeepEqual(t, []string{"10.0.0.0/8"}, bans.Subnets)
+
+	_, err = ns.Unban(ctx, &pbrpc.BanRequest{PeerId: pid})
+	require.NoError(t, err)
+	_, err = ns.Unban(ctx, &pbrpc.BanRequest{Ip: "10.0.0.0/8"})
+	require.NoError(t, err)
+	_, err = ns.Unban(ctx, &pbrpc.BanRequest{Ip: "10.0.0.0/8"})
+	assert.ErrorContains(t, "Could not update ban", err)
+	bans, err = ns.ListBans(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(bans.PeerIds))
+	assert.Equal(t, 0, len(bans.Subnets))
+}
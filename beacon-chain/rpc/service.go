@@ -292,6 +292,7 @@ func (s *Service) Start() {
 		BeaconDB:            s.beaconDB,
 		AttestationsPool:    s.attestationsPool,
 		SlashingsPool:       s.slashingsPool,
+		VoluntaryExitsPool:  s.exitPool,
 		ChainInfoFetcher:    s.chainInfoFetcher,
 		ChainStartFetcher:   s.chainStartFetcher,
 		DepositFetcher:      s.depositFetcher,
@@ -302,12 +303,16 @@ func (s *Service) Start() {
 		BlockNotifier:       s.blockNotifier,
 		AttestationNotifier: s.operationNotifier,
 		Broadcaster:         s.p2p,
+		BlockReceiver:       s.blockReceiver,
+		AttestationReceiver: s.attestationReceiver,
 		StateGen:            s.stateGen,
 		SyncChecker:         s.syncService,
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
+	pbrpc.RegisterPeerManagerServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterRewardsServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
 	if s.enableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
@@ -322,6 +327,7 @@ func (s *Service) Start() {
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterLivenessServer(s.grpcServer, validatorServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
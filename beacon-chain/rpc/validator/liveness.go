@@ -0,0 +1,84 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorLiveness reports whether the requested validators were live in the given epoch,
+// that is whether one of their attestations was included on chain, or one of their blocks was
+// seen, in the epoch. Only the current and the previous epochs of the head state are supported,
+// as the attestations of older epochs are no longer part of the head state. Epochs past the
+// current epoch of the head state have nothing on chain yet, so no validator is live in them.
+func (vs *Server) GetValidatorLiveness(
+	ctx context.Context,
+	req *pbrpc.ValidatorLivenessRequest,
+) (*pbrpc.ValidatorLivenessResponse, error) {
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+	previousEpoch := helpers.PrevEpoch(headState)
+	if req.Epoch < previousEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot report liveness for epoch %d, only the current epoch %d and the previous epoch are supported",
+			req.Epoch,
+			currentEpoch,
+		)
+	}
+
+	live := make(map[uint64]bool)
+	if req.Epoch <= currentEpoch {
+		v, b, err := precompute.New(ctx, headState)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set up pre compute instance: %v", err)
+		}
+		v, _, err = precompute.ProcessAttestations(ctx, headState, v, b)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not pre compute attestations: %v", err)
+		}
+		for i, val := range v {
+			if (req.Epoch == currentEpoch && val.IsCurrentEpochAttester) ||
+				(req.Epoch == previousEpoch && val.IsPrevEpochAttester) {
+				live[uint64(i)] = true
+			}
+		}
+		// Blocks of forks are considered as well, as they were proposed all the same.
+		blks, _, err := vs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(req.Epoch).SetEndEpoch(req.Epoch))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for epoch %d: %v", req.Epoch, err)
+		}
+		for _, blk := range blks {
+			if blk == nil || blk.Block == nil {
+				continue
+			}
+			live[blk.Block.ProposerIndex] = true
+		}
+	}
+
+	liveness := make([]*pbrpc.ValidatorLivenessResponse_ValidatorLiveness, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		liveness[i] = &pbrpc.ValidatorLivenessResponse_ValidatorLiveness{
+			PublicKey: pubKey,
+		}
+		index, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+		if !ok {
+			continue
+		}
+		liveness[i].Index = index
+		liveness[i].IsLive = live[index]
+	}
+	return &pbrpc.ValidatorLivenessResponse{
+		Epoch:    req.Epoch,
+		Liveness: liveness,
+	}, nil
+}
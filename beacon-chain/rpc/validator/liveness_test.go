@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetValidatorLiveness(t *testing.T) {
+	db, _ := dbutil.SetupDB(t)
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, headState.SetSlot(2*slotsPerEpoch-1))
+
+	// The first member of a committee of the previous epoch and the second member of a
+	// committee of the current epoch attested.
+	prevCommittee, err := helpers.BeaconCommitteeFromState(headState, 0, 0)
+	require.NoError(t, err)
+	prevBits := bitfield.NewBitlist(uint64(len(prevCommittee)))
+	prevBits.SetBitAt(0, true)
+	currCommittee, err := helpers.BeaconCommitteeFromState(headState, slotsPerEpoch, 0)
+	require.NoError(t, err)
+	currBits := bitfield.NewBitlist(uint64(len(currCommittee)))
+	currBits.SetBitAt(1, true)
+	require.NoError(t, headState.SetPreviousEpochAttestations([]*pbp2p.PendingAttestation{{
+		Data: &ethpb.AttestationData{
+			Slot:            0,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+		},
+		InclusionDelay:  1,
+		AggregationBits: prevBits,
+	}}))
+	require.NoError(t, headState.SetCurrentEpochAttestations([]*pbp2p.PendingAttestation{{
+		Data: &ethpb.AttestationData{
+			Slot:            slotsPerEpoch,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		},
+		InclusionDelay:  1,
+		AggregationBits: currBits,
+	}}))
+
+	// A validator proposed a block in the current epoch.
+	proposerIndex := uint64(40)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slotsPerEpoch + 1
+	blk.Block.ProposerIndex = proposerIndex
+	require.NoError(t, db.SaveBlock(ctx, blk))
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: headState},
+	}
+	pubKey := func(index uint64) []byte {
+		val, err := headState.ValidatorAtIndexReadOnly(index)
+		require.NoError(t, err)
+		key := val.PublicKey()
+		return key[:]
+	}
+	unknownKey := make([]byte, 48)
+	pubKeys := [][]byte{pubKey(prevCommittee[0]), pubKey(currCommittee[1]), pubKey(proposerIndex), unknownKey}
+
+	res, err := vs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 0, PublicKeys: pubKeys})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(res.Liveness))
+	assert.Equal(t, uint64(0), res.Epoch)
+	assert.Equal(t, prevCommittee[0], res.Liveness[0].Index)
+	assert.Equal(t, true, res.Liveness[0].IsLive)
+	assert.Equal(t, prevCommittee[0] == currCommittee[1], res.Liveness[1].IsLive)
+	assert.Equal(t, prevCommittee[0] == proposerIndex, res.Liveness[2].IsLive)
+	assert.Equal(t, false, res.Liveness[3].IsLive)
+
+	res, err = vs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 1, PublicKeys: pubKeys})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(res.Liveness))
+	assert.Equal(t, currCommittee[1] == prevCommittee[0], res.Liveness[0].IsLive)
+	assert.Equal(t, true, res.Liveness[1].IsLive)
+	assert.Equal(t, true, res.Liveness[2].IsLive)
+	assert.Equal(t, false, res.Liveness[3].IsLive)
+	assert.DeepEqual(t, unknownKey, res.Liveness[3].PublicKey)
+
+	// Nothing is on chain yet for future epochs.
+	res, err = vs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 2, PublicKeys: pubKeys})
+	require.NoError(t, err)
+	for _, liveness := range res.Liveness {
+		assert.Equal(t, false, liveness.IsLive)
+	}
+}
+
+func TestGetValidatorLiveness_OldEpoch(t *testing.T) {
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, headState.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+	vs := &Server{
+		HeadFetcher: &mockChain.ChainService{State: headState},
+	}
+	_, err := vs.GetValidatorLiveness(context.Background(), &pbrpc.ValidatorLivenessRequest{Epoch: 1})
+	assert.ErrorContains(t, "Cannot report liveness for epoch 1", err)
+}
@@ -0,0 +1,17 @@
+package slasher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	droppedAttestationsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_slasher_dropped_attestations_total",
+		Help: "Number of attestations not checked by the in-process slasher because its queue was full.",
+	})
+	droppedBlocksCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_slasher_dropped_blocks_total",
+		Help: "Number of blocks not checked by the in-process slasher because its queue was full.",
+	})
+)
@@ -0,0 +1,370 @@
+// Package slasher runs slashing detection inside the beacon node. Attestations and blocks received
+// over gossip or processed by the blockchain service are checked for double proposals, double votes
+// and surround votes with the detection logic of the standalone slasher, and the slashings found are
+// placed in the slashings pool for inclusion in the next proposed block.
+package slasher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	slasherdb "github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+const (
+	// maxQueuedAttestations is the number of attestations queued for the next detection batch,
+	// above which attestations are dropped.
+	maxQueuedAttestations = 1 << 16
+	// blockQueueSize is the number of blocks queued for detection, above which blocks are dropped.
+	blockQueueSize = 64
+)
+
+// Config to set up the in-process slasher.
+type Config struct {
+	SlasherDB           slasherdb.Database
+	BeaconDB            db.ReadOnlyDatabase
+	HeadFetcher         blockchain.HeadFetcher
+	AttPreStateFetcher  blockchain.AttestationReceiver
+	StateNotifier       statefeed.Notifier
+	BlockNotifier       blockfeed.Notifier
+	AttestationNotifier operation.Notifier
+	SlashingPool        *slashings.Pool
+}
+
+// Service detects slashable offenses in the attestations and blocks seen by the beacon node.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	cfg             *Config
+	detector        *detection.Service
+	gossipBlocks    chan *ethpb.SignedBeaconBlock
+	processedBlocks chan [32]byte
+	attQueueLock    sync.Mutex
+	attQueue        []*queuedAttestation
+}
+
+// queuedAttestation is an attestation waiting for detection. Attestations received on the
+// operation feed may come from unverified sources such as the API, so their signature is verified
+// before they are saved, unlike those of the blocks processed by the blockchain service.
+type queuedAttestation struct {
+	att      *ethpb.Attestation
+	verified bool
+}
+
+// NewService configures the in-process slasher.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+		// Only the detection methods of the service are used, it is never started
+		// as it would otherwise listen to a standalone slasher's beacon client.
+		detector:        detection.NewService(ctx, &detection.Config{SlasherDB: cfg.SlasherDB}),
+		gossipBlocks:    make(chan *ethpb.SignedBeaconBlock, blockQueueSize),
+		processedBlocks: make(chan [32]byte, blockQueueSize),
+	}
+}
+
+// Start the in-process slasher.
+func (s *Service) Start() {
+	log.Info("Starting in-process slasher")
+	go s.run()
+	go s.detect()
+}
+
+// Stop the in-process slasher.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the in-process slasher.
+func (s *Service) Status() error {
+	return nil
+}
+
+// run receives the attestations and blocks seen by the beacon node and queues them for detection.
+// Detection never runs in this loop, so the feeds are not blocked by the slasher. Attestations and
+// blocks which don't fit in the queues are dropped.
+func (s *Service) run() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	blockChannel := make(chan *feed.Event, 1)
+	blockSub := s.cfg.BlockNotifier.BlockFeed().Subscribe(blockChannel)
+	defer blockSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.cfg.AttestationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	for {
+		select {
+		case event := <-opChannel:
+			switch data := event.Data.(type) {
+			case *operation.UnAggregatedAttReceivedData:
+				s.queueAttestations(false, data.Attestation)
+			case *operation.AggregatedAttReceivedData:
+				if data.Attestation != nil {
+					s.queueAttestations(false, data.Attestation.Aggregate)
+				}
+			}
+		case event := <-blockChannel:
+			if event.Type != blockfeed.ReceivedBlock {
+				continue
+			}
+			data, ok := event.Data.(*blockfeed.ReceivedBlockData)
+			if !ok {
+				log.Error("Event feed data is not type *blockfeed.ReceivedBlockData")
+				continue
+			}
+			select {
+			case s.gossipBlocks <- data.SignedBlock:
+			default:
+				droppedBlocksCounter.Inc()
+			}
+		case event := <-stateChannel:
+			if event.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				log.Error("Event feed data is not type *statefeed.BlockProcessedData")
+				continue
+			}
+			select {
+			case s.processedBlocks <- data.BlockRoot:
+			default:
+				droppedBlocksCounter.Inc()
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		case err := <-blockSub.Err():
+			log.WithError(err).Error("Could not subscribe to block notifier")
+			return
+		case err := <-opSub.Err():
+			log.WithError(err).Error("Could not subscribe to operation notifier")
+			return
+		}
+	}
+}
+
+// detect runs slashing detection on the queued blocks as they arrive, and on the queued
+// attestations once per slot as a single batch.
+func (s *Service) detect() {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case blk := <-s.gossipBlocks:
+			if err := s.processGossipBlock(s.ctx, blk); err != nil {
+				log.WithError(err).Debug("Could not detect slashings for gossip block")
+			}
+		case root := <-s.processedBlocks:
+			blk, err := s.processBlock(s.ctx, root)
+			if err != nil {
+				log.WithError(err).Error("Could not detect slashings for processed block")
+			}
+			// Attestations included in blocks may never have been seen over gossip. Their signatures
+			// were verified when the block was processed.
+			if blk != nil {
+				s.queueAttestations(true, blk.Block.Body.Attestations...)
+			}
+		case <-ticker.C:
+			if atts := s.dequeueAttestations(); len(atts) > 0 {
+				s.processAttestations(s.ctx, atts)
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// queueAttestations adds attestations to the next detection batch, dropping those exceeding
+// the queue capacity. Verified is set if the signatures of the attestations were verified.
+func (s *Service) queueAttestations(verified bool, atts ...*ethpb.Attestation) {
+	s.attQueueLock.Lock()
+	defer s.attQueueLock.Unlock()
+	for _, att := range atts {
+		if len(s.attQueue) >= maxQueuedAttestations {
+			droppedAttestationsCounter.Inc()
+			continue
+		}
+		s.attQueue = append(s.attQueue, &queuedAttestation{att: att, verified: verified})
+	}
+}
+
+// dequeueAttestations empties the attestation queue, returning its attestations.
+func (s *Service) dequeueAttestations() []*queuedAttestation {
+	s.attQueueLock.Lock()
+	defer s.attQueueLock.Unlock()
+	atts := s.attQueue
+	s.attQueue = nil
+	return atts
+}
+
+// processGossipBlock checks a block received over gossip for double proposals. Gossip blocks are
+// announced before validation, so the proposer signature is verified first to keep forged headers
+// out of the slasher DB.
+func (s *Service) processGossipBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) error {
+	if blk == nil || blk.Block == nil {
+		return errors.New("nil block")
+	}
+	headState, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if err := blocks.VerifyBlockSignature(headState, blk); err != nil {
+		return errors.Wrap(err, "could not verify block signature")
+	}
+	return s.processBlockHeader(ctx, blk)
+}
+
+// processBlock checks a block processed by the blockchain service for double proposals, and returns
+// the block so its attestations can be checked as well.
+func (s *Service) processBlock(ctx context.Context, root [32]byte) (*ethpb.SignedBeaconBlock, error) {
+	blk, err := s.cfg.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block")
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, errors.Errorf("block %#x not found", root)
+	}
+	return blk, s.processBlockHeader(ctx, blk)
+}
+
+func (s *Service) processBlockHeader(ctx context.Context, blk *ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.processBlockHeader")
+	defer span.End()
+	header, err := blockutil.SignedBeaconBlockHeaderFromBlock(blk)
+	if err != nil {
+		return errors.Wrap(err, "could not get block header from block")
+	}
+	slashing, err := s.detector.DetectDoubleProposals(ctx, header)
+	if err != nil {
+		return errors.Wrap(err, "could not detect double proposals")
+	}
+	if slashing == nil {
+		return nil
+	}
+	headState, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	// The pool rejects slashings it already holds, which happens when the same block
+	// is seen over gossip and again once processed.
+	if err := s.cfg.SlashingPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+		log.WithError(err).Debug("Could not insert proposer slashing into pool")
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"proposerIndex": header.Header.ProposerIndex,
+		"slot":          header.Header.Slot,
+	}).Info("Detected double proposal")
+	return nil
+}
+
+// processAttestations converts a batch of attestations to indexed attestations, saves them for
+// later lookups of conflicting attestations and runs slashing detection on them. Attestations with
+// an invalid signature are dropped, so that forged votes neither produce bogus slashings nor take
+// the place of real ones in the slasher DB.
+func (s *Service) processAttestations(ctx context.Context, atts []*queuedAttestation) {
+	ctx, span := trace.StartSpan(ctx, "slasher.processAttestations")
+	defer span.End()
+	seen := make(map[[32]byte]bool, len(atts))
+	indexedAtts := make([]*ethpb.IndexedAttestation, 0, len(atts))
+	for _, queued := range atts {
+		// The same attestation is received both over gossip and in blocks.
+		root, err := hashutil.HashProto(queued.att)
+		if err != nil || seen[root] {
+			continue
+		}
+		indexedAtt, err := s.indexedAttestation(ctx, queued.att, queued.verified)
+		if err != nil {
+			log.WithError(err).Debug("Could not convert attestation to indexed attestation")
+			continue
+		}
+		seen[root] = true
+		indexedAtts = append(indexedAtts, indexedAtt)
+	}
+	if len(indexedAtts) == 0 {
+		return
+	}
+	if err := s.cfg.SlasherDB.SaveIndexedAttestations(ctx, indexedAtts); err != nil {
+		log.WithError(err).Error("Could not save indexed attestations")
+		return
+	}
+	attesterSlashings, err := s.detector.DetectAndUpdateAttesterSlashings(ctx, indexedAtts)
+	if err != nil {
+		log.WithError(err).Error("Could not detect attester slashings")
+		return
+	}
+	if len(attesterSlashings) == 0 {
+		return
+	}
+	headState, err := s.cfg.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	for _, slashing := range attesterSlashings {
+		if err := s.cfg.SlashingPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+			log.WithError(err).Debug("Could not insert attester slashing into pool")
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"validatorIndices": sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices),
+			"targetEpoch":      slashing.Attestation_1.Data.Target.Epoch,
+		}).Info("Detected slashable attestation")
+	}
+}
+
+// indexedAttestation converts an attestation to an indexed attestation, verifying its signature
+// against the state of its target epoch unless it is already verified.
+func (s *Service) indexedAttestation(ctx context.Context, att *ethpb.Attestation, verified bool) (*ethpb.IndexedAttestation, error) {
+	if att == nil || att.Data == nil || att.Data.Target == nil || att.Data.Source == nil {
+		return nil, errors.New("nil attestation")
+	}
+	preState, err := s.cfg.AttPreStateFetcher.AttestationPreState(ctx, att)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation pre state")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation committee")
+	}
+	indexedAtt := attestationutil.ConvertToIndexed(ctx, att, committee)
+	if !verified {
+		if err := blocks.VerifyIndexedAttestation(ctx, preState, indexedAtt); err != nil {
+			return nil, errors.Wrap(err, "could not verify attestation signature")
+		}
+	}
+	return indexedAtt, nil
+}
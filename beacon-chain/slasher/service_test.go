@@ -0,0 +1,210 @@
+package slasher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	slasherTesting "github.com/prysmaticlabs/prysm/slasher/db/testing"
+)
+
+func setupService(t *testing.T, st *stateTrie.BeaconState) (*Service, *slashings.Pool) {
+	pool := slashings.NewPool()
+	chainService := &mock.ChainService{State: st}
+	s := NewService(context.Background(), &Config{
+		SlasherDB:          slasherTesting.SetupSlasherDB(t, false),
+		HeadFetcher:        chainService,
+		AttPreStateFetcher: chainService,
+		SlashingPool:       pool,
+	})
+	return s, pool
+}
+
+func signedAttestation(t *testing.T, st *stateTrie.BeaconState, keys []bls.SecretKey, blockRoot byte) *ethpb.Attestation {
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: bytesutil.PadTo([]byte{blockRoot}, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+	}
+	committee, err := helpers.BeaconCommitteeFromState(st, data.Slot, data.CommitteeIndex)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	sigs := make([]bls.Signature, len(committee))
+	for i, idx := range committee {
+		bits.SetBitAt(uint64(i), true)
+		sig, err := helpers.ComputeDomainAndSign(st, 0, data, params.BeaconConfig().DomainBeaconAttester, keys[idx])
+		require.NoError(t, err)
+		sigs[i], err = bls.SignatureFromBytes(sig)
+		require.NoError(t, err)
+	}
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            data,
+		Signature:       bls.AggregateSignatures(sigs).Marshal(),
+	}
+}
+
+func signedBlock(t *testing.T, st *stateTrie.BeaconState, keys []bls.SecretKey, graffiti byte) *ethpb.SignedBeaconBlock {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	blk.Block.ProposerIndex = 3
+	blk.Block.Body.Graffiti = bytesutil.PadTo([]byte{graffiti}, 32)
+	var err error
+	blk.Signature, err = helpers.ComputeDomainAndSign(st, 0, blk.Block, params.BeaconConfig().DomainBeaconProposer, keys[3])
+	require.NoError(t, err)
+	return blk
+}
+
+func TestService_processAttestations(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	s, pool := setupService(t, st)
+
+	att := signedAttestation(t, st, keys, 1)
+	s.processAttestations(ctx, []*queuedAttestation{{att: att}, {att: att, verified: true}})
+	assert.Equal(t, 0, len(pool.PendingAttesterSlashings(ctx, st, true)))
+
+	// The same committee votes for another block root with the same target.
+	s.processAttestations(ctx, []*queuedAttestation{{att: signedAttestation(t, st, keys, 2)}})
+	pending := pool.PendingAttesterSlashings(ctx, st, true)
+	require.Equal(t, 1, len(pending))
+	assert.Equal(t, uint64(0), pending[0].Attestation_1.Data.Target.Epoch)
+	assert.Equal(t, len(pending[0].Attestation_1.AttestingIndices), len(pending[0].Attestation_2.AttestingIndices))
+}
+
+func TestService_processAttestations_DropsForgedAttestations(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	s, _ := setupService(t, st)
+
+	// An attestation received over the API but not signed by the committee is not saved, so that
+	// it can't take the place of the real vote.
+	forged := signedAttestation(t, st, keys, 1)
+	forged.Signature = signedAttestation(t, st, keys, 2).Signature
+	s.processAttestations(ctx, []*queuedAttestation{{att: forged}})
+	saved, err := s.cfg.SlasherDB.IndexedAttestationsForTarget(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(saved))
+
+	s.processAttestations(ctx, []*queuedAttestation{{att: signedAttestation(t, st, keys, 2)}})
+	saved, err = s.cfg.SlasherDB.IndexedAttestationsForTarget(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(saved))
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{2}, 32), saved[0].Data.BeaconBlockRoot)
+}
+
+func TestService_processGossipBlock(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	s, pool := setupService(t, st)
+
+	blk := signedBlock(t, st, keys, 1)
+	require.NoError(t, s.processGossipBlock(ctx, blk))
+	require.NoError(t, s.processGossipBlock(ctx, blk))
+	assert.Equal(t, 0, len(pool.PendingProposerSlashings(ctx, st, true)))
+
+	// Blocks not signed by their proposer are ignored.
+	forged := signedBlock(t, st, keys, 2)
+	forged.Signature = blk.Signature
+	assert.ErrorContains(t, "could not verify block signature", s.processGossipBlock(ctx, forged))
+	assert.Equal(t, 0, len(pool.PendingProposerSlashings(ctx, st, true)))
+
+	require.NoError(t, s.processGossipBlock(ctx, signedBlock(t, st, keys, 2)))
+	pending := pool.PendingProposerSlashings(ctx, st, true)
+	require.Equal(t, 1, len(pending))
+	assert.Equal(t, uint64(3), pending[0].Header_1.Header.ProposerIndex)
+}
+
+func TestService_runQueuesWithoutDetection(t *testing.T) {
+	chainService := &mock.ChainService{}
+	s := NewService(context.Background(), &Config{
+		StateNotifier:       chainService.StateNotifier(),
+		BlockNotifier:       chainService.BlockNotifier(),
+		AttestationNotifier: chainService.OperationNotifier(),
+	})
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	stateFeed := chainService.StateNotifier().StateFeed()
+	blockFeed := chainService.BlockNotifier().BlockFeed()
+	opFeed := chainService.OperationNotifier().OperationFeed()
+	go s.run()
+
+	// Wait for the service to subscribe to the feeds.
+	attEvent := &feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{Attestation: &ethpb.Attestation{}},
+	}
+	for opFeed.Send(attEvent) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Without detection running, the feeds are not blocked and blocks above the queue
+	// capacity are dropped.
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 2*blockQueueSize; i++ {
+			blockFeed.Send(&feed.Event{
+				Type: blockfeed.ReceivedBlock,
+				Data: &blockfeed.ReceivedBlockData{SignedBlock: testutil.NewBeaconBlock()},
+			})
+			stateFeed.Send(&feed.Event{
+				Type: statefeed.BlockProcessed,
+				Data: &statefeed.BlockProcessedData{BlockRoot: [32]byte{byte(i)}},
+			})
+		}
+		for i := 0; i < 9; i++ {
+			opFeed.Send(attEvent)
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Feeds blocked by the slasher")
+	}
+	assert.Equal(t, blockQueueSize, len(s.gossipBlocks))
+	assert.Equal(t, blockQueueSize, len(s.processedBlocks))
+	require.NoError(t, waitFor(func() bool {
+		s.attQueueLock.Lock()
+		defer s.attQueueLock.Unlock()
+		return len(s.attQueue) == 10
+	}))
+	assert.Equal(t, 10, len(s.dequeueAttestations()))
+	assert.Equal(t, 0, len(s.dequeueAttestations()))
+}
+
+func TestService_queueAttestations_DropsOverflow(t *testing.T) {
+	s := NewService(context.Background(), &Config{})
+	atts := make([]*ethpb.Attestation, maxQueuedAttestations+10)
+	s.queueAttestations(false, atts...)
+	assert.Equal(t, maxQueuedAttestations, len(s.dequeueAttestations()))
+}
+
+// waitFor polls the condition until it holds or a timeout is reached.
+func waitFor(condition func() bool) error {
+	for i := 0; i < 500; i++ {
+		if condition() {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errors.New("timed out waiting for condition")
+}
@@ -183,6 +183,11 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (*state
 	}
 	targetSlot := summary.Slot
 
+	// In archive mode, the state of a finalized block is rebuilt from the state diffs.
+	if s.saveStateDiffs && targetSlot <= s.finalizedSlot() && s.beaconDB.IsFinalizedBlock(ctx, blockRoot) {
+		return s.loadStateBySlotFromDiffs(ctx, targetSlot)
+	}
+
 	// Since the requested state is not in caches, start replaying using the last available ancestor state which is
 	// retrieved using input block's parent root.
 	startState, err := s.lastAncestorState(ctx, blockRoot)
@@ -218,6 +223,11 @@ func (s *State) loadStateBySlot(ctx context.Context, slot uint64) (*state.Beacon
 		return s.beaconDB.GenesisState(ctx)
 	}
 
+	// In archive mode, a finalized state is rebuilt from the state diffs.
+	if s.saveStateDiffs && slot <= s.finalizedSlot() {
+		return s.loadStateBySlotFromDiffs(ctx, slot)
+	}
+
 	// Gather last saved state, that is where node starts to replay the blocks.
 	startState, err := s.lastSavedState(ctx, slot)
 	if err != nil {
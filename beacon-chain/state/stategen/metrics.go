@@ -13,4 +13,11 @@ var (
 			Buckets: []float64{64, 256, 1024, 2048, 4096},
 		},
 	)
+	stateDiffApplyCount = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "state_diff_apply_count",
+			Help:    "The number of state diffs applied to rebuild an archived state",
+			Buckets: []float64{1, 4, 16, 64, 256},
+		},
+	)
 )
@@ -7,6 +7,7 @@ import (
 
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -38,6 +39,14 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 			return ctx.Err()
 		}
 
+		if s.saveStateDiffs && slot%params.BeaconConfig().SlotsPerEpoch == 0 && slot != 0 {
+			// A missing diff only makes rebuilding the states after it replay blocks instead,
+			// so it does not prevent saving the archived points.
+			if err := s.saveStateDiff(ctx, slot); err != nil {
+				log.WithError(err).WithField("slot", slot).Error("Could not save state diff")
+			}
+		}
+
 		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
 			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
 			if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -61,6 +62,44 @@ func TestMigrateToCold_HappyPath(t *testing.T) {
 	require.LogsContain(t, hook, "Saved state in DB")
 }
 
+func TestMigrateToCold_StateDiffError(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+
+	service := New(db, cache.NewStateSummaryCache())
+	service.saveStateDiffs = true
+	service.slotsPerArchivedPoint = params.BeaconConfig().SlotsPerEpoch
+	genesisState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := testutil.NewBeaconBlock()
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveState(ctx, genesisState, gRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+
+	// The diff from the genesis state can't be computed, as the registry shrunk.
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	aRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, b))
+	require.NoError(t, service.epochBoundaryStateCache.put(aRoot, beaconState))
+	b = testutil.NewBeaconBlock()
+	b.Block.Slot = params.BeaconConfig().SlotsPerEpoch + 1
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, b))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	// The archived point is saved anyway.
+	require.LogsContain(t, hook, "Could not save state diff")
+	assert.Equal(t, false, db.HasStateDiff(ctx, params.BeaconConfig().SlotsPerEpoch))
+	assert.Equal(t, true, db.HasState(ctx, aRoot))
+}
+
 func TestMigrateToCold_RegeneratePath(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
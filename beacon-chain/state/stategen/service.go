@@ -10,6 +10,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -28,6 +29,9 @@ type State struct {
 	stateSummaryCache       *cache.StateSummaryCache
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	saveStateDiffs          bool
+	// The state of the last saved state diff, only accessed while migrating states to the cold section.
+	lastStateDiffState *state.BeaconState
 }
 
 // This tracks the config in the event of long non-finality,
@@ -61,6 +65,7 @@ func New(db db.NoHeadAccessDatabase, stateSummaryCache *cache.StateSummaryCache)
 		saveHotStateDB: &saveHotStateDbConfig{
 			duration: defaultHotStateDBInterval,
 		},
+		saveStateDiffs: flags.Get().ArchiveStateDiffs,
 	}
 }
 
@@ -115,6 +120,13 @@ func (s *State) isFinalizedRoot(r [32]byte) bool {
 	return r == s.finalizedInfo.root
 }
 
+// Returns the cached finalized slot.
+func (s *State) finalizedSlot() uint64 {
+	s.finalizedInfo.lock.RLock()
+	defer s.finalizedInfo.lock.RUnlock()
+	return s.finalizedInfo.slot
+}
+
 // Returns the cached and copied finalized state.
 func (s *State) finalizedState() *state.BeaconState {
 	s.finalizedInfo.lock.RLock()
@@ -0,0 +1,353 @@
+package stategen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// saveStateDiff saves the diff between the finalized states of the input epoch boundary slot
+// and of the previous epoch boundary slot. This is only used in archive mode, where a historical
+// state is rebuilt from the nearest archived state and the diffs in between.
+func (s *State) saveStateDiff(ctx context.Context, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveStateDiff")
+	defer span.End()
+
+	if s.beaconDB.HasStateDiff(ctx, slot) {
+		return nil
+	}
+
+	var target *stateTrie.BeaconState
+	cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+	if err != nil {
+		return err
+	}
+	if exists && cached.state.Slot() == slot {
+		target = cached.state
+	} else {
+		target, err = s.loadStateBySlot(ctx, slot)
+		if err != nil {
+			return errors.Wrapf(err, "could not load state of slot %d", slot)
+		}
+	}
+
+	// The state of the previous diff is kept in memory, as diffs are saved in increasing slots order.
+	baseSlot := slot - params.BeaconConfig().SlotsPerEpoch
+	base := s.lastStateDiffState
+	if base == nil || base.Slot() != baseSlot {
+		base, err = s.loadStateBySlot(ctx, baseSlot)
+		if err != nil {
+			return errors.Wrapf(err, "could not load state of slot %d", baseSlot)
+		}
+	}
+
+	diff, err := computeStateDiff(base, target)
+	if err != nil {
+		return errors.Wrap(err, "could not compute state diff")
+	}
+	if err := s.beaconDB.SaveStateDiff(ctx, diff); err != nil {
+		return err
+	}
+	s.lastStateDiffState = target.Copy()
+	log.WithFields(logrus.Fields{
+		"slot":     slot,
+		"baseSlot": baseSlot,
+	}).Debug("Saved state diff in DB")
+	return nil
+}
+
+// This loads the finalized state of the input slot in archive mode. The state of the last epoch
+// boundary is rebuilt from the nearest saved state and the diffs in between, then the blocks after
+// that epoch boundary are replayed.
+func (s *State) loadStateBySlotFromDiffs(ctx context.Context, slot uint64) (*stateTrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.loadStateBySlotFromDiffs")
+	defer span.End()
+
+	boundary := slot - slot%params.BeaconConfig().SlotsPerEpoch
+	st, err := s.epochBoundaryStateFromDiffs(ctx, boundary)
+	if err != nil {
+		return nil, err
+	}
+	return s.replayFinalizedBlocks(ctx, st, slot)
+}
+
+// This rebuilds the finalized state of the input epoch boundary slot by applying the saved diffs
+// to the last saved state. If a diff is missing, such as for the epochs finalized before archive
+// mode was enabled, the last rebuilt state is returned and the caller replays blocks from there.
+func (s *State) epochBoundaryStateFromDiffs(ctx context.Context, boundary uint64) (*stateTrie.BeaconState, error) {
+	st, err := s.lastSavedState(ctx, boundary)
+	if err != nil {
+		return nil, err
+	}
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// A saved state which is not on an epoch boundary, such as the archived state of a skipped
+	// archived point slot, is advanced to the next epoch boundary first.
+	if st.Slot()%slotsPerEpoch != 0 {
+		next := st.Slot() - st.Slot()%slotsPerEpoch + slotsPerEpoch
+		if next > boundary {
+			return st, nil
+		}
+		st, err = s.replayFinalizedBlocks(ctx, st, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	applied := 0
+	for slot := st.Slot() + slotsPerEpoch; slot <= boundary; slot += slotsPerEpoch {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		diff, err := s.beaconDB.StateDiff(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		if diff == nil {
+			break
+		}
+		st, err = applyStateDiff(st, diff)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not apply state diff of slot %d", slot)
+		}
+		applied++
+	}
+	stateDiffApplyCount.Observe(float64(applied))
+	return st, nil
+}
+
+// This replays the finalized blocks after the input state until the target slot is reached.
+func (s *State) replayFinalizedBlocks(ctx context.Context, st *stateTrie.BeaconState, targetSlot uint64) (*stateTrie.BeaconState, error) {
+	lastRoot, lastSlot, err := s.lastSavedBlock(ctx, targetSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last valid block")
+	}
+	if st.Slot() >= lastSlot {
+		return s.ReplayBlocks(ctx, st, nil, targetSlot)
+	}
+	blks, err := s.LoadBlocks(ctx, st.Slot()+1, lastSlot, lastRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load blocks")
+	}
+	replayBlockCount.Observe(float64(len(blks)))
+	return s.ReplayBlocks(ctx, st, blks, targetSlot)
+}
+
+// computeStateDiff returns the diff producing the target state from the base state. Vectors and
+// balances only carry their changed entries, balances being saved as deltas, and the attestations
+// rotated by the epoch transition are not saved again.
+func computeStateDiff(base, target *stateTrie.BeaconState) (*dbpb.StateDiff, error) {
+	b := base.CloneInnerState()
+	t := target.CloneInnerState()
+	if b == nil || t == nil {
+		return nil, errUnknownState
+	}
+	if t.Slot < b.Slot {
+		return nil, fmt.Errorf("target slot %d < base slot %d", t.Slot, b.Slot)
+	}
+	if len(t.Validators) < len(b.Validators) || len(t.Balances) != len(t.Validators) {
+		return nil, errors.New("invalid validator registry length")
+	}
+	if len(t.HistoricalRoots) < len(b.HistoricalRoots) {
+		return nil, errors.New("invalid historical roots length")
+	}
+
+	blockRoots, err := changedBytes(b.BlockRoots, t.BlockRoots)
+	if err != nil {
+		return nil, errors.Wrap(err, "block roots")
+	}
+	stateRoots, err := changedBytes(b.StateRoots, t.StateRoots)
+	if err != nil {
+		return nil, errors.Wrap(err, "state roots")
+	}
+	randaoMixes, err := changedBytes(b.RandaoMixes, t.RandaoMixes)
+	if err != nil {
+		return nil, errors.Wrap(err, "randao mixes")
+	}
+	if len(t.Slashings) != len(b.Slashings) {
+		return nil, errors.New("invalid slashings length")
+	}
+	slashings := make([]*dbpb.IndexedUint64, 0)
+	for i := range t.Slashings {
+		if t.Slashings[i] != b.Slashings[i] {
+			slashings = append(slashings, &dbpb.IndexedUint64{Index: uint64(i), Value: t.Slashings[i]})
+		}
+	}
+
+	validators := make([]*dbpb.IndexedValidator, 0)
+	for i, v := range t.Validators {
+		if i < len(b.Validators) && proto.Equal(b.Validators[i], v) {
+			continue
+		}
+		validators = append(validators, &dbpb.IndexedValidator{Index: uint64(i), Validator: v})
+	}
+	balanceDeltas := make([]*dbpb.IndexedInt64, 0)
+	for i, bal := range t.Balances {
+		var baseBal uint64
+		if i < len(b.Balances) {
+			baseBal = b.Balances[i]
+		}
+		if bal != baseBal {
+			balanceDeltas = append(balanceDeltas, &dbpb.IndexedInt64{Index: uint64(i), Value: int64(bal) - int64(baseBal)})
+		}
+	}
+	// The current epoch attestations of the base state become the first previous epoch
+	// attestations of the target state at the epoch transition, and are not saved again.
+	rotated := 0
+	if t.Slot/params.BeaconConfig().SlotsPerEpoch > b.Slot/params.BeaconConfig().SlotsPerEpoch {
+		for rotated < len(b.CurrentEpochAttestations) && rotated < len(t.PreviousEpochAttestations) {
+			equal, err := pendingAttestationsEqual(b.CurrentEpochAttestations[rotated], t.PreviousEpochAttestations[rotated])
+			if err != nil {
+				return nil, err
+			}
+			if !equal {
+				break
+			}
+			rotated++
+		}
+	}
+
+	return &dbpb.StateDiff{
+		Slot:                        t.Slot,
+		BaseSlot:                    b.Slot,
+		Fork:                        t.Fork,
+		LatestBlockHeader:           t.LatestBlockHeader,
+		BlockRoots:                  blockRoots,
+		StateRoots:                  stateRoots,
+		HistoricalRoots:             t.HistoricalRoots[len(b.HistoricalRoots):],
+		Eth1Data:                    t.Eth1Data,
+		Eth1DataVotes:               t.Eth1DataVotes,
+		Eth1DepositIndex:            t.Eth1DepositIndex,
+		Validators:                  validators,
+		BalanceDeltas:               balanceDeltas,
+		RandaoMixes:                 randaoMixes,
+		Slashings:                   slashings,
+		PreviousEpochAttestations:   t.PreviousEpochAttestations[rotated:],
+		CurrentEpochAttestations:    t.CurrentEpochAttestations,
+		RotatedAttestations:         uint64(rotated),
+		JustificationBits:           t.JustificationBits,
+		PreviousJustifiedCheckpoint: t.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  t.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         t.FinalizedCheckpoint,
+	}, nil
+}
+
+// applyStateDiff returns the state produced by applying the diff to the base state, which is not modified.
+func applyStateDiff(base *stateTrie.BeaconState, diff *dbpb.StateDiff) (*stateTrie.BeaconState, error) {
+	if base == nil || diff == nil {
+		return nil, errUnknownState
+	}
+	st := base.CloneInnerState()
+	if st.Slot != diff.BaseSlot {
+		return nil, fmt.Errorf("state slot %d does not match diff base slot %d", st.Slot, diff.BaseSlot)
+	}
+
+	if err := setChangedBytes(st.BlockRoots, diff.BlockRoots); err != nil {
+		return nil, errors.Wrap(err, "block roots")
+	}
+	if err := setChangedBytes(st.StateRoots, diff.StateRoots); err != nil {
+		return nil, errors.Wrap(err, "state roots")
+	}
+	if err := setChangedBytes(st.RandaoMixes, diff.RandaoMixes); err != nil {
+		return nil, errors.Wrap(err, "randao mixes")
+	}
+	for _, s := range diff.Slashings {
+		if s.Index >= uint64(len(st.Slashings)) {
+			return nil, fmt.Errorf("slashings index %d out of range", s.Index)
+		}
+		st.Slashings[s.Index] = s.Value
+	}
+	for _, v := range diff.Validators {
+		switch {
+		case v.Index < uint64(len(st.Validators)):
+			st.Validators[v.Index] = v.Validator
+		case v.Index == uint64(len(st.Validators)):
+			st.Validators = append(st.Validators, v.Validator)
+		default:
+			return nil, fmt.Errorf("validator index %d out of range", v.Index)
+		}
+	}
+	// New validators have a zero base balance.
+	balances := make([]uint64, len(st.Validators))
+	copy(balances, st.Balances)
+	for _, d := range diff.BalanceDeltas {
+		if d.Index >= uint64(len(balances)) {
+			return nil, fmt.Errorf("balance index %d out of range", d.Index)
+		}
+		bal := int64(balances[d.Index]) + d.Value
+		if bal < 0 {
+			return nil, fmt.Errorf("negative balance of validator %d", d.Index)
+		}
+		balances[d.Index] = uint64(bal)
+	}
+	if diff.RotatedAttestations > uint64(len(st.CurrentEpochAttestations)) {
+		return nil, errors.New("invalid rotated attestations count")
+	}
+	previousEpochAttestations := make([]*pb.PendingAttestation, 0, int(diff.RotatedAttestations)+len(diff.PreviousEpochAttestations))
+	previousEpochAttestations = append(previousEpochAttestations, st.CurrentEpochAttestations[:diff.RotatedAttestations]...)
+	previousEpochAttestations = append(previousEpochAttestations, diff.PreviousEpochAttestations...)
+
+	st.Slot = diff.Slot
+	st.Fork = diff.Fork
+	st.LatestBlockHeader = diff.LatestBlockHeader
+	st.HistoricalRoots = append(st.HistoricalRoots, diff.HistoricalRoots...)
+	st.Eth1Data = diff.Eth1Data
+	st.Eth1DataVotes = diff.Eth1DataVotes
+	st.Eth1DepositIndex = diff.Eth1DepositIndex
+	st.Balances = balances
+	st.PreviousEpochAttestations = previousEpochAttestations
+	st.CurrentEpochAttestations = diff.CurrentEpochAttestations
+	st.JustificationBits = bitfield.Bitvector4(diff.JustificationBits)
+	st.PreviousJustifiedCheckpoint = diff.PreviousJustifiedCheckpoint
+	st.CurrentJustifiedCheckpoint = diff.CurrentJustifiedCheckpoint
+	st.FinalizedCheckpoint = diff.FinalizedCheckpoint
+	return stateTrie.InitializeFromProtoUnsafe(st)
+}
+
+// pendingAttestationsEqual compares the SSZ encodings of two pending attestations.
+func pendingAttestationsEqual(a, b *pb.PendingAttestation) (bool, error) {
+	encA, err := a.MarshalSSZ()
+	if err != nil {
+		return false, err
+	}
+	encB, err := b.MarshalSSZ()
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(encA, encB), nil
+}
+
+// changedBytes returns the entries of the target vector which differ from the base vector.
+func changedBytes(base, target [][]byte) ([]*dbpb.IndexedBytes, error) {
+	if len(base) != len(target) {
+		return nil, fmt.Errorf("vector length %d does not match %d", len(target), len(base))
+	}
+	changed := make([]*dbpb.IndexedBytes, 0)
+	for i := range target {
+		if !bytes.Equal(base[i], target[i]) {
+			changed = append(changed, &dbpb.IndexedBytes{Index: uint64(i), Value: target[i]})
+		}
+	}
+	return changed, nil
+}
+
+// setChangedBytes sets the changed entries of a vector.
+func setChangedBytes(vector [][]byte, changed []*dbpb.IndexedBytes) error {
+	for _, c := range changed {
+		if c.Index >= uint64(len(vector)) {
+			return fmt.Errorf("index %d out of range", c.Index)
+		}
+		vector[c.Index] = c.Value
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestComputeApplyStateDiff(t *testing.T) {
+	ctx := context.Background()
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	// The current epoch attestations of the base state are rotated to the previous epoch ones.
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := range atts {
+		atts[i] = &pb.PendingAttestation{
+			AggregationBits: bitfield.Bitlist{0x03},
+			Data: &ethpb.AttestationData{
+				Slot:            uint64(i),
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			InclusionDelay: 1,
+		}
+	}
+	require.NoError(t, base.SetCurrentEpochAttestations(atts[:2]))
+	target := base.Copy()
+	require.NoError(t, target.SetPreviousEpochAttestations(atts))
+	require.NoError(t, target.SetCurrentEpochAttestations(nil))
+
+	require.NoError(t, target.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, target.UpdateBlockRootAtIndex(1, [32]byte{'a'}))
+	require.NoError(t, target.UpdateStateRootAtIndex(2, [32]byte{'b'}))
+	require.NoError(t, target.UpdateRandaoMixesAtIndex(3, []byte{'c'}))
+	require.NoError(t, target.UpdateSlashingsAtIndex(4, 100))
+	require.NoError(t, target.AppendHistoricalRoots([32]byte{'d'}))
+	require.NoError(t, target.UpdateBalancesAtIndex(0, 1))
+	require.NoError(t, target.UpdateBalancesAtIndex(1, params.BeaconConfig().MaxEffectiveBalance+10))
+	v, err := target.ValidatorAtIndex(5)
+	require.NoError(t, err)
+	v.Slashed = true
+	require.NoError(t, target.UpdateValidatorAtIndex(5, v))
+	require.NoError(t, target.AppendValidator(&ethpb.Validator{PublicKey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32)}))
+	require.NoError(t, target.AppendBalance(5))
+	require.NoError(t, target.SetJustificationBits(bitfield.Bitvector4{0x03}))
+	require.NoError(t, target.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'e'}, 32)}))
+
+	diff, err := computeStateDiff(base, target)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), diff.BaseSlot)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, diff.Slot)
+	assert.Equal(t, 1, len(diff.BlockRoots))
+	assert.Equal(t, 1, len(diff.StateRoots))
+	assert.Equal(t, 1, len(diff.RandaoMixes))
+	assert.Equal(t, 1, len(diff.Slashings))
+	assert.Equal(t, 1, len(diff.HistoricalRoots))
+	assert.Equal(t, 2, len(diff.Validators), "Only the changed and new validators should be saved")
+	assert.DeepEqual(t, []*dbpb.IndexedInt64{
+		{Index: 0, Value: int64(1) - int64(params.BeaconConfig().MaxEffectiveBalance)},
+		{Index: 1, Value: 10},
+		{Index: 32, Value: 5},
+	}, diff.BalanceDeltas, "Only the changed balances should be saved")
+	assert.Equal(t, uint64(2), diff.RotatedAttestations)
+	assert.Equal(t, 1, len(diff.PreviousEpochAttestations), "Rotated attestations should not be saved again")
+
+	applied, err := applyStateDiff(base, diff)
+	require.NoError(t, err)
+	wanted, err := target.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	got, err := applied.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wanted, got, "Applied diff did not produce the target state")
+	baseRoot, err := base.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, wanted, baseRoot, "Base state should not be modified")
+
+	_, err = applyStateDiff(target, diff)
+	assert.ErrorContains(t, "does not match diff base slot", err)
+	diff.BalanceDeltas = append(diff.BalanceDeltas, &dbpb.IndexedInt64{Index: 33, Value: 1})
+	_, err = applyStateDiff(base, diff)
+	assert.ErrorContains(t, "balance index 33 out of range", err)
+	diff.BalanceDeltas = diff.BalanceDeltas[:3]
+	diff.RotatedAttestations = 3
+	_, err = applyStateDiff(base, diff)
+	assert.ErrorContains(t, "invalid rotated attestations count", err)
+}
+
+func TestStateDiffs_SaveAndRebuildState(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	beaconState, pks := testutil.DeterministicGenesisState(t, 64)
+	genesisStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, beaconState, gRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+
+	// Build a chain of 2.5 epochs, skipping the first slot of the second epoch.
+	lastSlot := slotsPerEpoch*2 + slotsPerEpoch/2
+	stateRoots := make(map[uint64][32]byte)
+	st := beaconState.Copy()
+	var lastRoot [32]byte
+	for slot := uint64(1); slot <= lastSlot; slot++ {
+		if slot == slotsPerEpoch {
+			continue
+		}
+		conf := testutil.DefaultBlockGenConfig()
+		// The block generator can't attest the last slot of the previous epoch.
+		if slot%slotsPerEpoch == 0 {
+			conf.NumAttestations = 0
+		}
+		b, err := testutil.GenerateFullBlock(st, pks, conf, slot)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, b)
+		require.NoError(t, err)
+		lastRoot, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, b))
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: slot, Root: lastRoot[:]}))
+		stateRoots[slot], err = st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+	}
+
+	service := New(db, cache.NewStateSummaryCache())
+	service.saveStateDiffs = true
+	service.slotsPerArchivedPoint = 1 << 20
+	service.finalizedInfo = &finalizedInfo{slot: 0, root: gRoot, state: beaconState}
+	require.NoError(t, service.MigrateToCold(ctx, lastRoot))
+	assert.Equal(t, true, db.HasStateDiff(ctx, slotsPerEpoch))
+	assert.Equal(t, true, db.HasStateDiff(ctx, slotsPerEpoch*2))
+	assert.Equal(t, false, db.HasStateDiff(ctx, slotsPerEpoch*3))
+	service.finalizedInfo.slot = lastSlot
+
+	// The epoch boundary state is rebuilt from the genesis state and the diffs only.
+	boundary, err := service.epochBoundaryStateFromDiffs(ctx, slotsPerEpoch*2)
+	require.NoError(t, err)
+	r, err := boundary.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, stateRoots[slotsPerEpoch*2], r)
+
+	for _, slot := range []uint64{slotsPerEpoch + 1, slotsPerEpoch * 2, lastSlot} {
+		loaded, err := service.StateBySlot(ctx, slot)
+		require.NoError(t, err)
+		assert.Equal(t, slot, loaded.Slot())
+		r, err := loaded.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, stateRoots[slot], r, "Wrong state of slot %d", slot)
+	}
+}
@@ -0,0 +1,85 @@
+package backfill
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// proposerChecker checks the proposer indices of backfilled blocks against the proposer selection
+// of their slots. The states at the epochs of the blocks are not available, so the selection is
+// replayed from the head state: its randao mixes give the seeds of the epochs of the last
+// historical vector, and the activation and exit epochs of its validators, which never change once
+// set, give the validators active at any epoch.
+type proposerChecker struct {
+	st            *stateTrie.BeaconState
+	activeIndices map[uint64][]uint64
+}
+
+func newProposerChecker(st *stateTrie.BeaconState) *proposerChecker {
+	return &proposerChecker{
+		st:            st,
+		activeIndices: make(map[uint64][]uint64),
+	}
+}
+
+// check returns an error if the validator could not have been selected as the proposer of the slot.
+// The effective balances at the epoch of the slot are unknown, so a candidate of the selection is
+// only known to be accepted when its random byte is 0, and the proposer must be one of the
+// candidates up to the first such candidate. The check is skipped for the epochs whose randao mix
+// was overwritten in the head state.
+func (c *proposerChecker) check(slot, proposerIndex uint64) error {
+	epoch := helpers.SlotToEpoch(slot)
+	cfg := params.BeaconConfig()
+	if epoch+cfg.EpochsPerHistoricalVector-cfg.MinSeedLookahead-1 <= helpers.CurrentEpoch(c.st) {
+		return nil
+	}
+	indices, err := c.activeValidatorIndices(epoch)
+	if err != nil {
+		return err
+	}
+	length := uint64(len(indices))
+	if length == 0 {
+		return errors.Errorf("no active validator at epoch %d", epoch)
+	}
+	seed, err := helpers.Seed(c.st, epoch, cfg.DomainBeaconProposer)
+	if err != nil {
+		return errors.Wrap(err, "could not generate seed")
+	}
+	seedWithSlotHash := hashutil.Hash(append(seed[:], bytesutil.Bytes8(slot)...))
+	hashFunc := hashutil.CustomSHA256Hasher()
+	for i := uint64(0); ; i++ {
+		shuffledIndex, err := helpers.ComputeShuffledIndex(i%length, length, seedWithSlotHash, true /* shuffle */)
+		if err != nil {
+			return err
+		}
+		if indices[shuffledIndex] == proposerIndex {
+			return nil
+		}
+		randomByte := hashFunc(append(seedWithSlotHash[:], bytesutil.Bytes8(i/32)...))[i%32]
+		if randomByte == 0 {
+			return errors.Errorf("validator %d is not the proposer of slot %d", proposerIndex, slot)
+		}
+	}
+}
+
+// activeValidatorIndices returns the indices of the validators active at the epoch.
+func (c *proposerChecker) activeValidatorIndices(epoch uint64) ([]uint64, error) {
+	if indices, ok := c.activeIndices[epoch]; ok {
+		return indices, nil
+	}
+	var indices []uint64
+	if err := c.st.ReadFromEveryValidator(func(idx int, val stateTrie.ReadOnlyValidator) error {
+		if helpers.IsActiveValidatorUsingTrie(val, epoch) {
+			indices = append(indices, uint64(idx))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	c.activeIndices[epoch] = indices
+	return indices, nil
+}
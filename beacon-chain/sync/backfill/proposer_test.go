@@ -0,0 +1,36 @@
+package backfill
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProposerChecker_check(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	checker := newProposerChecker(st)
+	rejected := 0
+	for slot := uint64(0); slot < 2*params.BeaconConfig().SlotsPerEpoch; slot++ {
+		proposer := proposerAt(t, st, slot)
+		require.NoError(t, checker.check(slot, proposer), "Proposer of slot %d rejected", slot)
+		for idx := uint64(0); idx < 64; idx++ {
+			if checker.check(slot, idx) != nil {
+				rejected++
+			}
+		}
+	}
+	assert.NotEqual(t, 0, rejected, "No validator was rejected")
+}
+
+func TestProposerChecker_check_OverwrittenRandaoMix(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().EpochsPerHistoricalVector*params.BeaconConfig().SlotsPerEpoch))
+	checker := newProposerChecker(st)
+	// The seed of the genesis epoch is no longer known, so any validator is accepted.
+	for idx := uint64(0); idx < 64; idx++ {
+		assert.NoError(t, checker.check(1, idx))
+	}
+}
@@ -0,0 +1,341 @@
+// Package backfill fetches, in the background, the blocks missing below the lowest block of the
+// contiguous chain the beacon node has in its database, such as the blocks before the anchor of a
+// checkpoint synced node. Blocks are requested backwards over the blocks by range RPC, verified
+// against the parent root of the lowest block and their proposer signatures, and saved as
+// finalized, one batch at a time.
+package backfill
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+const (
+	// DefaultBatchSize is the number of slots requested from a peer in a single batch.
+	DefaultBatchSize = 64
+	// DefaultBatchInterval is the minimum time between two batch requests.
+	DefaultBatchInterval = time.Second
+	// maxBackoff is the maximum time between two batch requests after failed ones.
+	maxBackoff = 5 * time.Minute
+)
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P           p2p.P2P
+	DB            db.NoHeadAccessDatabase
+	Chain         blockchain.HeadFetcher
+	InitialSync   prysmsync.Checker
+	BatchSize     uint64
+	BatchInterval time.Duration
+}
+
+// Service backfills the blocks missing below the lowest contiguous block of the database.
+type Service struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	cfg      *Config
+	complete *abool.AtomicBool
+	// cursor is the slot below which the next batch of blocks is requested.
+	cursor uint64
+	// failures is the number of consecutive failed batches, and retryAfter the time before which
+	// no batch is requested after them.
+	failures   uint
+	retryAfter time.Time
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.BatchInterval == 0 {
+		cfg.BatchInterval = DefaultBatchInterval
+	}
+	return &Service{
+		ctx:      ctx,
+		cancel:   cancel,
+		cfg:      cfg,
+		complete: abool.New(),
+	}
+}
+
+// Start the backfill service.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Complete returns true once the database holds every block down to the genesis block.
+func (s *Service) Complete() bool {
+	return s.complete.IsSet()
+}
+
+// run requests a batch of blocks at most once per batch interval, and only once the node is done
+// with initial sync, so that backfilling never competes with syncing to the head of the chain.
+// Failed batches back off the following requests.
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.BatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case <-ticker.C:
+			if s.cfg.InitialSync.Syncing() || timeutils.Now().Before(s.retryAfter) {
+				continue
+			}
+			done, err := s.step(s.ctx)
+			if err != nil {
+				log.WithError(err).Debug("Could not backfill blocks")
+				s.backOff()
+				continue
+			}
+			s.failures = 0
+			if done {
+				s.complete.Set()
+				log.Info("Backfill complete, all blocks since genesis are in the database")
+				return
+			}
+		}
+	}
+}
+
+// backOff delays the next batch request after a failed one, doubling the delay with every
+// consecutive failure up to the maximum backoff.
+func (s *Service) backOff() {
+	backoff := s.cfg.BatchInterval << s.failures
+	if backoff > maxBackoff || backoff <= 0 {
+		backoff = maxBackoff
+	} else {
+		s.failures++
+	}
+	s.retryAfter = timeutils.Now().Add(backoff)
+}
+
+// step fetches, verifies and saves a batch of blocks. It returns true once there is no block left
+// to backfill.
+func (s *Service) step(ctx context.Context) (bool, error) {
+	low, err := s.lowestBlock(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not determine lowest block")
+	}
+	if low.Block.Slot == 0 {
+		return true, nil
+	}
+	if s.cursor == 0 || s.cursor > low.Block.Slot {
+		s.cursor = low.Block.Slot
+	}
+
+	start := uint64(0)
+	if s.cursor > s.cfg.BatchSize {
+		start = s.cursor - s.cfg.BatchSize
+	}
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     s.cursor - start,
+		Step:      1,
+	}
+	pid, err := s.peer()
+	if err != nil {
+		return false, err
+	}
+	blks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.cfg.P2P, pid, req, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not request blocks from peer %s", pid)
+	}
+	// The genesis block is not part of the batch, it is saved along with the genesis state.
+	for len(blks) > 0 && blks[0].Block.Slot == 0 {
+		blks = blks[1:]
+	}
+	if len(blks) == 0 {
+		if start == 0 {
+			// The genesis slot is reached without finding the parent of the lowest block, so the
+			// peer withheld blocks it claims to have. Start over from the lowest block.
+			s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+			s.cursor = low.Block.Slot
+			return false, errors.Errorf("peer %s did not serve the parent of the lowest block", pid)
+		}
+		// The batch only covers skipped slots, move on to the previous batch.
+		s.cursor = start
+		return false, nil
+	}
+	if err := s.verifyBatch(ctx, low, blks); err != nil {
+		s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		s.cursor = low.Block.Slot
+		return false, errors.Wrapf(err, "invalid blocks from peer %s", pid)
+	}
+	if err := s.cfg.DB.SaveBackfilledBlocks(ctx, blks); err != nil {
+		return false, errors.Wrap(err, "could not save blocks")
+	}
+	s.cursor = blks[0].Block.Slot
+	log.WithFields(logrus.Fields{
+		"startSlot": blks[0].Block.Slot,
+		"endSlot":   blks[len(blks)-1].Block.Slot,
+		"count":     len(blks),
+	}).Debug("Backfilled blocks")
+	return false, nil
+}
+
+// lowestBlock returns the lowest block of the contiguous chain of blocks leading to the finalized
+// checkpoint, walking down from the saved backfill block root, the origin block root or the
+// finalized checkpoint root, and saves it as the backfill block root.
+func (s *Service) lowestBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error) {
+	backfillRoot, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	root := backfillRoot
+	if root == params.BeaconConfig().ZeroHash {
+		if root, err = s.cfg.DB.OriginBlockRoot(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if root == params.BeaconConfig().ZeroHash {
+		cp, err := s.cfg.DB.FinalizedCheckpoint(ctx)
+		if err != nil {
+			return nil, err
+		}
+		root = bytesutil.ToBytes32(cp.Root)
+	}
+	if root == params.BeaconConfig().ZeroHash {
+		genesisBlk, err := s.cfg.DB.GenesisBlock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if genesisBlk == nil || genesisBlk.Block == nil {
+			return nil, errors.New("no genesis block in the database")
+		}
+		return genesisBlk, nil
+	}
+
+	blk, err := s.cfg.DB.Block(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, errors.Errorf("block %#x not found in the database", root)
+	}
+	lowRoot := root
+	for blk.Block.Slot > 0 {
+		parentRoot := bytesutil.ToBytes32(blk.Block.ParentRoot)
+		parent, err := s.cfg.DB.Block(ctx, parentRoot)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil || parent.Block == nil {
+			break
+		}
+		blk, lowRoot = parent, parentRoot
+	}
+	if lowRoot != backfillRoot {
+		if err := s.cfg.DB.SaveBackfillBlockRoot(ctx, lowRoot); err != nil {
+			return nil, err
+		}
+		log.WithFields(logrus.Fields{
+			"slot": blk.Block.Slot,
+			"root": fmt.Sprintf("%#x", bytesutil.Trunc(lowRoot[:])),
+		}).Debug("Updated lowest contiguous block")
+	}
+	return blk, nil
+}
+
+// verifyBatch checks that the blocks are sorted by slot and form a chain leading to the parent of
+// the lowest block, and that they are signed by validators which could propose at their slots,
+// then verifies their proposer signatures at once.
+func (s *Service) verifyBatch(ctx context.Context, low *ethpb.SignedBeaconBlock, blks []*ethpb.SignedBeaconBlock) error {
+	expectedRoot := low.Block.ParentRoot
+	for i := len(blks) - 1; i >= 0; i-- {
+		root, err := blks[i].Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(root[:], expectedRoot) {
+			return errors.Errorf("block %#x at slot %d is not the parent of the next block", root, blks[i].Block.Slot)
+		}
+		expectedRoot = blks[i].Block.ParentRoot
+	}
+
+	st, err := s.cfg.Chain.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if st == nil {
+		return errors.New("nil head state")
+	}
+	proposers := newProposerChecker(st)
+	set := bls.NewSet()
+	for _, blk := range blks {
+		if blk.Block.ProposerIndex >= uint64(st.NumValidators()) {
+			return errors.Errorf("unknown proposer index %d", blk.Block.ProposerIndex)
+		}
+		if err := proposers.check(blk.Block.Slot, blk.Block.ProposerIndex); err != nil {
+			return err
+		}
+		pubkey := st.PubkeyAtIndex(blk.Block.ProposerIndex)
+		domain, err := helpers.Domain(
+			st.Fork(),
+			helpers.SlotToEpoch(blk.Block.Slot),
+			params.BeaconConfig().DomainBeaconProposer,
+			st.GenesisValidatorRoot(),
+		)
+		if err != nil {
+			return err
+		}
+		blkSet, err := helpers.RetrieveBlockSignatureSet(blk.Block, pubkey[:], blk.Signature, domain)
+		if err != nil {
+			return err
+		}
+		set.Join(blkSet)
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify block signatures")
+	}
+	if !verified {
+		return errors.New("invalid block signatures")
+	}
+	return nil
+}
+
+// peer picks a random peer among the connected peers agreeing on the best finalized epoch.
+func (s *Service) peer() (peer.ID, error) {
+	_, pids := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, 0)
+	if len(pids) == 0 {
+		return "", errors.New("no suitable peer to request blocks from")
+	}
+	return pids[rand.NewGenerator().Intn(len(pids))], nil
+}
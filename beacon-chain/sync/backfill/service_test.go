@@ -0,0 +1,346 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	syncmock "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// signedChain returns a genesis block followed by a signed block at each of the given slots.
+func signedChain(t *testing.T, st *stateTrie.BeaconState, keys []bls.SecretKey, slots []uint64) []*ethpb.SignedBeaconBlock {
+	genesis := blocks.NewGenesisBlock(make([]byte, 32))
+	chain := []*ethpb.SignedBeaconBlock{genesis}
+	for _, slot := range slots {
+		parentRoot, err := chain[len(chain)-1].Block.HashTreeRoot()
+		require.NoError(t, err)
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ProposerIndex = proposerAt(t, st, slot)
+		blk.Block.ParentRoot = parentRoot[:]
+		blk.Signature, err = helpers.ComputeDomainAndSign(
+			st,
+			helpers.SlotToEpoch(slot),
+			blk.Block,
+			params.BeaconConfig().DomainBeaconProposer,
+			keys[blk.Block.ProposerIndex],
+		)
+		require.NoError(t, err)
+		chain = append(chain, blk)
+	}
+	return chain
+}
+
+// proposerAt returns the proposer of the slot selected by the state.
+func proposerAt(t *testing.T, st *stateTrie.BeaconState, slot uint64) uint64 {
+	st = st.Copy()
+	require.NoError(t, st.SetSlot(slot))
+	idx, err := helpers.BeaconProposerIndex(st)
+	require.NoError(t, err)
+	return idx
+}
+
+// connectPeer connects a peer serving the given chain over the blocks by range RPC.
+func connectPeer(t *testing.T, host *p2pt.TestP2P, chain []*ethpb.SignedBeaconBlock) *p2pt.TestP2P {
+	const topic = "/eth2/beacon_chain/req/beacon_blocks_by_range/1/ssz_snappy"
+	p := p2pt.NewTestP2P(t)
+	p.SetStreamHandler(topic, func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &pb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p.Encoding().DecodeWithMaxLength(stream, req))
+		for _, blk := range chain {
+			if blk.Block.Slot >= req.StartSlot && blk.Block.Slot < req.StartSlot+req.Count {
+				assert.NoError(t, prysmsync.WriteChunk(stream, p.Encoding(), blk))
+			}
+		}
+	})
+	p.Connect(host)
+	host.Peers().Add(new(enr.Record), p.PeerID(), nil, network.DirOutbound)
+	host.Peers().SetConnectionState(p.PeerID(), peers.PeerConnected)
+	host.Peers().SetChainState(p.PeerID(), &pb.Status{
+		ForkDigest:     params.BeaconConfig().GenesisForkVersion,
+		FinalizedRoot:  make([]byte, 32),
+		FinalizedEpoch: 1,
+		HeadRoot:       make([]byte, 32),
+		HeadSlot:       chain[len(chain)-1].Block.Slot,
+	})
+	return p
+}
+
+// saveOrigin saves the genesis block and the chain from the origin index, finalized at the origin
+// block, as a checkpoint synced node would have them.
+func saveOrigin(t *testing.T, beaconDB db.Database, chain []*ethpb.SignedBeaconBlock, origin int) {
+	ctx := context.Background()
+	genesisRoot, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, chain[0]))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveBlocks(ctx, chain[origin:]))
+	originRoot, err := chain[origin].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOriginBlockRoot(ctx, originRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, testutil.NewBeaconState(), originRoot))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: originRoot[:]}))
+}
+
+func TestService_lowestBlock(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	chain := signedChain(t, st, keys, []uint64{1, 2, 3, 5, 8, 9})
+
+	beaconDB, _ := dbtest.SetupDB(t)
+	saveOrigin(t, beaconDB, chain, 4)
+	s := NewService(ctx, &Config{DB: beaconDB})
+	low, err := s.lowestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), low.Block.Slot)
+	originRoot, err := chain[4].Block.HashTreeRoot()
+	require.NoError(t, err)
+	backfillRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, originRoot, backfillRoot)
+
+	// Blocks saved below the backfill block are picked up.
+	require.NoError(t, beaconDB.SaveBlocks(ctx, chain[2:4]))
+	low, err = s.lowestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), low.Block.Slot)
+	require.NoError(t, beaconDB.SaveBlock(ctx, chain[1]))
+	low, err = s.lowestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), low.Block.Slot)
+}
+
+func TestService_lowestBlock_FromFinalized(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	chain := signedChain(t, st, keys, []uint64{1, 2, 3})
+
+	// A node synced from genesis has every block.
+	beaconDB, _ := dbtest.SetupDB(t)
+	genesisRoot, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlocks(ctx, chain))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	root, err := chain[3].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, testutil.NewBeaconState(), root))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: root[:]}))
+
+	s := NewService(ctx, &Config{DB: beaconDB})
+	low, err := s.lowestBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), low.Block.Slot)
+	backfillRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfillRoot)
+	done, err := s.step(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, done)
+}
+
+func TestService_step(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	slots := make([]uint64, 0)
+	for slot := uint64(1); slot <= 40; slot++ {
+		// Leave a batch worth of skipped slots.
+		if slot < 10 || slot > 18 {
+			slots = append(slots, slot)
+		}
+	}
+	chain := signedChain(t, st, keys, slots)
+
+	beaconDB, _ := dbtest.SetupDB(t)
+	saveOrigin(t, beaconDB, chain, len(chain)-5)
+	host := p2pt.NewTestP2P(t)
+	connectPeer(t, host, chain)
+	s := NewService(ctx, &Config{
+		P2P:         host,
+		DB:          beaconDB,
+		Chain:       &mock.ChainService{State: st},
+		InitialSync: &syncmock.Sync{},
+		BatchSize:   8,
+	})
+
+	done := false
+	for i := 0; i < 10 && !done; i++ {
+		var err error
+		done, err = s.step(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, true, done, "Backfill did not complete")
+	for _, blk := range chain {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, root), "Block at slot %d was not backfilled", blk.Block.Slot)
+		assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", blk.Block.Slot)
+	}
+	genesisRoot, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	backfillRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfillRoot)
+}
+
+func TestService_step_InvalidSignature(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	chain := signedChain(t, st, keys, []uint64{1, 2, 3, 4, 5, 6})
+	chain[3].Signature = chain[2].Signature
+
+	beaconDB, _ := dbtest.SetupDB(t)
+	saveOrigin(t, beaconDB, chain, 5)
+	host := p2pt.NewTestP2P(t)
+	p := connectPeer(t, host, chain)
+	s := NewService(ctx, &Config{
+		P2P:         host,
+		DB:          beaconDB,
+		Chain:       &mock.ChainService{State: st},
+		InitialSync: &syncmock.Sync{},
+	})
+
+	_, err := s.step(ctx)
+	assert.ErrorContains(t, "invalid block signatures", err)
+	badResponses, err := host.Peers().Scorers().BadResponsesScorer().Count(p.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+	root, err := chain[4].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, false, beaconDB.HasBlock(ctx, root))
+}
+
+func TestService_step_NotLinked(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	chain := signedChain(t, st, keys, []uint64{1, 2, 3, 4, 5, 6})
+	other := signedChain(t, st, keys, []uint64{1, 2, 3, 4, 5, 6})
+	other[4].Block.Body.Graffiti = bytesutil.PadTo([]byte("fork"), 32)
+
+	beaconDB, _ := dbtest.SetupDB(t)
+	saveOrigin(t, beaconDB, chain, 5)
+	host := p2pt.NewTestP2P(t)
+	connectPeer(t, host, other)
+	s := NewService(ctx, &Config{
+		P2P:         host,
+		DB:          beaconDB,
+		Chain:       &mock.ChainService{State: st},
+		InitialSync: &syncmock.Sync{},
+	})
+
+	_, err := s.step(ctx)
+	assert.ErrorContains(t, "is not the parent of the next block", err)
+	backfillRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	originRoot, err := chain[5].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, originRoot, backfillRoot)
+}
+
+func TestService_step_WrongProposer(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	chain := signedChain(t, st, keys, []uint64{1, 2, 3, 4, 5, 6})
+	// Block 4 is signed by a validator which could not propose at its slot.
+	checker := newProposerChecker(st)
+	wrong := uint64(0)
+	for ; checker.check(4, wrong) == nil; wrong++ {
+	}
+	chain[4].Block.ProposerIndex = wrong
+	var err error
+	chain[4].Signature, err = helpers.ComputeDomainAndSign(
+		st,
+		0,
+		chain[4].Block,
+		params.BeaconConfig().DomainBeaconProposer,
+		keys[wrong],
+	)
+	require.NoError(t, err)
+	parentRoot, err := chain[4].Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain[5].Block.ParentRoot = parentRoot[:]
+
+	beaconDB, _ := dbtest.SetupDB(t)
+	saveOrigin(t, beaconDB, chain, 5)
+	host := p2pt.NewTestP2P(t)
+	p := connectPeer(t, host, chain)
+	s := NewService(ctx, &Config{
+		P2P:         host,
+		DB:          beaconDB,
+		Chain:       &mock.ChainService{State: st},
+		InitialSync: &syncmock.Sync{},
+	})
+
+	_, err = s.step(ctx)
+	assert.ErrorContains(t, "is not the proposer of slot 4", err)
+	badResponses, err := host.Peers().Scorers().BadResponsesScorer().Count(p.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+}
+
+func TestService_step_ParentNotServed(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	chain := signedChain(t, st, keys, []uint64{1, 2, 3, 4, 5, 6})
+
+	beaconDB, _ := dbtest.SetupDB(t)
+	saveOrigin(t, beaconDB, chain, 5)
+	host := p2pt.NewTestP2P(t)
+	// The peer serves no block below the lowest block.
+	p := connectPeer(t, host, chain[:1])
+	s := NewService(ctx, &Config{
+		P2P:         host,
+		DB:          beaconDB,
+		Chain:       &mock.ChainService{State: st},
+		InitialSync: &syncmock.Sync{},
+		BatchSize:   2,
+	})
+
+	var err error
+	for i := 0; i < 3 && err == nil; i++ {
+		_, err = s.step(ctx)
+	}
+	assert.ErrorContains(t, "did not serve the parent of the lowest block", err)
+	badResponses, err := host.Peers().Scorers().BadResponsesScorer().Count(p.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, badResponses)
+	// The next batch starts over from the lowest block.
+	assert.Equal(t, chain[5].Block.Slot, s.cursor)
+}
+
+func TestService_backOff(t *testing.T) {
+	s := NewService(context.Background(), &Config{BatchInterval: time.Minute})
+	s.backOff()
+	assert.Equal(t, uint(1), s.failures)
+	s.backOff()
+	s.backOff()
+	assert.Equal(t, uint(3), s.failures)
+	assert.Equal(t, true, s.retryAfter.After(timeutils.Now().Add(3*time.Minute)))
+	for i := 0; i < 10; i++ {
+		s.backOff()
+	}
+	assert.Equal(t, true, !s.retryAfter.After(timeutils.Now().Add(maxBackoff)))
+}
@@ -0,0 +1,158 @@
+// Package checkpoint allows a beacon node to start from a trusted finalized state and block,
+// the anchor, instead of syncing the whole chain from genesis. The anchor is either loaded from
+// SSZ files or fetched from the debug API of another beacon node, verified, and saved in the
+// database as the finalized checkpoint of the node, which then syncs forward from there.
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// Anchor is the trusted point the beacon node starts from. The genesis state is required
+// alongside the finalized state and block, as the node relies on it for chain information
+// such as the genesis time and the genesis validators root. The finalized epoch is the one
+// of the checkpoint the anchor block is the root of, which can not be told from the block
+// when the slots before the start of the epoch were skipped.
+type Anchor struct {
+	Block          *ethpb.SignedBeaconBlock
+	State          *stateTrie.BeaconState
+	GenesisState   *stateTrie.BeaconState
+	FinalizedEpoch uint64
+}
+
+// blockEpoch returns the first epoch the anchor block can be the checkpoint block of.
+func (a *Anchor) blockEpoch() uint64 {
+	slot := a.Block.Block.Slot
+	epoch := helpers.SlotToEpoch(slot)
+	if !helpers.IsEpochStart(slot) {
+		epoch++
+	}
+	return epoch
+}
+
+// Verify checks that the anchor state, block and genesis state are consistent with each other.
+func (a *Anchor) Verify(ctx context.Context) error {
+	if a.Block == nil || a.Block.Block == nil {
+		return errors.New("no anchor block")
+	}
+	if a.State == nil {
+		return errors.New("no anchor state")
+	}
+	if a.GenesisState == nil {
+		return errors.New("no genesis state")
+	}
+	if a.Block.Block.Slot == 0 {
+		return errors.New("anchor block is the genesis block, use the genesis state to sync from genesis")
+	}
+	if a.FinalizedEpoch == 0 {
+		return errors.New("no finalized epoch for the anchor block")
+	}
+	if a.FinalizedEpoch < a.blockEpoch() {
+		return errors.Errorf("anchor block at slot %d cannot be the checkpoint block of epoch %d", a.Block.Block.Slot, a.FinalizedEpoch)
+	}
+	if a.State.Slot() != a.Block.Block.Slot {
+		return errors.Errorf("anchor state slot %d does not match anchor block slot %d", a.State.Slot(), a.Block.Block.Slot)
+	}
+	stateRoot, err := a.State.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash anchor state")
+	}
+	if !bytes.Equal(stateRoot[:], a.Block.Block.StateRoot) {
+		return errors.Errorf("anchor state root %#x does not match anchor block state root %#x", stateRoot, a.Block.Block.StateRoot)
+	}
+	if a.GenesisState.Slot() != 0 {
+		return errors.Errorf("genesis state is at slot %d", a.GenesisState.Slot())
+	}
+	if !bytes.Equal(a.State.GenesisValidatorRoot(), a.GenesisState.GenesisValidatorRoot()) {
+		return errors.New("anchor state and genesis state have different genesis validators roots")
+	}
+	return nil
+}
+
+// Save verifies the anchor, then saves the genesis data and the anchor in the database, with
+// the anchor block as the head, the justified and the finalized checkpoint of the node.
+func (a *Anchor) Save(ctx context.Context, beaconDB db.HeadAccessDatabase) error {
+	if err := a.Verify(ctx); err != nil {
+		return errors.Wrap(err, "invalid checkpoint")
+	}
+
+	genesisStateRoot, err := a.GenesisState.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash genesis state")
+	}
+	genesisBlk := blocks.NewGenesisBlock(genesisStateRoot[:])
+	genesisBlkRoot, err := genesisBlk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	if err := saveBlockAndState(ctx, beaconDB, genesisBlk, genesisBlkRoot, a.GenesisState); err != nil {
+		return errors.Wrap(err, "could not save genesis data")
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save genesis block root")
+	}
+
+	root, err := a.Block.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get anchor block root")
+	}
+	if err := saveBlockAndState(ctx, beaconDB, a.Block, root, a.State); err != nil {
+		return errors.Wrap(err, "could not save anchor")
+	}
+	// The origin block root must be saved before the finalized checkpoint, as the finalized
+	// block roots index must not walk beyond the anchor block.
+	if err := beaconDB.SaveOriginBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save origin block root")
+	}
+	if err := beaconDB.SaveHeadBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: a.FinalizedEpoch, Root: root[:]}
+	if err := beaconDB.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+
+	log.WithFields(logrus.Fields{
+		"slot":  a.Block.Block.Slot,
+		"epoch": checkpoint.Epoch,
+		"root":  fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+	}).Info("Saved checkpoint sync anchor")
+	return nil
+}
+
+func saveBlockAndState(
+	ctx context.Context,
+	beaconDB db.HeadAccessDatabase,
+	blk *ethpb.SignedBeaconBlock,
+	root [32]byte,
+	st *stateTrie.BeaconState,
+) error {
+	if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+		return errors.Wrap(err, "could not save block")
+	}
+	if err := beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: blk.Block.Slot,
+		Root: root[:],
+	}); err != nil {
+		return errors.Wrap(err, "could not save state summary")
+	}
+	if err := beaconDB.SaveState(ctx, st, root); err != nil {
+		return errors.Wrap(err, "could not save state")
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package checkpoint
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+func testAnchor(t *testing.T, slot uint64) *Anchor {
+	genesisState, _ := testutil.DeterministicGenesisState(t, 64)
+	st := genesisState.Copy()
+	require.NoError(t, st.SetSlot(slot))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	blk.Block.StateRoot = stateRoot[:]
+	return &Anchor{Block: blk, State: st, GenesisState: genesisState, FinalizedEpoch: helpers.SlotToEpoch(slot) + 1}
+}
+
+func TestAnchor_Verify(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, testAnchor(t, 70).Verify(ctx))
+
+	a := testAnchor(t, 70)
+	a.Block.Block.Slot = 71
+	assert.ErrorContains(t, "anchor state slot 70 does not match anchor block slot 71", a.Verify(ctx))
+
+	a = testAnchor(t, 70)
+	a.Block.Block.StateRoot = make([]byte, 32)
+	assert.ErrorContains(t, "does not match anchor block state root", a.Verify(ctx))
+
+	a = testAnchor(t, 70)
+	a.GenesisState = a.State
+	assert.ErrorContains(t, "genesis state is at slot 70", a.Verify(ctx))
+
+	a = testAnchor(t, 70)
+	require.NoError(t, a.GenesisState.SetGenesisValidatorRoot(make([]byte, 32)))
+	assert.ErrorContains(t, "different genesis validators roots", a.Verify(ctx))
+
+	a = testAnchor(t, 70)
+	a.GenesisState = nil
+	assert.ErrorContains(t, "no genesis state", a.Verify(ctx))
+
+	a = testAnchor(t, 70)
+	a.FinalizedEpoch = 2
+	assert.ErrorContains(t, "cannot be the checkpoint block of epoch 2", a.Verify(ctx))
+
+	// The epoch is not guessed from the block slot.
+	a = testAnchor(t, 70)
+	a.FinalizedEpoch = 0
+	assert.ErrorContains(t, "no finalized epoch for the anchor block", a.Verify(ctx))
+
+	// The slots of the epochs after the anchor block were skipped, the finalized epoch is later.
+	a = testAnchor(t, 70)
+	a.FinalizedEpoch = 5
+	require.NoError(t, a.Verify(ctx))
+}
+
+func TestAnchor_Save(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, sc := testDB.SetupDB(t)
+	a := testAnchor(t, 70)
+	require.NoError(t, a.Save(ctx, beaconDB))
+
+	root, err := a.Block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	originRoot, err := beaconDB.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, originRoot)
+	head, err := beaconDB.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(70), head.Block.Slot)
+	finalized, err := beaconDB.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 3, Root: root[:]}, finalized)
+	justified, err := beaconDB.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, justified)
+	assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, root))
+
+	genesisBlock, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, genesisBlock)
+	genesisState, err := beaconDB.GenesisState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), genesisState.Slot())
+
+	// The state management resumes from the anchor.
+	st, err := stategen.New(beaconDB, sc).Resume(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(70), st.Slot())
+}
+
+func TestAnchor_Save_Invalid(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+	a := testAnchor(t, 70)
+	a.Block.Block.Slot = 0
+	assert.ErrorContains(t, "invalid checkpoint", a.Save(ctx, beaconDB))
+	genesisBlock, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.SignedBeaconBlock)(nil), genesisBlock)
+}
+
+func TestFromFiles(t *testing.T) {
+	a := testAnchor(t, 70)
+	dir := t.TempDir()
+	write := func(name string, marshal func() ([]byte, error)) string {
+		enc, err := marshal()
+		require.NoError(t, err)
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, enc, 0600))
+		return path
+	}
+	blockPath := write("block.ssz", a.Block.MarshalSSZ)
+	statePath := write("state.ssz", a.State.CloneInnerState().MarshalSSZ)
+	genesisPath := write("genesis.ssz", a.GenesisState.CloneInnerState().MarshalSSZ)
+
+	loaded, err := FromFiles(blockPath, statePath, genesisPath, 3)
+	require.NoError(t, err)
+	require.NoError(t, loaded.Verify(context.Background()))
+	assert.DeepEqual(t, a.Block, loaded.Block)
+	assert.Equal(t, uint64(3), loaded.FinalizedEpoch)
+
+	_, err = FromFiles(blockPath, statePath, genesisPath, 0)
+	assert.ErrorContains(t, "the epoch of the finalized checkpoint is required", err)
+	_, err = FromFiles(statePath, statePath, genesisPath, 3)
+	assert.ErrorContains(t, "could not unmarshal block", err)
+	_, err = FromFiles(blockPath, filepath.Join(dir, "missing.ssz"), genesisPath, 3)
+	assert.ErrorContains(t, "could not read checkpoint state", err)
+}
+
+type mockBeaconChainClient struct {
+	ethpb.BeaconChainClient
+	head *ethpb.ChainHead
+}
+
+func (m *mockBeaconChainClient) GetChainHead(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	return m.head, nil
+}
+
+type mockDebugClient struct {
+	pbrpc.DebugClient
+	anchor *Anchor
+	root   [32]byte
+}
+
+func (m *mockDebugClient) GetBlock(_ context.Context, req *pbrpc.BlockRequest, _ ...grpc.CallOption) (*pbrpc.SSZResponse, error) {
+	if bytesutil.ToBytes32(req.BlockRoot) != m.root {
+		return &pbrpc.SSZResponse{Encoded: make([]byte, 0)}, nil
+	}
+	enc, err := m.anchor.Block.MarshalSSZ()
+	return &pbrpc.SSZResponse{Encoded: enc}, err
+}
+
+func (m *mockDebugClient) GetBeaconState(_ context.Context, req *pbrpc.BeaconStateRequest, _ ...grpc.CallOption) (*pbrpc.SSZResponse, error) {
+	st := m.anchor.State
+	if q, ok := req.QueryFilter.(*pbrpc.BeaconStateRequest_Slot); ok && q.Slot == 0 {
+		st = m.anchor.GenesisState
+	}
+	enc, err := st.CloneInnerState().MarshalSSZ()
+	return &pbrpc.SSZResponse{Encoded: enc}, err
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+	a := testAnchor(t, 70)
+	root, err := a.Block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	debugClient := &mockDebugClient{anchor: a, root: root}
+
+	fetched, err := fetch(ctx, &mockBeaconChainClient{head: &ethpb.ChainHead{
+		FinalizedEpoch:     3,
+		FinalizedBlockRoot: root[:],
+	}}, debugClient)
+	require.NoError(t, err)
+	require.NoError(t, fetched.Verify(ctx))
+	assert.DeepEqual(t, a.Block, fetched.Block)
+	assert.Equal(t, uint64(0), fetched.GenesisState.Slot())
+	assert.Equal(t, uint64(3), fetched.FinalizedEpoch)
+
+	_, err = fetch(ctx, &mockBeaconChainClient{head: &ethpb.ChainHead{}}, debugClient)
+	assert.ErrorContains(t, "has not finalized any epoch", err)
+	_, err = fetch(ctx, &mockBeaconChainClient{head: &ethpb.ChainHead{
+		FinalizedEpoch:     3,
+		FinalizedBlockRoot: make([]byte, 32),
+	}}, debugClient)
+	assert.ErrorContains(t, "not found", err)
+}
+
+func TestFetch_SkippedSlots(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := testDB.SetupDB(t)
+	a := testAnchor(t, 70)
+	root, err := a.Block.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The slots of epochs 3 and 4 were skipped, the block at slot 70 is the checkpoint block of epoch 5.
+	fetched, err := fetch(ctx, &mockBeaconChainClient{head: &ethpb.ChainHead{
+		FinalizedEpoch:     5,
+		FinalizedBlockRoot: root[:],
+	}}, &mockDebugClient{anchor: a, root: root})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), fetched.FinalizedEpoch)
+	require.NoError(t, fetched.Save(ctx, beaconDB))
+	finalized, err := beaconDB.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 5, Root: root[:]}, finalized)
+}
@@ -0,0 +1,60 @@
+package checkpoint
+
+import (
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// FromFiles loads the anchor from SSZ encoded files of the finalized block, the state at that
+// block and the genesis state. The epoch is the one of the finalized checkpoint the block is
+// the root of.
+func FromFiles(blockPath, statePath, genesisStatePath string, epoch uint64) (*Anchor, error) {
+	if epoch == 0 {
+		return nil, errors.New("the epoch of the finalized checkpoint is required")
+	}
+	enc, err := ioutil.ReadFile(blockPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint block")
+	}
+	blk, err := unmarshalBlock(enc)
+	if err != nil {
+		return nil, err
+	}
+	enc, err = ioutil.ReadFile(statePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint state")
+	}
+	st, err := unmarshalState(enc)
+	if err != nil {
+		return nil, err
+	}
+	enc, err = ioutil.ReadFile(genesisStatePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read genesis state")
+	}
+	genesisState, err := unmarshalState(enc)
+	if err != nil {
+		return nil, err
+	}
+	return &Anchor{Block: blk, State: st, GenesisState: genesisState, FinalizedEpoch: epoch}, nil
+}
+
+func unmarshalBlock(enc []byte) (*ethpb.SignedBeaconBlock, error) {
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return blk, nil
+}
+
+func unmarshalState(enc []byte) (*stateTrie.BeaconState, error) {
+	st := &pb.BeaconState{}
+	if err := st.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	return stateTrie.InitializeFromProtoUnsafe(st)
+}
@@ -0,0 +1,104 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// FromNode fetches the anchor from the latest finalized checkpoint of the beacon node serving
+// gRPC at the endpoint. The node must be started with the debug endpoints enabled.
+func FromNode(ctx context.Context, endpoint, cert string, maxCallRecvMsgSize int) (*Anchor, error) {
+	var dialOpt grpc.DialOption
+	if cert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cert, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get valid credentials")
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	} else {
+		dialOpt = grpc.WithInsecure()
+		log.Warn("Using an insecure gRPC connection to fetch the checkpoint, provide a certificate to use a secure connection")
+	}
+	conn, err := grpc.DialContext(
+		ctx,
+		endpoint,
+		dialOpt,
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxCallRecvMsgSize)),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Failed to close connection")
+		}
+	}()
+	return fetch(ctx, ethpb.NewBeaconChainClient(conn), pbrpc.NewDebugClient(conn))
+}
+
+func fetch(ctx context.Context, beaconClient ethpb.BeaconChainClient, debugClient pbrpc.DebugClient) (*Anchor, error) {
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain head")
+	}
+	if head.FinalizedEpoch == 0 {
+		return nil, errors.New("the node has not finalized any epoch yet")
+	}
+	root := head.FinalizedBlockRoot
+	log.WithFields(logrus.Fields{
+		"epoch": head.FinalizedEpoch,
+		"root":  fmt.Sprintf("%#x", bytesutil.Trunc(root)),
+	}).Info("Fetching finalized checkpoint")
+
+	blockResp, err := debugClient.GetBlock(ctx, &pbrpc.BlockRequest{BlockRoot: root})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized block")
+	}
+	if len(blockResp.Encoded) == 0 {
+		return nil, errors.Errorf("finalized block %#x not found", root)
+	}
+	blk, err := unmarshalBlock(blockResp.Encoded)
+	if err != nil {
+		return nil, err
+	}
+	blkRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized block root")
+	}
+	if !bytes.Equal(blkRoot[:], root) {
+		return nil, errors.Errorf("received block %#x instead of finalized block %#x", blkRoot, root)
+	}
+
+	stateResp, err := debugClient.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_BlockRoot{BlockRoot: root},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized state")
+	}
+	st, err := unmarshalState(stateResp.Encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisResp, err := debugClient.GetBeaconState(ctx, &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_Slot{Slot: 0},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis state")
+	}
+	genesisState, err := unmarshalState(genesisResp.Encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &Anchor{Block: blk, State: st, GenesisState: genesisState, FinalizedEpoch: head.FinalizedEpoch}, nil
+}
@@ -101,10 +101,16 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayPort,
 			flags.GPRCGatewayCorsDomain,
 			flags.HTTPWeb3ProviderFlag,
+			flags.FallbackWeb3ProviderFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
 			flags.DisableSync,
+			flags.EnableBackfill,
+			flags.BackfillBatchSize,
+			flags.BackfillBatchInterval,
+			flags.MonitorValidatorsFlag,
 			flags.SlotsPerArchivedPoint,
+			flags.ArchiveStateDiffs,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
@@ -115,6 +121,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
+			flags.ForkChoiceDumpReorgDepth,
+			flags.ForkChoiceDumpDir,
+			flags.ExportFile,
+			flags.ExportSocket,
+			flags.ExportKafkaURL,
+			flags.ExportCursorFile,
+			flags.ExportStartEpochFlag,
+			flags.EnableSlasherFlag,
+			flags.SlasherDirFlag,
 		},
 	},
 	{
@@ -129,10 +144,22 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
 			cmd.StaticPeers,
+			cmd.TrustedPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
 		},
 	},
+	{
+		Name: "checkpoint sync",
+		Flags: []cli.Flag{
+			flags.CheckpointBlockFlag,
+			flags.CheckpointStateFlag,
+			flags.CheckpointEpochFlag,
+			flags.CheckpointGenesisStateFlag,
+			flags.CheckpointSyncProviderFlag,
+			flags.CheckpointSyncProviderCertFlag,
+		},
+	},
 	{
 		Name: "log",
 		Flags: []cli.Flag{
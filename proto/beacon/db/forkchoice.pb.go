@@ -0,0 +1,1501 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/forkchoice.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ForkChoiceStore struct {
+	JustifiedEpoch             uint64            `protobuf:"varint,1,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	FinalizedEpoch             uint64            `protobuf:"varint,2,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	FinalizedRoot              []byte            `protobuf:"bytes,3,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`
+	Nodes                      []*ForkChoiceNode `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Votes                      []*ForkChoiceVote `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes,omitempty"`
+	Balances                   []uint64          `protobuf:"varint,6,rep,packed,name=balances,proto3" json:"balances,omitempty"`
+	PreviousProposerBoostRoot  []byte            `protobuf:"bytes,7,opt,name=previous_proposer_boost_root,json=previousProposerBoostRoot,proto3" json:"previous_proposer_boost_root,omitempty"`
+	PreviousProposerBoostScore uint64            `protobuf:"varint,8,opt,name=previous_proposer_boost_score,json=previousProposerBoostScore,proto3" json:"previous_proposer_boost_score,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}          `json:"-"`
+	XXX_unrecognized           []byte            `json:"-"`
+	XXX_sizecache              int32             `json:"-"`
+}
+
+func (m *ForkChoiceStore) Reset()         { *m = ForkChoiceStore{} }
+func (m *ForkChoiceStore) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceStore) ProtoMessage()    {}
+func (*ForkChoiceStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875cee35c0df88cd, []int{0}
+}
+func (m *ForkChoiceStore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceStore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceStore.Merge(m, src)
+}
+func (m *ForkChoiceStore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceStore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceStore proto.InternalMessageInfo
+
+func (m *ForkChoiceStore) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceStore) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceStore) GetFinalizedRoot() []byte {
+	if m != nil {
+		return m.FinalizedRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceStore) GetNodes() []*ForkChoiceNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ForkChoiceStore) GetVotes() []*ForkChoiceVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *ForkChoiceStore) GetBalances() []uint64 {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *ForkChoiceStore) GetPreviousProposerBoostRoot() []byte {
+	if m != nil {
+		return m.PreviousProposerBoostRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceStore) GetPreviousProposerBoostScore() uint64 {
+	if m != nil {
+		return m.PreviousProposerBoostScore
+	}
+	return 0
+}
+
+type ForkChoiceNode struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Parent               uint64   `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	JustifiedEpoch       uint64   `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       uint64   `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	Weight               uint64   `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	BestChild            uint64   `protobuf:"varint,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
+	BestDescendant       uint64   `protobuf:"varint,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
+	Graffiti             []byte   `protobuf:"bytes,9,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceNode) Reset()         { *m = ForkChoiceNode{} }
+func (m *ForkChoiceNode) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceNode) ProtoMessage()    {}
+func (*ForkChoiceNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875cee35c0df88cd, []int{1}
+}
+func (m *ForkChoiceNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceNode.Merge(m, src)
+}
+func (m *ForkChoiceNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceNode proto.InternalMessageInfo
+
+func (m *ForkChoiceNode) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *ForkChoiceNode) GetParent() uint64 {
+	if m != nil {
+		return m.Parent
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetBestChild() uint64 {
+	if m != nil {
+		return m.BestChild
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetBestDescendant() uint64 {
+	if m != nil {
+		return m.BestDescendant
+	}
+	return 0
+}
+
+func (m *ForkChoiceNode) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
+	}
+	return nil
+}
+
+type ForkChoiceVote struct {
+	CurrentRoot          []byte   `protobuf:"bytes,1,opt,name=current_root,json=currentRoot,proto3" json:"current_root,omitempty"`
+	NextRoot             []byte   `protobuf:"bytes,2,opt,name=next_root,json=nextRoot,proto3" json:"next_root,omitempty"`
+	NextEpoch            uint64   `protobuf:"varint,3,opt,name=next_epoch,json=nextEpoch,proto3" json:"next_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceVote) Reset()         { *m = ForkChoiceVote{} }
+func (m *ForkChoiceVote) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceVote) ProtoMessage()    {}
+func (*ForkChoiceVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875cee35c0df88cd, []int{2}
+}
+func (m *ForkChoiceVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceVote.Merge(m, src)
+}
+func (m *ForkChoiceVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceVote proto.InternalMessageInfo
+
+func (m *ForkChoiceVote) GetCurrentRoot() []byte {
+	if m != nil {
+		return m.CurrentRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceVote) GetNextRoot() []byte {
+	if m != nil {
+		return m.NextRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceVote) GetNextEpoch() uint64 {
+	if m != nil {
+		return m.NextEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ForkChoiceStore)(nil), "prysm.beacon.db.ForkChoiceStore")
+	proto.RegisterType((*ForkChoiceNode)(nil), "prysm.beacon.db.ForkChoiceNode")
+	proto.RegisterType((*ForkChoiceVote)(nil), "prysm.beacon.db.ForkChoiceVote")
+}
+
+func init() { proto.RegisterFile("proto/beacon/db/forkchoice.proto", fileDescriptor_875cee35c0df88cd) }
+
+var fileDescriptor_875cee35c0df88cd = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe5, 0xc4, 0x09, 0xc9, 0xb6, 0x24, 0xd2, 0x1e, 0x2a, 0x53, 0x48, 0x30, 0x91, 0x10,
+	0x39, 0xd9, 0x12, 0x88, 0x1b, 0x12, 0xa2, 0x05, 0x8e, 0x08, 0xb9, 0x12, 0x07, 0x2e, 0x96, 0xbd,
+	0x1e, 0xc7, 0x4b, 0x5d, 0x8f, 0xb5, 0xbb, 0x29, 0x7f, 0x9e, 0x84, 0x13, 0xcf, 0xc3, 0x91, 0x47,
+	0x40, 0x79, 0x12, 0xb4, 0xb3, 0xc6, 0xa5, 0x55, 0x10, 0xdc, 0x76, 0xbe, 0xf9, 0xed, 0x66, 0xe6,
+	0xfb, 0x62, 0x16, 0xb6, 0x0a, 0x0d, 0xc6, 0x39, 0x64, 0x02, 0x9b, 0xb8, 0xc8, 0xe3, 0x12, 0xd5,
+	0xb9, 0xa8, 0x50, 0x0a, 0x88, 0xa8, 0xc5, 0xe7, 0xad, 0xfa, 0xac, 0x2f, 0x22, 0x47, 0x44, 0x45,
+	0xbe, 0xfa, 0x3a, 0x64, 0xf3, 0xd7, 0xa8, 0xce, 0x4f, 0x89, 0x3a, 0x33, 0xa8, 0x80, 0x3f, 0x62,
+	0xf3, 0x0f, 0x5b, 0x6d, 0x64, 0x29, 0xa1, 0x48, 0xa1, 0x45, 0x51, 0x05, 0x5e, 0xe8, 0xad, 0xfd,
+	0x64, 0xd6, 0xcb, 0xaf, 0xac, 0x6a, 0xc1, 0x52, 0x36, 0x59, 0x2d, 0xbf, 0xf4, 0xe0, 0xc0, 0x81,
+	0xbd, 0xec, 0xc0, 0x87, 0xec, 0x4a, 0x49, 0x15, 0xa2, 0x09, 0x86, 0xa1, 0xb7, 0x3e, 0x4c, 0x6e,
+	0xf7, 0x6a, 0x82, 0x68, 0xf8, 0x53, 0x36, 0x6a, 0xb0, 0x00, 0x1d, 0xf8, 0xe1, 0x70, 0x7d, 0xf0,
+	0xf8, 0x7e, 0x74, 0x63, 0xda, 0xe8, 0x6a, 0xd2, 0x37, 0x58, 0x40, 0xe2, 0x68, 0x7b, 0xed, 0x12,
+	0x0d, 0xe8, 0x60, 0xf4, 0xcf, 0x6b, 0xef, 0xd0, 0x40, 0xe2, 0x68, 0x7e, 0xcc, 0x26, 0x79, 0x56,
+	0x67, 0x8d, 0x00, 0x1d, 0x8c, 0xc3, 0xe1, 0xda, 0x4f, 0xfa, 0x9a, 0x3f, 0x67, 0xf7, 0x5a, 0x05,
+	0x97, 0x12, 0xb7, 0x3a, 0x6d, 0x15, 0xb6, 0xa8, 0x41, 0xa5, 0x39, 0xa2, 0x36, 0x6e, 0xfc, 0x5b,
+	0x34, 0xfe, 0x9d, 0xdf, 0xcc, 0xdb, 0x0e, 0x39, 0xb1, 0x04, 0xad, 0xf2, 0x82, 0x2d, 0xfe, 0xf6,
+	0x80, 0x16, 0xa8, 0x20, 0x98, 0x90, 0x51, 0xc7, 0x7b, 0x5f, 0x38, 0xb3, 0xc4, 0xea, 0xdb, 0x80,
+	0xcd, 0xae, 0x2f, 0xcc, 0x39, 0xf3, 0x75, 0x8d, 0xa6, 0x8b, 0x83, 0xce, 0x56, 0xa3, 0x91, 0x06,
+	0x34, 0x12, 0x9d, 0xf9, 0x11, 0x1b, 0xb7, 0x99, 0x82, 0xc6, 0xf9, 0xec, 0x27, 0x5d, 0xb5, 0x2f,
+	0x59, 0xff, 0x7f, 0x93, 0x1d, 0xed, 0x4d, 0xf6, 0x88, 0x8d, 0x3f, 0x82, 0xdc, 0x54, 0x26, 0x18,
+	0xbb, 0x5f, 0x72, 0x15, 0x5f, 0x30, 0x96, 0x83, 0x36, 0xa9, 0xa8, 0x64, 0x5d, 0x90, 0x5d, 0x7e,
+	0x32, 0xb5, 0xca, 0xa9, 0x15, 0xec, 0xfb, 0xd4, 0x2e, 0x40, 0x0b, 0x68, 0x8a, 0xac, 0x31, 0x9d,
+	0x21, 0x33, 0x2b, 0xbf, 0xec, 0x55, 0x1b, 0xd2, 0x46, 0x65, 0x65, 0x29, 0x8d, 0x0c, 0xa6, 0xb4,
+	0x61, 0x5f, 0xaf, 0x90, 0xcd, 0xae, 0x27, 0xcb, 0x1f, 0xb0, 0x43, 0xb1, 0x55, 0x76, 0x55, 0x17,
+	0x93, 0x47, 0x37, 0x0e, 0x3a, 0x8d, 0x82, 0xb9, 0xcb, 0xa6, 0x0d, 0x7c, 0x32, 0xe9, 0x1f, 0x9e,
+	0x4d, 0xac, 0x40, 0xcd, 0x05, 0x63, 0xd4, 0x74, 0x1b, 0x3b, 0xef, 0x08, 0xa7, 0x65, 0x4f, 0x9e,
+	0x7d, 0xdf, 0x2d, 0xbd, 0x1f, 0xbb, 0xa5, 0xf7, 0x73, 0xb7, 0xf4, 0xde, 0x47, 0x1b, 0x69, 0xaa,
+	0x6d, 0x1e, 0x09, 0xbc, 0x88, 0xe9, 0x1f, 0x97, 0x19, 0x29, 0xea, 0x2c, 0xd7, 0xae, 0x8a, 0x6f,
+	0x7c, 0x8c, 0xf9, 0x98, 0x84, 0x27, 0xbf, 0x06, 0x00, 0xc8, 0xb0, 0x09, 0xcd, 0xa6, 0x03, 0x00,
+	0x00,
+}
+
+func (m *ForkChoiceStore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceStore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PreviousProposerBoostScore != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.PreviousProposerBoostScore))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PreviousProposerBoostRoot) > 0 {
+		i -= len(m.PreviousProposerBoostRoot)
+		copy(dAtA[i:], m.PreviousProposerBoostRoot)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.PreviousProposerBoostRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Balances) > 0 {
+		dAtA2 := make([]byte, len(m.Balances)*10)
+		var j1 int
+		for _, num := range m.Balances {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintForkchoice(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintForkchoice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintForkchoice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FinalizedRoot) > 0 {
+		i -= len(m.FinalizedRoot)
+		copy(dAtA[i:], m.FinalizedRoot)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.FinalizedRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Graffiti) > 0 {
+		i -= len(m.Graffiti)
+		copy(dAtA[i:], m.Graffiti)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.Graffiti)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BestDescendant != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.BestDescendant))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BestChild != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.BestChild))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weight != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Parent != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NextEpoch != 0 {
+		i = encodeVarintForkchoice(dAtA, i, uint64(m.NextEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextRoot) > 0 {
+		i -= len(m.NextRoot)
+		copy(dAtA[i:], m.NextRoot)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.NextRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrentRoot) > 0 {
+		i -= len(m.CurrentRoot)
+		copy(dAtA[i:], m.CurrentRoot)
+		i = encodeVarintForkchoice(dAtA, i, uint64(len(m.CurrentRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForkchoice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForkchoice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForkChoiceStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.FinalizedEpoch))
+	}
+	l = len(m.FinalizedRoot)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovForkchoice(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovForkchoice(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		l = 0
+		for _, e := range m.Balances {
+			l += sovForkchoice(uint64(e))
+		}
+		n += 1 + sovForkchoice(uint64(l)) + l
+	}
+	l = len(m.PreviousProposerBoostRoot)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	if m.PreviousProposerBoostScore != 0 {
+		n += 1 + sovForkchoice(uint64(m.PreviousProposerBoostScore))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovForkchoice(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovForkchoice(uint64(m.Parent))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.FinalizedEpoch))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovForkchoice(uint64(m.Weight))
+	}
+	if m.BestChild != 0 {
+		n += 1 + sovForkchoice(uint64(m.BestChild))
+	}
+	if m.BestDescendant != 0 {
+		n += 1 + sovForkchoice(uint64(m.BestDescendant))
+	}
+	l = len(m.Graffiti)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentRoot)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	l = len(m.NextRoot)
+	if l > 0 {
+		n += 1 + l + sovForkchoice(uint64(l))
+	}
+	if m.NextEpoch != 0 {
+		n += 1 + sovForkchoice(uint64(m.NextEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovForkchoice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForkchoice(x uint64) (n int) {
+	return sovForkchoice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForkChoiceStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForkchoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedRoot = append(m.FinalizedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedRoot == nil {
+				m.FinalizedRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &ForkChoiceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &ForkChoiceVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowForkchoice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Balances = append(m.Balances, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowForkchoice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthForkchoice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthForkchoice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Balances) == 0 {
+					m.Balances = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowForkchoice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Balances = append(m.Balances, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousProposerBoostRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousProposerBoostRoot = append(m.PreviousProposerBoostRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousProposerBoostRoot == nil {
+				m.PreviousProposerBoostRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousProposerBoostScore", wireType)
+			}
+			m.PreviousProposerBoostScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousProposerBoostScore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForkchoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForkchoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
+			}
+			m.BestChild = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestChild |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+			}
+			m.BestDescendant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestDescendant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graffiti", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Graffiti = append(m.Graffiti[:0], dAtA[iNdEx:postIndex]...)
+			if m.Graffiti == nil {
+				m.Graffiti = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForkchoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForkchoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentRoot = append(m.CurrentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentRoot == nil {
+				m.CurrentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextRoot = append(m.NextRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextRoot == nil {
+				m.NextRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpoch", wireType)
+			}
+			m.NextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForkchoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthForkchoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForkchoice(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForkchoice
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForkchoice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForkchoice
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForkchoice
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForkchoice
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForkchoice        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForkchoice          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForkchoice = fmt.Errorf("proto: unexpected end of group")
+)
@@ -59,6 +59,31 @@ func (LoggingLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_851e5cb2de3d61dd, []int{5, 0}
 }
 
+type ForkChoiceExportRequest_Format int32
+
+const (
+	ForkChoiceExportRequest_JSON ForkChoiceExportRequest_Format = 0
+	ForkChoiceExportRequest_DOT  ForkChoiceExportRequest_Format = 1
+)
+
+var ForkChoiceExportRequest_Format_name = map[int32]string{
+	0: "JSON",
+	1: "DOT",
+}
+
+var ForkChoiceExportRequest_Format_value = map[string]int32{
+	"JSON": 0,
+	"DOT":  1,
+}
+
+func (x ForkChoiceExportRequest_Format) String() string {
+	return proto.EnumName(ForkChoiceExportRequest_Format_name, int32(x))
+}
+
+func (ForkChoiceExportRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{8, 0}
+}
+
 type InclusionSlotRequest struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
@@ -572,6 +597,100 @@ func (m *ProtoArrayNode) GetBestDescendant() uint64 {
 	return 0
 }
 
+type ForkChoiceExportRequest struct {
+	Format               ForkChoiceExportRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=ethereum.beacon.rpc.v1.ForkChoiceExportRequest_Format" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ForkChoiceExportRequest) Reset()         { *m = ForkChoiceExportRequest{} }
+func (m *ForkChoiceExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceExportRequest) ProtoMessage()    {}
+func (*ForkChoiceExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+}
+func (m *ForkChoiceExportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceExportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceExportRequest.Merge(m, src)
+}
+func (m *ForkChoiceExportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceExportRequest proto.InternalMessageInfo
+
+func (m *ForkChoiceExportRequest) GetFormat() ForkChoiceExportRequest_Format {
+	if m != nil {
+		return m.Format
+	}
+	return ForkChoiceExportRequest_JSON
+}
+
+type ForkChoiceExportResponse struct {
+	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceExportResponse) Reset()         { *m = ForkChoiceExportResponse{} }
+func (m *ForkChoiceExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceExportResponse) ProtoMessage()    {}
+func (*ForkChoiceExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{9}
+}
+func (m *ForkChoiceExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkChoiceExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkChoiceExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForkChoiceExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceExportResponse.Merge(m, src)
+}
+func (m *ForkChoiceExportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkChoiceExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceExportResponse proto.InternalMessageInfo
+
+func (m *ForkChoiceExportResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
 type DebugPeerResponses struct {
 	Responses            []*DebugPeerResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -583,7 +702,7 @@ func (m *DebugPeerResponses) Reset()         { *m = DebugPeerResponses{} }
 func (m *DebugPeerResponses) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponses) ProtoMessage()    {}
 func (*DebugPeerResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
 }
 func (m *DebugPeerResponses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,7 +756,7 @@ func (m *DebugPeerResponse) Reset()         { *m = DebugPeerResponse{} }
 func (m *DebugPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponse) ProtoMessage()    {}
 func (*DebugPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9}
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
 }
 func (m *DebugPeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,7 +857,7 @@ func (m *DebugPeerResponse_PeerInfo) Reset()         { *m = DebugPeerResponse_Pe
 func (m *DebugPeerResponse_PeerInfo) String() string { return proto.CompactTextString(m) }
 func (*DebugPeerResponse_PeerInfo) ProtoMessage()    {}
 func (*DebugPeerResponse_PeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9, 0}
+	return fileDescriptor_851e5cb2de3d61dd, []int{11, 0}
 }
 func (m *DebugPeerResponse_PeerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,6 +930,7 @@ func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
 
 func init() {
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ForkChoiceExportRequest_Format", ForkChoiceExportRequest_Format_name, ForkChoiceExportRequest_Format_value)
 	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
 	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
 	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
@@ -820,6 +940,8 @@ func init() {
 	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
 	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
 	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+	proto.RegisterType((*ForkChoiceExportRequest)(nil), "ethereum.beacon.rpc.v1.ForkChoiceExportRequest")
+	proto.RegisterType((*ForkChoiceExportResponse)(nil), "ethereum.beacon.rpc.v1.ForkChoiceExportResponse")
 	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
 	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
 	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
@@ -828,84 +950,90 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
 
 var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 1218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xef, 0x3a, 0x71, 0x12, 0x1f, 0xfb, 0xef, 0xb8, 0xd3, 0xfe, 0x53, 0xe3, 0xb6, 0x49, 0xba,
-	0x2d, 0xfd, 0xa4, 0x6b, 0xc5, 0x70, 0x81, 0x2a, 0x24, 0x94, 0xaf, 0xa6, 0x91, 0x42, 0x5b, 0x36,
-	0x2d, 0x17, 0x54, 0x68, 0x35, 0xd9, 0x3d, 0xb6, 0x97, 0x6c, 0x66, 0xb6, 0x3b, 0xb3, 0x01, 0x97,
-	0xbb, 0x0a, 0xc1, 0x25, 0x17, 0x48, 0x5c, 0xf3, 0x18, 0x3c, 0x02, 0x97, 0x48, 0xbc, 0x00, 0x8a,
-	0x78, 0x0a, 0xae, 0xd0, 0xcc, 0xec, 0xae, 0x6d, 0x62, 0x97, 0x80, 0xb8, 0x9b, 0xf3, 0x9b, 0xdf,
-	0xf9, 0x98, 0x73, 0xce, 0xcc, 0x19, 0x58, 0x89, 0x13, 0x2e, 0x79, 0xfb, 0x00, 0xa9, 0xcf, 0x59,
-	0x3b, 0x89, 0xfd, 0xf6, 0xf1, 0x5a, 0x3b, 0xc0, 0x83, 0xb4, 0xe7, 0xe8, 0x1d, 0xb2, 0x84, 0xb2,
-	0x8f, 0x09, 0xa6, 0x47, 0x8e, 0xe1, 0x38, 0x49, 0xec, 0x3b, 0xc7, 0x6b, 0xad, 0x4b, 0x28, 0xfb,
-	0xed, 0xe3, 0x35, 0x1a, 0xc5, 0x7d, 0xba, 0xd6, 0x66, 0x3c, 0x40, 0xa3, 0xd0, 0xb2, 0xc7, 0x2c,
-	0xc6, 0x9d, 0x58, 0x59, 0x3c, 0x42, 0x21, 0x68, 0x0f, 0x45, 0xc6, 0xb9, 0xd2, 0xe3, 0xbc, 0x17,
-	0x61, 0x9b, 0xc6, 0x61, 0x9b, 0x32, 0xc6, 0x25, 0x95, 0x21, 0x67, 0xf9, 0xee, 0xe5, 0x6c, 0x57,
-	0x4b, 0x07, 0x69, 0xb7, 0x8d, 0x47, 0xb1, 0x1c, 0x98, 0x4d, 0xfb, 0x01, 0x5c, 0xdc, 0x65, 0x7e,
-	0x94, 0x8a, 0x90, 0xb3, 0xfd, 0x88, 0x4b, 0x17, 0x5f, 0xa6, 0x28, 0x24, 0xa9, 0x43, 0x29, 0x0c,
-	0x9a, 0xd6, 0xaa, 0x75, 0x7b, 0xd6, 0x2d, 0x85, 0x01, 0x21, 0x30, 0x2b, 0x22, 0x2e, 0x9b, 0x25,
-	0x8d, 0xe8, 0xb5, 0x7d, 0x0f, 0xfe, 0xff, 0x17, 0x5d, 0x11, 0x73, 0x26, 0x70, 0x22, 0xf9, 0x05,
-	0x90, 0x0d, 0x7d, 0x86, 0x7d, 0x49, 0x25, 0xe6, 0x6e, 0x2e, 0x66, 0x4c, 0xed, 0xe8, 0xd1, 0x39,
-	0xc3, 0x25, 0x2b, 0x00, 0x07, 0x11, 0xf7, 0x0f, 0xbd, 0x84, 0x67, 0x56, 0x6a, 0x8f, 0xce, 0xb9,
-	0x15, 0x8d, 0xb9, 0x9c, 0xcb, 0x8d, 0x3a, 0xd4, 0x5e, 0xa6, 0x98, 0x0c, 0xbc, 0x6e, 0x18, 0x49,
-	0x4c, 0xec, 0xfb, 0x50, 0xdb, 0xd0, 0x9b, 0x99, 0xd9, 0xab, 0x63, 0x06, 0x94, 0xf1, 0xda, 0x88,
-	0xba, 0x7d, 0x0b, 0xaa, 0xfb, 0xfb, 0x9f, 0x16, 0xe1, 0x36, 0x61, 0x1e, 0x99, 0xcf, 0x03, 0x0c,
-	0x32, 0x6a, 0x2e, 0xda, 0xdf, 0x5a, 0x70, 0x61, 0x8f, 0xf7, 0x7a, 0x21, 0xeb, 0xed, 0xe1, 0x31,
-	0x46, 0xb9, 0xfd, 0x1d, 0x28, 0x47, 0x4a, 0xd6, 0xfc, 0x7a, 0x67, 0xcd, 0x99, 0x5c, 0x55, 0x67,
-	0x82, 0xae, 0x63, 0x04, 0xa3, 0x6f, 0xdf, 0x82, 0xb2, 0x96, 0xc9, 0x02, 0xcc, 0xee, 0x3e, 0x7e,
-	0xf8, 0xa4, 0x71, 0x8e, 0x54, 0xa0, 0xbc, 0xb5, 0xbd, 0xf1, 0x7c, 0xa7, 0x61, 0xa9, 0xe5, 0x33,
-	0x77, 0x7d, 0x73, 0xbb, 0x51, 0xb2, 0xbf, 0x99, 0x81, 0x2b, 0x4f, 0x55, 0xc5, 0xd6, 0x93, 0x84,
-	0x0e, 0x1e, 0xf2, 0xe4, 0x70, 0xb3, 0xcf, 0x43, 0x1f, 0x8b, 0x43, 0xdc, 0x82, 0xc5, 0x38, 0x49,
-	0x19, 0x7a, 0xb2, 0x9f, 0xa0, 0xe8, 0xf3, 0x28, 0xaf, 0x5e, 0x5d, 0xc3, 0xcf, 0x72, 0x54, 0x11,
-	0x3f, 0x4f, 0x85, 0x0c, 0xbb, 0x21, 0x06, 0x1e, 0xc6, 0xdc, 0xef, 0x67, 0x75, 0xaa, 0x17, 0xf0,
-	0xb6, 0x42, 0x15, 0xb1, 0x1b, 0x32, 0x1a, 0x85, 0xaf, 0x0a, 0xe2, 0x8c, 0x21, 0x16, 0xb0, 0x21,
-	0xba, 0x70, 0x5e, 0x37, 0x93, 0x47, 0x55, 0x6c, 0x9e, 0x6a, 0x5e, 0xd1, 0x9c, 0x5d, 0x9d, 0xb9,
-	0x5d, 0xed, 0xdc, 0x9c, 0x96, 0x99, 0xe1, 0x59, 0x1e, 0xf3, 0x00, 0xdd, 0xc5, 0x78, 0x4c, 0x16,
-	0xe4, 0x05, 0xcc, 0x87, 0x2c, 0x08, 0x7d, 0x14, 0xcd, 0xb2, 0xb6, 0xb4, 0xfe, 0xf7, 0x96, 0x4e,
-	0x67, 0xc5, 0xd9, 0x35, 0x36, 0xb6, 0x99, 0x4c, 0x06, 0x6e, 0x6e, 0xb1, 0xf5, 0x00, 0x6a, 0xa3,
-	0x1b, 0xa4, 0x01, 0x33, 0x87, 0x38, 0xd0, 0xf9, 0xaa, 0xb8, 0x6a, 0x49, 0x2e, 0x42, 0xf9, 0x98,
-	0x46, 0x29, 0x66, 0xa9, 0x31, 0xc2, 0x83, 0xd2, 0xfb, 0x96, 0xfd, 0xba, 0x04, 0xf5, 0xf1, 0xe0,
-	0x8b, 0x76, 0xb7, 0x86, 0xed, 0xae, 0xb0, 0x61, 0xf3, 0xba, 0x7a, 0x4d, 0x96, 0x60, 0x2e, 0xa6,
-	0x09, 0x32, 0x99, 0xe5, 0x31, 0x93, 0x26, 0x55, 0x64, 0xf6, 0xac, 0x15, 0x29, 0x4f, 0xac, 0xc8,
-	0x12, 0xcc, 0x7d, 0x81, 0x61, 0xaf, 0x2f, 0x9b, 0x73, 0xc6, 0x93, 0x91, 0xf4, 0xbd, 0x40, 0x21,
-	0x3d, 0xbf, 0x1f, 0x46, 0x41, 0x73, 0x5e, 0xef, 0x55, 0x14, 0xb2, 0xa9, 0x00, 0x65, 0x5f, 0x6f,
-	0x07, 0x28, 0x7c, 0x64, 0x01, 0x65, 0xb2, 0xb9, 0x60, 0xec, 0x2b, 0x78, 0xab, 0x40, 0xed, 0xcf,
-	0x80, 0x6c, 0xa9, 0x47, 0xed, 0x29, 0x62, 0x92, 0xe7, 0x5a, 0x90, 0x1d, 0xa8, 0x24, 0xb9, 0xd0,
-	0xb4, 0x74, 0xd5, 0xee, 0x4c, 0xab, 0xda, 0x29, 0x75, 0x77, 0xa8, 0x6b, 0xff, 0x54, 0x86, 0xf3,
-	0xa7, 0x08, 0xa4, 0x0d, 0x17, 0xa2, 0x50, 0x48, 0x64, 0x21, 0xeb, 0x79, 0x34, 0x08, 0x12, 0x14,
-	0xb9, 0xa3, 0x8a, 0x4b, 0x8a, 0xad, 0xf5, 0x7c, 0x87, 0x6c, 0x40, 0x25, 0x08, 0x13, 0xf4, 0xd5,
-	0x63, 0xa8, 0x0b, 0x51, 0xef, 0xdc, 0x18, 0xc6, 0x83, 0xb2, 0xef, 0xe4, 0x0f, 0xae, 0xa3, 0x1c,
-	0x6d, 0xe5, 0x5c, 0x77, 0xa8, 0x46, 0x3e, 0x86, 0x86, 0xcf, 0x19, 0x33, 0x92, 0x27, 0xd4, 0xdb,
-	0xa5, 0xab, 0x57, 0x1f, 0x6d, 0xed, 0x31, 0x53, 0x9b, 0x05, 0xdd, 0xbc, 0x74, 0x8b, 0xfe, 0x38,
-	0x40, 0x2e, 0xc1, 0x7c, 0x8c, 0x98, 0x78, 0x61, 0xa0, 0xcb, 0x5c, 0x71, 0xe7, 0x94, 0xb8, 0x1b,
-	0xa8, 0x36, 0x44, 0x96, 0xe8, 0x92, 0x56, 0x5c, 0xb5, 0x24, 0x4f, 0xa0, 0x62, 0xa8, 0xac, 0xcb,
-	0x75, 0x29, 0xab, 0x9d, 0xce, 0x99, 0x33, 0xaa, 0x0f, 0xb5, 0xcb, 0xba, 0xdc, 0x5d, 0x88, 0xb3,
-	0x15, 0xf9, 0x10, 0xaa, 0xda, 0xa0, 0x3a, 0x48, 0x2a, 0x74, 0x07, 0x54, 0x3b, 0xcb, 0xa7, 0x4c,
-	0xc6, 0x9d, 0x58, 0x99, 0xdc, 0xd7, 0x2c, 0x17, 0x94, 0x8a, 0x59, 0x93, 0x6b, 0x50, 0x8b, 0xa8,
-	0x90, 0x5e, 0x1a, 0x07, 0x54, 0x62, 0x90, 0xf5, 0x47, 0x55, 0x61, 0xcf, 0x0d, 0xd4, 0xfa, 0xc3,
-	0x82, 0x85, 0xdc, 0x35, 0xf9, 0x00, 0x16, 0x8e, 0x50, 0xd2, 0x80, 0x4a, 0xaa, 0xef, 0x47, 0xb5,
-	0xb3, 0x3a, 0xcd, 0xdb, 0x47, 0x28, 0xe9, 0x16, 0x95, 0xd4, 0x2d, 0x34, 0xc8, 0x15, 0xa8, 0xe8,
-	0x87, 0xc1, 0xe7, 0x91, 0x68, 0x96, 0x74, 0xa1, 0x87, 0x00, 0x59, 0x81, 0x6a, 0x97, 0xa6, 0x91,
-	0xf4, 0x7c, 0x9e, 0x16, 0x97, 0x0a, 0x34, 0xb4, 0xa9, 0x10, 0x72, 0x07, 0x1a, 0x39, 0xdb, 0x3b,
-	0xc6, 0x44, 0xcd, 0xa9, 0x2c, 0xe5, 0x8b, 0x39, 0xfe, 0x89, 0x81, 0xc9, 0x75, 0xf8, 0x1f, 0xed,
-	0x21, 0x93, 0x05, 0xcf, 0x54, 0xa1, 0xa6, 0xc1, 0x9c, 0x74, 0x0d, 0x6a, 0x3a, 0x7b, 0x11, 0x95,
-	0xc8, 0xfc, 0x41, 0x76, 0xb9, 0x74, 0x46, 0xf7, 0x0c, 0xd4, 0xf9, 0x71, 0x1e, 0xca, 0xba, 0x12,
-	0xe4, 0x6b, 0x0b, 0xea, 0x3b, 0x28, 0x47, 0x86, 0x1e, 0xb9, 0x3b, 0xad, 0x76, 0xa7, 0x27, 0x63,
-	0xeb, 0xfa, 0x34, 0xee, 0xc8, 0xe4, 0xb2, 0xaf, 0xbd, 0xfe, 0xf5, 0xf7, 0xef, 0x4b, 0x97, 0xc9,
-	0x5b, 0xed, 0xb1, 0xef, 0x83, 0xfe, 0x70, 0xb4, 0x75, 0xb3, 0x92, 0x2f, 0x61, 0x41, 0x45, 0xa1,
-	0x66, 0x1f, 0xb9, 0x31, 0xd5, 0xff, 0xc8, 0xf0, 0xfc, 0x0f, 0x3c, 0xeb, 0x49, 0x4b, 0xbe, 0x82,
-	0xc5, 0x7d, 0x94, 0xa3, 0x23, 0x90, 0xdc, 0xfb, 0x07, 0x83, 0xb2, 0xb5, 0xe4, 0x98, 0x8f, 0x8b,
-	0x93, 0x7f, 0x5c, 0x9c, 0x6d, 0xf5, 0x71, 0xb1, 0xaf, 0x6b, 0xd7, 0x57, 0xed, 0xcb, 0x93, 0x5c,
-	0x47, 0xc6, 0x10, 0xf9, 0xce, 0x82, 0x4b, 0x3b, 0x28, 0x27, 0x0d, 0x07, 0x32, 0xc5, 0x70, 0xeb,
-	0xbd, 0x7f, 0x33, 0x62, 0xec, 0x9b, 0x3a, 0x9c, 0x55, 0xb2, 0x3c, 0x29, 0x9c, 0x2e, 0x4f, 0x0e,
-	0x7d, 0xe3, 0x35, 0x81, 0xca, 0x5e, 0x28, 0xa4, 0xba, 0x19, 0x62, 0x6a, 0x08, 0x77, 0xcf, 0x7c,
-	0xbb, 0xc5, 0x9b, 0x4b, 0x10, 0x6b, 0x37, 0xaf, 0x60, 0x5e, 0x25, 0x01, 0x31, 0x21, 0xf6, 0x1b,
-	0x5e, 0xbe, 0x3c, 0xe3, 0x67, 0x7f, 0xad, 0xed, 0x55, 0xed, 0xbc, 0x45, 0x9a, 0xd3, 0x9c, 0x93,
-	0x1f, 0x2c, 0x68, 0xec, 0xa0, 0x1c, 0xfb, 0x21, 0x92, 0x77, 0xa6, 0x79, 0x98, 0xf4, 0x09, 0x6d,
-	0xdd, 0x3f, 0x23, 0x3b, 0x8b, 0xe9, 0x6d, 0x1d, 0xd3, 0x0a, 0xb9, 0x3a, 0x29, 0xa6, 0x30, 0x57,
-	0xd9, 0xa8, 0xfd, 0x7c, 0xb2, 0x6c, 0xfd, 0x72, 0xb2, 0x6c, 0xfd, 0x76, 0xb2, 0x6c, 0x1d, 0xcc,
-	0xe9, 0x0a, 0xbc, 0xfb, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0xf6, 0x1f, 0xf4, 0xba, 0x0b,
-	0x00, 0x00,
+	// 1315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x71, 0x62, 0x1f, 0x1b, 0xc7, 0x9d, 0x96, 0xc4, 0x38, 0x6d, 0x92, 0x6e, 0x7f,
+	0xd2, 0x1f, 0xba, 0x4b, 0x0c, 0x42, 0xa8, 0x42, 0x42, 0xf9, 0xab, 0x1b, 0x14, 0x92, 0xb2, 0x6e,
+	0xb9, 0xa0, 0x42, 0xd6, 0x64, 0xf7, 0xd8, 0x5e, 0xb2, 0xd9, 0xd9, 0xce, 0x8e, 0x43, 0x53, 0xee,
+	0x2a, 0x54, 0x2e, 0xb9, 0x40, 0xe2, 0x15, 0x78, 0x05, 0x1e, 0x01, 0x71, 0x85, 0xc4, 0x0b, 0xa0,
+	0x8a, 0xa7, 0xe0, 0x0a, 0xcd, 0xcc, 0xee, 0x3a, 0x6e, 0xec, 0x92, 0x22, 0xee, 0xe6, 0x7c, 0xf3,
+	0x9d, 0x9f, 0x3d, 0xe7, 0xec, 0x39, 0x03, 0x4b, 0x11, 0x67, 0x82, 0xd9, 0xfb, 0x48, 0x5d, 0x16,
+	0xda, 0x3c, 0x72, 0xed, 0xa3, 0x55, 0xdb, 0xc3, 0xfd, 0x7e, 0xd7, 0x52, 0x37, 0x64, 0x0e, 0x45,
+	0x0f, 0x39, 0xf6, 0x0f, 0x2d, 0xcd, 0xb1, 0x78, 0xe4, 0x5a, 0x47, 0xab, 0xf5, 0x79, 0x14, 0x3d,
+	0xfb, 0x68, 0x95, 0x06, 0x51, 0x8f, 0xae, 0xda, 0x21, 0xf3, 0x50, 0x2b, 0xd4, 0xcd, 0x21, 0x8b,
+	0x51, 0x23, 0x92, 0x16, 0x0f, 0x31, 0x8e, 0x69, 0x17, 0xe3, 0x84, 0x73, 0xb1, 0xcb, 0x58, 0x37,
+	0x40, 0x9b, 0x46, 0xbe, 0x4d, 0xc3, 0x90, 0x09, 0x2a, 0x7c, 0x16, 0xa6, 0xb7, 0x0b, 0xc9, 0xad,
+	0x92, 0xf6, 0xfb, 0x1d, 0x1b, 0x0f, 0x23, 0x71, 0xac, 0x2f, 0xcd, 0xbb, 0x70, 0x61, 0x3b, 0x74,
+	0x83, 0x7e, 0xec, 0xb3, 0xb0, 0x15, 0x30, 0xe1, 0xe0, 0x93, 0x3e, 0xc6, 0x82, 0x54, 0x20, 0xe7,
+	0x7b, 0x35, 0x63, 0xd9, 0xb8, 0x31, 0xe5, 0xe4, 0x7c, 0x8f, 0x10, 0x98, 0x8a, 0x03, 0x26, 0x6a,
+	0x39, 0x85, 0xa8, 0xb3, 0x79, 0x1b, 0xde, 0x7e, 0x45, 0x37, 0x8e, 0x58, 0x18, 0xe3, 0x48, 0xf2,
+	0x63, 0x20, 0xeb, 0xea, 0x1b, 0x5a, 0x82, 0x0a, 0x4c, 0xdd, 0x5c, 0x48, 0x98, 0xca, 0xd1, 0xfd,
+	0x09, 0xcd, 0x25, 0x4b, 0x00, 0xfb, 0x01, 0x73, 0x0f, 0xda, 0x9c, 0x25, 0x56, 0xca, 0xf7, 0x27,
+	0x9c, 0xa2, 0xc2, 0x1c, 0xc6, 0xc4, 0x7a, 0x05, 0xca, 0x4f, 0xfa, 0xc8, 0x8f, 0xdb, 0x1d, 0x3f,
+	0x10, 0xc8, 0xcd, 0x3b, 0x50, 0x5e, 0x57, 0x97, 0x89, 0xd9, 0x4b, 0x43, 0x06, 0xa4, 0xf1, 0xf2,
+	0x09, 0x75, 0x73, 0x05, 0x4a, 0xad, 0xd6, 0x97, 0x59, 0xb8, 0x35, 0x98, 0xc1, 0xd0, 0x65, 0x1e,
+	0x7a, 0x09, 0x35, 0x15, 0xcd, 0xef, 0x0d, 0x38, 0xbf, 0xc3, 0xba, 0x5d, 0x3f, 0xec, 0xee, 0xe0,
+	0x11, 0x06, 0xa9, 0xfd, 0x26, 0xe4, 0x03, 0x29, 0x2b, 0x7e, 0xa5, 0xb1, 0x6a, 0x8d, 0xae, 0xaa,
+	0x35, 0x42, 0xd7, 0xd2, 0x82, 0xd6, 0x37, 0x57, 0x20, 0xaf, 0x64, 0x52, 0x80, 0xa9, 0xed, 0xdd,
+	0x7b, 0x7b, 0xd5, 0x09, 0x52, 0x84, 0xfc, 0xe6, 0xd6, 0xfa, 0xa3, 0x66, 0xd5, 0x90, 0xc7, 0x87,
+	0xce, 0xda, 0xc6, 0x56, 0x35, 0x67, 0xbe, 0x98, 0x84, 0x8b, 0x0f, 0x64, 0xc5, 0xd6, 0x38, 0xa7,
+	0xc7, 0xf7, 0x18, 0x3f, 0xd8, 0xe8, 0x31, 0xdf, 0xc5, 0xec, 0x23, 0x56, 0x60, 0x36, 0xe2, 0xfd,
+	0x10, 0xdb, 0xa2, 0xc7, 0x31, 0xee, 0xb1, 0x20, 0xad, 0x5e, 0x45, 0xc1, 0x0f, 0x53, 0x54, 0x12,
+	0xbf, 0xee, 0xc7, 0xc2, 0xef, 0xf8, 0xe8, 0xb5, 0x31, 0x62, 0x6e, 0x2f, 0xa9, 0x53, 0x25, 0x83,
+	0xb7, 0x24, 0x2a, 0x89, 0x1d, 0x3f, 0xa4, 0x81, 0xff, 0x2c, 0x23, 0x4e, 0x6a, 0x62, 0x06, 0x6b,
+	0xa2, 0x03, 0xe7, 0x54, 0x33, 0xb5, 0xa9, 0x8c, 0xad, 0x2d, 0x9b, 0x37, 0xae, 0x4d, 0x2d, 0x4f,
+	0xde, 0x28, 0x35, 0xae, 0x8f, 0xcb, 0xcc, 0xe0, 0x5b, 0x76, 0x99, 0x87, 0xce, 0x6c, 0x34, 0x24,
+	0xc7, 0xe4, 0x31, 0xcc, 0xf8, 0xa1, 0xe7, 0xbb, 0x18, 0xd7, 0xf2, 0xca, 0xd2, 0xda, 0xbf, 0x5b,
+	0x3a, 0x9d, 0x15, 0x6b, 0x5b, 0xdb, 0xd8, 0x0a, 0x05, 0x3f, 0x76, 0x52, 0x8b, 0xf5, 0xbb, 0x50,
+	0x3e, 0x79, 0x41, 0xaa, 0x30, 0x79, 0x80, 0xc7, 0x2a, 0x5f, 0x45, 0x47, 0x1e, 0xc9, 0x05, 0xc8,
+	0x1f, 0xd1, 0xa0, 0x8f, 0x49, 0x6a, 0xb4, 0x70, 0x37, 0xf7, 0x91, 0x61, 0x3e, 0xcf, 0x41, 0x65,
+	0x38, 0xf8, 0xac, 0xdd, 0x8d, 0x41, 0xbb, 0x4b, 0x6c, 0xd0, 0xbc, 0x8e, 0x3a, 0x93, 0x39, 0x98,
+	0x8e, 0x28, 0xc7, 0x50, 0x24, 0x79, 0x4c, 0xa4, 0x51, 0x15, 0x99, 0x3a, 0x6b, 0x45, 0xf2, 0x23,
+	0x2b, 0x32, 0x07, 0xd3, 0xdf, 0xa0, 0xdf, 0xed, 0x89, 0xda, 0xb4, 0xf6, 0xa4, 0x25, 0xf5, 0x5f,
+	0x60, 0x2c, 0xda, 0x6e, 0xcf, 0x0f, 0xbc, 0xda, 0x8c, 0xba, 0x2b, 0x4a, 0x64, 0x43, 0x02, 0xd2,
+	0xbe, 0xba, 0xf6, 0x30, 0x76, 0x31, 0xf4, 0x68, 0x28, 0x6a, 0x05, 0x6d, 0x5f, 0xc2, 0x9b, 0x19,
+	0x6a, 0xbe, 0x30, 0x60, 0x7e, 0x90, 0xed, 0xad, 0xa7, 0x11, 0xe3, 0xd9, 0xe4, 0xd8, 0x85, 0xe9,
+	0x0e, 0xe3, 0x87, 0x54, 0x24, 0x3f, 0xc7, 0x87, 0xe3, 0x0a, 0x37, 0xc6, 0x80, 0xc4, 0x0f, 0xa9,
+	0x70, 0x12, 0x2b, 0xe6, 0x02, 0x4c, 0x6b, 0x44, 0xfe, 0x23, 0x9f, 0xb6, 0xf6, 0x76, 0xab, 0x13,
+	0x64, 0x06, 0x26, 0x37, 0xf7, 0x1e, 0x56, 0x0d, 0xd3, 0x82, 0xda, 0x69, 0x33, 0x83, 0x29, 0xe4,
+	0x51, 0x41, 0x93, 0xb2, 0xaa, 0xb3, 0xf9, 0x15, 0x90, 0x4d, 0x39, 0x8d, 0x1f, 0x20, 0xf2, 0x94,
+	0x18, 0x93, 0x26, 0x14, 0x79, 0x2a, 0xd4, 0x0c, 0xd5, 0x6e, 0x37, 0xc7, 0x45, 0x7d, 0x4a, 0xdd,
+	0x19, 0xe8, 0x9a, 0xbf, 0xe4, 0xe1, 0xdc, 0x29, 0x02, 0xb1, 0xe1, 0x7c, 0xe0, 0xc7, 0x02, 0x43,
+	0x3f, 0xec, 0xb6, 0xa9, 0xe7, 0x71, 0x8c, 0x53, 0x47, 0x45, 0x87, 0x64, 0x57, 0x6b, 0xe9, 0x0d,
+	0x59, 0x87, 0xa2, 0xe7, 0x73, 0x74, 0xe5, 0x14, 0x57, 0x1d, 0x54, 0x69, 0x5c, 0x1d, 0xc4, 0x83,
+	0xa2, 0x67, 0xa5, 0x9b, 0xc2, 0x92, 0x8e, 0x36, 0x53, 0xae, 0x33, 0x50, 0x23, 0x9f, 0x43, 0xd5,
+	0x65, 0x61, 0xa8, 0xa5, 0x76, 0x2c, 0xa8, 0x40, 0xd5, 0x76, 0x95, 0xc6, 0xf5, 0x31, 0xa6, 0x36,
+	0x32, 0xba, 0x1e, 0xd1, 0xb3, 0xee, 0x30, 0x40, 0xe6, 0x61, 0x26, 0x42, 0xe4, 0x6d, 0xdf, 0x53,
+	0xfd, 0x59, 0x74, 0xa6, 0xa5, 0xb8, 0xed, 0xc9, 0xff, 0x07, 0x43, 0xae, 0x7a, 0xb1, 0xe8, 0xc8,
+	0x23, 0xd9, 0x83, 0xa2, 0xa6, 0x86, 0x1d, 0xa6, 0x7a, 0xb0, 0xd4, 0x68, 0x9c, 0x39, 0xa3, 0xea,
+	0xa3, 0xb6, 0xc3, 0x0e, 0x73, 0x0a, 0x51, 0x72, 0x22, 0x9f, 0x40, 0x49, 0x19, 0x94, 0x1f, 0xd2,
+	0x8f, 0x55, 0xeb, 0x96, 0x1a, 0x8b, 0xa7, 0x4c, 0x46, 0x8d, 0x48, 0x9a, 0x6c, 0x29, 0x96, 0x03,
+	0x52, 0x45, 0x9f, 0xc9, 0x65, 0x28, 0x07, 0x34, 0x16, 0xed, 0x7e, 0xe4, 0x51, 0x81, 0x5e, 0xd2,
+	0xd8, 0x25, 0x89, 0x3d, 0xd2, 0x50, 0xfd, 0x6f, 0x03, 0x0a, 0xa9, 0x6b, 0xf2, 0x31, 0x14, 0x0e,
+	0x51, 0xd0, 0xac, 0x83, 0x4a, 0x8d, 0xe5, 0x71, 0xde, 0x3e, 0x43, 0x41, 0x37, 0xa9, 0xa0, 0x4e,
+	0xa6, 0x41, 0x2e, 0x42, 0x51, 0x4d, 0x34, 0x97, 0x05, 0x71, 0x2d, 0xa7, 0x0a, 0x3d, 0x00, 0xc8,
+	0x12, 0x94, 0x3a, 0xb4, 0x1f, 0x88, 0xb6, 0xcb, 0xfa, 0xd9, 0x34, 0x00, 0x05, 0x6d, 0x48, 0x84,
+	0xdc, 0x84, 0x6a, 0xca, 0x6e, 0x1f, 0x21, 0x97, 0x0b, 0x36, 0x49, 0xf9, 0x6c, 0x8a, 0x7f, 0xa1,
+	0x61, 0x72, 0x05, 0xde, 0xa2, 0x5d, 0x0c, 0x45, 0xc6, 0xd3, 0x55, 0x28, 0x2b, 0x30, 0x25, 0x5d,
+	0x86, 0xb2, 0xca, 0x5e, 0x40, 0x05, 0x86, 0xee, 0x71, 0x32, 0x15, 0x54, 0x46, 0x77, 0x34, 0xd4,
+	0xf8, 0xad, 0x00, 0x79, 0x55, 0x09, 0xf2, 0x9d, 0x01, 0x95, 0x26, 0x8a, 0x13, 0xdb, 0x9a, 0xdc,
+	0x1a, 0x57, 0xbb, 0xd3, 0x2b, 0xbd, 0x7e, 0x65, 0x1c, 0xf7, 0xc4, 0xca, 0x35, 0x2f, 0x3f, 0xff,
+	0xe3, 0xaf, 0x1f, 0x73, 0x0b, 0xe4, 0x1d, 0x7b, 0xe8, 0xdd, 0xa3, 0x5e, 0x4a, 0xb6, 0x6a, 0x56,
+	0xf2, 0x14, 0x0a, 0x32, 0x0a, 0xb9, 0xb4, 0xc9, 0xd5, 0xb1, 0xfe, 0x4f, 0x6c, 0xfd, 0xff, 0xc1,
+	0xb3, 0x7a, 0x22, 0x90, 0x6f, 0x61, 0xb6, 0x85, 0xe2, 0xe4, 0xee, 0x26, 0xb7, 0xdf, 0x60, 0xc3,
+	0xd7, 0xe7, 0x2c, 0xfd, 0xe2, 0xb2, 0xd2, 0x17, 0x97, 0xb5, 0x25, 0x5f, 0x5c, 0xe6, 0x15, 0xe5,
+	0xfa, 0x92, 0xb9, 0x30, 0xca, 0x75, 0xa0, 0x0d, 0x91, 0x1f, 0x0c, 0x98, 0x6f, 0xa2, 0x18, 0xb5,
+	0xd5, 0xc8, 0x18, 0xc3, 0xf5, 0x0f, 0xfe, 0xcb, 0x6e, 0x34, 0xaf, 0xab, 0x70, 0x96, 0xc9, 0xe2,
+	0xa8, 0x70, 0x3a, 0x8c, 0x1f, 0xb8, 0xda, 0xeb, 0xcf, 0x06, 0x9c, 0x6f, 0xa2, 0x78, 0x75, 0xce,
+	0x12, 0xfb, 0x0d, 0x07, 0x7b, 0xfd, 0xbd, 0xb3, 0x2b, 0x24, 0x21, 0xde, 0x51, 0x21, 0xae, 0x90,
+	0x6b, 0xaf, 0x0f, 0xd1, 0x46, 0x1d, 0x11, 0x87, 0xe2, 0x8e, 0x1f, 0x0b, 0xf9, 0x0f, 0xc7, 0x63,
+	0x93, 0x75, 0xeb, 0xcc, 0x73, 0x28, 0x7e, 0x7d, 0xb3, 0x44, 0xca, 0xcd, 0x33, 0x98, 0x91, 0xe5,
+	0x42, 0xe4, 0xc4, 0x7c, 0xcd, 0x8c, 0x4e, 0x73, 0x70, 0xf6, 0xbd, 0x62, 0x2e, 0x2b, 0xe7, 0x75,
+	0x52, 0x1b, 0xe7, 0x9c, 0xfc, 0x64, 0x40, 0xb5, 0x89, 0x62, 0xe8, 0x11, 0x4e, 0xde, 0x1d, 0xe7,
+	0x61, 0xd4, 0x3b, 0xbf, 0x7e, 0xe7, 0x8c, 0xec, 0x24, 0xa6, 0x6b, 0x2a, 0xa6, 0x25, 0x72, 0x69,
+	0x54, 0x4c, 0x7e, 0xaa, 0xb2, 0x5e, 0xfe, 0xf5, 0xe5, 0xa2, 0xf1, 0xfb, 0xcb, 0x45, 0xe3, 0xcf,
+	0x97, 0x8b, 0xc6, 0xfe, 0xb4, 0xaa, 0xc0, 0xfb, 0xff, 0x0c, 0x00, 0xd2, 0x33, 0x3b, 0xb3, 0x1d,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -924,6 +1052,7 @@ type DebugClient interface {
 	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
 	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	GetForkChoiceExport(ctx context.Context, in *ForkChoiceExportRequest, opts ...grpc.CallOption) (*ForkChoiceExportResponse, error)
 	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
 	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
 	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
@@ -973,6 +1102,15 @@ func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Emp
 	return out, nil
 }
 
+func (c *debugClient) GetForkChoiceExport(ctx context.Context, in *ForkChoiceExportRequest, opts ...grpc.CallOption) (*ForkChoiceExportResponse, error) {
+	out := new(ForkChoiceExportResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetForkChoiceExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *debugClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
 	out := new(DebugPeerResponses)
 	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
@@ -1006,6 +1144,7 @@ type DebugServer interface {
 	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
 	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
 	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
+	GetForkChoiceExport(context.Context, *ForkChoiceExportRequest) (*ForkChoiceExportResponse, error)
 	ListPeers(context.Context, *types.Empty) (*DebugPeerResponses, error)
 	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
 	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
@@ -1027,6 +1166,9 @@ func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *Loggi
 func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
 }
+func (*UnimplementedDebugServer) GetForkChoiceExport(ctx context.Context, req *ForkChoiceExportRequest) (*ForkChoiceExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForkChoiceExport not implemented")
+}
 func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *types.Empty) (*DebugPeerResponses, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
 }
@@ -1113,6 +1255,24 @@ func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_GetForkChoiceExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkChoiceExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetForkChoiceExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetForkChoiceExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetForkChoiceExport(ctx, req.(*ForkChoiceExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Empty)
 	if err := dec(in); err != nil {
@@ -1187,6 +1347,10 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProtoArrayForkChoice",
 			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
 		},
+		{
+			MethodName: "GetForkChoiceExport",
+			Handler:    _Debug_GetForkChoiceExport_Handler,
+		},
 		{
 			MethodName: "ListPeers",
 			Handler:    _Debug_ListPeers_Handler,
@@ -1579,6 +1743,72 @@ func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForkChoiceExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Format != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForkChoiceExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkChoiceExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForkChoiceExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1980,6 +2210,37 @@ func (m *ProtoArrayNode) Size() (n int) {
 	return n
 }
 
+func (m *ForkChoiceExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Format != 0 {
+		n += 1 + sovDebug(uint64(m.Format))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkChoiceExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DebugPeerResponses) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3083,6 +3344,165 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ForkChoiceExportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceExportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= ForkChoiceExportRequest_Format(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForkChoiceExportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForkChoiceExportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForkChoiceExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
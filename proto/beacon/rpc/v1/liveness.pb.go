@@ -0,0 +1,962 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/liveness.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorLivenessRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorLivenessRequest) Reset()         { *m = ValidatorLivenessRequest{} }
+func (m *ValidatorLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLivenessRequest) ProtoMessage()    {}
+func (*ValidatorLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d50183da928f3bf, []int{0}
+}
+func (m *ValidatorLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessRequest.Merge(m, src)
+}
+func (m *ValidatorLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessRequest proto.InternalMessageInfo
+
+func (m *ValidatorLivenessRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorLivenessRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorLivenessResponse struct {
+	Epoch                uint64                                         `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Liveness             []*ValidatorLivenessResponse_ValidatorLiveness `protobuf:"bytes,2,rep,name=liveness,proto3" json:"liveness,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *ValidatorLivenessResponse) Reset()         { *m = ValidatorLivenessResponse{} }
+func (m *ValidatorLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLivenessResponse) ProtoMessage()    {}
+func (*ValidatorLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d50183da928f3bf, []int{1}
+}
+func (m *ValidatorLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessResponse.Merge(m, src)
+}
+func (m *ValidatorLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessResponse proto.InternalMessageInfo
+
+func (m *ValidatorLivenessResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorLivenessResponse) GetLiveness() []*ValidatorLivenessResponse_ValidatorLiveness {
+	if m != nil {
+		return m.Liveness
+	}
+	return nil
+}
+
+type ValidatorLivenessResponse_ValidatorLiveness struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	IsLive               bool     `protobuf:"varint,3,opt,name=is_live,json=isLive,proto3" json:"is_live,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorLivenessResponse_ValidatorLiveness) Reset() {
+	*m = ValidatorLivenessResponse_ValidatorLiveness{}
+}
+func (m *ValidatorLivenessResponse_ValidatorLiveness) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ValidatorLivenessResponse_ValidatorLiveness) ProtoMessage() {}
+func (*ValidatorLivenessResponse_ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d50183da928f3bf, []int{1, 0}
+}
+func (m *ValidatorLivenessResponse_ValidatorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessResponse_ValidatorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessResponse_ValidatorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessResponse_ValidatorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessResponse_ValidatorLiveness.Merge(m, src)
+}
+func (m *ValidatorLivenessResponse_ValidatorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessResponse_ValidatorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessResponse_ValidatorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessResponse_ValidatorLiveness proto.InternalMessageInfo
+
+func (m *ValidatorLivenessResponse_ValidatorLiveness) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorLivenessResponse_ValidatorLiveness) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorLivenessResponse_ValidatorLiveness) GetIsLive() bool {
+	if m != nil {
+		return m.IsLive
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ValidatorLivenessRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorLivenessRequest")
+	proto.RegisterType((*ValidatorLivenessResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorLivenessResponse")
+	proto.RegisterType((*ValidatorLivenessResponse_ValidatorLiveness)(nil), "ethereum.beacon.rpc.v1.ValidatorLivenessResponse.ValidatorLiveness")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/liveness.proto", fileDescriptor_2d50183da928f3bf)
+}
+
+var fileDescriptor_2d50183da928f3bf = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x4e, 0xfb, 0x30,
+	0x14, 0xc6, 0xe5, 0xf6, 0xff, 0x2f, 0xe5, 0xb5, 0x0b, 0x51, 0x05, 0xa1, 0x82, 0x52, 0xc2, 0x52,
+	0x96, 0x98, 0x94, 0x1b, 0xc0, 0xc0, 0x00, 0x0b, 0x19, 0x58, 0x2b, 0x37, 0x7d, 0x6a, 0x2c, 0x82,
+	0x6d, 0x62, 0x37, 0xa2, 0x2b, 0x57, 0xe0, 0x0e, 0x5c, 0x80, 0x4b, 0x30, 0x22, 0x71, 0x01, 0x54,
+	0x71, 0x05, 0x76, 0x54, 0xa7, 0x0d, 0x43, 0xd3, 0xa1, 0xe3, 0xf3, 0xe7, 0xf7, 0xf3, 0xf7, 0xf9,
+	0x3d, 0xf0, 0x54, 0x2a, 0x8d, 0xa4, 0x43, 0x64, 0x91, 0x14, 0x34, 0x55, 0x11, 0xcd, 0x02, 0x9a,
+	0xf0, 0x0c, 0x05, 0x6a, 0xed, 0x5b, 0xd1, 0xd9, 0x45, 0x13, 0x63, 0x8a, 0x93, 0x07, 0x3f, 0xbf,
+	0xe6, 0xa7, 0x2a, 0xf2, 0xb3, 0xa0, 0x7d, 0x30, 0x96, 0x72, 0x9c, 0x20, 0x65, 0x8a, 0x53, 0x26,
+	0x84, 0x34, 0xcc, 0x70, 0x29, 0x16, 0x5d, 0xde, 0x2d, 0xb8, 0x77, 0x2c, 0xe1, 0x23, 0x66, 0x64,
+	0x7a, 0xb3, 0x00, 0x86, 0xf8, 0x38, 0x41, 0x6d, 0x9c, 0x16, 0xfc, 0x47, 0x25, 0xa3, 0xd8, 0x25,
+	0x5d, 0xd2, 0xfb, 0x17, 0xe6, 0x85, 0x73, 0x04, 0x0d, 0x35, 0x19, 0x26, 0x3c, 0x1a, 0xdc, 0xe3,
+	0x54, 0xbb, 0x95, 0x6e, 0xb5, 0xd7, 0x0c, 0x21, 0x3f, 0xba, 0xc6, 0xa9, 0xf6, 0x7e, 0x08, 0xec,
+	0x97, 0x30, 0xb5, 0x92, 0x42, 0xe3, 0x1a, 0xe8, 0x00, 0xea, 0xcb, 0x38, 0x96, 0xd8, 0xe8, 0x5f,
+	0xfa, 0xe5, 0x79, 0xfc, 0xb5, 0xe8, 0x12, 0xa5, 0x80, 0xb6, 0x19, 0xec, 0xac, 0xc8, 0xce, 0x21,
+	0xc0, 0x5f, 0x14, 0x6b, 0xa8, 0x19, 0x6e, 0x17, 0x49, 0xe6, 0x56, 0xb9, 0x18, 0xe1, 0x93, 0x5b,
+	0xc9, 0xad, 0xda, 0xc2, 0xd9, 0x83, 0x2d, 0xae, 0x07, 0x73, 0xb0, 0x5b, 0xed, 0x92, 0x5e, 0x3d,
+	0xac, 0x71, 0x3d, 0x27, 0xf6, 0xdf, 0x08, 0xd4, 0x0b, 0xf4, 0x2b, 0x81, 0xd6, 0x15, 0x9a, 0xd5,
+	0x37, 0xcf, 0x36, 0xc8, 0x65, 0xc7, 0xd0, 0x0e, 0x36, 0xfe, 0x09, 0xef, 0xf4, 0xf9, 0xf3, 0xfb,
+	0xa5, 0x72, 0xe2, 0x1c, 0x53, 0x34, 0x31, 0xcd, 0x02, 0x96, 0xa8, 0x98, 0x05, 0x34, 0x5b, 0x36,
+	0xe8, 0x62, 0x79, 0x2e, 0x9a, 0xef, 0xb3, 0x0e, 0xf9, 0x98, 0x75, 0xc8, 0xd7, 0xac, 0x43, 0x86,
+	0x35, 0xbb, 0x15, 0xe7, 0xbf, 0x03, 0x00, 0x24, 0xbc, 0x1e, 0xf3, 0x71, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LivenessClient is the client API for Liveness service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LivenessClient interface {
+	GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error)
+}
+
+type livenessClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLivenessClient(cc *grpc.ClientConn) LivenessClient {
+	return &livenessClient{cc}
+}
+
+func (c *livenessClient) GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error) {
+	out := new(ValidatorLivenessResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Liveness/GetValidatorLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LivenessServer is the server API for Liveness service.
+type LivenessServer interface {
+	GetValidatorLiveness(context.Context, *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error)
+}
+
+// UnimplementedLivenessServer can be embedded to have forward compatible implementations.
+type UnimplementedLivenessServer struct {
+}
+
+func (*UnimplementedLivenessServer) GetValidatorLiveness(ctx context.Context, req *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorLiveness not implemented")
+}
+
+func RegisterLivenessServer(s *grpc.Server, srv LivenessServer) {
+	s.RegisterService(&_Liveness_serviceDesc, srv)
+}
+
+func _Liveness_GetValidatorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LivenessServer).GetValidatorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Liveness/GetValidatorLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LivenessServer).GetValidatorLiveness(ctx, req.(*ValidatorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Liveness_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Liveness",
+	HandlerType: (*LivenessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorLiveness",
+			Handler:    _Liveness_GetValidatorLiveness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/liveness.proto",
+}
+
+func (m *ValidatorLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintLiveness(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Liveness) > 0 {
+		for iNdEx := len(m.Liveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiveness(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLivenessResponse_ValidatorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessResponse_ValidatorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessResponse_ValidatorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsLive {
+		i--
+		if m.IsLive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiveness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovLiveness(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovLiveness(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovLiveness(uint64(m.Epoch))
+	}
+	if len(m.Liveness) > 0 {
+		for _, e := range m.Liveness {
+			l = e.Size()
+			n += 1 + l + sovLiveness(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorLivenessResponse_ValidatorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovLiveness(uint64(m.Index))
+	}
+	if m.IsLive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovLiveness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiveness(x uint64) (n int) {
+	return sovLiveness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liveness = append(m.Liveness, &ValidatorLivenessResponse_ValidatorLiveness{})
+			if err := m.Liveness[len(m.Liveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLivenessResponse_ValidatorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiveness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiveness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiveness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiveness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiveness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiveness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiveness = fmt.Errorf("proto: unexpected end of group")
+)
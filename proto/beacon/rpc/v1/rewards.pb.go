@@ -0,0 +1,1425 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/rewards.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorRewardsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRewardsRequest) Reset()         { *m = ValidatorRewardsRequest{} }
+func (m *ValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardsRequest) ProtoMessage()    {}
+func (*ValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f510df47ec04d7, []int{0}
+}
+func (m *ValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsRequest.Merge(m, src)
+}
+func (m *ValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardsRequest proto.InternalMessageInfo
+
+func (m *ValidatorRewardsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *ValidatorRewardsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type ValidatorRewardsResponse struct {
+	Epoch                uint64                                       `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards              []*ValidatorRewardsResponse_ValidatorRewards `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *ValidatorRewardsResponse) Reset()         { *m = ValidatorRewardsResponse{} }
+func (m *ValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardsResponse) ProtoMessage()    {}
+func (*ValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f510df47ec04d7, []int{1}
+}
+func (m *ValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsResponse.Merge(m, src)
+}
+func (m *ValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *ValidatorRewardsResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse) GetRewards() []*ValidatorRewardsResponse_ValidatorRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+type ValidatorRewardsResponse_ValidatorRewards struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	SourceReward         uint64   `protobuf:"varint,3,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	SourcePenalty        uint64   `protobuf:"varint,4,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetReward         uint64   `protobuf:"varint,5,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	TargetPenalty        uint64   `protobuf:"varint,6,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadReward           uint64   `protobuf:"varint,7,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	HeadPenalty          uint64   `protobuf:"varint,8,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InclusionDelayReward uint64   `protobuf:"varint,9,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	InactivityPenalty    uint64   `protobuf:"varint,10,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	ProposerReward       uint64   `protobuf:"varint,11,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	BalanceBefore        uint64   `protobuf:"varint,12,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter         uint64   `protobuf:"varint,13,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) Reset() {
+	*m = ValidatorRewardsResponse_ValidatorRewards{}
+}
+func (m *ValidatorRewardsResponse_ValidatorRewards) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ValidatorRewardsResponse_ValidatorRewards) ProtoMessage() {}
+func (*ValidatorRewardsResponse_ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f510df47ec04d7, []int{1, 0}
+}
+func (m *ValidatorRewardsResponse_ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsResponse_ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsResponse_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardsResponse_ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsResponse_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewardsResponse_ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsResponse_ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsResponse_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardsResponse_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetSourceReward() uint64 {
+	if m != nil {
+		return m.SourceReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetTargetReward() uint64 {
+	if m != nil {
+		return m.TargetReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetHeadReward() uint64 {
+	if m != nil {
+		return m.HeadReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetInclusionDelayReward() uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetBalanceBefore() uint64 {
+	if m != nil {
+		return m.BalanceBefore
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) GetBalanceAfter() uint64 {
+	if m != nil {
+		return m.BalanceAfter
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorRewardsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorRewardsRequest")
+	proto.RegisterType((*ValidatorRewardsResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorRewardsResponse")
+	proto.RegisterType((*ValidatorRewardsResponse_ValidatorRewards)(nil), "ethereum.beacon.rpc.v1.ValidatorRewardsResponse.ValidatorRewards")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/rewards.proto", fileDescriptor_f1f510df47ec04d7) }
+
+var fileDescriptor_f1f510df47ec04d7 = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0x13, 0x3d,
+	0x14, 0xc5, 0x35, 0xf9, 0xd3, 0x7c, 0xbd, 0x99, 0xf6, 0x83, 0xa1, 0x2a, 0xa3, 0x08, 0xd2, 0x34,
+	0x08, 0x91, 0x0d, 0x63, 0x52, 0x78, 0x81, 0x56, 0x48, 0x2c, 0xd8, 0xa0, 0x59, 0xb0, 0x61, 0x11,
+	0x39, 0x9e, 0xdb, 0xc4, 0x30, 0xd8, 0xc6, 0x76, 0x02, 0xd9, 0xb2, 0x67, 0xc5, 0x1b, 0xf0, 0x00,
+	0x3c, 0x07, 0x4b, 0x24, 0x5e, 0x00, 0x45, 0xbc, 0x06, 0x12, 0x1a, 0x7b, 0x9c, 0x48, 0x84, 0x4a,
+	0xb0, 0xf4, 0x39, 0xe7, 0xfe, 0xae, 0xef, 0x8c, 0x2f, 0x9c, 0x2a, 0x2d, 0xad, 0x24, 0x53, 0xa4,
+	0x4c, 0x0a, 0xa2, 0x15, 0x23, 0xcb, 0x31, 0xd1, 0xf8, 0x96, 0xea, 0xc2, 0x64, 0xce, 0x4b, 0x8e,
+	0xd1, 0xce, 0x51, 0xe3, 0xe2, 0x75, 0xe6, 0x53, 0x99, 0x56, 0x2c, 0x5b, 0x8e, 0x7b, 0xb7, 0x66,
+	0x52, 0xce, 0x4a, 0x24, 0x54, 0x71, 0x42, 0x85, 0x90, 0x96, 0x5a, 0x2e, 0x45, 0x5d, 0x35, 0x7c,
+	0x09, 0x37, 0x9f, 0xd3, 0x92, 0x17, 0xd4, 0x4a, 0x9d, 0x7b, 0x5e, 0x8e, 0x6f, 0x16, 0x68, 0x6c,
+	0x72, 0x04, 0x6d, 0x54, 0x92, 0xcd, 0xd3, 0x68, 0x10, 0x8d, 0x5a, 0xb9, 0x3f, 0x24, 0x27, 0xd0,
+	0x55, 0x8b, 0x69, 0xc9, 0xd9, 0xe4, 0x15, 0xae, 0x4c, 0xda, 0x18, 0x34, 0x47, 0x71, 0x0e, 0x5e,
+	0x7a, 0x8a, 0x2b, 0x93, 0xa4, 0xd0, 0xe1, 0xa2, 0xe0, 0x0c, 0x4d, 0xda, 0x1c, 0x34, 0x47, 0xad,
+	0x3c, 0x1c, 0x87, 0x1f, 0xda, 0x90, 0xee, 0x36, 0x33, 0x4a, 0x0a, 0x83, 0x57, 0x74, 0x7b, 0x01,
+	0x9d, 0x7a, 0x4a, 0xd7, 0xa9, 0x7b, 0x76, 0x9e, 0xfd, 0x79, 0xcc, 0xec, 0x2a, 0xf0, 0xae, 0x11,
+	0x88, 0xbd, 0x9f, 0x4d, 0xb8, 0xf6, 0xbb, 0x9b, 0xdc, 0x06, 0xd8, 0xce, 0xe7, 0x2e, 0x13, 0xe7,
+	0xfb, 0x9b, 0xf1, 0xaa, 0x6b, 0x72, 0x51, 0xe0, 0xbb, 0xb4, 0xe1, 0xaf, 0xe9, 0x0e, 0xc9, 0x1d,
+	0x38, 0x30, 0x72, 0xa1, 0x19, 0x4e, 0x3c, 0x3b, 0x6d, 0x3a, 0x37, 0xf6, 0xa2, 0x47, 0x27, 0x77,
+	0xe1, 0xb0, 0x0e, 0x29, 0x14, 0xb4, 0xb4, 0xab, 0xb4, 0xe5, 0x52, 0x75, 0xe9, 0x33, 0x2f, 0x56,
+	0x2c, 0x4b, 0xf5, 0x0c, 0x6d, 0x60, 0xb5, 0x3d, 0xcb, 0x8b, 0x5b, 0x56, 0x1d, 0x0a, 0xac, 0x3d,
+	0xcf, 0xf2, 0x6a, 0x60, 0x9d, 0x40, 0x77, 0x8e, 0xb4, 0x08, 0xa4, 0x8e, 0xcb, 0x40, 0x25, 0xd5,
+	0x9c, 0x53, 0x88, 0x5d, 0x20, 0x50, 0xfe, 0x73, 0x09, 0x57, 0x14, 0x18, 0x8f, 0xe0, 0x98, 0x0b,
+	0x56, 0x2e, 0x0c, 0x97, 0x62, 0x52, 0x60, 0x49, 0x57, 0x01, 0xb7, 0xef, 0xc2, 0x47, 0x1b, 0xf7,
+	0x71, 0x65, 0xd6, 0xe0, 0xfb, 0x90, 0x70, 0x41, 0x99, 0xe5, 0x4b, 0x6e, 0x57, 0x1b, 0x3c, 0xb8,
+	0x8a, 0xeb, 0x5b, 0x27, 0x34, 0xb9, 0x07, 0xff, 0x2b, 0x2d, 0x95, 0x34, 0xa8, 0x03, 0xbd, 0xeb,
+	0xb2, 0x87, 0x41, 0xde, 0x0e, 0x3e, 0xa5, 0x25, 0x15, 0x0c, 0x27, 0x53, 0xbc, 0x94, 0x1a, 0xd3,
+	0xd8, 0x0f, 0x5e, 0xab, 0x17, 0x4e, 0xac, 0x3e, 0x62, 0x88, 0xd1, 0x4b, 0x8b, 0x3a, 0x3d, 0xf0,
+	0x1f, 0xb1, 0x16, 0xcf, 0x2b, 0xed, 0xec, 0x73, 0x04, 0x9d, 0xf0, 0xdb, 0x3f, 0x45, 0x70, 0xe3,
+	0x09, 0xda, 0x9d, 0xe7, 0x40, 0xfe, 0xfe, 0xbd, 0xb9, 0xad, 0xe9, 0x3d, 0xf8, 0xd7, 0x07, 0x3a,
+	0x1c, 0xbd, 0xff, 0xf6, 0xe3, 0x63, 0x63, 0x98, 0x0c, 0x08, 0xda, 0x39, 0x59, 0x8e, 0x69, 0xa9,
+	0xe6, 0x74, 0x4c, 0x96, 0x21, 0x6f, 0xc2, 0xa2, 0x5f, 0xc4, 0x5f, 0xd6, 0xfd, 0xe8, 0xeb, 0xba,
+	0x1f, 0x7d, 0x5f, 0xf7, 0xa3, 0xe9, 0x9e, 0xdb, 0xe0, 0x87, 0xbf, 0x06, 0x00, 0xa3, 0x88, 0x01,
+	0xde, 0x1c, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RewardsClient is the client API for Rewards service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RewardsClient interface {
+	GetValidatorRewards(ctx context.Context, in *ValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewardsResponse, error)
+}
+
+type rewardsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRewardsClient(cc *grpc.ClientConn) RewardsClient {
+	return &rewardsClient{cc}
+}
+
+func (c *rewardsClient) GetValidatorRewards(ctx context.Context, in *ValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewardsResponse, error) {
+	out := new(ValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Rewards/GetValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RewardsServer is the server API for Rewards service.
+type RewardsServer interface {
+	GetValidatorRewards(context.Context, *ValidatorRewardsRequest) (*ValidatorRewardsResponse, error)
+}
+
+// UnimplementedRewardsServer can be embedded to have forward compatible implementations.
+type UnimplementedRewardsServer struct {
+}
+
+func (*UnimplementedRewardsServer) GetValidatorRewards(ctx context.Context, req *ValidatorRewardsRequest) (*ValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorRewards not implemented")
+}
+
+func RegisterRewardsServer(s *grpc.Server, srv RewardsServer) {
+	s.RegisterService(&_Rewards_serviceDesc, srv)
+}
+
+func _Rewards_GetValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardsServer).GetValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Rewards/GetValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardsServer).GetValidatorRewards(ctx, req.(*ValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Rewards_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Rewards",
+	HandlerType: (*RewardsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorRewards",
+			Handler:    _Rewards_GetValidatorRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/rewards.proto",
+}
+
+func (m *ValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintRewards(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintRewards(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BalanceAfter != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.BalanceAfter))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BalanceBefore != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.BalanceBefore))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ProposerReward != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.ProposerReward))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.InactivityPenalty != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.InactivityPenalty))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.InclusionDelayReward != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.InclusionDelayReward))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HeadPenalty != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.HeadPenalty))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HeadReward != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.HeadReward))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TargetPenalty != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TargetPenalty))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TargetReward != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TargetReward))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SourcePenalty != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.SourcePenalty))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceReward != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.SourceReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovRewards(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovRewards(uint64(e))
+		}
+		n += 1 + sovRewards(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovRewards(uint64(m.Epoch))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewardsResponse_ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovRewards(uint64(m.Index))
+	}
+	if m.SourceReward != 0 {
+		n += 1 + sovRewards(uint64(m.SourceReward))
+	}
+	if m.SourcePenalty != 0 {
+		n += 1 + sovRewards(uint64(m.SourcePenalty))
+	}
+	if m.TargetReward != 0 {
+		n += 1 + sovRewards(uint64(m.TargetReward))
+	}
+	if m.TargetPenalty != 0 {
+		n += 1 + sovRewards(uint64(m.TargetPenalty))
+	}
+	if m.HeadReward != 0 {
+		n += 1 + sovRewards(uint64(m.HeadReward))
+	}
+	if m.HeadPenalty != 0 {
+		n += 1 + sovRewards(uint64(m.HeadPenalty))
+	}
+	if m.InclusionDelayReward != 0 {
+		n += 1 + sovRewards(uint64(m.InclusionDelayReward))
+	}
+	if m.InactivityPenalty != 0 {
+		n += 1 + sovRewards(uint64(m.InactivityPenalty))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovRewards(uint64(m.ProposerReward))
+	}
+	if m.BalanceBefore != 0 {
+		n += 1 + sovRewards(uint64(m.BalanceBefore))
+	}
+	if m.BalanceAfter != 0 {
+		n += 1 + sovRewards(uint64(m.BalanceAfter))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewards(x uint64) (n int) {
+	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRewards
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRewards
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRewards
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRewards
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRewards
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &ValidatorRewardsResponse_ValidatorRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardsResponse_ValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
+			}
+			m.SourceReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
+			}
+			m.SourcePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
+			}
+			m.TargetReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
+			}
+			m.TargetPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
+			}
+			m.HeadReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
+			}
+			m.HeadPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
+			}
+			m.InclusionDelayReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDelayReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
+			}
+			m.InactivityPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+			m.ProposerReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
+			}
+			m.BalanceBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceAfter", wireType)
+			}
+			m.BalanceAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceAfter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewards(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewards
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewards
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewards
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewards        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewards          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewards = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: proto/beacon/rpc/v1/liveness.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ValidatorLivenessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (x *ValidatorLivenessRequest) Reset() {
+	*x = ValidatorLivenessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_liveness_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorLivenessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorLivenessRequest) ProtoMessage() {}
+
+func (x *ValidatorLivenessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_liveness_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorLivenessRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorLivenessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_liveness_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorLivenessRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorLivenessRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorLivenessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch    uint64                                         `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Liveness []*ValidatorLivenessResponse_ValidatorLiveness `protobuf:"bytes,2,rep,name=liveness,proto3" json:"liveness,omitempty"`
+}
+
+func (x *ValidatorLivenessResponse) Reset() {
+	*x = ValidatorLivenessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_liveness_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorLivenessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorLivenessResponse) ProtoMessage() {}
+
+func (x *ValidatorLivenessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_liveness_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorLivenessResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorLivenessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_liveness_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorLivenessResponse) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorLivenessResponse) GetLiveness() []*ValidatorLivenessResponse_ValidatorLiveness {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+type ValidatorLivenessResponse_ValidatorLiveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Index     uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	IsLive    bool   `protobuf:"varint,3,opt,name=is_live,json=isLive,proto3" json:"is_live,omitempty"`
+}
+
+func (x *ValidatorLivenessResponse_ValidatorLiveness) Reset() {
+	*x = ValidatorLivenessResponse_ValidatorLiveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_beacon_rpc_v1_liveness_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorLivenessResponse_ValidatorLiveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorLivenessResponse_ValidatorLiveness) ProtoMessage() {}
+
+func (x *ValidatorLivenessResponse_ValidatorLiveness) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_beacon_rpc_v1_liveness_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorLivenessResponse_ValidatorLiveness.ProtoReflect.Descriptor instead.
+func (*ValidatorLivenessResponse_ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return file_proto_beacon_rpc_v1_liveness_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ValidatorLivenessResponse_ValidatorLiveness) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ValidatorLivenessResponse_ValidatorLiveness) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValidatorLivenessResponse_ValidatorLiveness) GetIsLive() bool {
+	if x != nil {
+		return x.IsLive
+	}
+	return false
+}
+
+var File_proto_beacon_rpc_v1_liveness_proto protoreflect.FileDescriptor
+
+var file_proto_beacon_rpc_v1_liveness_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x18, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xf5, 0x01,
+	0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x5f, 0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65,
+	0x73, 0x73, 0x1a, 0x61, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c,
+	0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
+	0x73, 0x4c, 0x69, 0x76, 0x65, 0x32, 0xb3, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65,
+	0x73, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4c, 0x69,
+	0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x2f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_beacon_rpc_v1_liveness_proto_rawDescOnce sync.Once
+	file_proto_beacon_rpc_v1_liveness_proto_rawDescData = file_proto_beacon_rpc_v1_liveness_proto_rawDesc
+)
+
+func file_proto_beacon_rpc_v1_liveness_proto_rawDescGZIP() []byte {
+	file_proto_beacon_rpc_v1_liveness_proto_rawDescOnce.Do(func() {
+		file_proto_beacon_rpc_v1_liveness_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_beacon_rpc_v1_liveness_proto_rawDescData)
+	})
+	return file_proto_beacon_rpc_v1_liveness_proto_rawDescData
+}
+
+var file_proto_beacon_rpc_v1_liveness_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_beacon_rpc_v1_liveness_proto_goTypes = []interface{}{
+	(*ValidatorLivenessRequest)(nil),                    // 0: ethereum.beacon.rpc.v1.ValidatorLivenessRequest
+	(*ValidatorLivenessResponse)(nil),                   // 1: ethereum.beacon.rpc.v1.ValidatorLivenessResponse
+	(*ValidatorLivenessResponse_ValidatorLiveness)(nil), // 2: ethereum.beacon.rpc.v1.ValidatorLivenessResponse.ValidatorLiveness
+}
+var file_proto_beacon_rpc_v1_liveness_proto_depIdxs = []int32{
+	2, // 0: ethereum.beacon.rpc.v1.ValidatorLivenessResponse.liveness:type_name -> ethereum.beacon.rpc.v1.ValidatorLivenessResponse.ValidatorLiveness
+	0, // 1: ethereum.beacon.rpc.v1.Liveness.GetValidatorLiveness:input_type -> ethereum.beacon.rpc.v1.ValidatorLivenessRequest
+	1, // 2: ethereum.beacon.rpc.v1.Liveness.GetValidatorLiveness:output_type -> ethereum.beacon.rpc.v1.ValidatorLivenessResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_beacon_rpc_v1_liveness_proto_init() }
+func file_proto_beacon_rpc_v1_liveness_proto_init() {
+	if File_proto_beacon_rpc_v1_liveness_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_beacon_rpc_v1_liveness_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorLivenessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_liveness_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorLivenessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_beacon_rpc_v1_liveness_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorLivenessResponse_ValidatorLiveness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_beacon_rpc_v1_liveness_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_beacon_rpc_v1_liveness_proto_goTypes,
+		DependencyIndexes: file_proto_beacon_rpc_v1_liveness_proto_depIdxs,
+		MessageInfos:      file_proto_beacon_rpc_v1_liveness_proto_msgTypes,
+	}.Build()
+	File_proto_beacon_rpc_v1_liveness_proto = out.File
+	file_proto_beacon_rpc_v1_liveness_proto_rawDesc = nil
+	file_proto_beacon_rpc_v1_liveness_proto_goTypes = nil
+	file_proto_beacon_rpc_v1_liveness_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// LivenessClient is the client API for Liveness service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LivenessClient interface {
+	GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error)
+}
+
+type livenessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLivenessClient(cc grpc.ClientConnInterface) LivenessClient {
+	return &livenessClient{cc}
+}
+
+func (c *livenessClient) GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error) {
+	out := new(ValidatorLivenessResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Liveness/GetValidatorLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LivenessServer is the server API for Liveness service.
+type LivenessServer interface {
+	GetValidatorLiveness(context.Context, *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error)
+}
+
+// UnimplementedLivenessServer can be embedded to have forward compatible implementations.
+type UnimplementedLivenessServer struct {
+}
+
+func (*UnimplementedLivenessServer) GetValidatorLiveness(context.Context, *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorLiveness not implemented")
+}
+
+func RegisterLivenessServer(s *grpc.Server, srv LivenessServer) {
+	s.RegisterService(&_Liveness_serviceDesc, srv)
+}
+
+func _Liveness_GetValidatorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LivenessServer).GetValidatorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Liveness/GetValidatorLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LivenessServer).GetValidatorLiveness(ctx, req.(*ValidatorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Liveness_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Liveness",
+	HandlerType: (*LivenessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorLiveness",
+			Handler:    _Liveness_GetValidatorLiveness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/liveness.proto",
+}
@@ -50,3 +50,116 @@ func V1ToV1Alpha1Block(alphaBlk *ethpb.SignedBeaconBlock) (*ethpb_alpha.SignedBe
 	}
 	return v1alpha1Block, nil
 }
+
+// V1Alpha1ValidatorToV1 converts a v1alpha1 Validator proto to a v1 proto.
+func V1Alpha1ValidatorToV1(v1Alpha1Validator *ethpb_alpha.Validator) *ethpb.Validator {
+	if v1Alpha1Validator == nil {
+		return &ethpb.Validator{}
+	}
+	return &ethpb.Validator{
+		PublicKey:                  v1Alpha1Validator.PublicKey,
+		WithdrawalCredentials:      v1Alpha1Validator.WithdrawalCredentials,
+		EffectiveBalance:           v1Alpha1Validator.EffectiveBalance,
+		Slashed:                    v1Alpha1Validator.Slashed,
+		ActivationEligibilityEpoch: v1Alpha1Validator.ActivationEligibilityEpoch,
+		ActivationEpoch:            v1Alpha1Validator.ActivationEpoch,
+		ExitEpoch:                  v1Alpha1Validator.ExitEpoch,
+		WithdrawableEpoch:          v1Alpha1Validator.WithdrawableEpoch,
+	}
+}
+
+// V1Alpha1CheckpointToV1 converts a v1alpha1 Checkpoint proto to a v1 proto.
+func V1Alpha1CheckpointToV1(v1Alpha1Checkpoint *ethpb_alpha.Checkpoint) *ethpb.Checkpoint {
+	if v1Alpha1Checkpoint == nil {
+		return &ethpb.Checkpoint{}
+	}
+	return &ethpb.Checkpoint{
+		Epoch: v1Alpha1Checkpoint.Epoch,
+		Root:  v1Alpha1Checkpoint.Root,
+	}
+}
+
+// V1Alpha1AttestationToV1 converts a v1alpha1 Attestation proto to a v1 proto.
+func V1Alpha1AttestationToV1(v1Alpha1Att *ethpb_alpha.Attestation) (*ethpb.Attestation, error) {
+	v1Att := &ethpb.Attestation{}
+	if err := convert(v1Alpha1Att, v1Att); err != nil {
+		return nil, errors.Wrap(err, "could not convert attestation")
+	}
+	return v1Att, nil
+}
+
+// V1AttestationToV1Alpha1 converts a v1 Attestation proto to a v1alpha1 proto.
+func V1AttestationToV1Alpha1(v1Att *ethpb.Attestation) (*ethpb_alpha.Attestation, error) {
+	v1Alpha1Att := &ethpb_alpha.Attestation{}
+	if err := convert(v1Att, v1Alpha1Att); err != nil {
+		return nil, errors.Wrap(err, "could not convert attestation")
+	}
+	return v1Alpha1Att, nil
+}
+
+// V1Alpha1AttSlashingToV1 converts a v1alpha1 AttesterSlashing proto to a v1 proto.
+func V1Alpha1AttSlashingToV1(v1Alpha1Slashing *ethpb_alpha.AttesterSlashing) (*ethpb.AttesterSlashing, error) {
+	v1Slashing := &ethpb.AttesterSlashing{}
+	if err := convert(v1Alpha1Slashing, v1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not convert attester slashing")
+	}
+	return v1Slashing, nil
+}
+
+// V1AttSlashingToV1Alpha1 converts a v1 AttesterSlashing proto to a v1alpha1 proto.
+func V1AttSlashingToV1Alpha1(v1Slashing *ethpb.AttesterSlashing) (*ethpb_alpha.AttesterSlashing, error) {
+	v1Alpha1Slashing := &ethpb_alpha.AttesterSlashing{}
+	if err := convert(v1Slashing, v1Alpha1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not convert attester slashing")
+	}
+	return v1Alpha1Slashing, nil
+}
+
+// V1Alpha1ProposerSlashingToV1 converts a v1alpha1 ProposerSlashing proto to a v1 proto.
+func V1Alpha1ProposerSlashingToV1(v1Alpha1Slashing *ethpb_alpha.ProposerSlashing) (*ethpb.ProposerSlashing, error) {
+	v1Slashing := &ethpb.ProposerSlashing{}
+	if err := convert(v1Alpha1Slashing, v1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not convert proposer slashing")
+	}
+	return v1Slashing, nil
+}
+
+// V1ProposerSlashingToV1Alpha1 converts a v1 ProposerSlashing proto to a v1alpha1 proto.
+func V1ProposerSlashingToV1Alpha1(v1Slashing *ethpb.ProposerSlashing) (*ethpb_alpha.ProposerSlashing, error) {
+	v1Alpha1Slashing := &ethpb_alpha.ProposerSlashing{}
+	if err := convert(v1Slashing, v1Alpha1Slashing); err != nil {
+		return nil, errors.Wrap(err, "could not convert proposer slashing")
+	}
+	return v1Alpha1Slashing, nil
+}
+
+// V1Alpha1ExitToV1 converts a v1alpha1 SignedVoluntaryExit proto to a v1 proto.
+func V1Alpha1ExitToV1(v1Alpha1Exit *ethpb_alpha.SignedVoluntaryExit) (*ethpb.SignedVoluntaryExit, error) {
+	v1Exit := &ethpb.SignedVoluntaryExit{}
+	if err := convert(v1Alpha1Exit, v1Exit); err != nil {
+		return nil, errors.Wrap(err, "could not convert voluntary exit")
+	}
+	return v1Exit, nil
+}
+
+// V1ExitToV1Alpha1 converts a v1 SignedVoluntaryExit proto to a v1alpha1 proto.
+func V1ExitToV1Alpha1(v1Exit *ethpb.SignedVoluntaryExit) (*ethpb_alpha.SignedVoluntaryExit, error) {
+	v1Alpha1Exit := &ethpb_alpha.SignedVoluntaryExit{}
+	if err := convert(v1Exit, v1Alpha1Exit); err != nil {
+		return nil, errors.Wrap(err, "could not convert voluntary exit")
+	}
+	return v1Alpha1Exit, nil
+}
+
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// convert copies a proto message into its wire compatible counterpart of another API version.
+func convert(from marshaler, to proto.Message) error {
+	marshaled, err := from.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal")
+	}
+	return proto.Unmarshal(marshaled, to)
+}
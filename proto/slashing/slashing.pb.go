@@ -30,6 +30,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type SlashingStatus int32
+
+const (
+	SlashingStatus_DETECTED  SlashingStatus = 0
+	SlashingStatus_SUBMITTED SlashingStatus = 1
+	SlashingStatus_INCLUDED  SlashingStatus = 2
+)
+
+var SlashingStatus_name = map[int32]string{
+	0: "DETECTED",
+	1: "SUBMITTED",
+	2: "INCLUDED",
+}
+
+var SlashingStatus_value = map[string]int32{
+	"DETECTED":  0,
+	"SUBMITTED": 1,
+	"INCLUDED":  2,
+}
+
+func (x SlashingStatus) String() string {
+	return proto.EnumName(SlashingStatus_name, int32(x))
+}
+
+func (SlashingStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{0}
+}
+
+type SlashingReason int32
+
+const (
+	SlashingReason_DOUBLE_PROPOSAL SlashingReason = 0
+	SlashingReason_DOUBLE_VOTE     SlashingReason = 1
+	SlashingReason_SURROUND_VOTE   SlashingReason = 2
+)
+
+var SlashingReason_name = map[int32]string{
+	0: "DOUBLE_PROPOSAL",
+	1: "DOUBLE_VOTE",
+	2: "SURROUND_VOTE",
+}
+
+var SlashingReason_value = map[string]int32{
+	"DOUBLE_PROPOSAL": 0,
+	"DOUBLE_VOTE":     1,
+	"SURROUND_VOTE":   2,
+}
+
+func (x SlashingReason) String() string {
+	return proto.EnumName(SlashingReason_name, int32(x))
+}
+
+func (SlashingReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{1}
+}
+
 type HighestAttestationRequest struct {
 	ValidatorIds         []uint64 `protobuf:"varint,1,rep,packed,name=validator_ids,json=validatorIds,proto3" json:"validator_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -328,6 +384,305 @@ func (m *AttesterSlashingResponse) GetAttesterSlashing() []*v1alpha1.AttesterSla
 	return nil
 }
 
+type SlashingEvidenceRequest struct {
+	ValidatorIndices     []uint64         `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	StartEpoch           uint64           `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64           `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Statuses             []SlashingStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=ethereum.slashing.SlashingStatus" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SlashingEvidenceRequest) Reset()         { *m = SlashingEvidenceRequest{} }
+func (m *SlashingEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashingEvidenceRequest) ProtoMessage()    {}
+func (*SlashingEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{6}
+}
+func (m *SlashingEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashingEvidenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashingEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingEvidenceRequest.Merge(m, src)
+}
+func (m *SlashingEvidenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingEvidenceRequest proto.InternalMessageInfo
+
+func (m *SlashingEvidenceRequest) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *SlashingEvidenceRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *SlashingEvidenceRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *SlashingEvidenceRequest) GetStatuses() []SlashingStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type ProposerSlashingEvidence struct {
+	Slashing             *v1alpha1.ProposerSlashing `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	Reason               SlashingReason             `protobuf:"varint,2,opt,name=reason,proto3,enum=ethereum.slashing.SlashingReason" json:"reason,omitempty"`
+	Status               SlashingStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ProposerSlashingEvidence) Reset()         { *m = ProposerSlashingEvidence{} }
+func (m *ProposerSlashingEvidence) String() string { return proto.CompactTextString(m) }
+func (*ProposerSlashingEvidence) ProtoMessage()    {}
+func (*ProposerSlashingEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{7}
+}
+func (m *ProposerSlashingEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSlashingEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSlashingEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSlashingEvidence.Merge(m, src)
+}
+func (m *ProposerSlashingEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSlashingEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSlashingEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSlashingEvidence proto.InternalMessageInfo
+
+func (m *ProposerSlashingEvidence) GetSlashing() *v1alpha1.ProposerSlashing {
+	if m != nil {
+		return m.Slashing
+	}
+	return nil
+}
+
+func (m *ProposerSlashingEvidence) GetReason() SlashingReason {
+	if m != nil {
+		return m.Reason
+	}
+	return SlashingReason_DOUBLE_PROPOSAL
+}
+
+func (m *ProposerSlashingEvidence) GetStatus() SlashingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashingStatus_DETECTED
+}
+
+type ProposerSlashingEvidenceResponse struct {
+	Evidence             []*ProposerSlashingEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ProposerSlashingEvidenceResponse) Reset()         { *m = ProposerSlashingEvidenceResponse{} }
+func (m *ProposerSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposerSlashingEvidenceResponse) ProtoMessage()    {}
+func (*ProposerSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{8}
+}
+func (m *ProposerSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSlashingEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSlashingEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSlashingEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSlashingEvidenceResponse.Merge(m, src)
+}
+func (m *ProposerSlashingEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSlashingEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSlashingEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSlashingEvidenceResponse proto.InternalMessageInfo
+
+func (m *ProposerSlashingEvidenceResponse) GetEvidence() []*ProposerSlashingEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+type AttesterSlashingEvidence struct {
+	Slashing             *v1alpha1.AttesterSlashing `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	SlashableIndices     []uint64                   `protobuf:"varint,2,rep,packed,name=slashable_indices,json=slashableIndices,proto3" json:"slashable_indices,omitempty"`
+	Reason               SlashingReason             `protobuf:"varint,3,opt,name=reason,proto3,enum=ethereum.slashing.SlashingReason" json:"reason,omitempty"`
+	Status               SlashingStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=ethereum.slashing.SlashingStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *AttesterSlashingEvidence) Reset()         { *m = AttesterSlashingEvidence{} }
+func (m *AttesterSlashingEvidence) String() string { return proto.CompactTextString(m) }
+func (*AttesterSlashingEvidence) ProtoMessage()    {}
+func (*AttesterSlashingEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{9}
+}
+func (m *AttesterSlashingEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterSlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterSlashingEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterSlashingEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterSlashingEvidence.Merge(m, src)
+}
+func (m *AttesterSlashingEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterSlashingEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterSlashingEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterSlashingEvidence proto.InternalMessageInfo
+
+func (m *AttesterSlashingEvidence) GetSlashing() *v1alpha1.AttesterSlashing {
+	if m != nil {
+		return m.Slashing
+	}
+	return nil
+}
+
+func (m *AttesterSlashingEvidence) GetSlashableIndices() []uint64 {
+	if m != nil {
+		return m.SlashableIndices
+	}
+	return nil
+}
+
+func (m *AttesterSlashingEvidence) GetReason() SlashingReason {
+	if m != nil {
+		return m.Reason
+	}
+	return SlashingReason_DOUBLE_PROPOSAL
+}
+
+func (m *AttesterSlashingEvidence) GetStatus() SlashingStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SlashingStatus_DETECTED
+}
+
+type AttesterSlashingEvidenceResponse struct {
+	Evidence             []*AttesterSlashingEvidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AttesterSlashingEvidenceResponse) Reset()         { *m = AttesterSlashingEvidenceResponse{} }
+func (m *AttesterSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*AttesterSlashingEvidenceResponse) ProtoMessage()    {}
+func (*AttesterSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da7e95107d0081b4, []int{10}
+}
+func (m *AttesterSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterSlashingEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterSlashingEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterSlashingEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterSlashingEvidenceResponse.Merge(m, src)
+}
+func (m *AttesterSlashingEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterSlashingEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterSlashingEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterSlashingEvidenceResponse proto.InternalMessageInfo
+
+func (m *AttesterSlashingEvidenceResponse) GetEvidence() []*AttesterSlashingEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 type ProposalHistory struct {
 	EpochBits            github_com_prysmaticlabs_go_bitfield.Bitlist `protobuf:"bytes,1,opt,name=epoch_bits,json=epochBits,proto3,casttype=github.com/prysmaticlabs/go-bitfield.Bitlist" json:"epoch_bits,omitempty"`
 	LatestEpochWritten   uint64                                       `protobuf:"varint,2,opt,name=latest_epoch_written,json=latestEpochWritten,proto3" json:"latest_epoch_written,omitempty"`
@@ -340,7 +695,7 @@ func (m *ProposalHistory) Reset()         { *m = ProposalHistory{} }
 func (m *ProposalHistory) String() string { return proto.CompactTextString(m) }
 func (*ProposalHistory) ProtoMessage()    {}
 func (*ProposalHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{6}
+	return fileDescriptor_da7e95107d0081b4, []int{11}
 }
 func (m *ProposalHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +750,7 @@ func (m *AttestationHistory) Reset()         { *m = AttestationHistory{} }
 func (m *AttestationHistory) String() string { return proto.CompactTextString(m) }
 func (*AttestationHistory) ProtoMessage()    {}
 func (*AttestationHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_da7e95107d0081b4, []int{7}
+	return fileDescriptor_da7e95107d0081b4, []int{12}
 }
 func (m *AttestationHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,12 +794,19 @@ func (m *AttestationHistory) GetLatestEpochWritten() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("ethereum.slashing.SlashingStatus", SlashingStatus_name, SlashingStatus_value)
+	proto.RegisterEnum("ethereum.slashing.SlashingReason", SlashingReason_name, SlashingReason_value)
 	proto.RegisterType((*HighestAttestationRequest)(nil), "ethereum.slashing.HighestAttestationRequest")
 	proto.RegisterType((*HighestAttestationResponse)(nil), "ethereum.slashing.HighestAttestationResponse")
 	proto.RegisterType((*HighestAttestation)(nil), "ethereum.slashing.HighestAttestation")
 	proto.RegisterType((*ProposerSlashingResponse)(nil), "ethereum.slashing.ProposerSlashingResponse")
 	proto.RegisterType((*Slashable)(nil), "ethereum.slashing.Slashable")
 	proto.RegisterType((*AttesterSlashingResponse)(nil), "ethereum.slashing.AttesterSlashingResponse")
+	proto.RegisterType((*SlashingEvidenceRequest)(nil), "ethereum.slashing.SlashingEvidenceRequest")
+	proto.RegisterType((*ProposerSlashingEvidence)(nil), "ethereum.slashing.ProposerSlashingEvidence")
+	proto.RegisterType((*ProposerSlashingEvidenceResponse)(nil), "ethereum.slashing.ProposerSlashingEvidenceResponse")
+	proto.RegisterType((*AttesterSlashingEvidence)(nil), "ethereum.slashing.AttesterSlashingEvidence")
+	proto.RegisterType((*AttesterSlashingEvidenceResponse)(nil), "ethereum.slashing.AttesterSlashingEvidenceResponse")
 	proto.RegisterType((*ProposalHistory)(nil), "ethereum.slashing.ProposalHistory")
 	proto.RegisterType((*AttestationHistory)(nil), "ethereum.slashing.AttestationHistory")
 	proto.RegisterMapType((map[uint64]uint64)(nil), "ethereum.slashing.AttestationHistory.TargetToSourceEntry")
@@ -453,49 +815,69 @@ func init() {
 func init() { proto.RegisterFile("proto/slashing/slashing.proto", fileDescriptor_da7e95107d0081b4) }
 
 var fileDescriptor_da7e95107d0081b4 = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x4e, 0xd4, 0x40,
-	0x14, 0x4e, 0x01, 0x7f, 0x18, 0x56, 0x5d, 0x06, 0x62, 0xd6, 0x0d, 0x2e, 0x58, 0x63, 0x84, 0x08,
-	0x5d, 0xc0, 0x1b, 0xf5, 0x4a, 0x36, 0x31, 0x61, 0x6f, 0xd4, 0x2c, 0x18, 0x2f, 0x9b, 0x69, 0x7b,
-	0x68, 0x27, 0x94, 0x4e, 0x9d, 0x39, 0x8b, 0xee, 0x7b, 0x78, 0xe7, 0x43, 0xf8, 0x1a, 0x5e, 0xfa,
-	0x04, 0xc6, 0xf0, 0x00, 0x3e, 0x80, 0x57, 0xa6, 0x33, 0xed, 0x6e, 0xa1, 0x5d, 0xb3, 0xdc, 0x4d,
-	0xcf, 0xdf, 0x77, 0xce, 0x77, 0xbe, 0x99, 0x92, 0x87, 0xa9, 0x14, 0x28, 0xba, 0x2a, 0x66, 0x2a,
-	0xe2, 0x49, 0x38, 0x3e, 0x38, 0xda, 0x4e, 0x97, 0x01, 0x23, 0x90, 0x30, 0x3c, 0x73, 0x0a, 0x47,
-	0x7b, 0x1d, 0x30, 0xea, 0x9e, 0xef, 0xb1, 0x38, 0x8d, 0xd8, 0x5e, 0xd7, 0x03, 0xe6, 0x8b, 0xc4,
-	0xf5, 0x62, 0xe1, 0x9f, 0x9a, 0x9c, 0xf6, 0x4e, 0xc8, 0x31, 0x1a, 0x7a, 0x8e, 0x2f, 0xce, 0xba,
-	0xa1, 0x08, 0x45, 0x57, 0x9b, 0xbd, 0xe1, 0x89, 0xfe, 0x32, 0x78, 0xd9, 0xc9, 0x84, 0xdb, 0xaf,
-	0xc9, 0x83, 0x43, 0x1e, 0x46, 0xa0, 0xf0, 0x00, 0x11, 0x14, 0x32, 0xe4, 0x22, 0x19, 0xc0, 0xa7,
-	0x21, 0x28, 0xa4, 0x8f, 0xc9, 0x9d, 0x73, 0x16, 0xf3, 0x80, 0xa1, 0x90, 0x2e, 0x0f, 0x54, 0xcb,
-	0xda, 0x98, 0xdf, 0x5c, 0x18, 0x34, 0xc6, 0xc6, 0x7e, 0xa0, 0xec, 0x90, 0xb4, 0xeb, 0x2a, 0xa8,
-	0x54, 0x24, 0x0a, 0x68, 0x9f, 0x34, 0xd8, 0xc4, 0x6c, 0x2a, 0x2c, 0xed, 0x3f, 0x71, 0x2a, 0x93,
-	0x39, 0x35, 0x45, 0x2e, 0xa5, 0xda, 0xdf, 0x2c, 0x42, 0xab, 0x41, 0xf4, 0x11, 0x69, 0x94, 0x9b,
-	0x6c, 0x59, 0x1b, 0xd6, 0xe6, 0xc2, 0x60, 0xa9, 0xd4, 0x23, 0xdd, 0x25, 0xab, 0x91, 0x49, 0x74,
-	0x95, 0x18, 0x4a, 0x1f, 0x5c, 0x48, 0x85, 0x1f, 0xb5, 0xe6, 0x74, 0x28, 0xcd, 0x7d, 0x47, 0xda,
-	0xf5, 0x26, 0xf3, 0x94, 0x33, 0x90, 0xc9, 0x10, 0x30, 0xcf, 0x98, 0xbf, 0x94, 0x71, 0xac, 0x5d,
-	0x3a, 0xc3, 0x4e, 0x49, 0xeb, 0xbd, 0x14, 0xa9, 0x50, 0x20, 0x8f, 0xf2, 0x91, 0xc6, 0x24, 0x1c,
-	0x93, 0xe5, 0x34, 0xf7, 0xb9, 0xc5, 0xbc, 0x39, 0x13, 0x4f, 0x27, 0x4c, 0x00, 0x46, 0x4e, 0xb1,
-	0x59, 0xa7, 0x52, 0xab, 0x99, 0x5e, 0xb1, 0xd8, 0x5b, 0x64, 0x51, 0x9f, 0x99, 0x17, 0x03, 0x5d,
-	0x23, 0x8b, 0xaa, 0xf8, 0xd0, 0x14, 0xdc, 0x1e, 0x4c, 0x0c, 0x59, 0x73, 0x86, 0xb2, 0xfa, 0xe6,
-	0x58, 0xee, 0x9b, 0xb5, 0xb9, 0x4a, 0xad, 0x26, 0xbb, 0x62, 0xb1, 0xbf, 0x5a, 0xe4, 0x9e, 0x99,
-	0x81, 0xc5, 0x87, 0x5c, 0xa1, 0x90, 0x23, 0xfa, 0x8e, 0x10, 0xcd, 0xa2, 0xeb, 0x71, 0x54, 0xba,
-	0xc9, 0x46, 0x6f, 0xf7, 0xef, 0xaf, 0xf5, 0xed, 0x92, 0x64, 0x53, 0x39, 0x52, 0x67, 0x0c, 0xb9,
-	0x1f, 0x33, 0x4f, 0x75, 0x43, 0xb1, 0xe3, 0x71, 0x3c, 0xe1, 0x10, 0x07, 0x4e, 0x8f, 0x63, 0xcc,
-	0x15, 0x0e, 0x16, 0x75, 0x8d, 0x1e, 0x47, 0x95, 0x6d, 0x29, 0x66, 0x19, 0xb0, 0xd9, 0x8e, 0xfb,
-	0x59, 0x72, 0x44, 0x48, 0x8a, 0xbd, 0x1a, 0x9f, 0x5e, 0xcf, 0x47, 0xe3, 0xb1, 0xff, 0x58, 0x84,
-	0x96, 0xc4, 0x53, 0x74, 0xe6, 0x93, 0x66, 0xbe, 0x66, 0x14, 0xb9, 0x44, 0x72, 0x0a, 0x5e, 0xd6,
-	0x28, 0xb5, 0x5a, 0xc0, 0x31, 0x4a, 0x38, 0x16, 0xb9, 0x86, 0x12, 0x94, 0xa3, 0xc1, 0x5d, 0xbc,
-	0x64, 0xbc, 0x7e, 0xb7, 0xed, 0x03, 0xb2, 0x52, 0x53, 0x98, 0x36, 0xc9, 0xfc, 0x29, 0x8c, 0x72,
-	0xa1, 0x67, 0x47, 0xba, 0x4a, 0x6e, 0x9c, 0xb3, 0x78, 0x08, 0x79, 0x2d, 0xf3, 0xf1, 0x6a, 0xee,
-	0x85, 0xb5, 0xff, 0x7d, 0x81, 0xdc, 0xd2, 0x4b, 0x01, 0x49, 0x53, 0x72, 0xbf, 0xaf, 0xc6, 0x92,
-	0x29, 0xdf, 0xa1, 0xad, 0x29, 0x8b, 0xee, 0x27, 0x01, 0x7c, 0x81, 0xa0, 0x14, 0xda, 0x7e, 0x36,
-	0x95, 0x90, 0x1a, 0x6d, 0x09, 0xd2, 0x2c, 0x21, 0xf6, 0xb2, 0x67, 0x8a, 0x3a, 0x53, 0xb0, 0x8e,
-	0x78, 0x98, 0x40, 0xd0, 0xd3, 0x2f, 0x9a, 0x8e, 0x3c, 0x04, 0x16, 0x80, 0xac, 0x05, 0x9c, 0x7a,
-	0xd3, 0x38, 0xe9, 0xd4, 0x8f, 0xf8, 0x56, 0x7c, 0x48, 0x03, 0x86, 0x70, 0x9d, 0x51, 0xd7, 0x6a,
-	0x90, 0x27, 0x37, 0xce, 0x23, 0xad, 0xab, 0xb3, 0x8d, 0x41, 0x36, 0xa7, 0x80, 0x54, 0xa7, 0xfb,
-	0x3f, 0x86, 0x24, 0x2b, 0xd5, 0x17, 0x4f, 0xd1, 0xed, 0xd9, 0x9e, 0x4f, 0xf3, 0x8a, 0xb7, 0x77,
-	0x66, 0x8c, 0x36, 0x14, 0xf6, 0x1a, 0x3f, 0x2e, 0x3a, 0xd6, 0xcf, 0x8b, 0x8e, 0xf5, 0xfb, 0xa2,
-	0x63, 0x79, 0x37, 0xf5, 0x6f, 0xe2, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x5a, 0x08,
-	0xcf, 0xaa, 0x06, 0x00, 0x00,
+	// 985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xe3, 0xc4,
+	0x1b, 0x5f, 0x27, 0xfd, 0xf7, 0x9f, 0x3c, 0x4d, 0x5b, 0x67, 0xba, 0x82, 0x10, 0x96, 0xb6, 0x6b,
+	0x84, 0xe8, 0xb6, 0x5b, 0x67, 0xb7, 0x7b, 0x61, 0x41, 0x48, 0x34, 0x4d, 0x44, 0x23, 0x95, 0xa6,
+	0x72, 0x12, 0x38, 0x46, 0xe3, 0x78, 0x36, 0xb1, 0xea, 0x7a, 0x8c, 0x67, 0x52, 0xa8, 0xb8, 0xf1,
+	0x19, 0xb8, 0xf1, 0x09, 0xf8, 0x1c, 0x5c, 0x38, 0x72, 0xe1, 0x8a, 0x50, 0x3f, 0x00, 0x1f, 0x80,
+	0x13, 0xf2, 0xcc, 0xd8, 0x71, 0x9a, 0xb8, 0x9b, 0x94, 0xdb, 0xcc, 0xf3, 0xfe, 0xf2, 0x9b, 0xe7,
+	0x19, 0xf8, 0x20, 0x08, 0x29, 0xa7, 0x35, 0xe6, 0x61, 0x36, 0x72, 0xfd, 0x61, 0x72, 0x30, 0x05,
+	0x1d, 0x95, 0x09, 0x1f, 0x91, 0x90, 0x8c, 0xaf, 0xcc, 0x98, 0x51, 0xdd, 0x21, 0x7c, 0x54, 0xbb,
+	0x7e, 0x89, 0xbd, 0x60, 0x84, 0x5f, 0xd6, 0x6c, 0x82, 0x07, 0xd4, 0xef, 0xdb, 0x1e, 0x1d, 0x5c,
+	0x4a, 0x9d, 0xea, 0xe1, 0xd0, 0xe5, 0xa3, 0xb1, 0x6d, 0x0e, 0xe8, 0x55, 0x6d, 0x48, 0x87, 0xb4,
+	0x26, 0xc8, 0xf6, 0xf8, 0x8d, 0xb8, 0x49, 0x7f, 0xd1, 0x49, 0x8a, 0x1b, 0x5f, 0xc0, 0x7b, 0xa7,
+	0xee, 0x70, 0x44, 0x18, 0x3f, 0xe6, 0x9c, 0x30, 0x8e, 0xb9, 0x4b, 0x7d, 0x8b, 0x7c, 0x3b, 0x26,
+	0x8c, 0xa3, 0x0f, 0x61, 0xfd, 0x1a, 0x7b, 0xae, 0x83, 0x39, 0x0d, 0xfb, 0xae, 0xc3, 0x2a, 0xda,
+	0x6e, 0x7e, 0x6f, 0xc5, 0x2a, 0x25, 0xc4, 0x96, 0xc3, 0x8c, 0x21, 0x54, 0xe7, 0x59, 0x60, 0x01,
+	0xf5, 0x19, 0x41, 0x2d, 0x28, 0xe1, 0x09, 0x59, 0x5a, 0x58, 0x3b, 0xfa, 0xc8, 0x9c, 0xc9, 0xcc,
+	0x9c, 0x63, 0x64, 0x4a, 0xd5, 0xf8, 0x59, 0x03, 0x34, 0x2b, 0x84, 0x9e, 0x42, 0x29, 0x1d, 0x64,
+	0x45, 0xdb, 0xd5, 0xf6, 0x56, 0xac, 0xb5, 0x54, 0x8c, 0xe8, 0x05, 0x3c, 0x1e, 0x49, 0xc5, 0x3e,
+	0xa3, 0xe3, 0x70, 0x40, 0xfa, 0x24, 0xa0, 0x83, 0x51, 0x25, 0x27, 0x44, 0x91, 0xe2, 0x75, 0x04,
+	0xab, 0x19, 0x71, 0xd2, 0x1a, 0x1c, 0x87, 0x43, 0xc2, 0x95, 0x46, 0x7e, 0x4a, 0xa3, 0x2b, 0x58,
+	0x42, 0xc3, 0x08, 0xa0, 0x72, 0x11, 0xd2, 0x80, 0x32, 0x12, 0x76, 0x54, 0x4a, 0x49, 0x11, 0xba,
+	0x50, 0x0e, 0x14, 0xaf, 0x1f, 0xe7, 0xab, 0x2a, 0xf1, 0xf1, 0xa4, 0x12, 0x84, 0x8f, 0xcc, 0xb8,
+	0xb3, 0xe6, 0x8c, 0x2d, 0x3d, 0xb8, 0x43, 0x31, 0x9e, 0x41, 0x51, 0x9c, 0xb1, 0xed, 0x11, 0xf4,
+	0x04, 0x8a, 0x2c, 0xbe, 0x88, 0x12, 0x14, 0xac, 0x09, 0x21, 0x0a, 0x4e, 0x96, 0x6c, 0x7e, 0x70,
+	0x58, 0xf1, 0x16, 0x0d, 0x6e, 0xc6, 0x96, 0x8e, 0xef, 0x50, 0x8c, 0x5f, 0x35, 0x78, 0x37, 0xbe,
+	0x34, 0xaf, 0x5d, 0x87, 0xf8, 0x03, 0x12, 0xc3, 0xea, 0x00, 0xca, 0xa9, 0x8e, 0xf9, 0x8e, 0x3b,
+	0x20, 0x31, 0xb4, 0xf4, 0x49, 0xdb, 0x24, 0x1d, 0xed, 0xc0, 0x1a, 0xe3, 0x38, 0xe4, 0x53, 0x2d,
+	0x03, 0x41, 0x92, 0xad, 0x7a, 0x1f, 0x8a, 0xc4, 0x77, 0xa6, 0xfa, 0x53, 0x20, 0xbe, 0x23, 0x99,
+	0x9f, 0x43, 0x21, 0xc2, 0xc9, 0x98, 0x11, 0x56, 0x59, 0xd9, 0xcd, 0xef, 0x6d, 0x1c, 0x3d, 0x9d,
+	0x03, 0xbd, 0x38, 0xd0, 0x8e, 0x10, 0xb5, 0x12, 0x15, 0xe3, 0x0f, 0x6d, 0xb6, 0xab, 0x71, 0x36,
+	0xe8, 0x04, 0x0a, 0xa9, 0x7a, 0x69, 0xcb, 0x34, 0x33, 0x51, 0x44, 0xaf, 0x61, 0x35, 0x24, 0x98,
+	0x51, 0x5f, 0x64, 0x76, 0x7f, 0x78, 0x96, 0x10, 0xb4, 0x94, 0x42, 0xa4, 0x2a, 0x03, 0xad, 0xe4,
+	0xdf, 0xaa, 0xaa, 0x32, 0x53, 0x0a, 0xc6, 0x25, 0xec, 0x66, 0xa5, 0x95, 0xe0, 0xe2, 0x4b, 0x28,
+	0x10, 0x45, 0x53, 0x70, 0x38, 0x98, 0xe3, 0x20, 0xd3, 0x4c, 0xa2, 0x6c, 0xfc, 0x98, 0x9b, 0x45,
+	0xdf, 0x03, 0x8a, 0x38, 0x03, 0xba, 0x49, 0x11, 0x0f, 0xa0, 0x9c, 0x60, 0x3d, 0x01, 0x54, 0x4e,
+	0x02, 0x2a, 0x61, 0xc4, 0x80, 0x9a, 0x54, 0x3c, 0xff, 0xf0, 0x8a, 0xaf, 0x3c, 0xa0, 0xe2, 0x59,
+	0x35, 0x58, 0xb2, 0xe2, 0x99, 0x66, 0x26, 0x15, 0xff, 0x49, 0x83, 0x4d, 0xd9, 0x18, 0xec, 0x9d,
+	0xba, 0x8c, 0xd3, 0xf0, 0x06, 0xb5, 0x01, 0xc4, 0x13, 0xe9, 0xdb, 0x2e, 0x67, 0xa2, 0xd4, 0xa5,
+	0xfa, 0x8b, 0x7f, 0xfe, 0xdc, 0x79, 0x9e, 0xda, 0x17, 0x41, 0x78, 0xc3, 0xae, 0x30, 0x77, 0x07,
+	0x1e, 0xb6, 0x59, 0x6d, 0x48, 0x0f, 0x6d, 0x97, 0xbf, 0x71, 0x89, 0xe7, 0x98, 0x75, 0x97, 0x7b,
+	0x2e, 0xe3, 0x56, 0x51, 0xd8, 0xa8, 0xbb, 0x9c, 0x45, 0x23, 0xd2, 0xc3, 0x51, 0x28, 0xf2, 0xe9,
+	0xf5, 0xbf, 0x0b, 0x5d, 0xce, 0x89, 0x1f, 0x0f, 0x55, 0xc9, 0x13, 0xaf, 0xf0, 0x1b, 0xc9, 0x31,
+	0xfe, 0xd6, 0x00, 0xa5, 0x26, 0x77, 0x1c, 0xd9, 0x00, 0x74, 0x35, 0x63, 0x39, 0x55, 0xf3, 0x59,
+	0xa5, 0xff, 0x3a, 0x33, 0xfd, 0xb4, 0x01, 0x53, 0x8e, 0xe1, 0x2e, 0x55, 0x03, 0xdc, 0xe7, 0xe1,
+	0x8d, 0xb5, 0xc1, 0xa7, 0x88, 0xcb, 0x47, 0x5b, 0x3d, 0x86, 0xad, 0x39, 0x86, 0x91, 0x0e, 0xf9,
+	0x4b, 0x72, 0xa3, 0xb6, 0x4c, 0x74, 0x44, 0x8f, 0xe1, 0x7f, 0xd7, 0xd8, 0x1b, 0x13, 0x65, 0x4b,
+	0x5e, 0x3e, 0xcd, 0x7d, 0xa2, 0xed, 0x7f, 0x06, 0x1b, 0xd3, 0x70, 0x40, 0x25, 0x28, 0x34, 0x9a,
+	0xdd, 0xe6, 0x49, 0xb7, 0xd9, 0xd0, 0x1f, 0xa1, 0x75, 0x28, 0x76, 0x7a, 0xf5, 0xaf, 0x5a, 0xdd,
+	0xe8, 0xaa, 0x45, 0xcc, 0xd6, 0xf9, 0xc9, 0x59, 0xaf, 0xd1, 0x6c, 0xe8, 0xb9, 0xfd, 0x16, 0x6c,
+	0x4c, 0xc3, 0x10, 0x6d, 0xc1, 0x66, 0xa3, 0xdd, 0xab, 0x9f, 0x35, 0xfb, 0x17, 0x56, 0xfb, 0xa2,
+	0xdd, 0x39, 0x3e, 0xd3, 0x1f, 0xa1, 0x4d, 0x58, 0x53, 0xc4, 0xaf, 0xdb, 0xdd, 0xa6, 0xae, 0xa1,
+	0x32, 0xac, 0x77, 0x7a, 0x96, 0xd5, 0xee, 0x9d, 0x37, 0x24, 0x29, 0x77, 0xf4, 0xcb, 0x2a, 0xfc,
+	0x5f, 0xd8, 0x22, 0x21, 0x0a, 0xe0, 0x9d, 0x16, 0x4b, 0xf6, 0x46, 0x7a, 0x91, 0x3e, 0xcb, 0x78,
+	0x78, 0x2d, 0xdf, 0x21, 0xdf, 0x13, 0x27, 0x25, 0x5a, 0x5d, 0x04, 0x97, 0x09, 0xac, 0x29, 0xe8,
+	0x29, 0x8f, 0xf5, 0xe8, 0xaf, 0x82, 0xcc, 0x0c, 0x5f, 0x1d, 0x77, 0xe8, 0x13, 0xa7, 0x2e, 0xbe,
+	0x35, 0x42, 0xf2, 0x94, 0x60, 0x87, 0x84, 0xd5, 0x45, 0x46, 0x4f, 0xe2, 0xd0, 0x85, 0xed, 0xf9,
+	0x29, 0x9e, 0xd3, 0x5e, 0xe0, 0x60, 0x4e, 0x96, 0x49, 0xf5, 0x49, 0xd6, 0x1b, 0x17, 0x6b, 0xd7,
+	0x86, 0xca, 0xdd, 0xdc, 0x12, 0x27, 0x7b, 0x19, 0x4e, 0x66, 0xb3, 0xbb, 0xdf, 0x47, 0x08, 0x5b,
+	0xb3, 0xdf, 0x1e, 0x86, 0x9e, 0x2f, 0xf6, 0x87, 0x92, 0x3b, 0xb7, 0x7a, 0xb8, 0xa0, 0xb4, 0x2a,
+	0xe1, 0x0f, 0xf7, 0xec, 0xbd, 0xfd, 0x7b, 0xa6, 0xde, 0x9d, 0x55, 0x5f, 0x7d, 0xb5, 0xcc, 0xca,
+	0x48, 0x39, 0xcf, 0xdc, 0x17, 0xff, 0xd5, 0xf9, 0xdb, 0x86, 0x70, 0xbd, 0xf4, 0xdb, 0xed, 0xb6,
+	0xf6, 0xfb, 0xed, 0xb6, 0xf6, 0xd7, 0xed, 0xb6, 0x66, 0xaf, 0x8a, 0x5f, 0xf2, 0xab, 0x7f, 0x07,
+	0x00, 0x7f, 0x06, 0x38, 0xca, 0xa9, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -515,6 +897,8 @@ type SlasherClient interface {
 	IsSlashableAttestationNoUpdate(ctx context.Context, in *v1alpha1.IndexedAttestation, opts ...grpc.CallOption) (*Slashable, error)
 	IsSlashableBlockNoUpdate(ctx context.Context, in *v1alpha1.BeaconBlockHeader, opts ...grpc.CallOption) (*Slashable, error)
 	HighestAttestations(ctx context.Context, in *HighestAttestationRequest, opts ...grpc.CallOption) (*HighestAttestationResponse, error)
+	ProposerSlashingEvidence(ctx context.Context, in *SlashingEvidenceRequest, opts ...grpc.CallOption) (*ProposerSlashingEvidenceResponse, error)
+	AttesterSlashingEvidence(ctx context.Context, in *SlashingEvidenceRequest, opts ...grpc.CallOption) (*AttesterSlashingEvidenceResponse, error)
 }
 
 type slasherClient struct {
@@ -570,6 +954,24 @@ func (c *slasherClient) HighestAttestations(ctx context.Context, in *HighestAtte
 	return out, nil
 }
 
+func (c *slasherClient) ProposerSlashingEvidence(ctx context.Context, in *SlashingEvidenceRequest, opts ...grpc.CallOption) (*ProposerSlashingEvidenceResponse, error) {
+	out := new(ProposerSlashingEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/ProposerSlashingEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slasherClient) AttesterSlashingEvidence(ctx context.Context, in *SlashingEvidenceRequest, opts ...grpc.CallOption) (*AttesterSlashingEvidenceResponse, error) {
+	out := new(AttesterSlashingEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.slashing.Slasher/AttesterSlashingEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SlasherServer is the server API for Slasher service.
 type SlasherServer interface {
 	IsSlashableAttestation(context.Context, *v1alpha1.IndexedAttestation) (*AttesterSlashingResponse, error)
@@ -577,6 +979,8 @@ type SlasherServer interface {
 	IsSlashableAttestationNoUpdate(context.Context, *v1alpha1.IndexedAttestation) (*Slashable, error)
 	IsSlashableBlockNoUpdate(context.Context, *v1alpha1.BeaconBlockHeader) (*Slashable, error)
 	HighestAttestations(context.Context, *HighestAttestationRequest) (*HighestAttestationResponse, error)
+	ProposerSlashingEvidence(context.Context, *SlashingEvidenceRequest) (*ProposerSlashingEvidenceResponse, error)
+	AttesterSlashingEvidence(context.Context, *SlashingEvidenceRequest) (*AttesterSlashingEvidenceResponse, error)
 }
 
 // UnimplementedSlasherServer can be embedded to have forward compatible implementations.
@@ -598,6 +1002,12 @@ func (*UnimplementedSlasherServer) IsSlashableBlockNoUpdate(ctx context.Context,
 func (*UnimplementedSlasherServer) HighestAttestations(ctx context.Context, req *HighestAttestationRequest) (*HighestAttestationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HighestAttestations not implemented")
 }
+func (*UnimplementedSlasherServer) ProposerSlashingEvidence(ctx context.Context, req *SlashingEvidenceRequest) (*ProposerSlashingEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposerSlashingEvidence not implemented")
+}
+func (*UnimplementedSlasherServer) AttesterSlashingEvidence(ctx context.Context, req *SlashingEvidenceRequest) (*AttesterSlashingEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttesterSlashingEvidence not implemented")
+}
 
 func RegisterSlasherServer(s *grpc.Server, srv SlasherServer) {
 	s.RegisterService(&_Slasher_serviceDesc, srv)
@@ -693,6 +1103,42 @@ func _Slasher_HighestAttestations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Slasher_ProposerSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).ProposerSlashingEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/ProposerSlashingEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).ProposerSlashingEvidence(ctx, req.(*SlashingEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slasher_AttesterSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlasherServer).AttesterSlashingEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.slashing.Slasher/AttesterSlashingEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlasherServer).AttesterSlashingEvidence(ctx, req.(*SlashingEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Slasher_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.slashing.Slasher",
 	HandlerType: (*SlasherServer)(nil),
@@ -717,6 +1163,14 @@ var _Slasher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HighestAttestations",
 			Handler:    _Slasher_HighestAttestations_Handler,
 		},
+		{
+			MethodName: "ProposerSlashingEvidence",
+			Handler:    _Slasher_ProposerSlashingEvidence_Handler,
+		},
+		{
+			MethodName: "AttesterSlashingEvidence",
+			Handler:    _Slasher_AttesterSlashingEvidence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/slashing/slashing.proto",
@@ -969,7 +1423,7 @@ func (m *AttesterSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *ProposalHistory) Marshal() (dAtA []byte, err error) {
+func (m *SlashingEvidenceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -979,12 +1433,12 @@ func (m *ProposalHistory) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProposalHistory) MarshalTo(dAtA []byte) (int, error) {
+func (m *SlashingEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProposalHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SlashingEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -993,20 +1447,291 @@ func (m *ProposalHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.LatestEpochWritten != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.LatestEpochWritten))
+	if len(m.Statuses) > 0 {
+		dAtA4 := make([]byte, len(m.Statuses)*10)
+		var j3 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSlashing(dAtA, i, uint64(j3))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x22
 	}
-	if len(m.EpochBits) > 0 {
-		i -= len(m.EpochBits)
-		copy(dAtA[i:], m.EpochBits)
-		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EpochBits)))
+	if m.EndEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EndEpoch))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
+	if m.StartEpoch != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA6 := make([]byte, len(m.ValidatorIndices)*10)
+		var j5 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintSlashing(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reason != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slashing != nil {
+		{
+			size, err := m.Slashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttesterSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reason != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SlashableIndices) > 0 {
+		dAtA9 := make([]byte, len(m.SlashableIndices)*10)
+		var j8 int
+		for _, num := range m.SlashableIndices {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintSlashing(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slashing != nil {
+		{
+			size, err := m.Slashing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSlashing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttesterSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LatestEpochWritten != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.LatestEpochWritten))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochBits) > 0 {
+		i -= len(m.EpochBits)
+		copy(dAtA[i:], m.EpochBits)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EpochBits)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *AttestationHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -1175,18 +1900,31 @@ func (m *AttesterSlashingResponse) Size() (n int) {
 	return n
 }
 
-func (m *ProposalHistory) Size() (n int) {
+func (m *SlashingEvidenceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.EpochBits)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovSlashing(uint64(e))
+		}
+		n += 1 + sovSlashing(uint64(l)) + l
 	}
-	if m.LatestEpochWritten != 0 {
-		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	if m.StartEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovSlashing(uint64(m.EndEpoch))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovSlashing(uint64(e))
+		}
+		n += 1 + sovSlashing(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1194,22 +1932,21 @@ func (m *ProposalHistory) Size() (n int) {
 	return n
 }
 
-func (m *AttestationHistory) Size() (n int) {
+func (m *ProposerSlashingEvidence) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TargetToSource) > 0 {
-		for k, v := range m.TargetToSource {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovSlashing(uint64(k)) + 1 + sovSlashing(uint64(v))
-			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
-		}
+	if m.Slashing != nil {
+		l = m.Slashing.Size()
+		n += 1 + l + sovSlashing(uint64(l))
 	}
-	if m.LatestEpochWritten != 0 {
-		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	if m.Reason != 0 {
+		n += 1 + sovSlashing(uint64(m.Reason))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1217,22 +1954,708 @@ func (m *AttestationHistory) Size() (n int) {
 	return n
 }
 
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ProposerSlashingEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSlashing
-			}
+
+func (m *AttesterSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slashing != nil {
+		l = m.Slashing.Size()
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if len(m.SlashableIndices) > 0 {
+		l = 0
+		for _, e := range m.SlashableIndices {
+			l += sovSlashing(uint64(e))
+		}
+		n += 1 + sovSlashing(uint64(l)) + l
+	}
+	if m.Reason != 0 {
+		n += 1 + sovSlashing(uint64(m.Reason))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlashing(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttesterSlashingEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposalHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochBits)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.LatestEpochWritten != 0 {
+		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TargetToSource) > 0 {
+		for k, v := range m.TargetToSource {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovSlashing(uint64(k)) + 1 + sovSlashing(uint64(v))
+			n += mapEntrySize + 1 + sovSlashing(uint64(mapEntrySize))
+		}
+	}
+	if m.LatestEpochWritten != 0 {
+		n += 1 + sovSlashing(uint64(m.LatestEpochWritten))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighestAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIds = append(m.ValidatorIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIds) == 0 {
+					m.ValidatorIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIds = append(m.ValidatorIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighestAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighestAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &HighestAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HighestAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HighestAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HighestAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestSourceEpoch", wireType)
+			}
+			m.HighestSourceEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestSourceEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestTargetEpoch", wireType)
+			}
+			m.HighestTargetEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestTargetEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSlashingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSlashing = append(m.ProposerSlashing, &v1alpha1.ProposerSlashing{})
+			if err := m.ProposerSlashing[len(m.ProposerSlashing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Slashable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slashable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slashable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Slashable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterSlashingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttesterSlashing = append(m.AttesterSlashing, &v1alpha1.AttesterSlashing{})
+			if err := m.AttesterSlashing[len(m.AttesterSlashing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashingEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -1242,19 +2665,133 @@ func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HighestAttestationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HighestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType == 0 {
-				var v uint64
+				var v SlashingStatus
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowSlashing
@@ -1264,12 +2801,12 @@ func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= uint64(b&0x7F) << shift
+					v |= SlashingStatus(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.ValidatorIds = append(m.ValidatorIds, v)
+				m.Statuses = append(m.Statuses, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1297,18 +2834,11 @@ func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ValidatorIds) == 0 {
-					m.ValidatorIds = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]SlashingStatus, 0, elementCount)
 				}
 				for iNdEx < postIndex {
-					var v uint64
+					var v SlashingStatus
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSlashing
@@ -1318,15 +2848,15 @@ func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint64(b&0x7F) << shift
+						v |= SlashingStatus(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.ValidatorIds = append(m.ValidatorIds, v)
+					m.Statuses = append(m.Statuses, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1353,7 +2883,7 @@ func (m *HighestAttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HighestAttestationResponse) Unmarshal(dAtA []byte) error {
+func (m *ProposerSlashingEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,15 +2906,15 @@ func (m *HighestAttestationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HighestAttestationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposerSlashingEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HighestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposerSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1411,89 +2941,18 @@ func (m *HighestAttestationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, &HighestAttestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Slashing == nil {
+				m.Slashing = &v1alpha1.ProposerSlashing{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSlashing(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HighestAttestation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSlashing
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HighestAttestation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HighestAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
-			}
-			m.ValidatorId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighestSourceEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.HighestSourceEpoch = 0
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1503,16 +2962,16 @@ func (m *HighestAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighestSourceEpoch |= uint64(b&0x7F) << shift
+				m.Reason |= SlashingReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighestTargetEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.HighestTargetEpoch = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1522,7 +2981,7 @@ func (m *HighestAttestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighestTargetEpoch |= uint64(b&0x7F) << shift
+				m.Status |= SlashingStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1552,7 +3011,7 @@ func (m *HighestAttestation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
+func (m *ProposerSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,15 +3034,15 @@ func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposerSlashingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposerSlashingEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposerSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposerSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1610,8 +3069,8 @@ func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerSlashing = append(m.ProposerSlashing, &v1alpha1.ProposerSlashing{})
-			if err := m.ProposerSlashing[len(m.ProposerSlashing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Evidence = append(m.Evidence, &ProposerSlashingEvidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1640,7 +3099,7 @@ func (m *ProposerSlashingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Slashable) Unmarshal(dAtA []byte) error {
+func (m *AttesterSlashingEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1663,17 +3122,129 @@ func (m *Slashable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Slashable: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttesterSlashingEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Slashable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttesterSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashing == nil {
+				m.Slashing = &v1alpha1.AttesterSlashing{}
+			}
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SlashableIndices = append(m.SlashableIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSlashing
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSlashing
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SlashableIndices) == 0 {
+					m.SlashableIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSlashing
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SlashableIndices = append(m.SlashableIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashableIndices", wireType)
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -1683,12 +3254,30 @@ func (m *Slashable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Reason |= SlashingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashingStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Slashable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -1714,7 +3303,7 @@ func (m *Slashable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
+func (m *AttesterSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1737,15 +3326,15 @@ func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttesterSlashingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttesterSlashingEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttesterSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttesterSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1772,8 +3361,8 @@ func (m *AttesterSlashingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttesterSlashing = append(m.AttesterSlashing, &v1alpha1.AttesterSlashing{})
-			if err := m.AttesterSlashing[len(m.AttesterSlashing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Evidence = append(m.Evidence, &AttesterSlashingEvidence{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -752,14 +752,15 @@ func (m *AuthResponse) GetTokenExpiration() uint64 {
 }
 
 type NodeConnectionResponse struct {
-	BeaconNodeEndpoint     string   `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
-	Connected              bool     `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
-	Syncing                bool     `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	GenesisTime            uint64   `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
-	DepositContractAddress []byte   `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	BeaconNodeEndpoint     string              `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
+	Connected              bool                `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	Syncing                bool                `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	GenesisTime            uint64              `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	DepositContractAddress []byte              `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	BeaconNodes            []*BeaconNodeHealth `protobuf:"bytes,6,rep,name=beacon_nodes,json=beaconNodes,proto3" json:"beacon_nodes,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}            `json:"-"`
+	XXX_unrecognized       []byte              `json:"-"`
+	XXX_sizecache          int32               `json:"-"`
 }
 
 func (m *NodeConnectionResponse) Reset()         { *m = NodeConnectionResponse{} }
@@ -830,6 +831,13 @@ func (m *NodeConnectionResponse) GetDepositContractAddress() []byte {
 	return nil
 }
 
+func (m *NodeConnectionResponse) GetBeaconNodes() []*BeaconNodeHealth {
+	if m != nil {
+		return m.BeaconNodes
+	}
+	return nil
+}
+
 type LogsEndpointResponse struct {
 	ValidatorLogsEndpoint string   `protobuf:"bytes,1,opt,name=validator_logs_endpoint,json=validatorLogsEndpoint,proto3" json:"validator_logs_endpoint,omitempty"`
 	BeaconLogsEndpoint    string   `protobuf:"bytes,2,opt,name=beacon_logs_endpoint,json=beaconLogsEndpoint,proto3" json:"beacon_logs_endpoint,omitempty"`
@@ -1152,6 +1160,101 @@ func (m *HasUsedWebResponse) GetHasWallet() bool {
 	return false
 }
 
+type BeaconNodeHealth struct {
+	Endpoint             string   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Active               bool     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Healthy              bool     `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Syncing              bool     `protobuf:"varint,4,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	HeadSlot             uint64   `protobuf:"varint,5,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	Peers                uint64   `protobuf:"varint,6,opt,name=peers,proto3" json:"peers,omitempty"`
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconNodeHealth) Reset()         { *m = BeaconNodeHealth{} }
+func (m *BeaconNodeHealth) String() string { return proto.CompactTextString(m) }
+func (*BeaconNodeHealth) ProtoMessage()    {}
+func (*BeaconNodeHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{18}
+}
+func (m *BeaconNodeHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconNodeHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconNodeHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconNodeHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconNodeHealth.Merge(m, src)
+}
+func (m *BeaconNodeHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconNodeHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconNodeHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconNodeHealth proto.InternalMessageInfo
+
+func (m *BeaconNodeHealth) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *BeaconNodeHealth) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *BeaconNodeHealth) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *BeaconNodeHealth) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *BeaconNodeHealth) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *BeaconNodeHealth) GetPeers() uint64 {
+	if m != nil {
+		return m.Peers
+	}
+	return 0
+}
+
+func (m *BeaconNodeHealth) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.validator.accounts.v2.KeymanagerKind", KeymanagerKind_name, KeymanagerKind_value)
 	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
@@ -1172,6 +1275,7 @@ func init() {
 	proto.RegisterType((*ImportKeystoresRequest)(nil), "ethereum.validator.accounts.v2.ImportKeystoresRequest")
 	proto.RegisterType((*ImportKeystoresResponse)(nil), "ethereum.validator.accounts.v2.ImportKeystoresResponse")
 	proto.RegisterType((*HasUsedWebResponse)(nil), "ethereum.validator.accounts.v2.HasUsedWebResponse")
+	proto.RegisterType((*BeaconNodeHealth)(nil), "ethereum.validator.accounts.v2.BeaconNodeHealth")
 }
 
 func init() {
@@ -1179,107 +1283,113 @@ func init() {
 }
 
 var fileDescriptor_8a5153635bfe042e = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0x23, 0xc7,
-	0x11, 0xce, 0x90, 0x12, 0x45, 0x15, 0x29, 0x8a, 0x6e, 0xfd, 0xd1, 0xd4, 0x5a, 0xd2, 0x8e, 0xe3,
-	0x95, 0x56, 0x6b, 0x93, 0x06, 0x37, 0x59, 0x2f, 0xf6, 0x26, 0x53, 0x8c, 0x57, 0xd0, 0xfe, 0x08,
-	0x63, 0x39, 0x42, 0x2e, 0x1e, 0xb4, 0x66, 0xda, 0xc3, 0x86, 0xc8, 0xee, 0xc9, 0x4c, 0x53, 0x2b,
-	0x6d, 0x2e, 0x81, 0x11, 0x20, 0x40, 0x80, 0x5c, 0xe2, 0x43, 0x90, 0x63, 0xf2, 0x04, 0x49, 0x10,
-	0x20, 0xaf, 0x90, 0x63, 0x80, 0x3c, 0x40, 0x82, 0x45, 0x2e, 0x49, 0x5e, 0x22, 0xe8, 0x9e, 0x9e,
-	0x3f, 0x2e, 0x69, 0x4a, 0x07, 0xdf, 0x66, 0xaa, 0xaa, 0xab, 0xbe, 0xae, 0xfe, 0xea, 0x07, 0xee,
-	0xfb, 0x01, 0x17, 0xbc, 0x7d, 0x89, 0x07, 0xd4, 0xc5, 0x82, 0x07, 0x6d, 0xec, 0x38, 0x7c, 0xc4,
-	0x44, 0xd8, 0xbe, 0xec, 0xb4, 0x5f, 0x91, 0x73, 0x1b, 0xfb, 0xb4, 0xa5, 0x6c, 0xd0, 0x16, 0x11,
-	0x7d, 0x12, 0x90, 0xd1, 0xb0, 0x95, 0x58, 0xb7, 0x62, 0xeb, 0xd6, 0x65, 0xa7, 0x79, 0xc7, 0xe3,
-	0xdc, 0x1b, 0x90, 0x36, 0xf6, 0x69, 0x1b, 0x33, 0xc6, 0x05, 0x16, 0x94, 0xb3, 0x30, 0x3a, 0xdd,
-	0xdc, 0xd4, 0x5a, 0xf5, 0x77, 0x3e, 0xfa, 0xaa, 0x4d, 0x86, 0xbe, 0xb8, 0xd6, 0xca, 0x8f, 0x3c,
-	0x2a, 0xfa, 0xa3, 0xf3, 0x96, 0xc3, 0x87, 0x6d, 0x8f, 0x7b, 0x3c, 0xb5, 0x92, 0x7f, 0x11, 0x44,
-	0xf9, 0x15, 0x99, 0x9b, 0xff, 0x2b, 0xc0, 0x4a, 0x37, 0x20, 0x58, 0x90, 0x33, 0x3c, 0x18, 0x10,
-	0x61, 0x91, 0x9f, 0x8e, 0x48, 0x28, 0xd0, 0x0b, 0x80, 0x0b, 0x72, 0x3d, 0xc4, 0x0c, 0x7b, 0x24,
-	0x68, 0x18, 0x3b, 0xc6, 0x5e, 0xad, 0xd3, 0x6a, 0x7d, 0x3b, 0xec, 0xd6, 0x71, 0x72, 0xe2, 0x98,
-	0x32, 0xd7, 0xca, 0x78, 0x40, 0xbb, 0xb0, 0xfc, 0x4a, 0x05, 0xb0, 0x7d, 0x1c, 0x86, 0xaf, 0x78,
-	0xe0, 0x36, 0x0a, 0x3b, 0xc6, 0xde, 0xa2, 0x55, 0x8b, 0xc4, 0x27, 0x5a, 0x8a, 0x9a, 0x50, 0x1e,
-	0x32, 0x32, 0xe4, 0x8c, 0x3a, 0x8d, 0xa2, 0xb2, 0x48, 0xfe, 0xd1, 0x5d, 0xa8, 0xb2, 0xd1, 0xd0,
-	0x8e, 0x43, 0x36, 0xe6, 0x76, 0x8c, 0xbd, 0x39, 0xab, 0xc2, 0x46, 0xc3, 0x03, 0x2d, 0x42, 0xdb,
-	0x50, 0x09, 0xc8, 0x90, 0x0b, 0x62, 0x63, 0xd7, 0x0d, 0x1a, 0xf3, 0xca, 0x03, 0x44, 0xa2, 0x03,
-	0xd7, 0x0d, 0xd0, 0x3d, 0x58, 0xd6, 0x06, 0x4e, 0x20, 0xc1, 0x88, 0x7e, 0xa3, 0xa4, 0x8c, 0x96,
-	0x22, 0x71, 0x37, 0x10, 0x27, 0x58, 0xf4, 0x33, 0x76, 0x17, 0xe4, 0x3a, 0xb2, 0x5b, 0xc8, 0xda,
-	0x1d, 0x93, 0x6b, 0x65, 0xf7, 0x00, 0x50, 0xec, 0x0f, 0xa7, 0x2e, 0xcb, 0xca, 0x54, 0x7b, 0xe8,
-	0x62, 0xed, 0xd4, 0xfc, 0x12, 0x56, 0xf3, 0xc9, 0x0e, 0x7d, 0xce, 0x42, 0x82, 0x7e, 0x04, 0xa5,
-	0x28, 0x0d, 0x2a, 0xd3, 0x95, 0xd9, 0x99, 0xce, 0x9f, 0xb7, 0xf4, 0x69, 0xf3, 0xaf, 0x06, 0x6c,
-	0xf4, 0x5c, 0x2a, 0x22, 0x75, 0x97, 0xb3, 0xaf, 0xa8, 0x17, 0xbf, 0xe8, 0x58, 0x66, 0x8c, 0x9b,
-	0x64, 0xa6, 0x70, 0xc3, 0xcc, 0x14, 0x6f, 0x9e, 0x99, 0xb9, 0xc9, 0x99, 0x79, 0x04, 0x8d, 0xcf,
-	0x08, 0x23, 0x01, 0x16, 0xe4, 0xb9, 0x7e, 0xee, 0x24, 0x3b, 0x59, 0x4a, 0x18, 0x79, 0x4a, 0x98,
-	0xbf, 0x32, 0xa0, 0x36, 0x96, 0xcc, 0x6d, 0xa8, 0x24, 0x54, 0x13, 0xfd, 0xf8, 0xa2, 0x31, 0xcd,
-	0x44, 0x1f, 0x9d, 0xc1, 0x72, 0xca, 0x4c, 0xfb, 0x82, 0xb2, 0x88, 0x8b, 0xb7, 0x27, 0x78, 0xed,
-	0x22, 0xf7, 0x6f, 0xfe, 0xc6, 0x80, 0x95, 0x67, 0x34, 0x14, 0x31, 0x1b, 0xe3, 0xd4, 0x7f, 0x04,
-	0x2b, 0x1e, 0x11, 0xb6, 0x4b, 0x7c, 0x1e, 0x52, 0x61, 0x8b, 0x2b, 0xdb, 0xc5, 0x02, 0x2b, 0x64,
-	0x65, 0xab, 0xee, 0x11, 0x71, 0x18, 0x69, 0x4e, 0xaf, 0x0e, 0xb1, 0xc0, 0x68, 0x13, 0x16, 0x7d,
-	0xec, 0x11, 0x3b, 0xa4, 0xaf, 0x89, 0x42, 0x36, 0x6f, 0x95, 0xa5, 0xe0, 0x73, 0xfa, 0x9a, 0xa0,
-	0xf7, 0x00, 0x94, 0x52, 0xf0, 0x0b, 0xc2, 0x74, 0xe2, 0x95, 0xf9, 0xa9, 0x14, 0xa0, 0x3a, 0x14,
-	0xf1, 0x60, 0xa0, 0xb2, 0x5c, 0xb6, 0xe4, 0xa7, 0xf9, 0x07, 0x03, 0x56, 0xf3, 0xa0, 0x74, 0x9e,
-	0xba, 0x50, 0x4e, 0x2a, 0xc9, 0xd8, 0x29, 0xee, 0x55, 0x3a, 0xbb, 0xb3, 0xee, 0xaf, 0x7d, 0x58,
-	0xc9, 0x41, 0x49, 0x06, 0x46, 0xae, 0x64, 0xaa, 0x13, 0x4c, 0x9a, 0x34, 0x52, 0x7c, 0x92, 0xe0,
-	0x7a, 0x0f, 0x40, 0x70, 0x81, 0x07, 0xd1, 0xa5, 0x8a, 0xea, 0x52, 0x8b, 0x4a, 0x22, 0x6f, 0x65,
-	0xfe, 0xc9, 0x80, 0x05, 0xed, 0x1c, 0x75, 0x60, 0x4d, 0x47, 0xa7, 0xcc, 0xb3, 0xfd, 0xd1, 0xf9,
-	0x80, 0x3a, 0x92, 0x6a, 0x2a, 0x5f, 0x55, 0x6b, 0x25, 0x55, 0x9e, 0x28, 0xdd, 0x31, 0xb9, 0x96,
-	0x9d, 0x41, 0x43, 0xb2, 0x19, 0x1e, 0x12, 0x8d, 0xa1, 0xa2, 0x65, 0x2f, 0xf0, 0x90, 0x48, 0xa4,
-	0xe3, 0x0f, 0x50, 0x54, 0x0e, 0x97, 0xdc, 0x5c, 0xf6, 0x77, 0xa5, 0x5d, 0x40, 0x2f, 0x55, 0xcb,
-	0xcd, 0x72, 0xb6, 0x96, 0x8a, 0x15, 0x65, 0x8f, 0xa1, 0x16, 0xe7, 0x23, 0x2d, 0xb1, 0x14, 0x6e,
-	0x94, 0xd4, 0xaa, 0x05, 0x7e, 0x8c, 0x32, 0x44, 0x0d, 0x58, 0xa0, 0xcc, 0xa5, 0x0e, 0x09, 0x1b,
-	0x85, 0x9d, 0xe2, 0xde, 0x9c, 0x15, 0xff, 0x9a, 0x5f, 0x42, 0xe5, 0x60, 0x24, 0xfa, 0xb1, 0xa7,
-	0x26, 0x94, 0x93, 0x3e, 0xa9, 0x29, 0x1f, 0xff, 0xa3, 0x87, 0xb0, 0x16, 0x7f, 0xdb, 0x8e, 0x2c,
-	0xf1, 0x60, 0xa8, 0x40, 0xe9, 0x4b, 0xaf, 0xc6, 0xca, 0x6e, 0x46, 0x67, 0xbe, 0x84, 0x6a, 0xe4,
-	0x5f, 0x3f, 0xfe, 0x2a, 0xcc, 0x47, 0xaf, 0x15, 0x79, 0x8f, 0x7e, 0xd0, 0x7d, 0xa8, 0xab, 0x0f,
-	0x9b, 0x5c, 0xf9, 0x34, 0x48, 0xbd, 0xce, 0x59, 0xcb, 0x4a, 0xde, 0x4b, 0xc4, 0xe6, 0x3f, 0x0d,
-	0x58, 0x7f, 0xc1, 0x5d, 0xd2, 0xe5, 0x8c, 0x11, 0x47, 0x8a, 0x12, 0xdf, 0x1f, 0xc3, 0xea, 0x39,
-	0xc1, 0x0e, 0x67, 0x36, 0xe3, 0x2e, 0xb1, 0x09, 0x73, 0x7d, 0x4e, 0x99, 0xd0, 0xa1, 0x50, 0xa4,
-	0x93, 0x67, 0x7b, 0x5a, 0x83, 0xee, 0xc0, 0xa2, 0x13, 0xf9, 0x21, 0x51, 0x2d, 0x96, 0xad, 0x54,
-	0x20, 0xb3, 0x16, 0x5e, 0x33, 0x87, 0x32, 0x4f, 0xbd, 0x58, 0xd9, 0x8a, 0x7f, 0xe5, 0xb3, 0x7b,
-	0x84, 0x91, 0x90, 0x86, 0xb6, 0xa0, 0x43, 0x12, 0x0f, 0x04, 0x2d, 0x3b, 0xa5, 0x43, 0x82, 0x1e,
-	0x43, 0x23, 0x7e, 0x76, 0x87, 0x33, 0x11, 0x60, 0x47, 0xa8, 0x06, 0x48, 0xc2, 0x50, 0x4d, 0x87,
-	0xaa, 0xb5, 0xae, 0xf5, 0x5d, 0xad, 0x3e, 0x88, 0xb4, 0xe6, 0xcf, 0x65, 0xe1, 0x70, 0x2f, 0x8c,
-	0x51, 0x26, 0xf7, 0x7b, 0x04, 0x1b, 0x49, 0x79, 0xd8, 0x03, 0xee, 0x85, 0xe3, 0x57, 0x5c, 0x4b,
-	0xd4, 0xd9, 0xf3, 0x99, 0xbc, 0xe4, 0x0f, 0x15, 0xb2, 0x79, 0xc9, 0x9e, 0x30, 0xbf, 0x31, 0x60,
-	0xad, 0xdb, 0xc7, 0xcc, 0x23, 0xf1, 0x7c, 0x8c, 0x09, 0x72, 0x1f, 0xea, 0xce, 0x28, 0x08, 0x08,
-	0xcb, 0x0c, 0xd4, 0x28, 0xf8, 0xb2, 0x96, 0x67, 0x27, 0xea, 0xd8, 0xcc, 0xbd, 0x01, 0x97, 0x8a,
-	0xdf, 0xc2, 0xa5, 0xc7, 0xf0, 0xce, 0x53, 0x1c, 0x8e, 0x75, 0xdd, 0xf7, 0x61, 0x49, 0x77, 0x5d,
-	0x72, 0x45, 0x43, 0xd5, 0x52, 0xe4, 0x53, 0x55, 0x23, 0x61, 0x4f, 0xc9, 0xcc, 0x4b, 0x58, 0x3f,
-	0x1a, 0xfa, 0x3c, 0x10, 0xb2, 0x1a, 0x04, 0x0f, 0x48, 0xa6, 0x45, 0xa2, 0x8b, 0x58, 0x66, 0x53,
-	0x65, 0x43, 0x5c, 0x55, 0x41, 0x8b, 0xd6, 0x3b, 0x89, 0xe6, 0x48, 0x2b, 0xf2, 0xe6, 0x63, 0xb7,
-	0x4b, 0xcd, 0xe3, 0x14, 0x98, 0xc7, 0xb0, 0xf1, 0x56, 0xdc, 0x94, 0xac, 0x71, 0x38, 0xfb, 0xed,
-	0xe2, 0x45, 0xb1, 0x2e, 0x69, 0x35, 0xa1, 0x79, 0x06, 0xe8, 0x29, 0x0e, 0xbf, 0x08, 0x89, 0x7b,
-	0x46, 0xce, 0x13, 0x3f, 0x26, 0x2c, 0xf5, 0x71, 0x68, 0x87, 0xd4, 0x63, 0xc4, 0xb5, 0x47, 0xbe,
-	0xbe, 0x7f, 0xa5, 0x8f, 0xc3, 0xcf, 0x95, 0xec, 0x0b, 0x5f, 0x36, 0x41, 0x69, 0xa3, 0x47, 0xbd,
-	0xe6, 0x79, 0x3f, 0x4e, 0xe5, 0xfe, 0x27, 0x50, 0xcb, 0x0f, 0x18, 0x54, 0x81, 0x85, 0xc3, 0x9e,
-	0x75, 0xf4, 0xe3, 0xde, 0x61, 0xfd, 0x7b, 0xa8, 0x0a, 0xe5, 0xa3, 0xe7, 0x27, 0x2f, 0xad, 0xd3,
-	0xde, 0x61, 0xdd, 0x40, 0x00, 0x25, 0xab, 0xf7, 0xfc, 0xe5, 0x69, 0xaf, 0x5e, 0xe8, 0xfc, 0x67,
-	0x0e, 0x4a, 0x91, 0x0f, 0xf4, 0x7b, 0x03, 0xaa, 0xd9, 0x15, 0x03, 0x3d, 0x9c, 0xd5, 0xd3, 0x27,
-	0x6c, 0x7f, 0xcd, 0x1f, 0xdc, 0xee, 0x50, 0x94, 0x02, 0xf3, 0xde, 0xd7, 0xff, 0xf8, 0xf7, 0x37,
-	0x85, 0x1d, 0x73, 0x53, 0x2e, 0xbc, 0xe9, 0x1a, 0x1c, 0x5d, 0xb7, 0xed, 0xa8, 0x23, 0x4f, 0x8c,
-	0x7d, 0x24, 0xa0, 0x9a, 0x5d, 0x50, 0xd0, 0x7a, 0x2b, 0x5a, 0x68, 0x5b, 0xf1, 0xaa, 0xda, 0xea,
-	0xc9, 0x85, 0xb6, 0x79, 0xcb, 0x2d, 0xc8, 0xbc, 0xa3, 0xe2, 0xaf, 0xa3, 0xd5, 0x49, 0xf1, 0xd1,
-	0xaf, 0x0d, 0xa8, 0x8f, 0xaf, 0x18, 0x53, 0x43, 0x3f, 0x9e, 0x15, 0x7a, 0xda, 0xb2, 0x62, 0xee,
-	0x2a, 0x10, 0x77, 0xd1, 0x76, 0x1e, 0x44, 0xbc, 0xb0, 0xb4, 0x3d, 0x7d, 0x10, 0xfd, 0xc5, 0x80,
-	0xe5, 0x31, 0x52, 0xa2, 0x47, 0xb3, 0xc2, 0x4e, 0xae, 0x9e, 0xe6, 0x27, 0xb7, 0x3e, 0xa7, 0xd1,
-	0x7e, 0xac, 0xd0, 0xee, 0x9b, 0x1f, 0x4c, 0x7c, 0xb2, 0xa4, 0x90, 0xda, 0x51, 0x19, 0x3c, 0x31,
-	0xf6, 0x3b, 0x7f, 0x2c, 0x40, 0x39, 0xd9, 0xb6, 0x7f, 0x67, 0x40, 0x35, 0xbb, 0x5b, 0xcc, 0x66,
-	0xdb, 0x84, 0xf5, 0x68, 0x36, 0xdb, 0x26, 0xad, 0x2f, 0xe6, 0x96, 0x82, 0xde, 0x40, 0xeb, 0x79,
-	0xe8, 0xc9, 0x66, 0xf2, 0x4b, 0x03, 0x6a, 0xf9, 0xde, 0x89, 0x7e, 0x38, 0x93, 0xd6, 0x93, 0x7a,
-	0x6d, 0x73, 0x0a, 0x49, 0xa6, 0xf1, 0x3d, 0x6e, 0x47, 0x6d, 0xe2, 0x52, 0x95, 0xb2, 0x3f, 0x17,
-	0xa0, 0xf4, 0x94, 0xe0, 0x81, 0xe8, 0xa3, 0xdf, 0x1a, 0xb0, 0xf1, 0x19, 0x11, 0x9f, 0x26, 0x23,
-	0x30, 0x1d, 0x9f, 0x53, 0xb9, 0x38, 0x93, 0x14, 0x93, 0xc7, 0xb0, 0xf9, 0xa1, 0x82, 0x77, 0x0f,
-	0x7d, 0x3f, 0x0f, 0xaf, 0xaf, 0x90, 0xb4, 0xd5, 0x68, 0x76, 0xd2, 0xe8, 0x51, 0x79, 0x88, 0xec,
-	0xf8, 0x09, 0xa7, 0x42, 0x9a, 0xfd, 0x62, 0x13, 0xe6, 0xa6, 0xf9, 0x40, 0x01, 0xfa, 0x00, 0xbd,
-	0x3f, 0x11, 0x90, 0x9c, 0x89, 0xed, 0x78, 0x26, 0x86, 0x9d, 0xff, 0x16, 0x61, 0x4e, 0x6e, 0x2c,
-	0xe8, 0x67, 0x00, 0x69, 0xbb, 0x9d, 0x8a, 0xa8, 0x33, 0x0b, 0xd1, 0xdb, 0x2d, 0xdb, 0xbc, 0xab,
-	0xf0, 0x6c, 0xa2, 0x77, 0xf3, 0x78, 0x28, 0xa3, 0x82, 0xe2, 0x01, 0x7d, 0x4d, 0x5c, 0xf4, 0xb5,
-	0x01, 0xf3, 0xcf, 0xb8, 0x47, 0x19, 0x7a, 0x30, 0x73, 0x37, 0x4e, 0xd7, 0xb7, 0xe6, 0x87, 0x37,
-	0x33, 0xce, 0x33, 0xd9, 0x5c, 0xc9, 0xe3, 0x18, 0xc8, 0xb8, 0xb2, 0x5f, 0xfe, 0xc2, 0x80, 0x92,
-	0x9c, 0x21, 0x23, 0xff, 0xbb, 0x44, 0xb1, 0xad, 0x50, 0xbc, 0x6b, 0x8e, 0x75, 0xcf, 0x50, 0x05,
-	0x96, 0x30, 0x7e, 0x02, 0xa5, 0x67, 0xdc, 0xe3, 0x23, 0x31, 0xf5, 0x11, 0xa6, 0x15, 0xca, 0x14,
-	0xd7, 0x03, 0xe5, 0xed, 0x89, 0xb1, 0xff, 0x69, 0xf5, 0x6f, 0x6f, 0xb6, 0x8c, 0xbf, 0xbf, 0xd9,
-	0x32, 0xfe, 0xf5, 0x66, 0xcb, 0x38, 0x2f, 0xa9, 0xe3, 0x0f, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff,
-	0x48, 0x2e, 0xe9, 0xa6, 0x51, 0x11, 0x00, 0x00,
+	// 1689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0x1b, 0xc7,
+	0x1d, 0xef, 0x92, 0x34, 0x4d, 0xfd, 0x49, 0x4b, 0xcc, 0x48, 0x96, 0x19, 0xca, 0x91, 0xe5, 0x4d,
+	0x63, 0xcb, 0x72, 0x42, 0x1a, 0x74, 0xea, 0x18, 0xbe, 0x39, 0x14, 0x1b, 0x1b, 0xf2, 0x87, 0xb0,
+	0x56, 0x6a, 0xf4, 0x92, 0xc5, 0x68, 0x77, 0xb2, 0x1c, 0x88, 0x9c, 0xd9, 0xee, 0x0c, 0x65, 0xc9,
+	0xbd, 0x14, 0x41, 0x81, 0x02, 0x05, 0x7a, 0x69, 0x0e, 0x45, 0x8f, 0xed, 0x13, 0xb4, 0x45, 0x80,
+	0x3e, 0x40, 0x2f, 0x3d, 0x16, 0xe8, 0x0b, 0x14, 0x46, 0x2f, 0x6d, 0x5f, 0xa2, 0x98, 0xd9, 0xd9,
+	0x2f, 0x9a, 0x34, 0xa5, 0x43, 0x6e, 0x3b, 0xff, 0xaf, 0xf9, 0xcd, 0xff, 0x7b, 0xe1, 0x56, 0x18,
+	0x71, 0xc9, 0xbb, 0xc7, 0x78, 0x44, 0x7d, 0x2c, 0x79, 0xd4, 0xc5, 0x9e, 0xc7, 0x27, 0x4c, 0x8a,
+	0xee, 0x71, 0xaf, 0xfb, 0x8a, 0x1c, 0xba, 0x38, 0xa4, 0x1d, 0x2d, 0x83, 0x36, 0x89, 0x1c, 0x92,
+	0x88, 0x4c, 0xc6, 0x9d, 0x54, 0xba, 0x93, 0x48, 0x77, 0x8e, 0x7b, 0xed, 0xab, 0x01, 0xe7, 0xc1,
+	0x88, 0x74, 0x71, 0x48, 0xbb, 0x98, 0x31, 0x2e, 0xb1, 0xa4, 0x9c, 0x89, 0x58, 0xbb, 0xbd, 0x61,
+	0xb8, 0xfa, 0x74, 0x38, 0xf9, 0xba, 0x4b, 0xc6, 0xa1, 0x3c, 0x35, 0xcc, 0x4f, 0x02, 0x2a, 0x87,
+	0x93, 0xc3, 0x8e, 0xc7, 0xc7, 0xdd, 0x80, 0x07, 0x3c, 0x93, 0x52, 0xa7, 0x18, 0xa2, 0xfa, 0x8a,
+	0xc5, 0xed, 0xff, 0x95, 0x60, 0xb5, 0x1f, 0x11, 0x2c, 0xc9, 0x4b, 0x3c, 0x1a, 0x11, 0xe9, 0x90,
+	0x9f, 0x4d, 0x88, 0x90, 0xe8, 0x19, 0xc0, 0x11, 0x39, 0x1d, 0x63, 0x86, 0x03, 0x12, 0xb5, 0xac,
+	0x2d, 0x6b, 0x7b, 0xb9, 0xd7, 0xe9, 0xbc, 0x1b, 0x76, 0x67, 0x2f, 0xd5, 0xd8, 0xa3, 0xcc, 0x77,
+	0x72, 0x16, 0xd0, 0x4d, 0x58, 0x79, 0xa5, 0x2f, 0x70, 0x43, 0x2c, 0xc4, 0x2b, 0x1e, 0xf9, 0xad,
+	0xd2, 0x96, 0xb5, 0xbd, 0xe4, 0x2c, 0xc7, 0xe4, 0x7d, 0x43, 0x45, 0x6d, 0xa8, 0x8d, 0x19, 0x19,
+	0x73, 0x46, 0xbd, 0x56, 0x59, 0x4b, 0xa4, 0x67, 0x74, 0x1d, 0x1a, 0x6c, 0x32, 0x76, 0x93, 0x2b,
+	0x5b, 0x95, 0x2d, 0x6b, 0xbb, 0xe2, 0xd4, 0xd9, 0x64, 0xfc, 0xd0, 0x90, 0xd0, 0x35, 0xa8, 0x47,
+	0x64, 0xcc, 0x25, 0x71, 0xb1, 0xef, 0x47, 0xad, 0x0b, 0xda, 0x02, 0xc4, 0xa4, 0x87, 0xbe, 0x1f,
+	0xa1, 0x1b, 0xb0, 0x62, 0x04, 0xbc, 0x48, 0x81, 0x91, 0xc3, 0x56, 0x55, 0x0b, 0x5d, 0x8a, 0xc9,
+	0xfd, 0x48, 0xee, 0x63, 0x39, 0xcc, 0xc9, 0x1d, 0x91, 0xd3, 0x58, 0xee, 0x62, 0x5e, 0x6e, 0x8f,
+	0x9c, 0x6a, 0xb9, 0xdb, 0x80, 0x12, 0x7b, 0x38, 0x33, 0x59, 0xd3, 0xa2, 0xc6, 0x42, 0x1f, 0x1b,
+	0xa3, 0xf6, 0x57, 0xb0, 0x56, 0x74, 0xb6, 0x08, 0x39, 0x13, 0x04, 0xfd, 0x18, 0xaa, 0xb1, 0x1b,
+	0xb4, 0xa7, 0xeb, 0x8b, 0x3d, 0x5d, 0xd4, 0x77, 0x8c, 0xb6, 0xfd, 0x57, 0x0b, 0xae, 0x0c, 0x7c,
+	0x2a, 0x63, 0x76, 0x9f, 0xb3, 0xaf, 0x69, 0x90, 0x44, 0x74, 0xca, 0x33, 0xd6, 0x59, 0x3c, 0x53,
+	0x3a, 0xa3, 0x67, 0xca, 0x67, 0xf7, 0x4c, 0x65, 0xb6, 0x67, 0xee, 0x41, 0xeb, 0x0b, 0xc2, 0x48,
+	0x84, 0x25, 0x79, 0x6a, 0xc2, 0x9d, 0x7a, 0x27, 0x9f, 0x12, 0x56, 0x31, 0x25, 0xec, 0x5f, 0x5b,
+	0xb0, 0x3c, 0xe5, 0xcc, 0x6b, 0x50, 0x4f, 0x53, 0x4d, 0x0e, 0x93, 0x87, 0x26, 0x69, 0x26, 0x87,
+	0xe8, 0x25, 0xac, 0x64, 0x99, 0xe9, 0x1e, 0x51, 0x16, 0xe7, 0xe2, 0xf9, 0x13, 0x7c, 0xf9, 0xa8,
+	0x70, 0xb6, 0x7f, 0x6b, 0xc1, 0xea, 0x13, 0x2a, 0x64, 0x92, 0x8d, 0x89, 0xeb, 0x3f, 0x81, 0xd5,
+	0x80, 0x48, 0xd7, 0x27, 0x21, 0x17, 0x54, 0xba, 0xf2, 0xc4, 0xf5, 0xb1, 0xc4, 0x1a, 0x59, 0xcd,
+	0x69, 0x06, 0x44, 0xee, 0xc6, 0x9c, 0x83, 0x93, 0x5d, 0x2c, 0x31, 0xda, 0x80, 0xa5, 0x10, 0x07,
+	0xc4, 0x15, 0xf4, 0x35, 0xd1, 0xc8, 0x2e, 0x38, 0x35, 0x45, 0x78, 0x41, 0x5f, 0x13, 0xf4, 0x01,
+	0x80, 0x66, 0x4a, 0x7e, 0x44, 0x98, 0x71, 0xbc, 0x16, 0x3f, 0x50, 0x04, 0xd4, 0x84, 0x32, 0x1e,
+	0x8d, 0xb4, 0x97, 0x6b, 0x8e, 0xfa, 0xb4, 0xff, 0x68, 0xc1, 0x5a, 0x11, 0x94, 0xf1, 0x53, 0x1f,
+	0x6a, 0x69, 0x25, 0x59, 0x5b, 0xe5, 0xed, 0x7a, 0xef, 0xe6, 0xa2, 0xf7, 0x1b, 0x1b, 0x4e, 0xaa,
+	0xa8, 0x92, 0x81, 0x91, 0x13, 0xe9, 0xe6, 0x30, 0x99, 0xa4, 0x51, 0xe4, 0xfd, 0x14, 0xd7, 0x07,
+	0x00, 0x92, 0x4b, 0x3c, 0x8a, 0x1f, 0x55, 0xd6, 0x8f, 0x5a, 0xd2, 0x14, 0xf5, 0x2a, 0xfb, 0xcf,
+	0x16, 0x5c, 0x34, 0xc6, 0x51, 0x0f, 0x2e, 0x9b, 0xdb, 0x29, 0x0b, 0xdc, 0x70, 0x72, 0x38, 0xa2,
+	0x9e, 0x4a, 0x35, 0xed, 0xaf, 0x86, 0xb3, 0x9a, 0x31, 0xf7, 0x35, 0x6f, 0x8f, 0x9c, 0xaa, 0xce,
+	0x60, 0x20, 0xb9, 0x0c, 0x8f, 0x89, 0xc1, 0x50, 0x37, 0xb4, 0x67, 0x78, 0x4c, 0x14, 0xd2, 0xe9,
+	0x00, 0x94, 0xb5, 0xc1, 0x4b, 0x7e, 0xc1, 0xfb, 0x37, 0x95, 0x5c, 0x44, 0x8f, 0x75, 0xcb, 0xcd,
+	0xe7, 0xec, 0x72, 0x46, 0xd6, 0x29, 0xbb, 0x07, 0xcb, 0x89, 0x3f, 0xb2, 0x12, 0xcb, 0xe0, 0xc6,
+	0x4e, 0x6d, 0x38, 0x10, 0x26, 0x28, 0x05, 0x6a, 0xc1, 0x45, 0xca, 0x7c, 0xea, 0x11, 0xd1, 0x2a,
+	0x6d, 0x95, 0xb7, 0x2b, 0x4e, 0x72, 0xb4, 0xbf, 0x82, 0xfa, 0xc3, 0x89, 0x1c, 0x26, 0x96, 0xda,
+	0x50, 0x4b, 0xfb, 0xa4, 0x49, 0xf9, 0xe4, 0x8c, 0xee, 0xc2, 0xe5, 0xe4, 0xdb, 0xf5, 0x54, 0x89,
+	0x47, 0x63, 0x0d, 0xca, 0x3c, 0x7a, 0x2d, 0x61, 0xf6, 0x73, 0x3c, 0xfb, 0x39, 0x34, 0x62, 0xfb,
+	0x26, 0xf8, 0x6b, 0x70, 0x21, 0x8e, 0x56, 0x6c, 0x3d, 0x3e, 0xa0, 0x5b, 0xd0, 0xd4, 0x1f, 0x2e,
+	0x39, 0x09, 0x69, 0x94, 0x59, 0xad, 0x38, 0x2b, 0x9a, 0x3e, 0x48, 0xc9, 0xf6, 0x77, 0x25, 0x58,
+	0x7f, 0xc6, 0x7d, 0xd2, 0xe7, 0x8c, 0x11, 0x4f, 0x91, 0x52, 0xdb, 0x77, 0x60, 0xed, 0x90, 0x60,
+	0x8f, 0x33, 0x97, 0x71, 0x9f, 0xb8, 0x84, 0xf9, 0x21, 0xa7, 0x4c, 0x9a, 0xab, 0x50, 0xcc, 0x53,
+	0xba, 0x03, 0xc3, 0x41, 0x57, 0x61, 0xc9, 0x8b, 0xed, 0x90, 0xb8, 0x16, 0x6b, 0x4e, 0x46, 0x50,
+	0x5e, 0x13, 0xa7, 0xcc, 0xa3, 0x2c, 0xd0, 0x11, 0xab, 0x39, 0xc9, 0x51, 0x85, 0x3d, 0x20, 0x8c,
+	0x08, 0x2a, 0x5c, 0x49, 0xc7, 0x24, 0x19, 0x08, 0x86, 0x76, 0x40, 0xc7, 0x04, 0xdd, 0x87, 0x56,
+	0x12, 0x76, 0x8f, 0x33, 0x19, 0x61, 0x4f, 0xea, 0x06, 0x48, 0x84, 0xd0, 0xd3, 0xa1, 0xe1, 0xac,
+	0x1b, 0x7e, 0xdf, 0xb0, 0x1f, 0xc6, 0x5c, 0xf4, 0x02, 0x1a, 0xb9, 0x67, 0x88, 0x56, 0x55, 0xd7,
+	0xc8, 0x9d, 0x45, 0x35, 0xf2, 0x79, 0xfa, 0xbc, 0x47, 0x04, 0x8f, 0xe4, 0xd0, 0xa9, 0x67, 0x0f,
+	0x16, 0xf6, 0x2f, 0x54, 0x35, 0xf2, 0x40, 0x24, 0x4f, 0x4f, 0x9d, 0x76, 0x0f, 0xae, 0xa4, 0xf6,
+	0xdc, 0x11, 0x0f, 0xc4, 0xb4, 0xdf, 0x2e, 0xa7, 0xec, 0xbc, 0x7e, 0xce, 0xd9, 0x45, 0xa5, 0x52,
+	0xde, 0xd9, 0x79, 0x0d, 0xfb, 0x5b, 0x0b, 0x2e, 0xf7, 0x87, 0x98, 0x05, 0x24, 0x19, 0xba, 0x49,
+	0xd6, 0xdd, 0x82, 0xa6, 0x37, 0x89, 0x22, 0xc2, 0x72, 0x53, 0x3a, 0xbe, 0x7c, 0xc5, 0xd0, 0xf3,
+	0x63, 0x7a, 0x6a, 0x90, 0x9f, 0x21, 0x41, 0xcb, 0xef, 0x48, 0xd0, 0xfb, 0xf0, 0xde, 0x23, 0x2c,
+	0xa6, 0x5a, 0xf9, 0x87, 0x70, 0xc9, 0xb4, 0x72, 0x72, 0x42, 0x85, 0xee, 0x53, 0x2a, 0xfe, 0x8d,
+	0x98, 0x38, 0xd0, 0x34, 0xfb, 0x18, 0xd6, 0x1f, 0x8f, 0x43, 0x1e, 0x49, 0x55, 0x62, 0x92, 0x47,
+	0x24, 0xd7, 0x77, 0xd1, 0x51, 0x42, 0x73, 0xa9, 0x96, 0x21, 0xbe, 0x2e, 0xcb, 0x25, 0xe7, 0xbd,
+	0x94, 0xf3, 0xd8, 0x30, 0x8a, 0xe2, 0x53, 0xaf, 0xcb, 0xc4, 0x13, 0x17, 0xd8, 0x7b, 0x70, 0xe5,
+	0xad, 0x7b, 0xb3, 0x0a, 0x48, 0xae, 0x73, 0xdf, 0xee, 0x08, 0x28, 0xe1, 0xa5, 0xfd, 0x4b, 0xd8,
+	0x2f, 0x01, 0x3d, 0xc2, 0xe2, 0x4b, 0x41, 0xfc, 0x97, 0xe4, 0x30, 0xb5, 0x63, 0xc3, 0xa5, 0x21,
+	0x16, 0xae, 0xa0, 0x01, 0x23, 0xbe, 0x3b, 0x09, 0xcd, 0xfb, 0xeb, 0x43, 0x2c, 0x5e, 0x68, 0xda,
+	0x97, 0xa1, 0xea, 0xac, 0x4a, 0xc6, 0xec, 0x0f, 0xa6, 0x78, 0x86, 0x89, 0x2b, 0xed, 0xbf, 0x59,
+	0xd0, 0x9c, 0x4e, 0x49, 0x15, 0xbd, 0xa9, 0xec, 0x4a, 0xcf, 0x68, 0x1d, 0xaa, 0xd8, 0x93, 0xf4,
+	0x98, 0x18, 0x5b, 0xe6, 0xa4, 0xaa, 0x70, 0xa8, 0xb5, 0x4f, 0x93, 0x2a, 0x34, 0xc7, 0x7c, 0x7d,
+	0x56, 0x8a, 0xf5, 0xb9, 0x01, 0x4b, 0x43, 0x82, 0x7d, 0x57, 0x8c, 0xb8, 0xd4, 0xd5, 0x56, 0x71,
+	0x6a, 0x8a, 0xf0, 0x62, 0xc4, 0xa5, 0x6a, 0x41, 0x21, 0x21, 0x91, 0xd0, 0xfb, 0x57, 0xc5, 0x89,
+	0x0f, 0x8a, 0x4a, 0xa2, 0x88, 0x47, 0x66, 0xdb, 0x8a, 0x0f, 0x3b, 0x9f, 0xc1, 0x72, 0x71, 0xf6,
+	0xa2, 0x3a, 0x5c, 0xdc, 0x1d, 0x38, 0x8f, 0x7f, 0x32, 0xd8, 0x6d, 0xfe, 0x00, 0x35, 0xa0, 0xf6,
+	0xf8, 0xe9, 0xfe, 0x73, 0xe7, 0x60, 0xb0, 0xdb, 0xb4, 0x10, 0x40, 0xd5, 0x19, 0x3c, 0x7d, 0x7e,
+	0x30, 0x68, 0x96, 0x7a, 0xff, 0xa9, 0x40, 0x35, 0xf6, 0x04, 0xfa, 0x83, 0x05, 0x8d, 0xfc, 0xf6,
+	0x85, 0xee, 0x2e, 0x2a, 0xe5, 0x19, 0x8b, 0x71, 0xfb, 0xd3, 0xf3, 0x29, 0xc5, 0x81, 0xb4, 0x6f,
+	0x7c, 0xf3, 0xcf, 0x7f, 0x7f, 0x5b, 0xda, 0xb2, 0x37, 0xd4, 0xbf, 0x40, 0xaa, 0xd7, 0x8d, 0x83,
+	0xd6, 0xf5, 0xb4, 0xca, 0x03, 0x6b, 0x07, 0x49, 0x68, 0xe4, 0x77, 0x37, 0xb4, 0xde, 0x89, 0x77,
+	0xfd, 0x4e, 0xb2, 0xc5, 0x77, 0x06, 0x6a, 0xd7, 0x6f, 0x9f, 0x73, 0x41, 0xb4, 0xaf, 0xea, 0xfb,
+	0xd7, 0xd1, 0xda, 0xac, 0xfb, 0xd1, 0x6f, 0x2c, 0x68, 0x4e, 0x6f, 0x5f, 0x73, 0xaf, 0xbe, 0xbf,
+	0xe8, 0xea, 0x79, 0x7b, 0x9c, 0x7d, 0x53, 0x83, 0xb8, 0x8e, 0xae, 0x15, 0x41, 0x24, 0xbb, 0x5c,
+	0x37, 0x30, 0x8a, 0xe8, 0x3b, 0x0b, 0x56, 0xa6, 0x4a, 0x0b, 0xdd, 0x5b, 0x74, 0xed, 0xec, 0x1e,
+	0xd0, 0xfe, 0xec, 0xdc, 0x7a, 0x06, 0xed, 0x1d, 0x8d, 0x76, 0xc7, 0xfe, 0x68, 0x66, 0xc8, 0xd2,
+	0x76, 0xd0, 0x8d, 0x8b, 0xf9, 0x81, 0xb5, 0xd3, 0xfb, 0x53, 0x09, 0x6a, 0xe9, 0x8f, 0xc8, 0xef,
+	0x2d, 0x68, 0xe4, 0xd7, 0xae, 0xc5, 0xd9, 0x36, 0x63, 0x73, 0x6c, 0x7f, 0x7a, 0x3e, 0x25, 0x03,
+	0x7d, 0x53, 0x43, 0x6f, 0xa1, 0xf5, 0x22, 0xf4, 0x44, 0x0f, 0xfd, 0xca, 0x82, 0xe5, 0xe2, 0x04,
+	0x40, 0x3f, 0x5a, 0x98, 0xd6, 0xb3, 0x26, 0x46, 0x7b, 0x4e, 0x92, 0xcc, 0xcb, 0xf7, 0xa4, 0xa9,
+	0x76, 0x89, 0x4f, 0xb5, 0xcb, 0xfe, 0x52, 0x82, 0xaa, 0xe9, 0x49, 0xbf, 0xb3, 0xe0, 0xca, 0x17,
+	0x44, 0x66, 0xbd, 0x2a, 0xdb, 0x2c, 0xe6, 0xe6, 0xe2, 0xc2, 0xa4, 0x98, 0xbd, 0xa1, 0xd8, 0x1f,
+	0x6b, 0x78, 0x37, 0xd0, 0x0f, 0x8b, 0xf0, 0xe2, 0x86, 0xd6, 0xd5, 0x5b, 0x8b, 0x97, 0xdd, 0x1e,
+	0x97, 0x87, 0xcc, 0x0f, 0x51, 0x31, 0x17, 0xd2, 0xe2, 0x88, 0xcd, 0x98, 0xfe, 0xf6, 0x6d, 0x0d,
+	0xe8, 0x23, 0xf4, 0xe1, 0x4c, 0x40, 0x6a, 0xb2, 0x77, 0x93, 0x06, 0x2d, 0x7a, 0xff, 0x2d, 0x43,
+	0x45, 0x2d, 0x73, 0xe8, 0xe7, 0x00, 0xd9, 0xd0, 0x98, 0x8b, 0xa8, 0xb7, 0x08, 0xd1, 0xdb, 0x83,
+	0xc7, 0xbe, 0xae, 0xf1, 0x6c, 0xa0, 0xf7, 0x8b, 0x78, 0x28, 0xa3, 0x92, 0xe2, 0x11, 0x7d, 0x4d,
+	0x7c, 0xf4, 0x8d, 0x05, 0x17, 0x9e, 0xf0, 0x80, 0x32, 0x74, 0x7b, 0xe1, 0x6f, 0x43, 0xb6, 0xd9,
+	0xb6, 0x3f, 0x3e, 0x9b, 0x70, 0x31, 0x93, 0xed, 0xd5, 0x22, 0x8e, 0x91, 0xba, 0x57, 0xf5, 0xcb,
+	0x5f, 0x5a, 0x50, 0x55, 0x93, 0x70, 0x12, 0x7e, 0x9f, 0x28, 0xae, 0x69, 0x14, 0xef, 0xdb, 0x53,
+	0xdd, 0x53, 0xe8, 0x8b, 0x15, 0x8c, 0x9f, 0x42, 0xf5, 0x09, 0x0f, 0xf8, 0x44, 0xce, 0x0d, 0xc2,
+	0xbc, 0x42, 0x99, 0x63, 0x7a, 0xa4, 0xad, 0x3d, 0xb0, 0x76, 0x3e, 0x6f, 0xfc, 0xfd, 0xcd, 0xa6,
+	0xf5, 0x8f, 0x37, 0x9b, 0xd6, 0xbf, 0xde, 0x6c, 0x5a, 0x87, 0x55, 0xad, 0x7e, 0xf7, 0xff, 0x03,
+	0x00, 0xf3, 0x9e, 0xdf, 0x09, 0x6c, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2437,6 +2547,20 @@ func (m *NodeConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.BeaconNodes) > 0 {
+		for iNdEx := len(m.BeaconNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeaconNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DepositContractAddress) > 0 {
 		i -= len(m.DepositContractAddress)
 		copy(dAtA[i:], m.DepositContractAddress)
@@ -2731,6 +2855,87 @@ func (m *HasUsedWebResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BeaconNodeHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconNodeHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconNodeHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Peers != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.Peers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWebApi(v)
 	base := offset
@@ -3028,6 +3233,12 @@ func (m *NodeConnectionResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
+	if len(m.BeaconNodes) > 0 {
+		for _, e := range m.BeaconNodes {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3151,6 +3362,41 @@ func (m *HasUsedWebResponse) Size() (n int) {
 	return n
 }
 
+func (m *BeaconNodeHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.Syncing {
+		n += 2
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovWebApi(uint64(m.HeadSlot))
+	}
+	if m.Peers != 0 {
+		n += 1 + sovWebApi(uint64(m.Peers))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWebApi(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4913,6 +5159,40 @@ func (m *NodeConnectionResponse) Unmarshal(dAtA []byte) error {
 				m.DepositContractAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeaconNodes = append(m.BeaconNodes, &BeaconNodeHealth{})
+			if err := m.BeaconNodes[len(m.BeaconNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -5578,6 +5858,222 @@ func (m *HasUsedWebResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BeaconNodeHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconNodeHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconNodeHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Syncing = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			m.Peers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Peers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWebApi(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,7 @@ mocks=(
       "$mock_path/beacon_validator_client_mock.go BeaconNodeValidatorClient,BeaconNodeValidator_WaitForChainStartClient,BeaconNodeValidator_WaitForActivationClient,BeaconNodeValidator_StreamDutiesClient"
       "$mock_path/node_service_mock.go NodeClient"
       "$mock_path/keymanager_mock.go RemoteSignerClient"
+      "$mock_path/liveness_client_mock.go LivenessClient"
 )
 
 for ((i = 0; i < ${#mocks[@]}; i++)); do
@@ -19,6 +20,7 @@ for ((i = 0; i < ${#mocks[@]}; i++)); do
     echo "generating $file for interfaces: $interfaces";
     GO11MODULE=on mockgen -package=mock -destination=$file github.com/prysmaticlabs/ethereumapis/eth/v1alpha1 $interfaces
     GO11MODULE=on mockgen -package=mock -destination=$file github.com/prysmaticlabs/prysm/proto/validator/accounts/v2 $interfaces
+    GO11MODULE=on mockgen -package=mock -destination=$file github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 $interfaces
 done
 
 goimports -w "$mock_path/."
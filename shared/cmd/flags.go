@@ -91,6 +91,12 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// TrustedPeers specifies a set of peers which are always re-dialed and exempt from the peer limit.
+	TrustedPeers = &cli.StringSliceFlag{
+		Name: "trusted-peer",
+		Usage: "Trust this peer, given as a multiaddr or ENR. Trusted peers are always re-dialed, never pruned " +
+			"and not counted towards the peer limit. This flag may be used multiple times.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
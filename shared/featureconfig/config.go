@@ -49,13 +49,15 @@ type Flags struct {
 	EnableSyncBacktracking             bool // EnableSyncBacktracking enables backtracking algorithm when searching for alternative forks during initial sync.
 	EnableLargerGossipHistory          bool // EnableLargerGossipHistory increases the gossip history we store in our caches.
 	WriteWalletPasswordOnWebOnboarding bool // WriteWalletPasswordOnWebOnboarding writes the password to disk after Prysm web signup.
+	DoppelgangerProtection             bool // DoppelgangerProtection makes the validator watch the chain for its keys being used elsewhere before signing.
+	MinimalSlashingProtection          bool // MinimalSlashingProtection makes the validator keep only the highest signed epochs and slot of its keys.
+	EnableProposerBoost                bool // EnableProposerBoost boosts the fork choice weight of timely blocks of the current slot.
 
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
 
 	// Slasher toggles.
 	EnableHistoricalDetection bool // EnableHistoricalDetection disables historical attestation detection and performs detection on the chain head immediately.
-	DisableLookback           bool // DisableLookback updates slasher to not use the lookback and update validator histories until epoch 0.
 
 	// Cache toggles.
 	EnableSSZCache          bool // EnableSSZCache see https://github.com/prysmaticlabs/prysm/pull/4558.
@@ -63,8 +65,8 @@ type Flags struct {
 	EnableSlasherConnection bool // EnableSlasher enable retrieval of slashing events from a slasher instance.
 	UseCheckPointInfoCache  bool // UseCheckPointInfoCache uses check point info cache to efficiently verify attestation signatures.
 
-	KafkaBootstrapServers          string // KafkaBootstrapServers to find kafka servers to stream blocks, attestations, etc.
 	AttestationAggregationStrategy string // AttestationAggregationStrategy defines aggregation strategy to be used when aggregating.
+	DatabaseEngine                 string // DatabaseEngine defines the storage engine of the beacon node database.
 }
 
 var featureConfig *Flags
@@ -139,17 +141,17 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 
 	cfg.EnableSSZCache = true
 
-	if ctx.String(kafkaBootstrapServersFlag.Name) != "" {
-		log.Warn("Enabling experimental kafka streaming.")
-		cfg.KafkaBootstrapServers = ctx.String(kafkaBootstrapServersFlag.Name)
-	}
-
 	if ctx.IsSet(disableGRPCConnectionLogging.Name) {
 		cfg.DisableGRPCConnectionLogs = true
 	}
 	cfg.AttestationAggregationStrategy = ctx.String(attestationAggregationStrategy.Name)
 	log.Infof("Using %q strategy on attestation aggregation", cfg.AttestationAggregationStrategy)
 
+	if ctx.String(DatabaseEngineFlag.Name) != "" {
+		log.Infof("Using %q database engine", ctx.String(DatabaseEngineFlag.Name))
+		cfg.DatabaseEngine = ctx.String(DatabaseEngineFlag.Name)
+	}
+
 	cfg.EnableEth1DataMajorityVote = true
 	if ctx.Bool(disableEth1DataMajorityVote.Name) {
 		log.Warn("Disabling eth1data majority vote")
@@ -181,6 +183,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.Warn("Using a larger gossip history for the node")
 		cfg.EnableLargerGossipHistory = true
 	}
+	if ctx.Bool(enableProposerBoost.Name) {
+		log.Warn("Enabling proposer score boost in fork choice")
+		cfg.EnableProposerBoost = true
+	}
 	Init(cfg)
 }
 
@@ -190,11 +196,6 @@ func ConfigureSlasher(ctx *cli.Context) {
 	complainOnDeprecatedFlags(ctx)
 	cfg := &Flags{}
 	configureTestnet(ctx, cfg)
-
-	if ctx.Bool(disableLookbackFlag.Name) {
-		log.Warn("Disabling slasher lookback")
-		cfg.DisableLookback = true
-	}
 	Init(cfg)
 }
 
@@ -213,6 +214,14 @@ func ConfigureValidator(ctx *cli.Context) {
 			"upon completing web onboarding.")
 		cfg.WriteWalletPasswordOnWebOnboarding = true
 	}
+	if ctx.Bool(enableDoppelgangerProtectionFlag.Name) {
+		log.Warn("Enabled doppelganger protection, validators will wait for a few epochs before performing their duties.")
+		cfg.DoppelgangerProtection = true
+	}
+	if ctx.Bool(enableMinimalSlashingProtectionFlag.Name) {
+		log.Warn("Enabled minimal slashing protection, only the highest signed epochs and slot of the validating keys are kept.")
+		cfg.MinimalSlashingProtection = true
+	}
 	cfg.EnableBlst = true
 	if ctx.Bool(disableBlst.Name) {
 		log.Warn("Disabling new BLS library blst")
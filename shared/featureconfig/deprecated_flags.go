@@ -17,9 +17,21 @@ var (
 		Usage:  deprecatedUsage,
 		Hidden: true,
 	}
+	deprecatedKafkaURL = &cli.StringFlag{
+		Name:   "kafka-url",
+		Usage:  deprecatedUsage,
+		Hidden: true,
+	}
+	deprecatedDisableLookback = &cli.BoolFlag{
+		Name:   "disable-lookback",
+		Usage:  deprecatedUsage,
+		Hidden: true,
+	}
 )
 
 var deprecatedFlags = []cli.Flag{
 	exampleDeprecatedFeatureFlag,
 	deprecatedDisableSyncBacktracking,
+	deprecatedKafkaURL,
+	deprecatedDisableLookback,
 }
@@ -29,18 +29,21 @@ var (
 		Name:  "interop-write-ssz-state-transitions",
 		Usage: "Write ssz states to disk after attempted state transition",
 	}
-	kafkaBootstrapServersFlag = &cli.StringFlag{
-		Name:  "kafka-url",
-		Usage: "Stream attestations and blocks to specified kafka servers. This field is used for bootstrap.servers kafka config field.",
-	}
 	enableExternalSlasherProtectionFlag = &cli.BoolFlag{
 		Name: "enable-external-slasher-protection",
 		Usage: "Enables the validator to connect to external slasher to prevent it from " +
 			"transmitting a slashable offence over the network.",
 	}
-	disableLookbackFlag = &cli.BoolFlag{
-		Name:  "disable-lookback",
-		Usage: "Disables use of the lookback feature and updates attestation history for validators from head to epoch 0",
+	enableDoppelgangerProtectionFlag = &cli.BoolFlag{
+		Name: "enable-doppelganger-protection",
+		Usage: "Enables the validator to watch the chain for a few epochs before performing its duties, " +
+			"and before performing the duties of keys added while running, refusing to sign for keys found " +
+			"to be used by another validator client.",
+	}
+	enableMinimalSlashingProtectionFlag = &cli.BoolFlag{
+		Name: "enable-minimal-slashing-protection",
+		Usage: "Enables the validator to keep only the highest signed source and target epochs and the highest " +
+			"signed proposal slot of its keys as slashing protection, pruning their full signing history.",
 	}
 	disableGRPCConnectionLogging = &cli.BoolFlag{
 		Name:  "disable-grpc-connection-logging",
@@ -51,6 +54,13 @@ var (
 		Usage: "Which strategy to use when aggregating attestations, one of: naive, max_cover.",
 		Value: "max_cover",
 	}
+	// DatabaseEngineFlag defines the storage engine of the beacon node database.
+	DatabaseEngineFlag = &cli.StringFlag{
+		Name: "db-engine",
+		Usage: "Storage engine of the beacon node database, one of: bolt, leveldb. Defaults to the engine of " +
+			"the existing database, or bolt for a new database. An existing database must be migrated to " +
+			"another engine with the db migrate command.",
+	}
 	disableBlst = &cli.BoolFlag{
 		Name:  "disable-blst",
 		Usage: "Disables the new BLS library, blst, from Supranational",
@@ -84,6 +94,11 @@ var (
 		Name:  "enable-larger-gossip-history",
 		Usage: "Enables the node to store a larger amount of gossip messages in its cache.",
 	}
+	enableProposerBoost = &cli.BoolFlag{
+		Name: "enable-proposer-boost",
+		Usage: "Enables fork choice to boost the weight of a block of the current slot received in time, " +
+			"mitigating ex-ante reorg and balancing attacks.",
+	}
 	writeWalletPasswordOnWebOnboarding = &cli.BoolFlag{
 		Name: "write-wallet-password-on-web-onboarding",
 		Usage: "(Danger): Writes the wallet password to the wallet directory on completing Prysm web onboarding. " +
@@ -100,6 +115,8 @@ var devModeFlags = []cli.Flag{
 var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	writeWalletPasswordOnWebOnboarding,
 	enableExternalSlasherProtectionFlag,
+	enableDoppelgangerProtectionFlag,
+	enableMinimalSlashingProtectionFlag,
 	ToledoTestnet,
 	PyrmontTestnet,
 	Mainnet,
@@ -109,7 +126,6 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 
 // SlasherFlags contains a list of all the feature flags that apply to the slasher client.
 var SlasherFlags = append(deprecatedFlags, []cli.Flag{
-	disableLookbackFlag,
 	ToledoTestnet,
 	PyrmontTestnet,
 	Mainnet,
@@ -122,9 +138,9 @@ var E2EValidatorFlags = []string{}
 var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	devModeFlag,
 	writeSSZStateTransitionsFlag,
-	kafkaBootstrapServersFlag,
 	disableGRPCConnectionLogging,
 	attestationAggregationStrategy,
+	DatabaseEngineFlag,
 	ToledoTestnet,
 	PyrmontTestnet,
 	Mainnet,
@@ -135,6 +151,7 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	checkPtInfoCache,
 	disablePruningDepositProofs,
 	disableSyncBacktracking,
+	enableProposerBoost,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
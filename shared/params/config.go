@@ -57,6 +57,10 @@ type BeaconChainConfig struct {
 	SafeSlotsToUpdateJustified       uint64 `yaml:"SAFE_SLOTS_TO_UPDATE_JUSTIFIED"`      // SafeSlotsToUpdateJustified is the minimal slots needed to update justified check point.
 	SecondsPerETH1Block              uint64 `yaml:"SECONDS_PER_ETH1_BLOCK"`              // SecondsPerETH1Block is the approximate time for a single eth1 block to be produced.
 
+	// Fork choice constants.
+	IntervalsPerSlot   uint64 `yaml:"INTERVALS_PER_SLOT"`   // IntervalsPerSlot defines the number of fork choice intervals in a slot, a block is timely if received in the first one.
+	ProposerScoreBoost uint64 `yaml:"PROPOSER_SCORE_BOOST"` // ProposerScoreBoost defines the percentage of the committee weight added to a timely block of the current slot in fork choice.
+
 	// State list lengths
 	EpochsPerHistoricalVector uint64 `yaml:"EPOCHS_PER_HISTORICAL_VECTOR"` // EpochsPerHistoricalVector defines max length in epoch to store old historical stats in beacon state.
 	EpochsPerSlashingsVector  uint64 `yaml:"EPOCHS_PER_SLASHINGS_VECTOR"`  // EpochsPerSlashingsVector defines max length in epoch to store old stats to recompute slashing witness.
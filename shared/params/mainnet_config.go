@@ -107,6 +107,10 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	// Future optimization: https://github.com/prysmaticlabs/prysm/issues/7739
 	SecondsPerETH1Block: 14,
 
+	// Fork choice values.
+	IntervalsPerSlot:   3,
+	ProposerScoreBoost: 70,
+
 	// State list length constants.
 	EpochsPerHistoricalVector: 65536,
 	EpochsPerSlashingsVector:  8192,
@@ -0,0 +1,276 @@
+// Package specencoding translates between proto messages and the JSON encoding of the Eth2 spec
+// used by the standard beacon node API and the remote signer API.
+package specencoding
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+)
+
+// The Eth2 spec encodes messages differently from the proto3 JSON mapping used by the
+// grpc-gateway: integers are decimal strings, byte arrays are 0x-prefixed hex strings and
+// some fields carry the name given to them by the spec rather than the proto field name.
+// The functions below translate between the two using reflection over the generated proto
+// structs.
+
+var timestampType = reflect.TypeOf(ptypes.Timestamp{})
+
+// specFieldNames maps proto field names to their spec counterpart where the two differ
+// and the proto definition does not carry a spec-name tag, keyed by struct name.
+var specFieldNames = map[string]map[string]string{
+	"SignedBeaconBlock":       {"block": "message"},
+	"SignedBeaconBlockHeader": {"header": "message"},
+	"SignedVoluntaryExit":     {"exit": "message"},
+	"ProposerSlashing":        {"header_1": "signed_header_1", "header_2": "signed_header_2"},
+}
+
+// Encode converts a proto message, or any value composed of proto messages, into a value
+// that encoding/json marshals according to the spec encoding.
+func Encode(v interface{}) interface{} {
+	return encodeValue(reflect.ValueOf(v))
+}
+
+func encodeValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Kind() == reflect.Ptr && v.Elem().Type() == timestampType {
+			return strconv.FormatInt(v.Elem().Interface().(ptypes.Timestamp).Seconds, 10)
+		}
+		return encodeValue(v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := fieldName(v.Type(), i)
+			if !ok {
+				continue
+			}
+			fields[name] = encodeValue(v.Field(i))
+		}
+		return fields
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("%#x", v.Bytes())
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = encodeValue(v.Index(i))
+		}
+		return items
+	case reflect.Map:
+		items := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			items[fmt.Sprint(iter.Key().Interface())] = encodeValue(iter.Value())
+		}
+		return items
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	default:
+		return v.Interface()
+	}
+}
+
+// Decode unmarshals a spec encoded JSON document into the proto message pointed to by msg.
+func Decode(data []byte, msg interface{}) error {
+	var raw interface{}
+	if err := unmarshalJSON(data, &raw); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("destination must be a non nil pointer")
+	}
+	return decodeValue(raw, v.Elem(), "")
+}
+
+func unmarshalJSON(data []byte, raw interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(raw)
+}
+
+func decodeValue(raw interface{}, v reflect.Value, path string) error {
+	if raw == nil {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(v.Type().Elem())
+		if v.Type().Elem() == timestampType {
+			seconds, err := parseInt(raw, path)
+			if err != nil {
+				return err
+			}
+			elem.Elem().Set(reflect.ValueOf(ptypes.Timestamp{Seconds: seconds}))
+		} else if err := decodeValue(raw, elem.Elem(), path); err != nil {
+			return err
+		}
+		v.Set(elem)
+	case reflect.Struct:
+		fields, ok := raw.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("%s: expected object", displayPath(path))
+		}
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := fieldName(v.Type(), i)
+			if !ok {
+				continue
+			}
+			if err := decodeValue(fields[name], v.Field(i), joinPath(path, name)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			s, ok := raw.(string)
+			if !ok {
+				return errors.Errorf("%s: expected hex string", displayPath(path))
+			}
+			b, err := DecodeHex(s)
+			if err != nil {
+				return errors.Wrapf(err, "%s", displayPath(path))
+			}
+			v.Set(reflect.ValueOf(b).Convert(v.Type()))
+			return nil
+		}
+		items, ok := raw.([]interface{})
+		if !ok {
+			return errors.Errorf("%s: expected array", displayPath(path))
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodeValue(item, slice.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	case reflect.Map:
+		items, ok := raw.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("%s: expected object", displayPath(path))
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(items))
+		for key, item := range items {
+			value := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeValue(item, value, joinPath(path, key)); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), value)
+		}
+		v.Set(m)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s, err := numberString(raw, path)
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return errors.Wrapf(err, "%s", displayPath(path))
+		}
+		v.SetUint(n)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := parseInt(raw, path)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Bool:
+		b, ok := raw.(bool)
+		if !ok {
+			return errors.Errorf("%s: expected boolean", displayPath(path))
+		}
+		v.SetBool(b)
+	case reflect.String:
+		s, ok := raw.(string)
+		if !ok {
+			return errors.Errorf("%s: expected string", displayPath(path))
+		}
+		v.SetString(s)
+	default:
+		return errors.Errorf("%s: unsupported type %s", displayPath(path), v.Type())
+	}
+	return nil
+}
+
+// fieldName returns the spec name of the i-th field of a generated proto
+// struct, or false if the field is not part of the proto message.
+func fieldName(t reflect.Type, i int) (string, bool) {
+	field := t.Field(i)
+	tag, ok := field.Tag.Lookup("protobuf")
+	if !ok {
+		return "", false
+	}
+	var name string
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			name = strings.TrimPrefix(part, "name=")
+		}
+	}
+	if specName, ok := field.Tag.Lookup("spec-name"); ok {
+		return specName, true
+	}
+	if renamed, ok := specFieldNames[t.Name()][name]; ok {
+		return renamed, true
+	}
+	return name, name != ""
+}
+
+// DecodeHex decodes a 0x-prefixed hex string.
+func DecodeHex(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") {
+		return nil, errors.Errorf("hex string %q is missing 0x prefix", s)
+	}
+	return hex.DecodeString(s[2:])
+}
+
+func numberString(raw interface{}, path string) (string, error) {
+	switch n := raw.(type) {
+	case string:
+		return n, nil
+	case json.Number:
+		return n.String(), nil
+	default:
+		return "", errors.Errorf("%s: expected number", displayPath(path))
+	}
+}
+
+func parseInt(raw interface{}, path string) (int64, error) {
+	s, err := numberString(raw, path)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "%s", displayPath(path))
+	}
+	return n, nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "body"
+	}
+	return path
+}
@@ -0,0 +1,48 @@
+package specencoding
+
+import (
+	"encoding/json"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEncode(t *testing.T) {
+	header := &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:          12,
+			ProposerIndex: 3,
+			ParentRoot:    []byte{0x0a, 0x0b},
+		},
+		Signature: []byte{0x01},
+	}
+	enc, err := json.Marshal(Encode(header))
+	require.NoError(t, err)
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(enc, &fields))
+	assert.Equal(t, "0x01", fields["signature"])
+	message, ok := fields["message"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, "12", message["slot"])
+	assert.Equal(t, "3", message["proposer_index"])
+	assert.Equal(t, "0x0a0b", message["parent_root"])
+
+	assert.Equal(t, "1606824000", Encode(&ptypes.Timestamp{Seconds: 1606824000}))
+	assert.Equal(t, nil, Encode((*ethpb.Checkpoint)(nil)))
+}
+
+func TestDecode(t *testing.T) {
+	exit := &ethpb.SignedVoluntaryExit{}
+	require.NoError(t, Decode([]byte(`{"message": {"epoch": "1", "validator_index": "2"}, "signature": "0x0a"}`), exit))
+	assert.Equal(t, uint64(1), exit.Exit.Epoch)
+	assert.Equal(t, uint64(2), exit.Exit.ValidatorIndex)
+	assert.DeepEqual(t, []byte{0x0a}, exit.Signature)
+
+	err := Decode([]byte(`{"message": {"epoch": "foo"}}`), &ethpb.SignedVoluntaryExit{})
+	assert.ErrorContains(t, "message.epoch", err)
+	err = Decode([]byte(`{"signature": "0a"}`), &ethpb.SignedVoluntaryExit{})
+	assert.ErrorContains(t, "missing 0x prefix", err)
+}
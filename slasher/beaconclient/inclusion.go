@@ -0,0 +1,274 @@
+package beaconclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+	"go.opencensus.io/trace"
+)
+
+// canonicalBlock is a block of the canonical chain whose slashings were marked as included.
+type canonicalBlock struct {
+	slot              uint64
+	root              [32]byte
+	parentRoot        [32]byte
+	proposerSlashings []*ethpb.ProposerSlashing
+	attesterSlashings []*ethpb.AttesterSlashing
+}
+
+// trackSlashingInclusion watches the canonical chain of the beacon node every slot, marking the
+// slashings in the slasher DB as included once a canonical block includes them, and as reverted
+// if the block including them is reorged out of the canonical chain.
+func (bs *Service) trackSlashingInclusion(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := bs.updateIncludedSlashings(ctx); err != nil {
+				log.WithError(err).Error("Could not update included slashings")
+			}
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// updateIncludedSlashings walks back from the head block of the beacon node to the tracked
+// canonical chain, reverting the slashings of the tracked blocks which are no longer canonical
+// and including the slashings of the new canonical blocks. Only the blocks of the last two
+// epochs are tracked, so deeper reorgs do not revert included slashings. When no chain is
+// tracked yet, the walk goes back to the latest block checked before the slasher restarted,
+// so the slashings included while it was down are not missed.
+func (bs *Service) updateIncludedSlashings(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "beaconclient.updateIncludedSlashings")
+	defer span.End()
+	head, err := bs.ChainHead(ctx)
+	if err != nil {
+		return err
+	}
+	var checkedSlot uint64
+	if len(bs.canonicalBlocks) == 0 {
+		checkedSlot, err = bs.slasherDB.LatestInclusionCheckedSlot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get latest slot checked for included slashings")
+		}
+	}
+	root := bytesutil.ToBytes32(head.HeadBlockRoot)
+	tracked := make(map[[32]byte]int, len(bs.canonicalBlocks))
+	for i, blk := range bs.canonicalBlocks {
+		tracked[blk.root] = i
+	}
+	depth := int(2 * params.BeaconConfig().SlotsPerEpoch)
+	var newBlocks []*canonicalBlock
+	for len(bs.canonicalBlocks) == 0 || len(newBlocks) < depth {
+		if _, ok := tracked[root]; ok {
+			break
+		}
+		blk, err := bs.canonicalBlockByRoot(ctx, root)
+		if err != nil {
+			return err
+		}
+		if blk == nil {
+			break
+		}
+		if len(bs.canonicalBlocks) == 0 {
+			// The blocks up to the checked slot were checked before the slasher restarted.
+			if checkedSlot > 0 && blk.slot <= checkedSlot {
+				break
+			}
+			newBlocks = append(newBlocks, blk)
+			// Only the head block is processed when no block was ever checked.
+			if checkedSlot == 0 {
+				break
+			}
+		} else {
+			newBlocks = append(newBlocks, blk)
+			if blk.slot <= bs.canonicalBlocks[0].slot {
+				break
+			}
+		}
+		root = blk.parentRoot
+	}
+	if len(newBlocks) == 0 {
+		return nil
+	}
+
+	if i, ok := tracked[root]; ok {
+		reverted := bs.canonicalBlocks[i+1:]
+		if err := bs.markSlashings(ctx, offensesOf(reverted), []types.SlashingStatus{types.Included}, types.Reverted); err != nil {
+			return err
+		}
+		bs.canonicalBlocks = bs.canonicalBlocks[:i+1]
+	} else {
+		// The new chain does not build on the tracked one, so which blocks were reorged out is unknown.
+		bs.canonicalBlocks = nil
+	}
+	if err := bs.markSlashings(
+		ctx,
+		offensesOf(newBlocks),
+		[]types.SlashingStatus{types.Active, types.Submitted, types.Reverted},
+		types.Included,
+	); err != nil {
+		return err
+	}
+	for i := len(newBlocks) - 1; i >= 0; i-- {
+		bs.canonicalBlocks = append(bs.canonicalBlocks, newBlocks[i])
+	}
+	if len(bs.canonicalBlocks) > depth {
+		bs.canonicalBlocks = bs.canonicalBlocks[len(bs.canonicalBlocks)-depth:]
+	}
+	return bs.slasherDB.SetLatestInclusionCheckedSlot(ctx, newBlocks[0].slot)
+}
+
+// canonicalBlockByRoot requests a block by its root from the beacon node, returning nil
+// if the beacon node does not have it.
+func (bs *Service) canonicalBlockByRoot(ctx context.Context, root [32]byte) (*canonicalBlock, error) {
+	res, err := bs.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root[:]},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve block %#x", root)
+	}
+	if res == nil || len(res.BlockContainers) == 0 {
+		return nil, nil
+	}
+	blk := res.BlockContainers[0].Block
+	if blk == nil || blk.Block == nil || blk.Block.Body == nil {
+		return nil, nil
+	}
+	return &canonicalBlock{
+		slot:              blk.Block.Slot,
+		root:              root,
+		parentRoot:        bytesutil.ToBytes32(blk.Block.ParentRoot),
+		proposerSlashings: blk.Block.Body.ProposerSlashings,
+		attesterSlashings: blk.Block.Body.AttesterSlashings,
+	}, nil
+}
+
+// proposerOffense identifies the double proposal of a proposer at a slot.
+type proposerOffense struct {
+	proposerIndex uint64
+	slot          uint64
+}
+
+// attesterOffense identifies the slashable attestations of a validator targeting an epoch.
+type attesterOffense struct {
+	validatorIndex uint64
+	targetEpoch    uint64
+}
+
+// blockOffenses are the offenses slashed by the slashings of blocks, with the slots of the blocks.
+// Offenses are matched rather than slashing messages, as the same offense can be slashed with
+// other conflicting messages than the ones the slasher detected, by another slasher for instance.
+type blockOffenses struct {
+	proposers map[proposerOffense]uint64
+	attesters map[attesterOffense]uint64
+}
+
+func offensesOf(blocks []*canonicalBlock) *blockOffenses {
+	offenses := &blockOffenses{
+		proposers: make(map[proposerOffense]uint64),
+		attesters: make(map[attesterOffense]uint64),
+	}
+	for _, blk := range blocks {
+		for _, slashing := range blk.proposerSlashings {
+			if offense, ok := proposerSlashingOffense(slashing); ok {
+				offenses.proposers[offense] = blk.slot
+			}
+		}
+		for _, slashing := range blk.attesterSlashings {
+			for _, offense := range attesterSlashingOffenses(slashing) {
+				offenses.attesters[offense] = blk.slot
+			}
+		}
+	}
+	return offenses
+}
+
+func proposerSlashingOffense(slashing *ethpb.ProposerSlashing) (proposerOffense, bool) {
+	if slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+		return proposerOffense{}, false
+	}
+	header := slashing.Header_1.Header
+	return proposerOffense{proposerIndex: header.ProposerIndex, slot: header.Slot}, true
+}
+
+func attesterSlashingOffenses(slashing *ethpb.AttesterSlashing) []attesterOffense {
+	att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+	if att1 == nil || att1.Data == nil || att1.Data.Target == nil ||
+		att2 == nil || att2.Data == nil || att2.Data.Target == nil {
+		return nil
+	}
+	slashableIndices := sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices)
+	offenses := make([]attesterOffense, 0, 2*len(slashableIndices))
+	for _, idx := range slashableIndices {
+		offenses = append(offenses,
+			attesterOffense{validatorIndex: idx, targetEpoch: att1.Data.Target.Epoch},
+			attesterOffense{validatorIndex: idx, targetEpoch: att2.Data.Target.Epoch},
+		)
+	}
+	return offenses
+}
+
+// markSlashings updates the status of the slashings saved in the slasher DB with any of the
+// from statuses which slash any of the given offenses.
+func (bs *Service) markSlashings(ctx context.Context, offenses *blockOffenses, from []types.SlashingStatus, to types.SlashingStatus) error {
+	if len(offenses.proposers) == 0 && len(offenses.attesters) == 0 {
+		return nil
+	}
+	for _, st := range from {
+		proposerSlashings, err := bs.slasherDB.ProposalSlashingsByStatus(ctx, st)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve proposer slashings")
+		}
+		for _, s := range proposerSlashings {
+			offense, ok := proposerSlashingOffense(s)
+			if !ok {
+				continue
+			}
+			slot, ok := offenses.proposers[offense]
+			if !ok {
+				continue
+			}
+			if err := bs.slasherDB.SaveProposerSlashing(ctx, to, s); err != nil {
+				return err
+			}
+			logSlashingStatus(slot, to, "proposer")
+		}
+		attesterSlashings, err := bs.slasherDB.AttesterSlashings(ctx, st)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve attester slashings")
+		}
+		for _, s := range attesterSlashings {
+			for _, offense := range attesterSlashingOffenses(s) {
+				slot, ok := offenses.attesters[offense]
+				if !ok {
+					continue
+				}
+				if err := bs.slasherDB.SaveAttesterSlashing(ctx, to, s); err != nil {
+					return err
+				}
+				logSlashingStatus(slot, to, "attester")
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func logSlashingStatus(slot uint64, st types.SlashingStatus, kind string) {
+	entry := log.WithField("slot", slot).WithField("type", kind)
+	if st == types.Included {
+		entry.Info("Slashing included in canonical block")
+	} else {
+		entry.Warn("Slashing reverted from the canonical chain")
+	}
+}
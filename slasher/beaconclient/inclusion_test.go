@@ -0,0 +1,191 @@
+package beaconclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
+)
+
+func TestService_updateIncludedSlashings(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	bs := Service{
+		beaconClient: client,
+		slasherDB:    testDB.SetupSlasherDB(t, false),
+	}
+
+	header := func() *ethpb.BeaconBlockHeader {
+		return &ethpb.BeaconBlockHeader{
+			ProposerIndex: 1,
+			Slot:          1,
+			ParentRoot:    make([]byte, 32),
+			StateRoot:     make([]byte, 32),
+			BodyRoot:      make([]byte, 32),
+		}
+	}
+	proposerSlashing := &ethpb.ProposerSlashing{
+		Header_1: &ethpb.SignedBeaconBlockHeader{
+			Header:    header(),
+			Signature: bytesutil.PadTo([]byte{1}, 96),
+		},
+		Header_2: &ethpb.SignedBeaconBlockHeader{
+			Header:    header(),
+			Signature: bytesutil.PadTo([]byte{2}, 96),
+		},
+	}
+	attesterSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1, 2},
+			Signature:        make([]byte, 96),
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo([]byte{1}, 32),
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			},
+		},
+		Attestation_2: &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{2, 3},
+			Signature:        make([]byte, 96),
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo([]byte{2}, 32),
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			},
+		},
+	}
+	require.NoError(t, bs.slasherDB.SaveProposerSlashing(ctx, types.Active, proposerSlashing))
+	require.NoError(t, bs.slasherDB.SaveAttesterSlashing(ctx, types.Submitted, attesterSlashing))
+
+	block := func(slot uint64, root, parent byte, body *ethpb.BeaconBlockBody) [32]byte {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = bytesutil.PadTo([]byte{parent}, 32)
+		if body != nil {
+			blk.Block.Body = body
+		}
+		r := bytesutil.ToBytes32([]byte{root})
+		client.EXPECT().ListBlocks(gomock.Any(), &ethpb.ListBlocksRequest{
+			QueryFilter: &ethpb.ListBlocksRequest_Root{Root: r[:]},
+		}).Return(&ethpb.ListBlocksResponse{
+			BlockContainers: []*ethpb.BeaconBlockContainer{{Block: blk, BlockRoot: r[:]}},
+		}, nil).AnyTimes()
+		return r
+	}
+	expectHead := func(root [32]byte) {
+		client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadBlockRoot: root[:]}, nil)
+	}
+	assertStatuses := func(proposer, attester types.SlashingStatus) {
+		_, st, err := bs.slasherDB.HasProposerSlashing(ctx, proposerSlashing)
+		require.NoError(t, err)
+		assert.Equal(t, proposer, st)
+		_, st, err = bs.slasherDB.HasAttesterSlashing(ctx, attesterSlashing)
+		require.NoError(t, err)
+		assert.Equal(t, attester, st)
+	}
+
+	root1 := block(1, 1, 0, nil)
+	// The slashings are included in the block at slot 2, with the attestations in another order.
+	block(2, 2, 1, &ethpb.BeaconBlockBody{
+		ProposerSlashings: []*ethpb.ProposerSlashing{proposerSlashing},
+		AttesterSlashings: []*ethpb.AttesterSlashing{{
+			Attestation_1: attesterSlashing.Attestation_2,
+			Attestation_2: attesterSlashing.Attestation_1,
+		}},
+	})
+	root3 := block(3, 3, 2, nil)
+	forkRoot := block(2, 4, 1, nil)
+
+	expectHead(root1)
+	require.NoError(t, bs.updateIncludedSlashings(ctx))
+	assert.Equal(t, 1, len(bs.canonicalBlocks))
+	assertStatuses(types.Active, types.Submitted)
+
+	expectHead(root3)
+	require.NoError(t, bs.updateIncludedSlashings(ctx))
+	assert.Equal(t, 3, len(bs.canonicalBlocks))
+	assertStatuses(types.Included, types.Included)
+
+	// A reorg to a chain without the slashings reverts them.
+	expectHead(forkRoot)
+	require.NoError(t, bs.updateIncludedSlashings(ctx))
+	assert.Equal(t, 2, len(bs.canonicalBlocks))
+	assert.Equal(t, forkRoot, bs.canonicalBlocks[1].root)
+	assertStatuses(types.Reverted, types.Reverted)
+}
+
+func TestService_updateIncludedSlashings_OtherMessagesAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	bs := Service{
+		beaconClient: client,
+		slasherDB:    testDB.SetupSlasherDB(t, false),
+	}
+
+	attestation := func(indices []uint64, blockRoot byte) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Signature:        make([]byte, 96),
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo([]byte{blockRoot}, 32),
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			},
+		}
+	}
+	detected := &ethpb.AttesterSlashing{
+		Attestation_1: attestation([]uint64{1, 2}, 1),
+		Attestation_2: attestation([]uint64{2, 3}, 2),
+	}
+	// Another slasher slashed the same validator with other aggregates.
+	included := &ethpb.AttesterSlashing{
+		Attestation_1: attestation([]uint64{2, 4}, 1),
+		Attestation_2: attestation([]uint64{2, 5}, 2),
+	}
+	require.NoError(t, bs.slasherDB.SaveAttesterSlashing(ctx, types.Submitted, detected))
+	// The slasher checked the blocks up to slot 1 before it restarted.
+	require.NoError(t, bs.slasherDB.SetLatestInclusionCheckedSlot(ctx, 1))
+
+	block := func(slot uint64, root, parent byte, body *ethpb.BeaconBlockBody) [32]byte {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = bytesutil.PadTo([]byte{parent}, 32)
+		if body != nil {
+			blk.Block.Body = body
+		}
+		r := bytesutil.ToBytes32([]byte{root})
+		client.EXPECT().ListBlocks(gomock.Any(), &ethpb.ListBlocksRequest{
+			QueryFilter: &ethpb.ListBlocksRequest_Root{Root: r[:]},
+		}).Return(&ethpb.ListBlocksResponse{
+			BlockContainers: []*ethpb.BeaconBlockContainer{{Block: blk, BlockRoot: r[:]}},
+		}, nil).AnyTimes()
+		return r
+	}
+	block(1, 1, 0, nil)
+	block(2, 2, 1, &ethpb.BeaconBlockBody{
+		AttesterSlashings: []*ethpb.AttesterSlashing{included},
+	})
+	root3 := block(3, 3, 2, nil)
+	client.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadBlockRoot: root3[:]}, nil)
+
+	require.NoError(t, bs.updateIncludedSlashings(ctx))
+	assert.Equal(t, 2, len(bs.canonicalBlocks))
+	_, st, err := bs.slasherDB.HasAttesterSlashing(ctx, detected)
+	require.NoError(t, err)
+	assert.Equal(t, types.SlashingStatus(types.Included), st)
+	checkedSlot, err := bs.slasherDB.LatestInclusionCheckedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), checkedSlot)
+}
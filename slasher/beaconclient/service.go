@@ -62,6 +62,7 @@ type Service struct {
 	publicKeyCache              *cache.PublicKeyCache
 	genesisValidatorRoot        []byte
 	beaconDialOptions           []grpc.DialOption
+	canonicalBlocks             []*canonicalBlock
 }
 
 // Config options for the beaconclient service.
@@ -198,5 +199,7 @@ func (bs *Service) Start() {
 	// as they are found.
 	go bs.subscribeDetectedProposerSlashings(bs.ctx, bs.proposerSlashingsChan)
 	go bs.subscribeDetectedAttesterSlashings(bs.ctx, bs.attesterSlashingsChan)
+	// We track the inclusion of the slashings in the canonical chain.
+	go bs.trackSlashingInclusion(bs.ctx)
 
 }
@@ -7,6 +7,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -25,6 +26,10 @@ func (bs *Service) subscribeDetectedProposerSlashings(ctx context.Context, ch ch
 		case slashing := <-ch:
 			if _, err := bs.beaconClient.SubmitProposerSlashing(ctx, slashing); err != nil {
 				log.Error(err)
+				continue
+			}
+			if err := bs.slasherDB.SaveProposerSlashing(ctx, types.Submitted, slashing); err != nil {
+				log.WithError(err).Error("Could not mark proposer slashing as submitted")
 			}
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
@@ -57,6 +62,9 @@ func (bs *Service) subscribeDetectedAttesterSlashings(ctx context.Context, ch ch
 						"targetEpoch": slashing.Attestation_1.Data.Target.Epoch,
 						"indices":     slashableIndices,
 					}).Info("Found a valid attester slashing! Submitting to beacon node")
+					if err := bs.slasherDB.SaveAttesterSlashing(ctx, types.Submitted, slashing); err != nil {
+						log.WithError(err).Error("Could not mark attester slashing as submitted")
+					}
 				} else if strings.Contains(err.Error(), helpers.ErrSigFailedToVerify.Error()) {
 					log.WithError(err).Errorf("Could not submit attester slashing with indices %v", slashableIndices)
 				} else if !strings.Contains(err.Error(), "could not slash") {
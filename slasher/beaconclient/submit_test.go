@@ -8,7 +8,10 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -20,6 +23,7 @@ func TestService_SubscribeDetectedProposerSlashings(t *testing.T) {
 
 	bs := Service{
 		beaconClient:          client,
+		slasherDB:             testDB.SetupSlasherDB(t, false),
 		proposerSlashingsFeed: new(event.Feed),
 	}
 
@@ -28,6 +32,9 @@ func TestService_SubscribeDetectedProposerSlashings(t *testing.T) {
 			Header: &ethpb.BeaconBlockHeader{
 				ProposerIndex: 5,
 				Slot:          5,
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      make([]byte, 32),
 			},
 			Signature: make([]byte, 96),
 		},
@@ -35,6 +42,9 @@ func TestService_SubscribeDetectedProposerSlashings(t *testing.T) {
 			Header: &ethpb.BeaconBlockHeader{
 				ProposerIndex: 5,
 				Slot:          5,
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      make([]byte, 32),
 			},
 			Signature: make([]byte, 96),
 		},
@@ -52,6 +62,10 @@ func TestService_SubscribeDetectedProposerSlashings(t *testing.T) {
 	cancel()
 	exitRoutine <- true
 	require.LogsContain(t, hook, "Context canceled")
+	found, st, err := bs.slasherDB.HasProposerSlashing(context.Background(), slashing)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, types.SlashingStatus(types.Submitted), st)
 }
 
 func TestService_SubscribeDetectedAttesterSlashings(t *testing.T) {
@@ -62,6 +76,7 @@ func TestService_SubscribeDetectedAttesterSlashings(t *testing.T) {
 
 	bs := Service{
 		beaconClient:          client,
+		slasherDB:             testDB.SetupSlasherDB(t, false),
 		attesterSlashingsFeed: new(event.Feed),
 	}
 
@@ -69,17 +84,32 @@ func TestService_SubscribeDetectedAttesterSlashings(t *testing.T) {
 		Attestation_1: &ethpb.IndexedAttestation{
 			AttestingIndices: []uint64{1, 2, 3},
 			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
 				Source: &ethpb.Checkpoint{
 					Epoch: 3,
+					Root:  make([]byte, 32),
 				},
 				Target: &ethpb.Checkpoint{
 					Epoch: 4,
+					Root:  make([]byte, 32),
 				},
 			},
+			Signature: make([]byte, 96),
 		},
 		Attestation_2: &ethpb.IndexedAttestation{
 			AttestingIndices: []uint64{3, 4, 5},
-			Data:             nil,
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Source: &ethpb.Checkpoint{
+					Epoch: 2,
+					Root:  make([]byte, 32),
+				},
+				Target: &ethpb.Checkpoint{
+					Epoch: 5,
+					Root:  make([]byte, 32),
+				},
+			},
+			Signature: make([]byte, 96),
 		},
 	}
 
@@ -95,4 +125,8 @@ func TestService_SubscribeDetectedAttesterSlashings(t *testing.T) {
 	cancel()
 	exitRoutine <- true
 	require.LogsContain(t, hook, "Context canceled")
+	found, st, err := bs.slasherDB.HasAttesterSlashing(context.Background(), slashing)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, types.SlashingStatus(types.Submitted), st)
 }
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+var (
+	// spanChunksCacheSize defines the max number of min-max span chunks the cache can hold.
+	spanChunksCacheSize = 1500
+	// Metrics for the span chunks cache.
+	spanChunksCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "span_chunks_cache_hit",
+		Help: "The total number of cache hits on the span chunks cache.",
+	})
+	spanChunksCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "span_chunks_cache_miss",
+		Help: "The total number of cache misses on the span chunks cache.",
+	})
+)
+
+type spanChunkKey struct {
+	kind types.SpanKind
+	key  types.ChunkKey
+}
+
+// SpanChunksCache is used to store the most recently used min-max span chunks.
+// Chunks are written through to the database, so evictions need no persistence.
+// The cached chunks are shared, callers must not modify them.
+type SpanChunksCache struct {
+	cache *lru.Cache
+}
+
+// NewSpanChunksCache initializes the cache.
+func NewSpanChunksCache(size int) (*SpanChunksCache, error) {
+	if size != 0 {
+		spanChunksCacheSize = size
+	}
+	cache, err := lru.New(spanChunksCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &SpanChunksCache{cache: cache}, nil
+}
+
+// Get returns the cached chunk for the requested kind and key, if any.
+func (c *SpanChunksCache) Get(kind types.SpanKind, key types.ChunkKey) ([]uint16, bool) {
+	item, exists := c.cache.Get(spanChunkKey{kind: kind, key: key})
+	if exists && item != nil {
+		spanChunksCacheHit.Inc()
+		return item.([]uint16), true
+	}
+	spanChunksCacheMiss.Inc()
+	return nil, false
+}
+
+// Set the chunk in the cache.
+func (c *SpanChunksCache) Set(kind types.SpanKind, key types.ChunkKey, chunk []uint16) {
+	c.cache.Add(spanChunkKey{kind: kind, key: key}, chunk)
+}
+
+// Length returns the number of cached chunks.
+func (c *SpanChunksCache) Length() int {
+	return c.cache.Len()
+}
+
+// Purge removes all chunks from the cache.
+func (c *SpanChunksCache) Purge() {
+	c.cache.Purge()
+}
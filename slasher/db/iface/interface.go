@@ -21,6 +21,7 @@ type ReadOnlyDatabase interface {
 	DeleteAttesterSlashing(ctx context.Context, attesterSlashing *ethpb.AttesterSlashing) error
 	HasAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) (bool, types.SlashingStatus, error)
 	GetLatestEpochDetected(ctx context.Context) (uint64, error)
+	LatestInclusionCheckedSlot(ctx context.Context) (uint64, error)
 
 	// BlockHeader related methods.
 	BlockHeaders(ctx context.Context, slot uint64, validatorID uint64) ([]*ethpb.SignedBeaconBlockHeader, error)
@@ -36,7 +37,8 @@ type ReadOnlyDatabase interface {
 	HighestAttestation(ctx context.Context, validatorID uint64) (*slashpb.HighestAttestation, error)
 
 	// MinMaxSpan related methods.
-	EpochSpans(ctx context.Context, epoch uint64, fromCache bool) (*detectionTypes.EpochStore, error)
+	SpanChunks(ctx context.Context, kind detectionTypes.SpanKind, keys []detectionTypes.ChunkKey) ([][]uint16, error)
+	AttestationRecords(ctx context.Context, targetEpoch uint64, validatorIndices []uint64) (map[uint64][2]byte, error)
 
 	// ProposerSlashing related methods.
 	ProposalSlashingsByStatus(ctx context.Context, status types.SlashingStatus) ([]*ethpb.ProposerSlashing, error)
@@ -47,9 +49,6 @@ type ReadOnlyDatabase interface {
 
 	// Chain data related methods.
 	ChainHead(ctx context.Context) (*ethpb.ChainHead, error)
-
-	// Cache management methods.
-	RemoveOldestFromCache(ctx context.Context) uint64
 }
 
 // WriteAccessDatabase represents a write access database with only functions that can modify the DB.
@@ -58,6 +57,7 @@ type WriteAccessDatabase interface {
 	SaveAttesterSlashing(ctx context.Context, status types.SlashingStatus, slashing *ethpb.AttesterSlashing) error
 	SaveAttesterSlashings(ctx context.Context, status types.SlashingStatus, slashings []*ethpb.AttesterSlashing) error
 	SetLatestEpochDetected(ctx context.Context, epoch uint64) error
+	SetLatestInclusionCheckedSlot(ctx context.Context, slot uint64) error
 
 	// BlockHeader related methods.
 	SaveBlockHeader(ctx context.Context, blockHeader *ethpb.SignedBeaconBlockHeader) error
@@ -74,7 +74,10 @@ type WriteAccessDatabase interface {
 	SaveHighestAttestation(ctx context.Context, highest *slashpb.HighestAttestation) error
 
 	// MinMaxSpan related methods.
-	SaveEpochSpans(ctx context.Context, epoch uint64, spans *detectionTypes.EpochStore, toCache bool) error
+	SaveSpanChunks(ctx context.Context, kind detectionTypes.SpanKind, keys []detectionTypes.ChunkKey, chunks [][]uint16) error
+	PruneSpanChunks(ctx context.Context, epochChunk uint64) error
+	SaveAttestationRecords(ctx context.Context, targetEpoch uint64, records map[uint64][2]byte) error
+	PruneAttestationRecords(ctx context.Context, epoch uint64) error
 
 	// ProposerSlashing related methods.
 	DeleteProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error
@@ -103,9 +106,3 @@ type Database interface {
 	DatabasePath() string
 	ClearDB() error
 }
-
-// EpochSpansStore represents a data access layer for marshaling and unmarshaling validator spans for each validator per epoch.
-type EpochSpansStore interface {
-	SetValidatorSpan(ctx context.Context, idx uint64, newSpan detectionTypes.Span) error
-	GetValidatorSpan(ctx context.Context, idx uint64) (detectionTypes.Span, error)
-}
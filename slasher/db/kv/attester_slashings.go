@@ -180,3 +180,32 @@ func (db *Store) SetLatestEpochDetected(ctx context.Context, epoch uint64) error
 		return err
 	})
 }
+
+// LatestInclusionCheckedSlot returns the slot of the latest canonical block whose slashings
+// were checked for inclusion, or 0 if no block was checked.
+func (db *Store) LatestInclusionCheckedSlot(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.LatestInclusionCheckedSlot")
+	defer span.End()
+	var slot uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(slashingBucket)
+		enc := b.Get([]byte(latestInclusionSlotKey))
+		if enc == nil {
+			return nil
+		}
+		slot = bytesutil.FromBytes8(enc)
+		return nil
+	})
+	return slot, err
+}
+
+// SetLatestInclusionCheckedSlot sets the slot of the latest canonical block whose slashings
+// were checked for inclusion.
+func (db *Store) SetLatestInclusionCheckedSlot(ctx context.Context, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SetLatestInclusionCheckedSlot")
+	defer span.End()
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(slashingBucket)
+		return b.Put([]byte(latestInclusionSlotKey), bytesutil.Bytes8(slot))
+	})
+}
@@ -4,95 +4,59 @@ import (
 	"context"
 	"testing"
 
-	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 )
 
 const (
 	benchmarkValidator = 300000
+	// benchmarkChunkLength is the number of spans in a chunk of 16 epochs for 256 validators.
+	benchmarkChunkLength = 16 * 256
 )
 
-func BenchmarkStore_SaveEpochSpans(b *testing.B) {
+func benchmarkSpanChunks() ([]types.ChunkKey, [][]uint16) {
+	numChunks := benchmarkValidator / 256
+	keys := make([]types.ChunkKey, numChunks)
+	chunks := make([][]uint16, numChunks)
+	for i := 0; i < numChunks; i++ {
+		keys[i] = types.ChunkKey{ValidatorChunk: uint64(i)}
+		chunks[i] = make([]uint16, benchmarkChunkLength)
+		for j := range chunks[i] {
+			chunks[i][j] = uint16(i + j)
+		}
+	}
+	return keys, chunks
+}
+
+func BenchmarkStore_SaveSpanChunks(b *testing.B) {
 	ctx := context.Background()
-	sigBytes := [2]byte{}
 	db := setupDB(b)
-	es := &types.EpochStore{}
+	db.EnableSpanCache(false)
+	keys, chunks := benchmarkSpanChunks()
 
-	es, err := es.SetValidatorSpan(benchmarkValidator, types.Span{MinSpan: 1, MaxSpan: 2, SigBytes: sigBytes, HasAttested: true})
-	assert.NoError(b, err)
-	for i := 0; i < benchmarkValidator; i++ {
-		es, err = es.SetValidatorSpan(uint64(i), types.Span{MinSpan: 1, MaxSpan: 2, SigBytes: sigBytes, HasAttested: true})
-		assert.NoError(b, err)
-	}
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		err := db.SaveEpochSpans(ctx, uint64(i%54000), es, false)
-		require.NoError(b, err, "Save validator span map failed")
+		for j := range keys {
+			keys[j].EpochChunk = uint64(i % 3375)
+		}
+		err := db.SaveSpanChunks(ctx, types.MinSpan, keys, chunks)
+		require.NoError(b, err, "Save span chunks failed")
 	}
 }
 
-func BenchmarkStore_EpochSpans(b *testing.B) {
+func BenchmarkStore_SpanChunks(b *testing.B) {
 	db := setupDB(b)
+	db.EnableSpanCache(false)
 	ctx := context.Background()
-	sigBytes := [2]byte{}
-	es := &types.EpochStore{}
-	es, err := es.SetValidatorSpan(benchmarkValidator, types.Span{MinSpan: 1, MaxSpan: 2, SigBytes: sigBytes, HasAttested: true})
-	assert.NoError(b, err)
-	for i := 0; i < benchmarkValidator; i++ {
-		es, err = es.SetValidatorSpan(uint64(i), types.Span{MinSpan: 1, MaxSpan: 2, SigBytes: sigBytes, HasAttested: true})
-		assert.NoError(b, err)
-	}
-	b.Log(len(es.Bytes()))
-	for i := 0; i < 200; i++ {
-		err := db.SaveEpochSpans(ctx, uint64(i), es, false)
-		require.NoError(b, err, "Save validator span map failed")
-	}
+	keys, chunks := benchmarkSpanChunks()
+	require.NoError(b, db.SaveSpanChunks(ctx, types.MinSpan, keys, chunks), "Save span chunks failed")
 	b.Log(db.db.Info())
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := db.EpochSpans(ctx, uint64(i%200), false)
-		require.NoError(b, err, "Read validator span map failed")
-	}
-}
 
-func BenchmarkStore_GetValidatorSpan(b *testing.B) {
-	sigBytes := [2]byte{}
-	es := &types.EpochStore{}
-	es, err := es.SetValidatorSpan(benchmarkValidator, types.Span{MinSpan: 1, MaxSpan: 2, SigBytes: sigBytes, HasAttested: true})
-	assert.NoError(b, err)
-	for i := 0; i < benchmarkValidator; i++ {
-		es, err = es.SetValidatorSpan(uint64(i), types.Span{MinSpan: uint16(i), MaxSpan: uint16(benchmarkValidator - i), SigBytes: sigBytes, HasAttested: true})
-		assert.NoError(b, err)
-	}
-	b.Log(len(es.Bytes()))
-
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := es.GetValidatorSpan(uint64(i % benchmarkValidator))
-		require.NoError(b, err, "Read validator span map failed")
-	}
-}
-
-func BenchmarkStore_SetValidatorSpan(b *testing.B) {
-	sigBytes := [2]byte{}
-	var err error
-	es := &types.EpochStore{}
-	es, err = es.SetValidatorSpan(benchmarkValidator, types.Span{MinSpan: 1, MaxSpan: 2, SigBytes: sigBytes, HasAttested: true})
-	assert.NoError(b, err)
-
-	for i := 0; i < benchmarkValidator; i++ {
-		es, err = es.SetValidatorSpan(uint64(i), types.Span{MinSpan: uint16(i), MaxSpan: uint16(benchmarkValidator - i), SigBytes: sigBytes, HasAttested: true})
-		assert.NoError(b, err)
-	}
-	b.Log(len(es.Bytes()))
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		es, err = es.SetValidatorSpan(uint64(i%benchmarkValidator), types.Span{MinSpan: uint16(i), MaxSpan: uint16(benchmarkValidator - i), SigBytes: sigBytes, HasAttested: true})
-		require.NoError(b, err, "Read validator span map failed")
+		_, err := db.SpanChunks(ctx, types.MinSpan, keys)
+		require.NoError(b, err, "Read span chunks failed")
 	}
 }
@@ -3,7 +3,6 @@
 package kv
 
 import (
-	"context"
 	"os"
 	"path"
 	"path/filepath"
@@ -13,7 +12,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/slasher/cache"
 	bolt "go.etcd.io/bbolt"
-	"go.opencensus.io/trace"
 )
 
 const (
@@ -29,36 +27,28 @@ type Store struct {
 	highestAttCacheEnabled  bool
 	spanCacheEnabled        bool
 	highestAttestationCache *cache.HighestAttestationCache
-	flatSpanCache           *cache.EpochFlatSpansCache
+	spanChunksCache         *cache.SpanChunksCache
 	db                      *bolt.DB
 	databasePath            string
 }
 
 // Config options for the slasher db.
 type Config struct {
-	// SpanCacheSize determines the span map cache size.
+	// SpanCacheSize determines the number of span chunks kept in memory.
 	SpanCacheSize               int
 	HighestAttestationCacheSize int
 }
 
 // Close closes the underlying boltdb database.
 func (db *Store) Close() error {
-	db.flatSpanCache.Purge()
+	db.spanChunksCache.Purge()
 	db.highestAttestationCache.Purge()
 	return db.db.Close()
 }
 
-// RemoveOldestFromCache clears the oldest key out of the cache only if the cache is at max capacity.
-func (db *Store) RemoveOldestFromCache(ctx context.Context) uint64 {
-	ctx, span := trace.StartSpan(ctx, "slasherDB.removeOldestFromCache")
-	defer span.End()
-	epochRemoved := db.flatSpanCache.PruneOldest()
-	return epochRemoved
-}
-
-// ClearSpanCache clears the spans cache.
+// ClearSpanCache clears the span chunks cache.
 func (db *Store) ClearSpanCache() {
-	db.flatSpanCache.Purge()
+	db.spanChunksCache.Purge()
 }
 
 func (db *Store) update(fn func(*bolt.Tx) error) error {
@@ -90,6 +80,19 @@ func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 	return nil
 }
 
+// deleteBuckets deletes the given buckets if they exist, used to remove deprecated buckets.
+func deleteBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+	for _, bucket := range buckets {
+		if tx.Bucket(bucket) == nil {
+			continue
+		}
+		if err := tx.DeleteBucket(bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewKVStore initializes a new boltDB key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
@@ -115,15 +118,18 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 	kv := &Store{db: boltDB, databasePath: dirPath}
 	kv.EnableSpanCache(true)
 	kv.EnableHighestAttestationCache(true)
-	flatSpanCache, err := cache.NewEpochFlatSpansCache(cfg.SpanCacheSize, persistFlatSpanMapsOnEviction(kv))
+	spanChunksCache, err := cache.NewSpanChunksCache(cfg.SpanCacheSize)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not create new flat cache")
+		return nil, errors.Wrap(err, "could not create new span chunks cache")
 	}
-	kv.flatSpanCache = flatSpanCache
+	kv.spanChunksCache = spanChunksCache
 	highestAttCache, err := cache.NewHighestAttestationCache(cfg.HighestAttestationCacheSize, persistHighestAttestationCacheOnEviction(kv))
 	kv.highestAttestationCache = highestAttCache
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
+		if err := deleteBuckets(tx, validatorsMinMaxSpanBucket, validatorsMinMaxSpanBucketNew); err != nil {
+			return err
+		}
 		return createBuckets(
 			tx,
 			indexedAttestationsBucket,
@@ -132,8 +138,9 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 			historicBlockHeadersBucket,
 			compressedIdxAttsBucket,
 			validatorsPublicKeysBucket,
-			validatorsMinMaxSpanBucket,
-			validatorsMinMaxSpanBucketNew,
+			minSpanChunksBucket,
+			maxSpanChunksBucket,
+			attestationRecordsBucket,
 			slashingBucket,
 			chainDataBucket,
 			highestAttestationBucket,
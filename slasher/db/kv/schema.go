@@ -6,8 +6,9 @@ import (
 )
 
 const (
-	latestEpochKey = "LATEST_EPOCH_DETECTED"
-	chainHeadKey   = "CHAIN_HEAD"
+	latestEpochKey         = "LATEST_EPOCH_DETECTED"
+	chainHeadKey           = "CHAIN_HEAD"
+	latestInclusionSlotKey = "LATEST_INCLUSION_CHECKED_SLOT"
 )
 
 var (
@@ -22,8 +23,12 @@ var (
 	compressedIdxAttsBucket           = []byte("compressed-idx-atts-bucket")
 	validatorsPublicKeysBucket        = []byte("validators-public-keys-bucket")
 	// In order to quickly detect surround and surrounded attestations we need to store
-	// the min and max span for each validator for each epoch.
+	// the min and max span for each validator for each epoch, in chunks of validators and epochs.
 	// see https://github.com/protolambda/eth2-surround/blob/master/README.md#min-max-surround
+	minSpanChunksBucket      = []byte("min-span-chunks-bucket")
+	maxSpanChunksBucket      = []byte("max-span-chunks-bucket")
+	attestationRecordsBucket = []byte("attestation-records-bucket")
+	// Deprecated buckets of the flat per epoch span maps, deleted on startup.
 	validatorsMinMaxSpanBucket    = []byte("validators-min-max-span-bucket")
 	validatorsMinMaxSpanBucketNew = []byte("validators-min-max-span-bucket-new")
 )
@@ -0,0 +1,257 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Tracks the highest and lowest observed epochs from the attestation records
+// used for attester slashing detection. This value is purely used
+// to trigger pruning and only needs to be maintained in memory.
+var highestObservedEpoch uint64
+var lowestObservedEpoch = params.BeaconConfig().FarFutureEpoch
+
+var (
+	slasherLowestObservedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_lowest_observed_epoch",
+		Help: "The lowest epoch number seen by slasher",
+	})
+	slasherHighestObservedEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "slasher_highest_observed_epoch",
+		Help: "The highest epoch number seen by slasher",
+	})
+)
+
+// SpanChunks returns the min or max span chunks for the given keys, in the same order.
+// Chunks which were never saved are returned as nil. The chunks are copies which the caller
+// may modify, the cached chunks only change once saved.
+func (db *Store) SpanChunks(ctx context.Context, kind types.SpanKind, keys []types.ChunkKey) ([][]uint16, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SpanChunks")
+	defer span.End()
+	chunks := make([][]uint16, len(keys))
+	var missing []int
+	for i, key := range keys {
+		if db.spanCacheEnabled {
+			if chunk, ok := db.spanChunksCache.Get(kind, key); ok {
+				chunks[i] = copySpanChunk(chunk)
+				continue
+			}
+		}
+		missing = append(missing, i)
+	}
+	if len(missing) == 0 {
+		return chunks, nil
+	}
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(spanChunksBucket(kind))
+		for _, i := range missing {
+			enc := b.Get(encodeChunkKey(keys[i]))
+			if enc == nil {
+				continue
+			}
+			chunk, err := decodeSpanChunk(enc)
+			if err != nil {
+				return err
+			}
+			chunks[i] = chunk
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if db.spanCacheEnabled {
+		for _, i := range missing {
+			if chunks[i] != nil {
+				db.spanChunksCache.Set(kind, keys[i], copySpanChunk(chunks[i]))
+			}
+		}
+	}
+	return chunks, nil
+}
+
+// SaveSpanChunks saves the min or max span chunks under the given keys in a single transaction.
+func (db *Store) SaveSpanChunks(ctx context.Context, kind types.SpanKind, keys []types.ChunkKey, chunks [][]uint16) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveSpanChunks")
+	defer span.End()
+	if len(keys) != len(chunks) {
+		return errors.New("number of keys and chunks differ")
+	}
+	err := db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(spanChunksBucket(kind))
+		for i, key := range keys {
+			if err := b.Put(encodeChunkKey(key), encodeSpanChunk(chunks[i])); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if db.spanCacheEnabled {
+		for i, key := range keys {
+			db.spanChunksCache.Set(kind, key, copySpanChunk(chunks[i]))
+		}
+	}
+	return nil
+}
+
+// PruneSpanChunks deletes the min and max span chunks of all epoch chunks before the given one.
+func (db *Store) PruneSpanChunks(ctx context.Context, epochChunk uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PruneSpanChunks")
+	defer span.End()
+	err := db.update(func(tx *bolt.Tx) error {
+		max := bytesutil.Uint64ToBytesBigEndian(epochChunk)
+		for _, kind := range []types.SpanKind{types.MinSpan, types.MaxSpan} {
+			b := tx.Bucket(spanChunksBucket(kind))
+			c := b.Cursor()
+			for k, _ := c.First(); k != nil && bytes.Compare(k[:8], max) < 0; k, _ = c.First() {
+				if err := b.Delete(k); err != nil {
+					return errors.Wrap(err, "failed to delete span chunk")
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	db.spanChunksCache.Purge()
+	return nil
+}
+
+// AttestationRecords returns the signature bytes of the attestations for the given target epoch
+// recorded for the given validators, keyed by validator index. Validators without a recorded
+// attestation are absent from the result.
+func (db *Store) AttestationRecords(ctx context.Context, targetEpoch uint64, validatorIndices []uint64) (map[uint64][2]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.AttestationRecords")
+	defer span.End()
+	records := make(map[uint64][2]byte)
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(attestationRecordsBucket)
+		for _, idx := range validatorIndices {
+			enc := b.Get(encodeTargetValidator(targetEpoch, idx))
+			if len(enc) != 2 {
+				continue
+			}
+			records[idx] = [2]byte{enc[0], enc[1]}
+		}
+		return nil
+	})
+	return records, err
+}
+
+// SaveAttestationRecords records the signature bytes of the attestations for the given target epoch,
+// keyed by validator index.
+func (db *Store) SaveAttestationRecords(ctx context.Context, targetEpoch uint64, records map[uint64][2]byte) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.SaveAttestationRecords")
+	defer span.End()
+	// Also prune indexed attestations older than the weak subjectivity period.
+	if err := db.setObservedEpochs(ctx, targetEpoch); err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(attestationRecordsBucket)
+		for idx, sigBytes := range records {
+			// Copy the signature bytes, bolt keeps the value until the transaction commits.
+			enc := []byte{sigBytes[0], sigBytes[1]}
+			if err := b.Put(encodeTargetValidator(targetEpoch, idx), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PruneAttestationRecords deletes the attestation records of all target epochs before the given one.
+func (db *Store) PruneAttestationRecords(ctx context.Context, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.PruneAttestationRecords")
+	defer span.End()
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(attestationRecordsBucket)
+		c := b.Cursor()
+		max := bytesutil.Uint64ToBytesBigEndian(epoch)
+		for k, _ := c.First(); k != nil && bytes.Compare(k[:8], max) < 0; k, _ = c.First() {
+			if err := b.Delete(k); err != nil {
+				return errors.Wrap(err, "failed to delete attestation record")
+			}
+		}
+		return nil
+	})
+}
+
+// EnableSpanCache used to enable or disable span chunks cache in tests.
+func (db *Store) EnableSpanCache(enable bool) {
+	db.spanCacheEnabled = enable
+}
+
+func (db *Store) setObservedEpochs(ctx context.Context, epoch uint64) error {
+	var err error
+	if epoch > highestObservedEpoch {
+		slasherHighestObservedEpoch.Set(float64(epoch))
+		highestObservedEpoch = epoch
+		// Prune block header history every PruneSlasherStoragePeriod epoch.
+		if highestObservedEpoch%params.BeaconConfig().PruneSlasherStoragePeriod == 0 {
+			if err = db.PruneAttHistory(ctx, epoch, params.BeaconConfig().WeakSubjectivityPeriod); err != nil {
+				return errors.Wrap(err, "failed to prune indexed attestations store")
+			}
+		}
+	}
+	if epoch < lowestObservedEpoch {
+		slasherLowestObservedEpoch.Set(float64(epoch))
+		lowestObservedEpoch = epoch
+	}
+	return err
+}
+
+func spanChunksBucket(kind types.SpanKind) []byte {
+	if kind == types.MinSpan {
+		return minSpanChunksBucket
+	}
+	return maxSpanChunksBucket
+}
+
+// Chunks are keyed by epoch chunk first, so that pruning walks the oldest chunks in order.
+func encodeChunkKey(key types.ChunkKey) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(key.EpochChunk), bytesutil.Uint64ToBytesBigEndian(key.ValidatorChunk)...)
+}
+
+func encodeTargetValidator(targetEpoch, validatorIdx uint64) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(targetEpoch), bytesutil.Uint64ToBytesBigEndian(validatorIdx)...)
+}
+
+func encodeSpanChunk(chunk []uint16) []byte {
+	enc := make([]byte, len(chunk)*2)
+	for i, span := range chunk {
+		binary.LittleEndian.PutUint16(enc[i*2:], span)
+	}
+	return enc
+}
+
+func copySpanChunk(chunk []uint16) []uint16 {
+	copied := make([]uint16, len(chunk))
+	copy(copied, chunk)
+	return copied
+}
+
+func decodeSpanChunk(enc []byte) ([]uint16, error) {
+	if len(enc)%2 != 0 {
+		return nil, errors.New("wrong data length for span chunk")
+	}
+	chunk := make([]uint16, len(enc)/2)
+	for i := range chunk {
+		chunk[i] = binary.LittleEndian.Uint16(enc[i*2:])
+	}
+	return chunk, nil
+}
@@ -0,0 +1,179 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestStore_SpanChunks_NilDB(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	keys := []types.ChunkKey{{ValidatorChunk: 1, EpochChunk: 2}}
+	chunks, err := db.SpanChunks(ctx, types.MinSpan, keys)
+	require.NoError(t, err, "Missing span chunks should not return error")
+	require.Equal(t, 1, len(chunks))
+	assert.DeepEqual(t, []uint16(nil), chunks[0], "Missing span chunk should be nil")
+}
+
+func TestStore_SaveReadSpanChunks(t *testing.T) {
+	for _, cacheEnabled := range []bool{true, false} {
+		db := setupDB(t)
+		db.EnableSpanCache(cacheEnabled)
+		ctx := context.Background()
+
+		keys := []types.ChunkKey{
+			{ValidatorChunk: 0, EpochChunk: 0},
+			{ValidatorChunk: 3, EpochChunk: 1},
+		}
+		minChunks := [][]uint16{{1, 2, 3, 65535}, {4, 5, 6, 7}}
+		maxChunks := [][]uint16{{0, 0, 9, 0}, {8, 0, 0, 1}}
+		require.NoError(t, db.SaveSpanChunks(ctx, types.MinSpan, keys, minChunks))
+		require.NoError(t, db.SaveSpanChunks(ctx, types.MaxSpan, keys, maxChunks))
+
+		// Read the keys in reverse, along with a missing one.
+		readKeys := []types.ChunkKey{keys[1], {ValidatorChunk: 3, EpochChunk: 0}, keys[0]}
+		chunks, err := db.SpanChunks(ctx, types.MinSpan, readKeys)
+		require.NoError(t, err)
+		assert.DeepEqual(t, [][]uint16{minChunks[1], nil, minChunks[0]}, chunks)
+		chunks, err = db.SpanChunks(ctx, types.MaxSpan, readKeys)
+		require.NoError(t, err)
+		assert.DeepEqual(t, [][]uint16{maxChunks[1], nil, maxChunks[0]}, chunks)
+
+		// Modifying a returned chunk should not modify the stored one.
+		chunks[0][0] = 100
+		chunks, err = db.SpanChunks(ctx, types.MaxSpan, keys[1:])
+		require.NoError(t, err)
+		assert.DeepEqual(t, maxChunks[1], chunks[0])
+	}
+}
+
+func TestStore_SaveSpanChunks_ToCache(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	keys := []types.ChunkKey{{ValidatorChunk: 1, EpochChunk: 1}}
+	saved := [][]uint16{{1, 2}}
+	require.NoError(t, db.SaveSpanChunks(ctx, types.MinSpan, keys, saved))
+	require.Equal(t, 1, db.spanChunksCache.Length())
+
+	// Modifying a saved chunk should not modify the cached one.
+	saved[0][0] = 100
+	chunks, err := db.SpanChunks(ctx, types.MinSpan, keys)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint16{1, 2}, chunks[0])
+
+	// Expect the chunk to be read from the DB once the cache is cleared.
+	db.ClearSpanCache()
+	chunks, err = db.SpanChunks(ctx, types.MinSpan, keys)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint16{1, 2}, chunks[0])
+	require.Equal(t, 1, db.spanChunksCache.Length())
+
+	// Modifying a chunk read from the DB should not modify the cached one.
+	chunks[0][0] = 100
+	chunks, err = db.SpanChunks(ctx, types.MinSpan, keys)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint16{1, 2}, chunks[0])
+}
+
+func TestStore_PruneSpanChunks(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	var keys []types.ChunkKey
+	var chunks [][]uint16
+	for epochChunk := uint64(0); epochChunk < 5; epochChunk++ {
+		for validatorChunk := uint64(0); validatorChunk < 3; validatorChunk++ {
+			keys = append(keys, types.ChunkKey{ValidatorChunk: validatorChunk, EpochChunk: epochChunk})
+			chunks = append(chunks, []uint16{uint16(epochChunk), uint16(validatorChunk)})
+		}
+	}
+	require.NoError(t, db.SaveSpanChunks(ctx, types.MinSpan, keys, chunks))
+	require.NoError(t, db.SaveSpanChunks(ctx, types.MaxSpan, keys, chunks))
+
+	require.NoError(t, db.PruneSpanChunks(ctx, 3))
+	for _, kind := range []types.SpanKind{types.MinSpan, types.MaxSpan} {
+		loaded, err := db.SpanChunks(ctx, kind, keys)
+		require.NoError(t, err)
+		for i, key := range keys {
+			if key.EpochChunk < 3 {
+				assert.DeepEqual(t, []uint16(nil), loaded[i], "Expected chunk %v to be pruned", key)
+			} else {
+				assert.DeepEqual(t, chunks[i], loaded[i], "Expected chunk %v to be kept", key)
+			}
+		}
+	}
+}
+
+func TestStore_AttestationRecords(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveAttestationRecords(ctx, 2, map[uint64][2]byte{1: {1, 2}, 300: {3, 4}}))
+	require.NoError(t, db.SaveAttestationRecords(ctx, 5, map[uint64][2]byte{1: {5, 6}}))
+
+	records, err := db.AttestationRecords(ctx, 2, []uint64{1, 2, 300})
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[uint64][2]byte{1: {1, 2}, 300: {3, 4}}, records)
+	records, err = db.AttestationRecords(ctx, 5, []uint64{1, 300})
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[uint64][2]byte{1: {5, 6}}, records)
+
+	require.NoError(t, db.PruneAttestationRecords(ctx, 5))
+	records, err = db.AttestationRecords(ctx, 2, []uint64{1, 300})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records), "Expected records before the pruning epoch to be deleted")
+	records, err = db.AttestationRecords(ctx, 5, []uint64{1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(records), "Expected records of the pruning epoch to be kept")
+}
+
+func TestStore_SlasherObservedEpoch(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	prevConfig := params.BeaconConfig().Copy()
+
+	defer params.OverrideBeaconConfig(prevConfig)
+
+	for _, tt := range tests {
+		require.NoError(t, db.SaveIndexedAttestation(ctx, tt.idxAtt), "Save indexed attestation failed")
+
+		found, err := db.HasIndexedAttestation(ctx, tt.idxAtt)
+		require.NoError(t, err, "Failed to get indexed attestation")
+		require.Equal(t, true, found, "Expected to find attestation in DB")
+	}
+	//dont prune when not multiple of PruneSlasherStoragePeriod
+	params.BeaconConfig().PruneSlasherStoragePeriod = 2
+	highestObservedEpoch = params.BeaconConfig().WeakSubjectivityPeriod
+	require.NoError(t, db.setObservedEpochs(ctx, highestObservedEpoch+1))
+	for _, tt := range tests {
+		exists, err := db.HasIndexedAttestation(ctx, tt.idxAtt)
+		require.NoError(t, err)
+		require.Equal(t, true, exists, "Expected to find attestation newer than prune age in DB")
+
+	}
+	//prune on PruneSlasherStoragePeriod
+	params.BeaconConfig().PruneSlasherStoragePeriod = 1
+	highestObservedEpoch = params.BeaconConfig().WeakSubjectivityPeriod
+	currentEpoch := highestObservedEpoch + 1
+	historyToKeep := highestObservedEpoch
+	require.NoError(t, db.setObservedEpochs(ctx, highestObservedEpoch+1))
+
+	for _, tt := range tests {
+		exists, err := db.HasIndexedAttestation(ctx, tt.idxAtt)
+		require.NoError(t, err)
+
+		if tt.idxAtt.Data.Target.Epoch > currentEpoch-historyToKeep {
+			require.Equal(t, true, exists, "Expected to find attestation newer than prune age in DB")
+		} else {
+			require.Equal(t, false, exists, "Expected to not find attestation older than prune age in DB")
+		}
+	}
+}
@@ -15,6 +15,8 @@ const (
 	Included
 	// Reverted slashing proof that has been reverted and therefore is relevant again.
 	Reverted //relevant again
+	// Submitted slashing proof that has been submitted to a beacon node but not included yet.
+	Submitted
 )
 
 const (
@@ -29,9 +31,10 @@ func (status SlashingStatus) String() string {
 		"Unknown",
 		"Active",
 		"Included",
-		"Reverted"}
+		"Reverted",
+		"Submitted"}
 
-	if status < Active || status > Reverted {
+	if status < Active || status > Submitted {
 		return "Unknown"
 	}
 	// return the name of a SlashingStatus
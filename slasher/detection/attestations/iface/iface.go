@@ -18,4 +18,10 @@ type SpanDetector interface {
 
 	// Write functions.
 	UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error
+
+	// Read and write functions.
+	DetectAndUpdateSpans(
+		ctx context.Context,
+		atts []*ethpb.IndexedAttestation,
+	) ([][]*types.DetectionResult, error)
 }
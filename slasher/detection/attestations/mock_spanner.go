@@ -56,23 +56,24 @@ func (s *MockSpanDetector) DetectSlashingsForAttestation(
 	return detections, nil
 }
 
-// SpanForEpochByValidator returns the specific min-max span for a
-// validator index in a given epoch.
-func (s *MockSpanDetector) SpanForEpochByValidator(_ context.Context, _, _ uint64) (types.Span, error) {
-	return types.Span{MinSpan: 0, MaxSpan: 0, SigBytes: [2]byte{}, HasAttested: false}, nil
-}
-
-// ValidatorSpansByEpoch returns a list of all validator spans in a given epoch.
-func (s *MockSpanDetector) ValidatorSpansByEpoch(_ context.Context, _ uint64) map[uint64]types.Span {
-	return make(map[uint64]types.Span)
-}
-
-// DeleteValidatorSpansByEpoch mocks the delete spans by epoch function.
-func (s *MockSpanDetector) DeleteValidatorSpansByEpoch(_ context.Context, _, _ uint64) error {
-	return nil
-}
-
 // UpdateSpans is a mock for updating the spans for a given attestation..
 func (s *MockSpanDetector) UpdateSpans(_ context.Context, _ *ethpb.IndexedAttestation) error {
 	return nil
 }
+
+// DetectAndUpdateSpans mocks the detection for a batch of attestations, detecting
+// for each attestation as DetectSlashingsForAttestation does.
+func (s *MockSpanDetector) DetectAndUpdateSpans(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+) ([][]*types.DetectionResult, error) {
+	detections := make([][]*types.DetectionResult, len(atts))
+	for i, att := range atts {
+		results, err := s.DetectSlashingsForAttestation(ctx, att)
+		if err != nil {
+			return nil, err
+		}
+		detections[i] = results
+	}
+	return detections, nil
+}
@@ -0,0 +1,335 @@
+package attestations
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"go.opencensus.io/trace"
+)
+
+// spanBatch holds the span chunks and attestation records loaded while processing
+// a batch of attestations, so each chunk is read and written at most once per batch.
+type spanBatch struct {
+	detector     *SpanDetector
+	chunks       map[types.SpanKind]map[types.ChunkKey][]uint16
+	dirtyChunks  map[types.SpanKind]map[types.ChunkKey]bool
+	records      map[uint64]map[uint64][2]byte
+	checked      map[uint64]map[uint64]bool
+	dirtyRecords map[uint64]map[uint64][2]byte
+}
+
+func (s *SpanDetector) newSpanBatch() *spanBatch {
+	return &spanBatch{
+		detector: s,
+		chunks: map[types.SpanKind]map[types.ChunkKey][]uint16{
+			types.MinSpan: make(map[types.ChunkKey][]uint16),
+			types.MaxSpan: make(map[types.ChunkKey][]uint16),
+		},
+		dirtyChunks: map[types.SpanKind]map[types.ChunkKey]bool{
+			types.MinSpan: make(map[types.ChunkKey]bool),
+			types.MaxSpan: make(map[types.ChunkKey]bool),
+		},
+		records:      make(map[uint64]map[uint64][2]byte),
+		checked:      make(map[uint64]map[uint64]bool),
+		dirtyRecords: make(map[uint64]map[uint64][2]byte),
+	}
+}
+
+// prefetch loads the chunks holding the spans at the source epochs and the attestation
+// records at the target epochs of the attestations, which detection always reads.
+func (b *spanBatch) prefetch(ctx context.Context, atts []*ethpb.IndexedAttestation) error {
+	ctx, span := trace.StartSpan(ctx, "spanner.prefetch")
+	defer span.End()
+	var keys []types.ChunkKey
+	seen := make(map[types.ChunkKey]bool)
+	targets := make(map[uint64][]uint64)
+	for _, att := range atts {
+		source, target, err := b.detector.attestationEpochs(att)
+		if err != nil {
+			continue
+		}
+		for _, idx := range att.AttestingIndices {
+			key := b.chunkKey(idx, source)
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+			targets[target] = append(targets[target], idx)
+		}
+	}
+	for _, kind := range []types.SpanKind{types.MinSpan, types.MaxSpan} {
+		if err := b.loadChunks(ctx, kind, keys); err != nil {
+			return err
+		}
+	}
+	for target, indices := range targets {
+		if err := b.loadRecords(ctx, target, indices); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// detect checks the spans and attestation records of a validator for an attestation with the
+// given source and target epochs, returning the first slashable offense found, if any.
+func (b *spanBatch) detect(ctx context.Context, idx, source, target uint64) (*types.DetectionResult, error) {
+	distance := uint16(target - source)
+	minSpan, err := b.span(ctx, types.MinSpan, idx, source)
+	if err != nil {
+		return nil, err
+	}
+	// The attestation surrounds a previous attestation of the validator.
+	if minSpan < distance {
+		return b.surroundResult(ctx, idx, source+uint64(minSpan))
+	}
+	maxSpan, err := b.span(ctx, types.MaxSpan, idx, source)
+	if err != nil {
+		return nil, err
+	}
+	// The attestation is surrounded by a previous attestation of the validator.
+	if maxSpan > distance {
+		return b.surroundResult(ctx, idx, source+uint64(maxSpan))
+	}
+	// Check if the validator has attested for this target epoch or not.
+	sig, ok, err := b.record(ctx, idx, target)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return &types.DetectionResult{
+			ValidatorIndex: idx,
+			Kind:           types.DoubleVote,
+			SlashableEpoch: target,
+			SigBytes:       sig,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (b *spanBatch) surroundResult(ctx context.Context, idx, slashableEpoch uint64) (*types.DetectionResult, error) {
+	sig, _, err := b.record(ctx, idx, slashableEpoch)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DetectionResult{
+		ValidatorIndex: idx,
+		Kind:           types.SurroundVote,
+		SlashableEpoch: slashableEpoch,
+		SigBytes:       sig,
+	}, nil
+}
+
+// update records the attestation of a validator and updates its min and max spans.
+func (b *spanBatch) update(ctx context.Context, idx, source, target uint64, sig [2]byte) error {
+	// If the validator has already attested for this target epoch,
+	// then we do not need to update the recorded signature bytes.
+	if _, ok, err := b.record(ctx, idx, target); err != nil {
+		return err
+	} else if !ok {
+		b.setRecord(idx, target, sig)
+	}
+	latestMinSpanDistanceObserved.Set(float64(target - source))
+	latestMaxSpanDistanceObserved.Set(float64(target - source))
+
+	// Min spans are updated from the epoch before the source back until an epoch which
+	// already has a lower min span, as all earlier epochs then have lower min spans too.
+	lowest := b.lowestEpoch(target)
+	for epoch := source; epoch > lowest; {
+		epoch--
+		newSpan := uint16(target - epoch)
+		minSpan, err := b.span(ctx, types.MinSpan, idx, epoch)
+		if err != nil {
+			return err
+		}
+		if minSpan <= newSpan {
+			break
+		}
+		b.setSpan(types.MinSpan, idx, epoch, newSpan)
+	}
+	// Max spans are updated from the epoch after the source up until an epoch which
+	// already has a higher max span, as all later epochs then have higher max spans too.
+	for epoch := source + 1; epoch < target; epoch++ {
+		newSpan := uint16(target - epoch)
+		maxSpan, err := b.span(ctx, types.MaxSpan, idx, epoch)
+		if err != nil {
+			return err
+		}
+		if maxSpan >= newSpan {
+			break
+		}
+		b.setSpan(types.MaxSpan, idx, epoch, newSpan)
+	}
+	return nil
+}
+
+// flush saves the updated chunks and attestation records, and prunes the chunks
+// and records which fell out of the history.
+func (b *spanBatch) flush(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "spanner.flush")
+	defer span.End()
+	slasherDB := b.detector.slasherDB
+	for _, kind := range []types.SpanKind{types.MinSpan, types.MaxSpan} {
+		if len(b.dirtyChunks[kind]) == 0 {
+			continue
+		}
+		keys := make([]types.ChunkKey, 0, len(b.dirtyChunks[kind]))
+		chunks := make([][]uint16, 0, len(b.dirtyChunks[kind]))
+		for key := range b.dirtyChunks[kind] {
+			keys = append(keys, key)
+			chunks = append(chunks, b.chunks[kind][key])
+		}
+		if err := slasherDB.SaveSpanChunks(ctx, kind, keys, chunks); err != nil {
+			return errors.Wrap(err, "could not save span chunks")
+		}
+	}
+	var highestTarget uint64
+	for target, records := range b.dirtyRecords {
+		if err := slasherDB.SaveAttestationRecords(ctx, target, records); err != nil {
+			return errors.Wrap(err, "could not save attestation records")
+		}
+		if target > highestTarget {
+			highestTarget = target
+		}
+	}
+
+	p := b.detector.params
+	pruneEpochChunk := b.lowestEpoch(highestTarget) / p.ChunkSize
+	if pruneEpochChunk <= b.detector.prunedEpochChunk {
+		return nil
+	}
+	if err := slasherDB.PruneSpanChunks(ctx, pruneEpochChunk); err != nil {
+		return errors.Wrap(err, "could not prune span chunks")
+	}
+	if err := slasherDB.PruneAttestationRecords(ctx, pruneEpochChunk*p.ChunkSize); err != nil {
+		return errors.Wrap(err, "could not prune attestation records")
+	}
+	b.detector.prunedEpochChunk = pruneEpochChunk
+	return nil
+}
+
+// lowestEpoch returns the lowest epoch for which spans are kept, given the latest target epoch.
+func (b *spanBatch) lowestEpoch(target uint64) uint64 {
+	if target < b.detector.params.HistoryLength {
+		return 0
+	}
+	return target - b.detector.params.HistoryLength
+}
+
+func (b *spanBatch) chunkKey(idx, epoch uint64) types.ChunkKey {
+	p := b.detector.params
+	return types.ChunkKey{
+		ValidatorChunk: idx / p.ValidatorChunkSize,
+		EpochChunk:     epoch / p.ChunkSize,
+	}
+}
+
+// Spans are laid out validator by validator within a chunk, each validator holding ChunkSize epochs.
+func (b *spanBatch) cellIndex(idx, epoch uint64) uint64 {
+	p := b.detector.params
+	return (idx%p.ValidatorChunkSize)*p.ChunkSize + epoch%p.ChunkSize
+}
+
+func (b *spanBatch) span(ctx context.Context, kind types.SpanKind, idx, epoch uint64) (uint16, error) {
+	key := b.chunkKey(idx, epoch)
+	chunk, ok := b.chunks[kind][key]
+	if !ok {
+		if err := b.loadChunks(ctx, kind, []types.ChunkKey{key}); err != nil {
+			return 0, err
+		}
+		chunk = b.chunks[kind][key]
+	}
+	return chunk[b.cellIndex(idx, epoch)], nil
+}
+
+// setSpan sets a span in a chunk which has been loaded by reading the span first. The chunks
+// of a batch are copies owned by the batch, so changes are only visible once flushed.
+func (b *spanBatch) setSpan(kind types.SpanKind, idx, epoch uint64, value uint16) {
+	key := b.chunkKey(idx, epoch)
+	b.chunks[kind][key][b.cellIndex(idx, epoch)] = value
+	b.dirtyChunks[kind][key] = true
+}
+
+// loadChunks loads the chunks of the given keys which are not loaded yet. Chunks which were
+// never saved are filled with the neutral span, which never detects an offense.
+func (b *spanBatch) loadChunks(ctx context.Context, kind types.SpanKind, keys []types.ChunkKey) error {
+	var missing []types.ChunkKey
+	for _, key := range keys {
+		if _, ok := b.chunks[kind][key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	chunks, err := b.detector.slasherDB.SpanChunks(ctx, kind, missing)
+	if err != nil {
+		return errors.Wrap(err, "could not load span chunks")
+	}
+	p := b.detector.params
+	chunkLength := int(p.ChunkSize * p.ValidatorChunkSize)
+	for i, key := range missing {
+		chunk := chunks[i]
+		if chunk == nil {
+			chunk = neutralChunk(kind, chunkLength)
+		}
+		if len(chunk) != chunkLength {
+			return errors.Errorf("span chunk has %d spans instead of %d, were the chunk parameters changed?", len(chunk), chunkLength)
+		}
+		b.chunks[kind][key] = chunk
+	}
+	return nil
+}
+
+func neutralChunk(kind types.SpanKind, length int) []uint16 {
+	chunk := make([]uint16, length)
+	// Min spans start at the largest distance, max spans start at zero.
+	if kind == types.MinSpan {
+		for i := range chunk {
+			chunk[i] = math.MaxUint16
+		}
+	}
+	return chunk
+}
+
+func (b *spanBatch) record(ctx context.Context, idx, target uint64) ([2]byte, bool, error) {
+	if !b.checked[target][idx] {
+		if err := b.loadRecords(ctx, target, []uint64{idx}); err != nil {
+			return [2]byte{}, false, err
+		}
+	}
+	sig, ok := b.records[target][idx]
+	return sig, ok, nil
+}
+
+func (b *spanBatch) setRecord(idx, target uint64, sig [2]byte) {
+	b.records[target][idx] = sig
+	if b.dirtyRecords[target] == nil {
+		b.dirtyRecords[target] = make(map[uint64][2]byte)
+	}
+	b.dirtyRecords[target][idx] = sig
+}
+
+// loadRecords loads the attestation records of the given validators for a target epoch. Validators
+// without a record are remembered as such, so they are not looked up again.
+func (b *spanBatch) loadRecords(ctx context.Context, target uint64, indices []uint64) error {
+	loaded, err := b.detector.slasherDB.AttestationRecords(ctx, target, indices)
+	if err != nil {
+		return errors.Wrap(err, "could not load attestation records")
+	}
+	if b.records[target] == nil {
+		b.records[target] = make(map[uint64][2]byte)
+	}
+	if b.checked[target] == nil {
+		b.checked[target] = make(map[uint64]bool)
+	}
+	for _, idx := range indices {
+		b.checked[target][idx] = true
+	}
+	for idx, sig := range loaded {
+		b.records[target][idx] = sig
+	}
+	return nil
+}
@@ -5,20 +5,24 @@ package attestations
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/slasher/db"
-	dbTypes "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/iface"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
+var log = logrus.WithField("prefix", "spanner")
+
 var (
 	latestMinSpanDistanceObserved = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "latest_min_span_distance_observed",
@@ -34,10 +38,24 @@ var (
 	})
 )
 
-// We look back 128 epochs when updating min/max spans
-// for incoming attestations.
-// TODO(#5040): Remove lookback and handle min spans properly.
-const epochLookback = 128
+// Parameters define the layout of the min-max span chunks. Spans are stored in
+// chunks holding ValidatorChunkSize validators over ChunkSize epochs, and spans
+// older than HistoryLength epochs are pruned.
+type Parameters struct {
+	ChunkSize          uint64
+	ValidatorChunkSize uint64
+	HistoryLength      uint64
+}
+
+// DefaultParameters returns the span chunk parameters covering the whole
+// weak subjectivity period, in chunks of 16 epochs for 256 validators.
+func DefaultParameters() *Parameters {
+	return &Parameters{
+		ChunkSize:          16,
+		ValidatorChunkSize: 256,
+		HistoryLength:      params.BeaconConfig().WeakSubjectivityPeriod,
+	}
+}
 
 var _ iface.SpanDetector = (*SpanDetector)(nil)
 
@@ -46,14 +64,28 @@ var _ iface.SpanDetector = (*SpanDetector)(nil)
 // spans from validators and attestation data roots.
 type SpanDetector struct {
 	slasherDB db.Database
+	params    *Parameters
+	// lock serializes span updates, which read and write back whole chunks, and keeps
+	// detection from reading chunks while an update is saving them.
+	lock             sync.RWMutex
+	prunedEpochChunk uint64
 }
 
 // NewSpanDetector creates a new instance of a struct tracking
-// several epochs of min-max spans for each validator in
-// the beacon state.
+// min-max spans for each validator over the weak subjectivity period.
 func NewSpanDetector(db db.Database) *SpanDetector {
+	return NewSpanDetectorWithParameters(db, DefaultParameters())
+}
+
+// NewSpanDetectorWithParameters creates a new span detector with the given
+// span chunk parameters. The history length is capped, as spans are stored as 16 bit distances.
+func NewSpanDetectorWithParameters(db db.Database, p *Parameters) *SpanDetector {
+	if p.HistoryLength >= math.MaxUint16 {
+		p.HistoryLength = math.MaxUint16 - 1
+	}
 	return &SpanDetector{
 		slasherDB: db,
+		params:    p,
 	}
 }
 
@@ -66,295 +98,143 @@ func (s *SpanDetector) DetectSlashingsForAttestation(
 ) ([]*types.DetectionResult, error) {
 	ctx, traceSpan := trace.StartSpan(ctx, "spanner.DetectSlashingsForAttestation")
 	defer traceSpan.End()
-	sourceEpoch := att.Data.Source.Epoch
-	targetEpoch := att.Data.Target.Epoch
-	dis := targetEpoch - sourceEpoch
-
-	if sourceEpoch > targetEpoch { //Prevent underflow and handle source > target slashable cases.
-		dis = sourceEpoch - targetEpoch
-		sourceEpoch, targetEpoch = targetEpoch, sourceEpoch
-		sourceLargerThenTargetObserved.Inc()
-	}
-
-	if dis > params.BeaconConfig().WeakSubjectivityPeriod {
-		return nil, fmt.Errorf(
-			"attestation span was greater than weak subjectivity period %d, received: %d",
-			params.BeaconConfig().WeakSubjectivityPeriod,
-			dis,
-		)
-	}
-
-	spanMap, err := s.slasherDB.EpochSpans(ctx, sourceEpoch, dbTypes.UseCache)
+	source, target, err := s.attestationEpochs(att)
 	if err != nil {
 		return nil, err
 	}
-	targetSpanMap, err := s.slasherDB.EpochSpans(ctx, targetEpoch, dbTypes.UseCache)
-	if err != nil {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	batch := s.newSpanBatch()
+	if err := batch.prefetch(ctx, []*ethpb.IndexedAttestation{att}); err != nil {
 		return nil, err
 	}
-
 	var detections []*types.DetectionResult
-	distance := uint16(dis)
 	for _, idx := range att.AttestingIndices {
 		if ctx.Err() != nil {
 			return nil, errors.Wrap(ctx.Err(), "could not detect slashings")
 		}
-		span, err := spanMap.GetValidatorSpan(idx)
+		detection, err := batch.detect(ctx, idx, source, target)
 		if err != nil {
 			return nil, err
 		}
-		minSpan := span.MinSpan
-		if minSpan > 0 && minSpan < distance {
-			slashableEpoch := sourceEpoch + uint64(minSpan)
-			targetSpans, err := s.slasherDB.EpochSpans(ctx, slashableEpoch, dbTypes.UseCache)
-			if err != nil {
-				return nil, err
-			}
-			valSpan, err := targetSpans.GetValidatorSpan(idx)
-			if err != nil {
-				return nil, err
-			}
-			detections = append(detections, &types.DetectionResult{
-				ValidatorIndex: idx,
-				Kind:           types.SurroundVote,
-				SlashableEpoch: slashableEpoch,
-				SigBytes:       valSpan.SigBytes,
-			})
-			continue
-		}
-
-		maxSpan := span.MaxSpan
-		if maxSpan > distance {
-			slashableEpoch := sourceEpoch + uint64(maxSpan)
-			targetSpans, err := s.slasherDB.EpochSpans(ctx, slashableEpoch, dbTypes.UseCache)
-			if err != nil {
-				return nil, err
-			}
-			valSpan, err := targetSpans.GetValidatorSpan(idx)
-			if err != nil {
-				return nil, err
-			}
-			detections = append(detections, &types.DetectionResult{
-				ValidatorIndex: idx,
-				Kind:           types.SurroundVote,
-				SlashableEpoch: slashableEpoch,
-				SigBytes:       valSpan.SigBytes,
-			})
-			continue
-		}
-
-		targetSpan, err := targetSpanMap.GetValidatorSpan(idx)
-		if err != nil {
-			return nil, err
-		}
-		// Check if the validator has attested for this epoch or not.
-		if targetSpan.HasAttested {
-			detections = append(detections, &types.DetectionResult{
-				ValidatorIndex: idx,
-				Kind:           types.DoubleVote,
-				SlashableEpoch: targetEpoch,
-				SigBytes:       targetSpan.SigBytes,
-			})
-			continue
+		if detection != nil {
+			detections = append(detections, detection)
 		}
 	}
-
 	return detections, nil
 }
 
 // UpdateSpans given an indexed attestation for all of its attesting indices.
 func (s *SpanDetector) UpdateSpans(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	ctx, span := trace.StartSpan(ctx, "spanner.UpdateSpans")
-	defer span.End()
-	// Save the signature for the received attestation so we can have more detail to find it in the DB.
-	if err := s.saveSigBytes(ctx, att); err != nil {
-		return err
-	}
-	// Update min and max spans.
-	if err := s.updateMinSpan(ctx, att); err != nil {
-		return err
-	}
-	return s.updateMaxSpan(ctx, att)
-}
-
-// saveSigBytes saves the first 2 bytes of the signature for the att we're updating the spans to.
-// Later used to help us find the violating attestation in the DB.
-func (s *SpanDetector) saveSigBytes(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	ctx, traceSpan := trace.StartSpan(ctx, "spanner.saveSigBytes")
+	ctx, traceSpan := trace.StartSpan(ctx, "spanner.UpdateSpans")
 	defer traceSpan.End()
-	target := att.Data.Target.Epoch
-	source := att.Data.Source.Epoch
-	// handle source > target well
-	if source > target {
-		target = source
-	}
-	spanMap, err := s.slasherDB.EpochSpans(ctx, target, dbTypes.UseCache)
+	source, target, err := s.attestationEpochs(att)
 	if err != nil {
 		return err
 	}
-
-	// We loop through the indices, instead of constantly locking/unlocking the cache for equivalent accesses.
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	batch := s.newSpanBatch()
+	if err := batch.prefetch(ctx, []*ethpb.IndexedAttestation{att}); err != nil {
+		return err
+	}
 	for _, idx := range att.AttestingIndices {
 		if ctx.Err() != nil {
-			return errors.Wrap(ctx.Err(), "could not save signature bytes")
+			return errors.Wrap(ctx.Err(), "could not update spans")
 		}
-		span, err := spanMap.GetValidatorSpan(idx)
-		if err != nil {
-			return err
-		}
-		// If the validator has already attested for this target epoch,
-		// then we do not need to update the values of the span sig bytes.
-		if span.HasAttested {
-			return nil
-		}
-
-		sigBytes := [2]byte{0, 0}
-		if len(att.Signature) > 1 {
-			sigBytes = [2]byte{att.Signature[0], att.Signature[1]}
-		}
-		// Save the signature bytes into the span for this epoch.
-		newSpan := types.Span{
-			MinSpan:     span.MinSpan,
-			MaxSpan:     span.MaxSpan,
-			HasAttested: true,
-			SigBytes:    sigBytes,
-		}
-		spanMap, err = spanMap.SetValidatorSpan(idx, newSpan)
-		if err != nil {
+		if err := batch.update(ctx, idx, source, target, sigBytes(att)); err != nil {
 			return err
 		}
 	}
-	return s.slasherDB.SaveEpochSpans(ctx, target, spanMap, dbTypes.UseCache)
+	return batch.flush(ctx)
 }
 
-// Updates a min span for a validator index given a source and target epoch
-// for an attestation produced by the validator. Used for catching surrounding votes.
-func (s *SpanDetector) updateMinSpan(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	ctx, traceSpan := trace.StartSpan(ctx, "spanner.updateMinSpan")
+// DetectAndUpdateSpans detects slashable offenses for a batch of attestations and updates
+// the spans of the validators which did not commit one. Attestations are processed in order
+// of target epoch, each seeing the updates of the previous ones, and every span chunk is read
+// and written once for the whole batch. The detections are returned in the order of the given
+// attestations. Attestations spanning more than the history length are skipped.
+func (s *SpanDetector) DetectAndUpdateSpans(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+) ([][]*types.DetectionResult, error) {
+	ctx, traceSpan := trace.StartSpan(ctx, "spanner.DetectAndUpdateSpans")
 	defer traceSpan.End()
-	source := att.Data.Source.Epoch
-	target := att.Data.Target.Epoch
-	if source < 1 {
-		return nil
-	}
-	// handle source > target well
-	if source > target {
-		source, target = target, source
+	order := make([]int, 0, len(atts))
+	sources := make([]uint64, len(atts))
+	targets := make([]uint64, len(atts))
+	for i, att := range atts {
+		source, target, err := s.attestationEpochs(att)
+		if err != nil {
+			log.WithError(err).Debug("Skipping attestation")
+			continue
+		}
+		sources[i], targets[i] = source, target
+		order = append(order, i)
 	}
-	valIndices := make([]uint64, len(att.AttestingIndices))
-	copy(valIndices, att.AttestingIndices)
-	latestMinSpanDistanceObserved.Set(float64(att.Data.Target.Epoch - att.Data.Source.Epoch))
+	sort.SliceStable(order, func(i, j int) bool {
+		return targets[order[i]] < targets[order[j]]
+	})
 
-	// the for loop tries to update min span using cache for as long as there
-	// is a relevant cached epoch. when there is no such epoch in cache batch
-	// db read and write is used.
-	var spanMap *types.EpochStore
-	epoch := source - 1
-	lookbackEpoch := epoch - epochLookback
-	// prevent underflow
-	if epoch < epochLookback {
-		lookbackEpoch = 0
-	}
-	untilEpoch := lookbackEpoch
-	if featureconfig.Get().DisableLookback {
-		untilEpoch = 0
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	batch := s.newSpanBatch()
+	if err := batch.prefetch(ctx, atts); err != nil {
+		return nil, err
 	}
-	var err error
-	dbOrCache := dbTypes.UseCache
-	for ; epoch >= untilEpoch; epoch-- {
-		if ctx.Err() != nil {
-			return errors.Wrap(ctx.Err(), "could not update min spans")
-		}
-		spanMap, err = s.slasherDB.EpochSpans(ctx, epoch, dbTypes.UseCache)
-		if err != nil {
-			return err
-		}
-		indices := valIndices[:0]
-		for _, idx := range valIndices {
-			span, err := spanMap.GetValidatorSpan(idx)
+	detections := make([][]*types.DetectionResult, len(atts))
+	for _, i := range order {
+		att := atts[i]
+		for _, idx := range att.AttestingIndices {
+			if ctx.Err() != nil {
+				return nil, errors.Wrap(ctx.Err(), "could not process attestations")
+			}
+			detection, err := batch.detect(ctx, idx, sources[i], targets[i])
 			if err != nil {
-				return err
+				return nil, err
 			}
-			newMinSpan := uint16(target - epoch)
-			if span.MinSpan == 0 || span.MinSpan > newMinSpan {
-				span = types.Span{
-					MinSpan:     newMinSpan,
-					MaxSpan:     span.MaxSpan,
-					SigBytes:    span.SigBytes,
-					HasAttested: span.HasAttested,
-				}
-				spanMap, err = spanMap.SetValidatorSpan(idx, span)
-				if err != nil {
-					return err
-				}
-				indices = append(indices, idx)
+			if detection != nil {
+				detections[i] = append(detections[i], detection)
+				continue
+			}
+			if err := batch.update(ctx, idx, sources[i], targets[i], sigBytes(att)); err != nil {
+				return nil, err
 			}
-		}
-		if epoch <= lookbackEpoch && dbOrCache == dbTypes.UseCache {
-			dbOrCache = dbTypes.UseDB
-		}
-		if err := s.slasherDB.SaveEpochSpans(ctx, epoch, spanMap, dbOrCache); err != nil {
-			return err
-		}
-		if len(indices) == 0 {
-			break
-		}
-		if epoch == 0 {
-			break
 		}
 	}
-	return nil
+	if err := batch.flush(ctx); err != nil {
+		return nil, err
+	}
+	return detections, nil
 }
 
-// Updates a max span for a validator index given a source and target epoch
-// for an attestation produced by the validator. Used for catching surrounded votes.
-func (s *SpanDetector) updateMaxSpan(ctx context.Context, att *ethpb.IndexedAttestation) error {
-	ctx, traceSpan := trace.StartSpan(ctx, "spanner.updateMaxSpan")
-	defer traceSpan.End()
+// attestationEpochs returns the source and target epochs of an attestation, swapped
+// if the source is larger than the target, and checks that they lie within the history length.
+func (s *SpanDetector) attestationEpochs(att *ethpb.IndexedAttestation) (uint64, uint64, error) {
+	if att == nil || att.Data == nil || att.Data.Source == nil || att.Data.Target == nil {
+		return 0, 0, errors.New("incomplete attestation")
+	}
 	source := att.Data.Source.Epoch
 	target := att.Data.Target.Epoch
-	// handle source > target well
-	if source > target {
+	if source > target { // Prevent underflow and handle source > target slashable cases.
 		source, target = target, source
+		sourceLargerThenTargetObserved.Inc()
 	}
-	latestMaxSpanDistanceObserved.Set(float64(target - source))
-	valIndices := make([]uint64, len(att.AttestingIndices))
-	copy(valIndices, att.AttestingIndices)
-	for epoch := source + 1; epoch < target; epoch++ {
-		if ctx.Err() != nil {
-			return errors.Wrap(ctx.Err(), "could not update max spans")
-		}
-		spanMap, err := s.slasherDB.EpochSpans(ctx, epoch, dbTypes.UseCache)
-		if err != nil {
-			return err
-		}
-		indices := valIndices[:0]
-		for _, idx := range valIndices {
-			span, err := spanMap.GetValidatorSpan(idx)
-			if err != nil {
-				return err
-			}
-			newMaxSpan := uint16(target - epoch)
-			if newMaxSpan > span.MaxSpan {
-				span = types.Span{
-					MinSpan:     span.MinSpan,
-					MaxSpan:     newMaxSpan,
-					SigBytes:    span.SigBytes,
-					HasAttested: span.HasAttested,
-				}
-				spanMap, err = spanMap.SetValidatorSpan(idx, span)
-				if err != nil {
-					return err
-				}
-				indices = append(indices, idx)
-			}
-		}
-		if err := s.slasherDB.SaveEpochSpans(ctx, epoch, spanMap, dbTypes.UseCache); err != nil {
-			return err
-		}
-		if len(indices) == 0 {
-			break
-		}
+	if target-source > s.params.HistoryLength {
+		return 0, 0, fmt.Errorf(
+			"attestation span was greater than weak subjectivity period %d, received: %d",
+			s.params.HistoryLength,
+			target-source,
+		)
+	}
+	return source, target, nil
+}
+
+// sigBytes returns the first 2 bytes of the signature of the attestation,
+// later used to help us find the violating attestation in the DB.
+func sigBytes(att *ethpb.IndexedAttestation) [2]byte {
+	if len(att.Signature) > 1 {
+		return [2]byte{att.Signature[0], att.Signature[1]}
 	}
-	return nil
+	return [2]byte{0, 0}
 }
@@ -2,16 +2,17 @@ package attestations
 
 import (
 	"context"
+	"math"
 	"reflect"
+	"sync"
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
-	dbTypes "github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 )
 
@@ -241,9 +242,7 @@ func TestSpanDetector_DetectSlashingsForAttestation_Double(t *testing.T) {
 			db := testDB.SetupSlasherDB(t, false)
 			ctx := context.Background()
 
-			sd := &SpanDetector{
-				slasherDB: db,
-			}
+			sd := NewSpanDetector(db)
 
 			require.NoError(t, sd.UpdateSpans(ctx, tt.att))
 
@@ -485,22 +484,10 @@ func TestSpanDetector_DetectSlashingsForAttestation_Surround(t *testing.T) {
 			db := testDB.SetupSlasherDB(t, false)
 			ctx := context.Background()
 
-			sd := &SpanDetector{
-				slasherDB: db,
-			}
+			sd := NewSpanDetector(db)
 			// We only care about validator index 0 for these tests for simplicity.
 			validatorIndex := uint64(0)
-			for k, v := range tt.spansByEpochForValidator {
-				epochStore, err := types.NewEpochStore([]byte{})
-				require.NoError(t, err)
-				span := types.Span{
-					MinSpan: v[0],
-					MaxSpan: v[1],
-				}
-				epochStore, err = epochStore.SetValidatorSpan(validatorIndex, span)
-				require.NoError(t, err)
-				require.NoError(t, sd.slasherDB.SaveEpochSpans(ctx, k, epochStore, dbTypes.UseDB), "Failed to save to slasherDB")
-			}
+			saveSpans(t, sd, validatorIndex, tt.spansByEpochForValidator)
 
 			att := &ethpb.IndexedAttestation{
 				Data: &ethpb.AttestationData{
@@ -739,9 +726,7 @@ func TestSpanDetector_DetectSlashingsForAttestation_MultipleValidators(t *testin
 				assert.NoError(t, db.Close())
 			}()
 
-			spanDetector := &SpanDetector{
-				slasherDB: db,
-			}
+			spanDetector := NewSpanDetector(db)
 			for _, att := range tt.atts {
 				require.NoError(t, spanDetector.UpdateSpans(ctx, att), "Failed to save to slasherDB")
 			}
@@ -784,7 +769,8 @@ func TestNewSpanDetector_UpdateSpans(t *testing.T) {
 	type testStruct struct {
 		name string
 		att  *ethpb.IndexedAttestation
-		want []map[uint64]types.Span
+		// Min and max spans by epoch, for every attesting validator. A min span of 0 means no min span.
+		want [][2]uint16
 	}
 	tests := []testStruct{
 		{
@@ -802,37 +788,7 @@ func TestNewSpanDetector_UpdateSpans(t *testing.T) {
 				},
 				Signature: []byte{1, 2},
 			},
-			want: []map[uint64]types.Span{
-				// Epoch 0.
-				{
-					0: {MinSpan: 4, MaxSpan: 0, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					1: {MinSpan: 4, MaxSpan: 0, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					2: {MinSpan: 4, MaxSpan: 0, SigBytes: [2]byte{0, 0}, HasAttested: false},
-				},
-				// Epoch 1.
-				{
-					0: {MinSpan: 3, MaxSpan: 0, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					1: {MinSpan: 3, MaxSpan: 0, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					2: {MinSpan: 3, MaxSpan: 0, SigBytes: [2]byte{0, 0}, HasAttested: false},
-				},
-				// Epoch 2.
-				{},
-				// Epoch 3.
-				{
-					0: {MinSpan: 0, MaxSpan: 1, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					1: {MinSpan: 0, MaxSpan: 1, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					2: {MinSpan: 0, MaxSpan: 1, SigBytes: [2]byte{0, 0}, HasAttested: false},
-				},
-				// Epoch 4.
-				{
-					0: {MinSpan: 0, MaxSpan: 0, SigBytes: [2]byte{1, 2}, HasAttested: true},
-					1: {MinSpan: 0, MaxSpan: 0, SigBytes: [2]byte{1, 2}, HasAttested: true},
-					2: {MinSpan: 0, MaxSpan: 0, SigBytes: [2]byte{1, 2}, HasAttested: true},
-				},
-				{},
-				{},
-				{},
-			},
+			want: [][2]uint16{{4, 0}, {3, 0}, {0, 0}, {0, 1}, {0, 0}, {0, 0}, {0, 0}, {0, 0}},
 		},
 		{
 			name: "Distance of 4 should update max spans accordingly",
@@ -849,100 +805,165 @@ func TestNewSpanDetector_UpdateSpans(t *testing.T) {
 				},
 				Signature: []byte{1, 2},
 			},
-			want: []map[uint64]types.Span{
-				// Epoch 0.
-				{},
-				// Epoch 1.
-				{
-					0: {MinSpan: 0, MaxSpan: 4, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					1: {MinSpan: 0, MaxSpan: 4, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					2: {MinSpan: 0, MaxSpan: 4, SigBytes: [2]byte{0, 0}, HasAttested: false},
-				},
-				// Epoch 2.
-				{
-					0: {MinSpan: 0, MaxSpan: 3, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					1: {MinSpan: 0, MaxSpan: 3, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					2: {MinSpan: 0, MaxSpan: 3, SigBytes: [2]byte{0, 0}, HasAttested: false},
-				},
-				// Epoch 3.
-				{
-					0: {MinSpan: 0, MaxSpan: 2, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					1: {MinSpan: 0, MaxSpan: 2, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					2: {MinSpan: 0, MaxSpan: 2, SigBytes: [2]byte{0, 0}, HasAttested: false},
-				},
-				// Epoch 4.
-				{
-					0: {MinSpan: 0, MaxSpan: 1, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					1: {MinSpan: 0, MaxSpan: 1, SigBytes: [2]byte{0, 0}, HasAttested: false},
-					2: {MinSpan: 0, MaxSpan: 1, SigBytes: [2]byte{0, 0}, HasAttested: false},
-				},
-				// Epoch 5.
-				{
-					0: {MinSpan: 0, MaxSpan: 0, SigBytes: [2]byte{1, 2}, HasAttested: true},
-					1: {MinSpan: 0, MaxSpan: 0, SigBytes: [2]byte{1, 2}, HasAttested: true},
-					2: {MinSpan: 0, MaxSpan: 0, SigBytes: [2]byte{1, 2}, HasAttested: true},
-				},
-				{},
-				{},
-			},
+			want: [][2]uint16{{0, 0}, {0, 4}, {0, 3}, {0, 2}, {0, 1}, {0, 0}, {0, 0}, {0, 0}},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			db := testDB.SetupSlasherDB(t, false)
 			ctx := context.Background()
-			defer func() {
-				assert.NoError(t, db.ClearDB())
-			}()
-			defer func() {
-				assert.NoError(t, db.Close())
-			}()
 
-			sd := &SpanDetector{
-				slasherDB: db,
-			}
+			sd := NewSpanDetector(db)
 			require.NoError(t, sd.UpdateSpans(ctx, tt.att))
-			for epoch := range tt.want {
-				sm, err := sd.slasherDB.EpochSpans(ctx, uint64(epoch), dbTypes.UseDB)
-				require.NoError(t, err, "Failed to read from slasherDB")
-				resMap, err := sm.ToMap()
-				require.NoError(t, err)
-				assert.DeepEqual(t, tt.want[epoch], resMap)
+			for _, idx := range tt.att.AttestingIndices {
+				for epoch, want := range tt.want {
+					assert.DeepEqual(t, want, readSpans(t, sd, idx, uint64(epoch)), "Unexpected spans for validator %d at epoch %d", idx, epoch)
+				}
 			}
+			records, err := db.AttestationRecords(ctx, tt.att.Data.Target.Epoch, tt.att.AttestingIndices)
+			require.NoError(t, err)
+			assert.DeepEqual(t, map[uint64][2]byte{0: {1, 2}, 1: {1, 2}, 2: {1, 2}}, records)
 		})
 	}
 }
 
-func TestSpanDetector_UpdateMinSpansCheckCacheSize(t *testing.T) {
-	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{DisableLookback: true})
-	defer resetCfg()
+func TestSpanDetector_DetectAndUpdateSpans(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	sd := NewSpanDetector(db)
 
-	att := &ethpb.IndexedAttestation{
-		AttestingIndices: []uint64{0},
-		Data: &ethpb.AttestationData{
-			CommitteeIndex: 0,
-			Source: &ethpb.Checkpoint{
-				Epoch: 150,
-			},
-			Target: &ethpb.Checkpoint{
-				Epoch: 152,
-			},
+	double := indexedAttestation(3, 6, []uint64{0, 1})
+	double.Data.BeaconBlockRoot = []byte("other block root")
+	atts := []*ethpb.IndexedAttestation{
+		// Surrounds the attestation of validator 2 processed before it in the same batch.
+		indexedAttestation(1, 8, []uint64{2}),
+		indexedAttestation(3, 6, []uint64{0, 1, 2}),
+		double,
+		// Spans more than the weak subjectivity period, skipped.
+		indexedAttestation(0, params.BeaconConfig().WeakSubjectivityPeriod+1, []uint64{3}),
+	}
+	res, err := sd.DetectAndUpdateSpans(ctx, atts)
+	require.NoError(t, err)
+	require.Equal(t, len(atts), len(res))
+	want := [][]*types.DetectionResult{
+		{
+			{ValidatorIndex: 2, Kind: types.SurroundVote, SlashableEpoch: 6, SigBytes: [2]byte{1, 2}},
 		},
-		Signature: []byte{1, 2},
+		nil,
+		{
+			{ValidatorIndex: 0, Kind: types.DoubleVote, SlashableEpoch: 6, SigBytes: [2]byte{1, 2}},
+			{ValidatorIndex: 1, Kind: types.DoubleVote, SlashableEpoch: 6, SigBytes: [2]byte{1, 2}},
+		},
+		nil,
 	}
+	assert.DeepEqual(t, want, res)
 
-	db := testDB.SetupSlasherDB(t, false)
+	// The spans of the batch are persisted for later attestations.
+	res, err = sd.DetectAndUpdateSpans(ctx, []*ethpb.IndexedAttestation{indexedAttestation(4, 5, []uint64{0, 3})})
+	require.NoError(t, err)
+	want = [][]*types.DetectionResult{
+		{
+			{ValidatorIndex: 0, Kind: types.SurroundVote, SlashableEpoch: 6, SigBytes: [2]byte{1, 2}},
+		},
+	}
+	assert.DeepEqual(t, want, res)
+}
+
+func TestSpanDetector_DetectWhileUpdating(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, true)
 	ctx := context.Background()
-	defer func() {
-		assert.NoError(t, db.ClearDB())
-	}()
-	defer func() {
-		assert.NoError(t, db.Close())
+	sd := NewSpanDetector(db)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for target := uint64(2); target < 50; target++ {
+			_, err := sd.DetectAndUpdateSpans(ctx, []*ethpb.IndexedAttestation{indexedAttestation(target-1, target, []uint64{0, 1})})
+			assert.NoError(t, err)
+		}
 	}()
+	// Detection reads the chunks being updated, and should never see a partially saved update.
+	for i := 0; i < 50; i++ {
+		res, err := sd.DetectSlashingsForAttestation(ctx, indexedAttestation(0, 60, []uint64{0, 1}))
+		require.NoError(t, err)
+		for _, r := range res {
+			assert.Equal(t, types.SurroundVote, r.Kind)
+		}
+	}
+	wg.Wait()
+}
 
-	sd := &SpanDetector{
-		slasherDB: db,
+func TestSpanDetector_WeakSubjectivityPeriod(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	sd := NewSpanDetectorWithParameters(db, &Parameters{
+		ChunkSize:          4,
+		ValidatorChunkSize: 2,
+		HistoryLength:      40,
+	})
+
+	// Surround votes are detected across all chunks of the history.
+	require.NoError(t, sd.UpdateSpans(ctx, indexedAttestation(1, 39, []uint64{0, 3})))
+	res, err := sd.DetectSlashingsForAttestation(ctx, indexedAttestation(20, 21, []uint64{3}))
+	require.NoError(t, err)
+	want := []*types.DetectionResult{
+		{ValidatorIndex: 3, Kind: types.SurroundVote, SlashableEpoch: 39, SigBytes: [2]byte{1, 2}},
+	}
+	assert.DeepEqual(t, want, res)
+	res, err = sd.DetectSlashingsForAttestation(ctx, indexedAttestation(0, 40, []uint64{0}))
+	require.NoError(t, err)
+	want = []*types.DetectionResult{
+		{ValidatorIndex: 0, Kind: types.SurroundVote, SlashableEpoch: 39, SigBytes: [2]byte{1, 2}},
+	}
+	assert.DeepEqual(t, want, res)
+
+	// Attestations spanning more than the history are rejected.
+	_, err = sd.DetectSlashingsForAttestation(ctx, indexedAttestation(0, 41, []uint64{0}))
+	assert.ErrorContains(t, "attestation span was greater than weak subjectivity period 40", err)
+
+	// Chunks and records falling out of the history are pruned.
+	require.NoError(t, sd.UpdateSpans(ctx, indexedAttestation(60, 61, []uint64{0})))
+	chunks, err := db.SpanChunks(ctx, types.MaxSpan, []types.ChunkKey{{ValidatorChunk: 0, EpochChunk: 4}, {ValidatorChunk: 0, EpochChunk: 5}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint16(nil), chunks[0], "Expected chunk before the history to be pruned")
+	assert.NotNil(t, chunks[1], "Expected chunk within the history to be kept")
+	records, err := db.AttestationRecords(ctx, 39, []uint64{0})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(records), "Expected record within the history to be kept")
+	require.NoError(t, sd.UpdateSpans(ctx, indexedAttestation(80, 81, []uint64{0})))
+	records, err = db.AttestationRecords(ctx, 39, []uint64{0})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records), "Expected record before the history to be pruned")
+}
+
+// saveSpans saves the min and max spans of a validator by epoch. A min span of 0 means no min span.
+func saveSpans(t *testing.T, sd *SpanDetector, idx uint64, spans map[uint64][3]uint16) {
+	ctx := context.Background()
+	batch := sd.newSpanBatch()
+	for epoch, span := range spans {
+		for _, kind := range []types.SpanKind{types.MinSpan, types.MaxSpan} {
+			_, err := batch.span(ctx, kind, idx, epoch)
+			require.NoError(t, err)
+		}
+		if span[0] != 0 {
+			batch.setSpan(types.MinSpan, idx, epoch, span[0])
+		}
+		batch.setSpan(types.MaxSpan, idx, epoch, span[1])
+	}
+	require.NoError(t, batch.flush(ctx))
+}
+
+// readSpans returns the min and max spans of a validator at an epoch. A min span of 0 means no min span.
+func readSpans(t *testing.T, sd *SpanDetector, idx, epoch uint64) [2]uint16 {
+	ctx := context.Background()
+	batch := sd.newSpanBatch()
+	minSpan, err := batch.span(ctx, types.MinSpan, idx, epoch)
+	require.NoError(t, err)
+	maxSpan, err := batch.span(ctx, types.MaxSpan, idx, epoch)
+	require.NoError(t, err)
+	if minSpan == math.MaxUint16 {
+		minSpan = 0
 	}
-	require.NoError(t, sd.updateMinSpan(ctx, att))
-	require.Equal(t, epochLookback, db.CacheLength(ctx), "Unexpected cache length")
+	return [2]uint16{minSpan, maxSpan}
 }
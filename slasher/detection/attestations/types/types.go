@@ -2,11 +2,7 @@
 // slashable objects detected by slasher.
 package types
 
-import (
-	"errors"
-
-	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-)
+import "github.com/prysmaticlabs/prysm/shared/bytesutil"
 
 // DetectionKind defines an enum type that
 // gives us information on the type of slashable offense
@@ -46,47 +42,22 @@ func (result *DetectionResult) Marshal() []byte {
 	return resultBytes
 }
 
-// Span defines the structure used for detecting surround and double votes.
-type Span struct {
-	MinSpan     uint16
-	MaxSpan     uint16
-	SigBytes    [2]byte
-	HasAttested bool
-}
-
-// SpannerEncodedLength the byte length of validator span data structure.
-var SpannerEncodedLength = uint64(7)
+// SpanKind distinguishes the min spans from the max spans
+// tracked for surround vote detection.
+type SpanKind uint8
 
-// UnmarshalSpan returns a span from an encoded, flattened byte array.
-// Note: This is a very often used function, so it is as optimized as possible.
-func UnmarshalSpan(enc []byte) (Span, error) {
-	r := Span{}
-	if len(enc) != int(SpannerEncodedLength) {
-		return r, errors.New("wrong data length for min max span")
-	}
-	r.MinSpan = uint16(enc[0]) | uint16(enc[1])<<8
-	r.MaxSpan = uint16(enc[2]) | uint16(enc[3])<<8
-	sigB := [2]byte{}
-	copy(sigB[:], enc[4:6])
-	r.SigBytes = sigB
-	r.HasAttested = enc[6]&1 == 1
-	return r, nil
-}
+const (
+	// MinSpan denotes the min spans, used for catching attestations
+	// which surround a previous attestation of the same validator.
+	MinSpan SpanKind = iota
+	// MaxSpan denotes the max spans, used for catching attestations
+	// which are surrounded by a previous attestation of the same validator.
+	MaxSpan
+)
 
-// Marshal converts the span struct into a flattened byte array.
-// Note: This is a very often used function, so it is as optimized as possible.
-func (span Span) Marshal() []byte {
-	var attested byte = 0
-	if span.HasAttested {
-		attested = 1
-	}
-	return []byte{
-		byte(span.MinSpan),
-		byte(span.MinSpan >> 8),
-		byte(span.MaxSpan),
-		byte(span.MaxSpan >> 8),
-		span.SigBytes[0],
-		span.SigBytes[1],
-		attested,
-	}
+// ChunkKey identifies a chunk of min or max spans, holding the spans
+// of a fixed-size range of validators over a fixed-size range of epochs.
+type ChunkKey struct {
+	ValidatorChunk uint64
+	EpochChunk     uint64
 }
@@ -27,6 +27,40 @@ func (ds *Service) DetectAttesterSlashings(
 	if err != nil {
 		return nil, err
 	}
+	return ds.attesterSlashingsForResults(ctx, att, results)
+}
+
+// DetectAndUpdateAttesterSlashings detects double, surround and surrounding attestation offences
+// for a batch of attestations, updating the spans of the validators which did not commit one.
+// The slashings found for all attestations of the batch are returned.
+func (ds *Service) DetectAndUpdateAttesterSlashings(
+	ctx context.Context,
+	atts []*ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "detection.DetectAndUpdateAttesterSlashings")
+	defer span.End()
+	results, err := ds.minMaxSpanDetector.DetectAndUpdateSpans(ctx, atts)
+	if err != nil {
+		return nil, err
+	}
+	var slashings []*ethpb.AttesterSlashing
+	for i, att := range atts {
+		attSlashings, err := ds.attesterSlashingsForResults(ctx, att, results[i])
+		if err != nil {
+			return nil, err
+		}
+		slashings = append(slashings, attSlashings...)
+	}
+	return slashings, nil
+}
+
+// attesterSlashingsForResults builds the attester slashings for the detection results of an attestation,
+// looking up the conflicting attestations in the DB.
+func (ds *Service) attesterSlashingsForResults(
+	ctx context.Context,
+	att *ethpb.IndexedAttestation,
+	results []*types.DetectionResult,
+) ([]*ethpb.AttesterSlashing, error) {
 	// If the response is nil, there was no slashing detected.
 	if len(results) == 0 {
 		return nil, nil
@@ -71,8 +105,20 @@ func (ds *Service) DetectAttesterSlashings(
 			slashingList = append(slashingList, ss)
 		}
 	}
-	if len(slashings) > 0 {
-		if err = ds.slasherDB.SaveAttesterSlashings(ctx, status.Active, slashings); err != nil {
+	// Slashings seen before are not saved again, so the submission and inclusion
+	// status tracked for them is kept.
+	newSlashings := make([]*ethpb.AttesterSlashing, 0, len(slashingList))
+	for _, ss := range slashingList {
+		found, _, err := ds.slasherDB.HasAttesterSlashing(ctx, ss)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not check if slashing exists")
+		}
+		if !found {
+			newSlashings = append(newSlashings, ss)
+		}
+	}
+	if len(newSlashings) > 0 {
+		if err = ds.slasherDB.SaveAttesterSlashings(ctx, status.Active, newSlashings); err != nil {
 			return nil, err
 		}
 	}
@@ -353,6 +353,39 @@ func TestDetect_detectAttesterSlashings_Double(t *testing.T) {
 	}
 }
 
+func TestDetect_DetectAndUpdateAttesterSlashings(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	ds := Service{
+		ctx:                ctx,
+		slasherDB:          db,
+		minMaxSpanDetector: attestations.NewSpanDetector(db),
+	}
+	newAtt := func(source, target uint64, sig byte) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{3},
+			Data: &ethpb.AttestationData{
+				Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+				BeaconBlockRoot: make([]byte, 32),
+			},
+			Signature: bytesutil.PadTo([]byte{sig}, 96),
+		}
+	}
+	// The second attestation surrounds the first one, both within the same batch.
+	atts := []*ethpb.IndexedAttestation{newAtt(2, 3, 1), newAtt(1, 4, 2), newAtt(4, 5, 3)}
+	require.NoError(t, db.SaveIndexedAttestations(ctx, atts))
+
+	slashings, err := ds.DetectAndUpdateAttesterSlashings(ctx, atts)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings), "Unexpected amount of slashings found")
+	require.DeepEqual(t, atts[1], slashings[0].Attestation_1)
+	require.DeepEqual(t, atts[0], slashings[0].Attestation_2)
+	attsl, err := db.AttesterSlashings(ctx, status.Active)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(attsl), "Didnt save slashing to db")
+}
+
 func TestDetect_updateHighestAttestation(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -8,9 +8,11 @@ package detection
 
 import (
 	"context"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
@@ -48,32 +50,27 @@ func (ds *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.Sign
 }
 
 // detectIncomingAttestations subscribes to an event feed for
-// attestation objects from a notifier interface. Upon receiving
-// an attestation from the feed, we run surround vote and double vote
-// detection on the attestation.
+// attestation objects from a notifier interface. Received attestations
+// are collected and, once per slot, surround vote and double vote
+// detection is run on them as a batch.
 func (ds *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethpb.IndexedAttestation) {
 	ctx, span := trace.StartSpan(ctx, "detection.detectIncomingAttestations")
 	defer span.End()
 	sub := ds.notifier.AttestationFeed().Subscribe(ch)
 	defer sub.Unsubscribe()
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	var batch []*ethpb.IndexedAttestation
 	for {
 		select {
 		case indexedAtt := <-ch:
-			slashings, err := ds.DetectAttesterSlashings(ctx, indexedAtt)
-			if err != nil {
-				log.WithError(err).Error("Could not detect attester slashings")
+			batch = append(batch, indexedAtt)
+		case <-ticker.C:
+			if len(batch) == 0 {
 				continue
 			}
-			if len(slashings) < 1 {
-				if err := ds.minMaxSpanDetector.UpdateSpans(ctx, indexedAtt); err != nil {
-					log.WithError(err).Error("Could not update spans")
-				}
-			}
-			ds.submitAttesterSlashings(ctx, slashings)
-
-			if err := ds.UpdateHighestAttestation(ctx, indexedAtt); err != nil {
-				log.WithError(err).Error("Could not update highest attestation")
-			}
+			ds.processAttestations(ctx, batch)
+			batch = nil
 		case <-sub.Err():
 			log.Error("Subscriber closed, exiting goroutine")
 			return
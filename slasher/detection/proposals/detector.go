@@ -41,9 +41,17 @@ func (dd *ProposeDetector) DetectDoublePropose(
 			continue
 		}
 		ps := &ethpb.ProposerSlashing{Header_1: incomingBlk, Header_2: blockHeader}
-		if err := dd.slasherDB.SaveProposerSlashing(ctx, status.Active, ps); err != nil {
+		// A slashing seen before is not saved again, so the submission and inclusion
+		// status tracked for it is kept.
+		found, _, err := dd.slasherDB.HasProposerSlashing(ctx, ps)
+		if err != nil {
 			return nil, err
 		}
+		if !found {
+			if err := dd.slasherDB.SaveProposerSlashing(ctx, status.Active, ps); err != nil {
+				return nil, err
+			}
+		}
 		return ps, nil
 	}
 	if err := dd.slasherDB.SaveBlockHeader(ctx, incomingBlk); err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/proposals/iface"
 	testDetect "github.com/prysmaticlabs/prysm/slasher/detection/testing"
 )
@@ -78,3 +79,31 @@ func TestProposalsDetector_DetectSlashingsForBlockHeaders(t *testing.T) {
 		})
 	}
 }
+
+func TestProposalsDetector_KeepsStatusOfSlashingsSeenBefore(t *testing.T) {
+	db := testDB.SetupSlasherDB(t, false)
+	ctx := context.Background()
+	sd := &ProposeDetector{
+		slasherDB: db,
+	}
+	s0, err := helpers.StartSlot(0)
+	require.NoError(t, err)
+	blk1, err := testDetect.SignedBlockHeader(s0, 0)
+	require.NoError(t, err)
+	blk2, err := testDetect.SignedBlockHeader(s0, 0)
+	require.NoError(t, err)
+	require.NoError(t, sd.slasherDB.SaveBlockHeader(ctx, blk1))
+
+	slashing, err := sd.DetectDoublePropose(ctx, blk2)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	require.NoError(t, db.SaveProposerSlashing(ctx, types.Included, slashing))
+
+	slashing, err = sd.DetectDoublePropose(ctx, blk2)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	found, st, err := db.HasProposerSlashing(ctx, slashing)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, types.SlashingStatus(types.Included), st)
+}
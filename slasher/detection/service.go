@@ -180,33 +180,13 @@ func (ds *Service) detectHistoricalChainData(ctx context.Context) {
 			return
 		}
 
-		for _, att := range indexedAtts {
-			if ctx.Err() == context.Canceled {
-				log.WithError(ctx.Err()).Error("context has been canceled, ending detection")
-				return
-			}
-			slashings, err := ds.DetectAttesterSlashings(ctx, att)
-			if err != nil {
-				log.WithError(err).Error("Could not detect attester slashings")
-				continue
-			}
-			if len(slashings) < 1 {
-				if err := ds.minMaxSpanDetector.UpdateSpans(ctx, att); err != nil {
-					log.WithError(err).Error("Could not update spans")
-				}
-			}
-			ds.submitAttesterSlashings(ctx, slashings)
-
-			if err := ds.UpdateHighestAttestation(ctx, att); err != nil {
-				log.WithError(err).Errorf("Could not update highest attestation")
-			}
-		}
+		// The attestations of an epoch are processed as a single batch.
+		ds.processAttestations(ctx, indexedAtts)
 		latestStoredHead = &ethpb.ChainHead{HeadEpoch: epoch}
 		if err := ds.slasherDB.SaveChainHead(ctx, latestStoredHead); err != nil {
 			log.WithError(err).Error("Could not persist chain head to disk")
 		}
 		storedEpoch = epoch
-		ds.slasherDB.RemoveOldestFromCache(ctx)
 		if epoch == currentChainHead.HeadEpoch-1 {
 			currentChainHead, err = ds.chainFetcher.ChainHead(ctx)
 			if err != nil {
@@ -221,6 +201,25 @@ func (ds *Service) detectHistoricalChainData(ctx context.Context) {
 	log.Infof("Completed slashing detection on historical chain data up to epoch %d", storedEpoch)
 }
 
+// processAttestations runs slashing detection on a batch of attestations, updating the
+// spans of the validators which did not commit an offense, and submits the slashings found.
+func (ds *Service) processAttestations(ctx context.Context, atts []*ethpb.IndexedAttestation) {
+	ctx, span := trace.StartSpan(ctx, "detection.processAttestations")
+	defer span.End()
+	slashings, err := ds.DetectAndUpdateAttesterSlashings(ctx, atts)
+	if err != nil {
+		log.WithError(err).Error("Could not detect attester slashings")
+		return
+	}
+	ds.submitAttesterSlashings(ctx, slashings)
+
+	for _, att := range atts {
+		if err := ds.UpdateHighestAttestation(ctx, att); err != nil {
+			log.WithError(err).Error("Could not update highest attestation")
+		}
+	}
+}
+
 func (ds *Service) submitAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
 	ctx, span := trace.StartSpan(ctx, "detection.submitAttesterSlashings")
 	defer span.End()
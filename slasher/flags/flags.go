@@ -51,10 +51,10 @@ var (
 		Name:  "enable-historical-detection",
 		Usage: "Enables historical attestation detection for the slasher. Requires --historical-slasher-node on the beacon node.",
 	}
-	// SpanCacheSize is a flag that sets the size of span cache.
+	// SpanCacheSize is a flag that sets the number of span chunks kept in memory.
 	SpanCacheSize = &cli.IntFlag{
 		Name:  "spans-cache-size",
-		Usage: "Sets the span cache size.",
+		Usage: "Sets the number of min-max span chunks kept in memory.",
 		Value: 1500,
 	}
 	// HighestAttCacheSize is a flag that sets the size of highest attestation cache.
@@ -63,4 +63,28 @@ var (
 		Usage: "Sets the highest attestation cache size.",
 		Value: 3000,
 	}
+	// IngestBeaconDBFlag defines the beacon node database directory historical blocks are ingested from.
+	IngestBeaconDBFlag = &cli.StringFlag{
+		Name:  "beacon-db-path",
+		Usage: "Beacon node database directory to ingest historical blocks and attestations from. The beacon node must not be running",
+	}
+	// IngestArchiveDirFlag defines the directory of SSZ encoded blocks historical blocks are ingested from.
+	IngestArchiveDirFlag = &cli.StringFlag{
+		Name: "block-archive-dir",
+		Usage: "Directory of SSZ encoded blocks named beacon_block_<slot>.ssz to ingest historical blocks and attestations from. " +
+			"It must hold a state named beacon_state_<slot>.ssz at or before the first ingested epoch to replay the blocks from. " +
+			"The archived state is trusted, the signatures of the archived blocks and attestations are verified",
+	}
+	// IngestWorkersFlag defines the number of batches of epochs loaded in parallel during ingestion.
+	IngestWorkersFlag = &cli.IntFlag{
+		Name:  "ingest-workers",
+		Usage: "Number of batches of epochs loaded in parallel during ingestion",
+		Value: 4,
+	}
+	// IngestBatchEpochsFlag defines the number of epochs in a batch during ingestion.
+	IngestBatchEpochsFlag = &cli.Uint64Flag{
+		Name:  "ingest-batch-epochs",
+		Usage: "Number of epochs of blocks ingested as a single batch",
+		Value: 4,
+	}
 )
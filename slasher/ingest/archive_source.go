@@ -0,0 +1,266 @@
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	blockFilePrefix = "beacon_block_"
+	stateFilePrefix = "beacon_state_"
+	sszFileSuffix   = ".ssz"
+)
+
+// ArchiveSource reads SSZ encoded blocks from a directory, in files named beacon_block_<slot>.ssz
+// as written by the interop tooling. Additional blocks at the same slot are named with a suffix
+// after the slot, such as beacon_block_<slot>_1.ssz. As blocks come without states, the canonical
+// chain is replayed from a post block state named beacon_state_<slot>.ssz in the same directory,
+// which must be at or before the start slot of the first ingested epoch.
+type ArchiveSource struct {
+	dir         string
+	blocks      map[uint64][]string
+	stateSlots  []uint64
+	stateFiles  map[uint64]string
+	highestSlot uint64
+	// window is the number of epochs before the last requested one whose states are kept,
+	// covering the epochs of the batches being ingested concurrently.
+	window      uint64
+	lock        sync.Mutex
+	replayed    *stateTrie.BeaconState
+	headRoot    [32]byte
+	nextEpoch   uint64
+	epochStates map[uint64]*stateTrie.BeaconState
+}
+
+// NewArchiveSource indexes the block and state files of an archive directory.
+func NewArchiveSource(dir string, window uint64) (*ArchiveSource, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read archive directory")
+	}
+	s := &ArchiveSource{
+		dir:         dir,
+		blocks:      make(map[uint64][]string),
+		stateFiles:  make(map[uint64]string),
+		window:      window,
+		epochStates: make(map[uint64]*stateTrie.BeaconState),
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		if slot, ok := fileSlot(f.Name(), blockFilePrefix); ok {
+			s.blocks[slot] = append(s.blocks[slot], f.Name())
+			if slot > s.highestSlot {
+				s.highestSlot = slot
+			}
+		} else if slot, ok := fileSlot(f.Name(), stateFilePrefix); ok {
+			s.stateSlots = append(s.stateSlots, slot)
+			s.stateFiles[slot] = f.Name()
+		}
+	}
+	if len(s.blocks) == 0 {
+		return nil, errors.Errorf("no blocks found in archive directory %s", dir)
+	}
+	if len(s.stateSlots) == 0 {
+		return nil, errors.Errorf("no state to replay the blocks from found in archive directory %s", dir)
+	}
+	for _, names := range s.blocks {
+		sort.Strings(names)
+	}
+	sort.Slice(s.stateSlots, func(i, j int) bool {
+		return s.stateSlots[i] < s.stateSlots[j]
+	})
+	return s, nil
+}
+
+// HighestEpoch returns the epoch of the highest block in the archive.
+func (s *ArchiveSource) HighestEpoch(_ context.Context) (uint64, error) {
+	return helpers.SlotToEpoch(s.highestSlot), nil
+}
+
+// Blocks returns all the archived blocks of an epoch.
+func (s *ArchiveSource) Blocks(_ context.Context, epoch uint64) ([]*ethpb.SignedBeaconBlock, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	var blks []*ethpb.SignedBeaconBlock
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		for _, name := range s.blocks[slot] {
+			blk, err := s.readBlock(name)
+			if err != nil {
+				return nil, err
+			}
+			blks = append(blks, blk)
+		}
+	}
+	return blks, nil
+}
+
+// EpochState returns the state at the start slot of an epoch, replaying the canonical
+// blocks up to it. Replaying restarts from the closest archived state when an epoch
+// before the replayed ones is requested.
+func (s *ArchiveSource) EpochState(ctx context.Context, epoch uint64) (*stateTrie.BeaconState, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if st, ok := s.epochStates[epoch]; ok {
+		return st.Copy(), nil
+	}
+	if s.replayed == nil || epoch < s.nextEpoch {
+		if err := s.loadState(ctx, epoch); err != nil {
+			return nil, err
+		}
+	}
+	for ; s.nextEpoch <= epoch; s.nextEpoch++ {
+		startSlot, err := helpers.StartSlot(s.nextEpoch)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.replayUntil(ctx, startSlot); err != nil {
+			// The replayed state may have been partially transitioned.
+			s.replayed = nil
+			return nil, err
+		}
+		st := s.replayed.Copy()
+		if st.Slot() < startSlot {
+			st, err = state.ProcessSlots(ctx, st, startSlot)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not process slots up to epoch %d", s.nextEpoch)
+			}
+		}
+		s.epochStates[s.nextEpoch] = st
+	}
+	for e := range s.epochStates {
+		if e+s.window < epoch {
+			delete(s.epochStates, e)
+		}
+	}
+	return s.epochStates[epoch].Copy(), nil
+}
+
+// loadState loads the closest archived state at or before the start slot of an epoch.
+func (s *ArchiveSource) loadState(ctx context.Context, epoch uint64) error {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(s.stateSlots), func(i int) bool {
+		return s.stateSlots[i] > startSlot
+	})
+	if i == 0 {
+		return errors.Errorf("no archived state at or before slot %d to replay the blocks from", startSlot)
+	}
+	slot := s.stateSlots[i-1]
+	enc, err := ioutil.ReadFile(filepath.Join(s.dir, s.stateFiles[slot]))
+	if err != nil {
+		return errors.Wrap(err, "could not read archived state")
+	}
+	protoState := &pb.BeaconState{}
+	if err := protoState.UnmarshalSSZ(enc); err != nil {
+		return errors.Wrap(err, "could not decode archived state")
+	}
+	st, err := stateTrie.InitializeFromProtoUnsafe(protoState)
+	if err != nil {
+		return err
+	}
+	// The state root of the latest block header is only filled in at the next slot.
+	header := stateTrie.CopyBeaconBlockHeader(st.LatestBlockHeader())
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		root, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return err
+		}
+		header.StateRoot = root[:]
+	}
+	s.headRoot, err = header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.replayed = st
+	s.nextEpoch = helpers.SlotToEpoch(st.Slot())
+	if startSlot, err := helpers.StartSlot(s.nextEpoch); err != nil {
+		return err
+	} else if st.Slot() > startSlot {
+		s.nextEpoch++
+	}
+	s.epochStates = make(map[uint64]*stateTrie.BeaconState)
+	log.WithField("slot", slot).Info("Replaying archived blocks from archived state")
+	return nil
+}
+
+// replayUntil applies the canonical blocks before the given slot to the replayed state.
+// Blocks which do not build on the replayed chain are skipped. The signatures and the state
+// roots of the blocks are verified, as the archive is not trusted beyond the archived state.
+func (s *ArchiveSource) replayUntil(ctx context.Context, slot uint64) error {
+	for next := s.replayed.Slot() + 1; next < slot; next++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		for _, name := range s.blocks[next] {
+			blk, err := s.readBlock(name)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(blk.Block.ParentRoot, s.headRoot[:]) {
+				continue
+			}
+			st, err := state.ExecuteStateTransition(ctx, s.replayed, blk)
+			if err != nil {
+				return errors.Wrapf(err, "could not replay block at slot %d", next)
+			}
+			s.replayed = st
+			s.headRoot, err = blk.Block.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func (s *ArchiveSource) readBlock(name string) (*ethpb.SignedBeaconBlock, error) {
+	enc, err := ioutil.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read archived block")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrapf(err, "could not decode archived block %s", name)
+	}
+	if blk.Block == nil {
+		return nil, errors.Errorf("archived block %s is empty", name)
+	}
+	return blk, nil
+}
+
+// fileSlot parses the slot of an archive file name with the given prefix.
+func fileSlot(name, prefix string) (uint64, bool) {
+	if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, sszFileSuffix) {
+		return 0, false
+	}
+	slot := strings.TrimSuffix(strings.TrimPrefix(name, prefix), sszFileSuffix)
+	if i := strings.IndexByte(slot, '_'); i >= 0 {
+		slot = slot[:i]
+	}
+	value, err := strconv.ParseUint(slot, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
@@ -0,0 +1,79 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestArchiveSource_EpochState(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	genesis, keys := testutil.DeterministicGenesisState(t, 64)
+	enc, err := genesis.CloneInnerState().MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "beacon_state_0.ssz"), enc, 0600))
+
+	// Blocks are archived up to the first slot of epoch 2, the expected state of
+	// epoch 2 is the state after the last block of epoch 1.
+	conf := testutil.DefaultBlockGenConfig()
+	conf.NumAttestations = 0
+	st := genesis.Copy()
+	var wanted [32]byte
+	for slot := uint64(1); slot <= 2*slotsPerEpoch; slot++ {
+		blk, err := testutil.GenerateFullBlock(st, keys, conf, slot)
+		require.NoError(t, err)
+		enc, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("beacon_block_%d.ssz", slot)), enc, 0600))
+		if slot == 2*slotsPerEpoch {
+			expected, err := state.ProcessSlots(ctx, st.Copy(), slot)
+			require.NoError(t, err)
+			wanted, err = expected.HashTreeRoot(ctx)
+			require.NoError(t, err)
+		}
+		st, err = state.ExecuteStateTransition(ctx, st, blk)
+		require.NoError(t, err)
+	}
+
+	s, err := NewArchiveSource(dir, 1)
+	require.NoError(t, err)
+	highestEpoch, err := s.HighestEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), highestEpoch)
+	blks, err := s.Blocks(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int(slotsPerEpoch), len(blks))
+
+	epochState, err := s.EpochState(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2*slotsPerEpoch, epochState.Slot())
+	root, err := epochState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wanted, root)
+
+	// Requesting an earlier epoch replays from the archived state again.
+	epochState, err = s.EpochState(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), epochState.Slot())
+}
+
+func TestNewArchiveSource_NoState(t *testing.T) {
+	dir := t.TempDir()
+	blk := testutil.NewBeaconBlock()
+	enc, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "beacon_block_0.ssz"), enc, 0600))
+	_, err = NewArchiveSource(dir, 1)
+	assert.ErrorContains(t, "no state to replay the blocks from", err)
+}
@@ -0,0 +1,103 @@
+package ingest
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	beacondb "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tos"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/db/kv"
+	"github.com/prysmaticlabs/prysm/slasher/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for ingesting historical data into the slasher database.
+var Commands = &cli.Command{
+	Name:     "ingest",
+	Category: "ingest",
+	Usage:    "ingests historical blocks and attestations into the slasher database from a beacon node database or a block archive",
+	Flags: cmd.WrapFlags([]cli.Flag{
+		cmd.DataDirFlag,
+		cmd.ChainConfigFileFlag,
+		flags.IngestBeaconDBFlag,
+		flags.IngestArchiveDirFlag,
+		flags.IngestWorkersFlag,
+		flags.IngestBatchEpochsFlag,
+	}),
+	Before: tos.VerifyTosAcceptedOrPrompt,
+	Action: func(cliCtx *cli.Context) error {
+		if err := ingest(cliCtx); err != nil {
+			logrus.Fatalf("Could not ingest historical data: %v", err)
+		}
+		return nil
+	},
+}
+
+func ingest(cliCtx *cli.Context) error {
+	featureconfig.ConfigureSlasher(cliCtx)
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name))
+	}
+	beaconDBPath := cliCtx.String(flags.IngestBeaconDBFlag.Name)
+	archiveDir := cliCtx.String(flags.IngestArchiveDirFlag.Name)
+	if (beaconDBPath == "") == (archiveDir == "") {
+		return errors.Errorf("exactly one of --%s or --%s is required", flags.IngestBeaconDBFlag.Name, flags.IngestArchiveDirFlag.Name)
+	}
+	workers := cliCtx.Int(flags.IngestWorkersFlag.Name)
+	batchEpochs := cliCtx.Uint64(flags.IngestBatchEpochsFlag.Name)
+	// The states of the epochs of all the batches being loaded are kept while replaying.
+	window := uint64(workers)*batchEpochs + 1
+
+	var source Source
+	if beaconDBPath != "" {
+		// Opening a missing beacon node database would create an empty one.
+		if ok, err := fileutil.HasDir(beaconDBPath); err != nil || !ok {
+			return errors.Errorf("beacon node database directory %s does not exist", beaconDBPath)
+		}
+		stateSummaryCache := cache.NewStateSummaryCache()
+		beaconDB, err := beacondb.NewDB(beaconDBPath, stateSummaryCache)
+		if err != nil {
+			return errors.Wrap(err, "could not open beacon node database")
+		}
+		defer func() {
+			if err := beaconDB.Close(); err != nil {
+				log.WithError(err).Error("Could not close beacon node database")
+			}
+		}()
+		source = NewDBSource(beaconDB, stateSummaryCache, window)
+	} else {
+		archiveSource, err := NewArchiveSource(archiveDir, window)
+		if err != nil {
+			return err
+		}
+		source = archiveSource
+	}
+
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.SlasherDbDirName)
+	slasherDB, err := db.NewDB(dbPath, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := slasherDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+
+	ingester := NewIngester(cliCtx.Context, &Config{
+		SlasherDB:   slasherDB,
+		Source:      source,
+		Workers:     workers,
+		BatchEpochs: batchEpochs,
+		// Unlike the blocks of a beacon node database, archived blocks were never verified.
+		VerifySignatures: archiveDir != "",
+	})
+	return ingester.Run(cliCtx.Context)
+}
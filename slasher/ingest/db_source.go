@@ -0,0 +1,208 @@
+package ingest
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// DBSource reads the blocks from a beacon node database, regenerating the states from the
+// states saved in the database. The state of the first requested epoch is regenerated from the
+// last saved state before it, and the states of the next epochs are replayed from it.
+type DBSource struct {
+	beaconDB db.Database
+	stateGen *stategen.State
+	// window is the number of epochs before the last requested one whose states are kept,
+	// covering the epochs of the batches being ingested concurrently.
+	window      uint64
+	lock        sync.Mutex
+	replayed    *stateTrie.BeaconState
+	nextEpoch   uint64
+	epochStates map[uint64]*stateTrie.BeaconState
+	// headChain holds the roots of the blocks from the head back to the finalized block, loaded
+	// once to tell the canonical blocks from the orphaned ones.
+	headChain     map[[32]byte]bool
+	finalizedRoot [32]byte
+	finalizedSlot uint64
+}
+
+// NewDBSource creates a source reading from the given beacon node database.
+func NewDBSource(beaconDB db.Database, stateSummaryCache *cache.StateSummaryCache, window uint64) *DBSource {
+	return &DBSource{
+		beaconDB:    beaconDB,
+		stateGen:    stategen.New(beaconDB, stateSummaryCache),
+		window:      window,
+		epochStates: make(map[uint64]*stateTrie.BeaconState),
+	}
+}
+
+// HighestEpoch returns the epoch of the head block of the database.
+func (s *DBSource) HighestEpoch(ctx context.Context) (uint64, error) {
+	head, err := s.beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get head block")
+	}
+	if head == nil || head.Block == nil {
+		return 0, errors.New("no head block in the beacon node database")
+	}
+	return helpers.SlotToEpoch(head.Block.Slot), nil
+}
+
+// Blocks returns all the blocks saved for an epoch.
+func (s *DBSource) Blocks(ctx context.Context, epoch uint64) ([]*ethpb.SignedBeaconBlock, error) {
+	blks, _, err := s.beaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(epoch).SetEndEpoch(epoch))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get blocks of epoch %d", epoch)
+	}
+	return blks, nil
+}
+
+// EpochState returns the canonical state at the start slot of an epoch, replaying the canonical
+// blocks since the last replayed epoch. The state is regenerated from the saved states when an
+// epoch before the replayed ones is requested.
+func (s *DBSource) EpochState(ctx context.Context, epoch uint64) (*stateTrie.BeaconState, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if st, ok := s.epochStates[epoch]; ok {
+		return st.Copy(), nil
+	}
+	if s.replayed == nil || epoch < s.nextEpoch {
+		slot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return nil, err
+		}
+		st, err := s.stateGen.StateBySlot(ctx, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get state of epoch %d", epoch)
+		}
+		s.replayed = st
+		s.nextEpoch = epoch + 1
+		s.epochStates = map[uint64]*stateTrie.BeaconState{epoch: st.Copy()}
+		return st.Copy(), nil
+	}
+	for ; s.nextEpoch <= epoch; s.nextEpoch++ {
+		if err := s.replayUntil(ctx, s.nextEpoch); err != nil {
+			// The replayed state may have been partially transitioned.
+			s.replayed = nil
+			return nil, err
+		}
+		s.epochStates[s.nextEpoch] = s.replayed.Copy()
+	}
+	for e := range s.epochStates {
+		if e+s.window < epoch {
+			delete(s.epochStates, e)
+		}
+	}
+	return s.epochStates[epoch].Copy(), nil
+}
+
+// replayUntil applies the canonical blocks up to the start slot of an epoch to the replayed state.
+func (s *DBSource) replayUntil(ctx context.Context, epoch uint64) error {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return err
+	}
+	if err := s.loadHeadChain(ctx); err != nil {
+		return err
+	}
+	blks, roots, err := s.beaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(s.replayed.Slot()+1).SetEndSlot(startSlot))
+	if err != nil {
+		return errors.Wrapf(err, "could not get blocks of epoch %d", epoch-1)
+	}
+	canonical := make([]*ethpb.SignedBeaconBlock, 0, len(blks))
+	for i, blk := range blks {
+		if s.isCanonical(ctx, roots[i], blk.Block.Slot) {
+			canonical = append(canonical, blk)
+		}
+	}
+	// The blocks are replayed in decreasing slot order.
+	sort.Slice(canonical, func(i, j int) bool {
+		return canonical[i].Block.Slot > canonical[j].Block.Slot
+	})
+	s.replayed, err = s.stateGen.ReplayBlocks(ctx, s.replayed, canonical, startSlot)
+	if err != nil {
+		return errors.Wrapf(err, "could not replay blocks up to epoch %d", epoch)
+	}
+	return nil
+}
+
+// loadHeadChain walks the parent links from the head block back to the finalized block.
+func (s *DBSource) loadHeadChain(ctx context.Context) error {
+	if s.headChain != nil {
+		return nil
+	}
+	finalized, err := s.beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	fRoot := bytesutil.ToBytes32(finalized.Root)
+	if fRoot == params.BeaconConfig().ZeroHash {
+		genesis, err := s.beaconDB.GenesisBlock(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get genesis block")
+		}
+		if genesis == nil || genesis.Block == nil {
+			return errors.New("genesis block not found")
+		}
+		fRoot, err = genesis.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+	}
+	fBlk, err := s.beaconDB.Block(ctx, fRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if fBlk == nil || fBlk.Block == nil {
+		return errors.Errorf("finalized block %#x not found", fRoot)
+	}
+
+	blk, err := s.beaconDB.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if blk == nil || blk.Block == nil {
+		return errors.New("no head block in the beacon node database")
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	headChain := make(map[[32]byte]bool)
+	for blk.Block.Slot > fBlk.Block.Slot {
+		headChain[root] = true
+		root = bytesutil.ToBytes32(blk.Block.ParentRoot)
+		blk, err = s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return errors.Wrap(err, "could not get block")
+		}
+		if blk == nil || blk.Block == nil {
+			return errors.Errorf("block %#x not found", root)
+		}
+	}
+	s.headChain = headChain
+	s.finalizedRoot = fRoot
+	s.finalizedSlot = fBlk.Block.Slot
+	return nil
+}
+
+// isCanonical returns whether a block is on the chain of the head block. The finalized index
+// holds every block of the finalized epoch, so the blocks after the finalized block are looked up
+// in the head chain instead.
+func (s *DBSource) isCanonical(ctx context.Context, root [32]byte, slot uint64) bool {
+	if slot > s.finalizedSlot {
+		return s.headChain[root]
+	}
+	return root == s.finalizedRoot || s.beaconDB.IsFinalizedBlock(ctx, root)
+}
@@ -0,0 +1,121 @@
+package ingest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// saveChain saves a chain of two epochs whose last slots are skipped, with orphaned blocks at the
+// start slot of epoch 1 and at the last slot of epoch 1 if orphans is set. It returns the roots of
+// the expected epoch states. As for the states regenerated by slot from the database, the state of
+// an epoch is the state after the block at its start slot, if any.
+func saveChain(t *testing.T, beaconDB db.Database, orphans bool) map[uint64][32]byte {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	genesis, keys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := genesis.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesisBlk))
+	require.NoError(t, beaconDB.SaveState(ctx, genesis, genesisRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	conf := testutil.DefaultBlockGenConfig()
+	conf.NumAttestations = 0
+	st := genesis.Copy()
+	wanted := make(map[uint64][32]byte)
+	var headRoot [32]byte
+	var headSlot uint64
+	for slot := uint64(1); slot <= 2*slotsPerEpoch; slot++ {
+		if orphans && (slot == slotsPerEpoch || slot == 2*slotsPerEpoch-1) {
+			orphan, err := testutil.GenerateFullBlock(st, keys, conf, slot)
+			require.NoError(t, err)
+			orphan.Block.Body.Graffiti = bytesutil.PadTo([]byte("orphan"), 32)
+			require.NoError(t, beaconDB.SaveBlock(ctx, orphan))
+		}
+		if slot < 2*slotsPerEpoch-1 {
+			blk, err := testutil.GenerateFullBlock(st, keys, conf, slot)
+			require.NoError(t, err)
+			require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+			st, err = state.ExecuteStateTransition(ctx, st, blk)
+			require.NoError(t, err)
+			headRoot, err = blk.Block.HashTreeRoot()
+			require.NoError(t, err)
+			headSlot = slot
+		}
+		if slot%slotsPerEpoch == 0 {
+			expected := st.Copy()
+			if expected.Slot() < slot {
+				expected, err = state.ProcessSlots(ctx, expected, slot)
+				require.NoError(t, err)
+			}
+			wanted[slot/slotsPerEpoch], err = expected.HashTreeRoot(ctx)
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: headSlot, Root: headRoot[:]}))
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, headRoot))
+	return wanted
+}
+
+func TestDBSource_EpochState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, stateSummaryCache := testDB.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	wanted := saveChain(t, beaconDB, false)
+
+	s := NewDBSource(beaconDB, stateSummaryCache, 1)
+	epochState, err := s.EpochState(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), epochState.Slot())
+
+	// The states of the next epochs are replayed from the state of the previous epoch.
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		epochState, err = s.EpochState(ctx, epoch)
+		require.NoError(t, err)
+		assert.Equal(t, epoch*slotsPerEpoch, epochState.Slot())
+		root, err := epochState.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, wanted[epoch], root)
+	}
+	assert.Equal(t, uint64(3), s.nextEpoch)
+
+	// The state of a kept epoch is not replayed again.
+	epochState, err = s.EpochState(ctx, 1)
+	require.NoError(t, err)
+	root, err := epochState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wanted[1], root)
+	assert.Equal(t, uint64(3), s.nextEpoch)
+}
+
+func TestDBSource_EpochState_SkipsOrphanedBlocks(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, stateSummaryCache := testDB.SetupDB(t)
+	wanted := saveChain(t, beaconDB, true)
+
+	s := NewDBSource(beaconDB, stateSummaryCache, 2)
+	_, err := s.EpochState(ctx, 0)
+	require.NoError(t, err)
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		epochState, err := s.EpochState(ctx, epoch)
+		require.NoError(t, err)
+		root, err := epochState.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, wanted[epoch], root)
+	}
+}
@@ -0,0 +1,268 @@
+// Package ingest backfills the slasher database with historical blocks and the attestations
+// they include, read directly from a beacon node database or an archive of SSZ encoded blocks
+// instead of being requested epoch by epoch from a running beacon node.
+package ingest
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/detection"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// Config options for the ingester.
+type Config struct {
+	SlasherDB db.Database
+	Source    Source
+	// Workers is the number of batches loaded in parallel.
+	Workers int
+	// BatchEpochs is the number of epochs of blocks in a batch.
+	BatchEpochs uint64
+	// VerifySignatures verifies the signatures of the blocks and attestations of the source,
+	// so a source which is not trusted cannot forge slashable offenses.
+	VerifySignatures bool
+}
+
+// Ingester loads batches of epochs from a source in parallel, and runs slashing detection on
+// them in order. The last ingested epoch is saved as the chain head of the slasher DB, which
+// ingestion resumes from and which the historical detection of the slasher node continues from.
+type Ingester struct {
+	cfg      *Config
+	detector *detection.Service
+}
+
+// batch holds the blocks and attestations of a range of epochs.
+type batch struct {
+	startEpoch uint64
+	endEpoch   uint64
+	headers    []*ethpb.SignedBeaconBlockHeader
+	atts       []*ethpb.IndexedAttestation
+	err        error
+}
+
+// NewIngester creates a new ingester.
+func NewIngester(ctx context.Context, cfg *Config) *Ingester {
+	if cfg.Workers < 1 {
+		cfg.Workers = 1
+	}
+	if cfg.BatchEpochs < 1 {
+		cfg.BatchEpochs = 1
+	}
+	return &Ingester{
+		cfg: cfg,
+		// Only the detection methods of the service are used, it is never started.
+		detector: detection.NewService(ctx, &detection.Config{SlasherDB: cfg.SlasherDB}),
+	}
+}
+
+// Run ingests the epochs from the recorded cursor up to the highest epoch of the source.
+func (i *Ingester) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	head, err := i.cfg.SlasherDB.ChainHead(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head from slasher DB")
+	}
+	var startEpoch uint64
+	if head != nil {
+		startEpoch = head.HeadEpoch + 1
+	}
+	highestEpoch, err := i.cfg.Source.HighestEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if startEpoch > highestEpoch {
+		log.WithField("epoch", highestEpoch).Info("Slasher DB already ingested up to the highest epoch of the source")
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"startEpoch": startEpoch,
+		"endEpoch":   highestEpoch,
+	}).Info("Ingesting historical blocks and attestations")
+
+	var batches []*batch
+	for epoch := startEpoch; epoch <= highestEpoch; epoch += i.cfg.BatchEpochs {
+		end := epoch + i.cfg.BatchEpochs - 1
+		if end > highestEpoch {
+			end = highestEpoch
+		}
+		batches = append(batches, &batch{startEpoch: epoch, endEpoch: end})
+	}
+
+	// At most one batch per worker is loaded or waiting to be applied at any time,
+	// which bounds memory and the range of epochs sources are asked for at once.
+	inFlight := make(chan struct{}, i.cfg.Workers)
+	jobs := make(chan *batch)
+	loaded := make(chan *batch, i.cfg.Workers)
+	go func() {
+		defer close(jobs)
+		for _, b := range batches {
+			select {
+			case inFlight <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- b:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	for w := 0; w < i.cfg.Workers; w++ {
+		go func() {
+			for b := range jobs {
+				b.err = i.load(ctx, b)
+				loaded <- b
+			}
+		}()
+	}
+
+	pending := make(map[uint64]*batch)
+	next := startEpoch
+	for applied := 0; applied < len(batches); {
+		var b *batch
+		select {
+		case b = <-loaded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if b.err != nil {
+			return errors.Wrapf(b.err, "could not load epochs %d to %d", b.startEpoch, b.endEpoch)
+		}
+		pending[b.startEpoch] = b
+		for b, ok := pending[next]; ok; b, ok = pending[next] {
+			delete(pending, next)
+			if err := i.apply(ctx, b); err != nil {
+				return errors.Wrapf(err, "could not ingest epochs %d to %d", b.startEpoch, b.endEpoch)
+			}
+			if err := i.cfg.SlasherDB.SaveChainHead(ctx, &ethpb.ChainHead{HeadEpoch: b.endEpoch}); err != nil {
+				return errors.Wrap(err, "could not save ingestion cursor")
+			}
+			<-inFlight
+			applied++
+			next = b.endEpoch + 1
+		}
+	}
+	log.WithField("epoch", highestEpoch).Info("Completed ingestion of historical blocks and attestations")
+	return nil
+}
+
+// load reads the blocks of a batch and converts their attestations to indexed attestations.
+func (i *Ingester) load(ctx context.Context, b *batch) error {
+	ctx, span := trace.StartSpan(ctx, "ingest.load")
+	defer span.End()
+	states := make(map[uint64]*stateTrie.BeaconState)
+	seen := make(map[[32]byte]bool)
+	for epoch := b.startEpoch; epoch <= b.endEpoch; epoch++ {
+		blks, err := i.cfg.Source.Blocks(ctx, epoch)
+		if err != nil {
+			return err
+		}
+		for _, blk := range blks {
+			if i.cfg.VerifySignatures {
+				st, err := i.epochState(ctx, states, helpers.SlotToEpoch(blk.Block.Slot))
+				if err != nil {
+					return err
+				}
+				if err := blocks.VerifyBlockSignature(st, blk); err != nil {
+					return errors.Wrapf(err, "could not verify signature of block at slot %d", blk.Block.Slot)
+				}
+			}
+			header, err := blockutil.SignedBeaconBlockHeaderFromBlock(blk)
+			if err != nil {
+				return errors.Wrap(err, "could not get block header from block")
+			}
+			b.headers = append(b.headers, header)
+			for _, att := range blk.Block.Body.Attestations {
+				// The same attestation can be included in several blocks.
+				root, err := hashutil.HashProto(att)
+				if err != nil {
+					return errors.Wrap(err, "could not hash attestation")
+				}
+				if seen[root] {
+					continue
+				}
+				seen[root] = true
+				st, err := i.epochState(ctx, states, att.Data.Target.Epoch)
+				if err != nil {
+					return err
+				}
+				committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+				if err != nil {
+					return errors.Wrap(err, "could not get attestation committee")
+				}
+				indexedAtt := attestationutil.ConvertToIndexed(ctx, att, committee)
+				if i.cfg.VerifySignatures {
+					if err := blocks.VerifyIndexedAttestation(ctx, st, indexedAtt); err != nil {
+						return errors.Wrapf(err, "could not verify signature of attestation in block at slot %d", blk.Block.Slot)
+					}
+				}
+				b.atts = append(b.atts, indexedAtt)
+			}
+		}
+	}
+	return nil
+}
+
+// epochState returns the state of an epoch from the states of a batch, requesting it from the
+// source the first time.
+func (i *Ingester) epochState(ctx context.Context, states map[uint64]*stateTrie.BeaconState, epoch uint64) (*stateTrie.BeaconState, error) {
+	if st, ok := states[epoch]; ok {
+		return st, nil
+	}
+	st, err := i.cfg.Source.EpochState(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	states[epoch] = st
+	return st, nil
+}
+
+// apply saves the blocks and attestations of a batch and runs slashing detection on them. The
+// slashings found are saved in the slasher DB by the detection.
+func (i *Ingester) apply(ctx context.Context, b *batch) error {
+	ctx, span := trace.StartSpan(ctx, "ingest.apply")
+	defer span.End()
+	var proposerSlashings int
+	for _, header := range b.headers {
+		slashing, err := i.detector.DetectDoubleProposals(ctx, header)
+		if err != nil {
+			return errors.Wrap(err, "could not detect double proposals")
+		}
+		if slashing != nil {
+			proposerSlashings++
+		}
+	}
+	if err := i.cfg.SlasherDB.SaveIndexedAttestations(ctx, b.atts); err != nil {
+		return errors.Wrap(err, "could not save indexed attestations")
+	}
+	attesterSlashings, err := i.detector.DetectAndUpdateAttesterSlashings(ctx, b.atts)
+	if err != nil {
+		return errors.Wrap(err, "could not detect attester slashings")
+	}
+	for _, att := range b.atts {
+		if err := i.detector.UpdateHighestAttestation(ctx, att); err != nil {
+			return errors.Wrap(err, "could not update highest attestation")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"startEpoch":        b.startEpoch,
+		"endEpoch":          b.endEpoch,
+		"blocks":            len(b.headers),
+		"attestations":      len(b.atts),
+		"proposerSlashings": proposerSlashings,
+		"attesterSlashings": len(attesterSlashings),
+	}).Info("Ingested epochs")
+	return nil
+}
@@ -0,0 +1,179 @@
+package ingest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	slasherTesting "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	status "github.com/prysmaticlabs/prysm/slasher/db/types"
+)
+
+type mockSource struct {
+	st           *stateTrie.BeaconState
+	blocks       map[uint64][]*ethpb.SignedBeaconBlock
+	highestEpoch uint64
+	lock         sync.Mutex
+	requested    []uint64
+}
+
+func (m *mockSource) HighestEpoch(_ context.Context) (uint64, error) {
+	return m.highestEpoch, nil
+}
+
+func (m *mockSource) Blocks(_ context.Context, epoch uint64) ([]*ethpb.SignedBeaconBlock, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.requested = append(m.requested, epoch)
+	return m.blocks[epoch], nil
+}
+
+func (m *mockSource) EpochState(_ context.Context, _ uint64) (*stateTrie.BeaconState, error) {
+	return m.st.Copy(), nil
+}
+
+func attestation(t *testing.T, st *stateTrie.BeaconState, blockRoot byte) *ethpb.Attestation {
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: bytesutil.PadTo([]byte{blockRoot}, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+	}
+	committee, err := helpers.BeaconCommitteeFromState(st, data.Slot, data.CommitteeIndex)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		bits.SetBitAt(uint64(i), true)
+	}
+	return &ethpb.Attestation{
+		AggregationBits: bits,
+		Data:            data,
+		Signature:       make([]byte, 96),
+	}
+}
+
+func block(slot, proposer uint64, graffiti byte, atts ...*ethpb.Attestation) *ethpb.SignedBeaconBlock {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ProposerIndex = proposer
+	blk.Block.Body.Graffiti = bytesutil.PadTo([]byte{graffiti}, 32)
+	blk.Block.Body.Attestations = atts
+	// Headers with the same signature are considered the same block.
+	blk.Signature = bytesutil.PadTo([]byte{graffiti}, 96)
+	return blk
+}
+
+func TestIngester_Run(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	att := attestation(t, st, 1)
+	source := &mockSource{
+		st: st,
+		blocks: map[uint64][]*ethpb.SignedBeaconBlock{
+			// Two different blocks from the same proposer at the same slot.
+			0: {block(1, 3, 1, att), block(1, 3, 2, att)},
+			// The same committee votes for another block root with the same target.
+			1: {block(slotsPerEpoch+1, 4, 1, attestation(t, st, 2))},
+			3: {block(3*slotsPerEpoch, 5, 1)},
+		},
+		highestEpoch: 3,
+	}
+	slasherDB := slasherTesting.SetupSlasherDB(t, false)
+	ingester := NewIngester(ctx, &Config{
+		SlasherDB:   slasherDB,
+		Source:      source,
+		Workers:     2,
+		BatchEpochs: 1,
+	})
+	require.NoError(t, ingester.Run(ctx))
+
+	proposerSlashings, err := slasherDB.ProposalSlashingsByStatus(ctx, status.Active)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposerSlashings))
+	assert.Equal(t, uint64(3), proposerSlashings[0].Header_1.Header.ProposerIndex)
+	attesterSlashings, err := slasherDB.AttesterSlashings(ctx, status.Active)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(attesterSlashings))
+	head, err := slasherDB.ChainHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), head.HeadEpoch)
+	assert.Equal(t, 4, len(source.requested))
+
+	// Ingestion resumes from the saved cursor.
+	source.requested = nil
+	source.blocks[4] = []*ethpb.SignedBeaconBlock{block(4*slotsPerEpoch, 6, 1)}
+	source.highestEpoch = 4
+	require.NoError(t, ingester.Run(ctx))
+	assert.DeepEqual(t, []uint64{4}, source.requested)
+	head, err = slasherDB.ChainHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), head.HeadEpoch)
+
+	// Nothing is left to ingest.
+	source.requested = nil
+	require.NoError(t, ingester.Run(ctx))
+	assert.Equal(t, 0, len(source.requested))
+}
+
+func TestIngester_Run_VerifySignatures(t *testing.T) {
+	ctx := context.Background()
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	conf := testutil.DefaultBlockGenConfig()
+	conf.NumAttestations = 1
+	blk, err := testutil.GenerateFullBlock(st, keys, conf, 1)
+	require.NoError(t, err)
+	source := &mockSource{
+		st:     st,
+		blocks: map[uint64][]*ethpb.SignedBeaconBlock{0: {blk}},
+	}
+	ingester := NewIngester(ctx, &Config{
+		SlasherDB:        slasherTesting.SetupSlasherDB(t, false),
+		Source:           source,
+		VerifySignatures: true,
+	})
+	require.NoError(t, ingester.Run(ctx))
+
+	// A forged block is rejected.
+	source.blocks[1] = []*ethpb.SignedBeaconBlock{block(params.BeaconConfig().SlotsPerEpoch, 3, 1)}
+	source.highestEpoch = 1
+	assert.ErrorContains(t, "could not verify signature of block", ingester.Run(ctx))
+
+	// A forged attestation in a signed block is rejected.
+	forged, err := testutil.GenerateFullBlock(st, keys, conf, 1)
+	require.NoError(t, err)
+	forged.Block.Body.Attestations = []*ethpb.Attestation{attestation(t, st, 1)}
+	forged.Signature, err = helpers.ComputeDomainAndSign(st, 0, forged.Block, params.BeaconConfig().DomainBeaconProposer, keys[forged.Block.ProposerIndex])
+	require.NoError(t, err)
+	source.blocks[1] = []*ethpb.SignedBeaconBlock{forged}
+	assert.ErrorContains(t, "could not verify signature of attestation", ingester.Run(ctx))
+}
+
+func TestFileSlot(t *testing.T) {
+	tests := []struct {
+		name string
+		slot uint64
+		ok   bool
+	}{
+		{name: "beacon_block_12.ssz", slot: 12, ok: true},
+		{name: "beacon_block_12_1.ssz", slot: 12, ok: true},
+		{name: "beacon_block_a.ssz", ok: false},
+		{name: "beacon_block_12.json", ok: false},
+		{name: "beacon_state_12.ssz", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot, ok := fileSlot(tt.name, blockFilePrefix)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.slot, slot)
+		})
+	}
+}
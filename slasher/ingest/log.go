@@ -0,0 +1,7 @@
+package ingest
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "ingest")
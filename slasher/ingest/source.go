@@ -0,0 +1,20 @@
+package ingest
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+)
+
+// Source provides the historical blocks to ingest, and the states needed
+// to compute the committees of the attestations they include.
+type Source interface {
+	// HighestEpoch returns the highest epoch with blocks in the source.
+	HighestEpoch(ctx context.Context) (uint64, error)
+	// Blocks returns the blocks of an epoch, including blocks which are not canonical.
+	Blocks(ctx context.Context, epoch uint64) ([]*ethpb.SignedBeaconBlock, error)
+	// EpochState returns the state at the start slot of an epoch, which
+	// determines the committees of the attestations targeting the epoch.
+	EpochState(ctx context.Context, epoch uint64) (*stateTrie.BeaconState, error)
+}
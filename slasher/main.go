@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
+	"github.com/prysmaticlabs/prysm/slasher/ingest"
 	"github.com/prysmaticlabs/prysm/slasher/node"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -95,6 +96,7 @@ func main() {
 	app.Version = version.GetVersion()
 	app.Commands = []*cli.Command{
 		db.DatabaseCommands,
+		ingest.Commands,
 	}
 	app.Flags = appFlags
 	app.Action = startSlasher
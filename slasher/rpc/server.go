@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -12,8 +13,10 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/p2putils"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	"github.com/prysmaticlabs/prysm/slasher/db"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -232,3 +235,145 @@ func (ss *Server) IsSlashableBlockNoUpdate(ctx context.Context, req *ethpb.Beaco
 	sl.Slashable = slash
 	return sl, nil
 }
+
+// dbSlashingStatuses maps the statuses of the evidence API to the statuses slashings are saved with.
+// A slashing reverted from the canonical chain was submitted before, and may be included again.
+var dbSlashingStatuses = map[slashpb.SlashingStatus][]types.SlashingStatus{
+	slashpb.SlashingStatus_DETECTED:  {types.Active},
+	slashpb.SlashingStatus_SUBMITTED: {types.Submitted, types.Reverted},
+	slashpb.SlashingStatus_INCLUDED:  {types.Included},
+}
+
+// ProposerSlashingEvidence lists the detected proposer slashings of the requested validators,
+// for blocks within the requested epoch range and with any of the requested statuses.
+func (ss *Server) ProposerSlashingEvidence(ctx context.Context, req *slashpb.SlashingEvidenceRequest) (*slashpb.ProposerSlashingEvidenceResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "history.ProposerSlashingEvidence")
+	defer span.End()
+
+	statuses, err := evidenceStatuses(req)
+	if err != nil {
+		return nil, err
+	}
+	indices := make(map[uint64]bool, len(req.ValidatorIndices))
+	for _, idx := range req.ValidatorIndices {
+		indices[idx] = true
+	}
+	evidence := make([]*slashpb.ProposerSlashingEvidence, 0)
+	for _, st := range statuses {
+		for _, dbStatus := range dbSlashingStatuses[st] {
+			slashings, err := ss.slasherDB.ProposalSlashingsByStatus(ctx, dbStatus)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not retrieve proposer slashings: %v", err)
+			}
+			for _, slashing := range slashings {
+				if slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+					continue
+				}
+				header := slashing.Header_1.Header
+				if len(indices) > 0 && !indices[header.ProposerIndex] {
+					continue
+				}
+				if !inEpochRange(req, helpers.SlotToEpoch(header.Slot)) {
+					continue
+				}
+				evidence = append(evidence, &slashpb.ProposerSlashingEvidence{
+					Slashing: slashing,
+					Reason:   slashpb.SlashingReason_DOUBLE_PROPOSAL,
+					Status:   st,
+				})
+			}
+		}
+	}
+	sort.Slice(evidence, func(i, j int) bool {
+		return evidence[i].Slashing.Header_1.Header.Slot < evidence[j].Slashing.Header_1.Header.Slot
+	})
+	return &slashpb.ProposerSlashingEvidenceResponse{
+		Evidence: evidence,
+	}, nil
+}
+
+// AttesterSlashingEvidence lists the detected attester slashings with any of the requested validators
+// attesting in both attestations, for attestations targeting an epoch within the requested epoch
+// range and with any of the requested statuses.
+func (ss *Server) AttesterSlashingEvidence(ctx context.Context, req *slashpb.SlashingEvidenceRequest) (*slashpb.AttesterSlashingEvidenceResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "history.AttesterSlashingEvidence")
+	defer span.End()
+
+	statuses, err := evidenceStatuses(req)
+	if err != nil {
+		return nil, err
+	}
+	evidence := make([]*slashpb.AttesterSlashingEvidence, 0)
+	for _, st := range statuses {
+		for _, dbStatus := range dbSlashingStatuses[st] {
+			slashings, err := ss.slasherDB.AttesterSlashings(ctx, dbStatus)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not retrieve attester slashings: %v", err)
+			}
+			for _, slashing := range slashings {
+				att1, att2 := slashing.Attestation_1, slashing.Attestation_2
+				if att1 == nil || att1.Data == nil || att1.Data.Target == nil ||
+					att2 == nil || att2.Data == nil || att2.Data.Target == nil {
+					continue
+				}
+				slashableIndices := sliceutil.IntersectionUint64(att1.AttestingIndices, att2.AttestingIndices)
+				if len(req.ValidatorIndices) > 0 && len(sliceutil.IntersectionUint64(slashableIndices, req.ValidatorIndices)) == 0 {
+					continue
+				}
+				if !inEpochRange(req, att1.Data.Target.Epoch) && !inEpochRange(req, att2.Data.Target.Epoch) {
+					continue
+				}
+				reason := slashpb.SlashingReason_SURROUND_VOTE
+				if att1.Data.Target.Epoch == att2.Data.Target.Epoch {
+					reason = slashpb.SlashingReason_DOUBLE_VOTE
+				}
+				evidence = append(evidence, &slashpb.AttesterSlashingEvidence{
+					Slashing:         slashing,
+					SlashableIndices: slashableIndices,
+					Reason:           reason,
+					Status:           st,
+				})
+			}
+		}
+	}
+	sort.Slice(evidence, func(i, j int) bool {
+		return evidence[i].Slashing.Attestation_1.Data.Target.Epoch < evidence[j].Slashing.Attestation_1.Data.Target.Epoch
+	})
+	return &slashpb.AttesterSlashingEvidenceResponse{
+		Evidence: evidence,
+	}, nil
+}
+
+// evidenceStatuses validates an evidence request and returns the distinct statuses it requests,
+// or all of them if none is requested.
+func evidenceStatuses(req *slashpb.SlashingEvidenceRequest) ([]slashpb.SlashingStatus, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil request provided")
+	}
+	if req.EndEpoch != 0 && req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "end epoch %d is before start epoch %d", req.EndEpoch, req.StartEpoch)
+	}
+	if len(req.Statuses) == 0 {
+		return []slashpb.SlashingStatus{
+			slashpb.SlashingStatus_DETECTED,
+			slashpb.SlashingStatus_SUBMITTED,
+			slashpb.SlashingStatus_INCLUDED,
+		}, nil
+	}
+	seen := make(map[slashpb.SlashingStatus]bool, len(req.Statuses))
+	statuses := make([]slashpb.SlashingStatus, 0, len(req.Statuses))
+	for _, st := range req.Statuses {
+		if _, ok := dbSlashingStatuses[st]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown slashing status %d", st)
+		}
+		if !seen[st] {
+			seen[st] = true
+			statuses = append(statuses, st)
+		}
+	}
+	return statuses, nil
+}
+
+func inEpochRange(req *slashpb.SlashingEvidenceRequest, epoch uint64) bool {
+	return epoch >= req.StartEpoch && (req.EndEpoch == 0 || epoch <= req.EndEpoch)
+}
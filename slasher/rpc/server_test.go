@@ -10,6 +10,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
@@ -20,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/slasher/beaconclient"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	"github.com/prysmaticlabs/prysm/slasher/db/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection"
 )
 
@@ -323,3 +325,118 @@ func TestServer_IsSlashableBlockNoUpdate(t *testing.T) {
 	require.NoError(t, err, "Got error while trying to detect slashing")
 	require.Equal(t, true, sl.Slashable, "Block should be found to be slashable")
 }
+
+func attesterSlashing(indices1, indices2 []uint64, source1, target1, source2, target2 uint64) *ethpb.AttesterSlashing {
+	att := func(indices []uint64, source, target uint64, root byte) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: bytesutil.PadTo([]byte{root}, 32),
+				Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	return &ethpb.AttesterSlashing{
+		Attestation_1: att(indices1, source1, target1, 1),
+		Attestation_2: att(indices2, source2, target2, 2),
+	}
+}
+
+func proposerSlashing(proposerIndex, slot uint64) *ethpb.ProposerSlashing {
+	header := func(sig byte) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				ProposerIndex: proposerIndex,
+				Slot:          slot,
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+				BodyRoot:      make([]byte, 32),
+			},
+			Signature: bytesutil.PadTo([]byte{sig}, 96),
+		}
+	}
+	return &ethpb.ProposerSlashing{Header_1: header(1), Header_2: header(2)}
+}
+
+func TestServer_AttesterSlashingEvidence(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupSlasherDB(t, false)
+	server := Server{ctx: ctx, slasherDB: db}
+
+	doubleVote := attesterSlashing([]uint64{1, 2}, []uint64{2, 3}, 0, 1, 0, 1)
+	surround := attesterSlashing([]uint64{4}, []uint64{4}, 1, 5, 2, 4)
+	reverted := attesterSlashing([]uint64{5}, []uint64{5}, 3, 6, 3, 6)
+	require.NoError(t, db.SaveAttesterSlashing(ctx, types.Active, doubleVote))
+	require.NoError(t, db.SaveAttesterSlashing(ctx, types.Included, surround))
+	require.NoError(t, db.SaveAttesterSlashing(ctx, types.Reverted, reverted))
+
+	res, err := server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Evidence))
+	assert.DeepEqual(t, doubleVote, res.Evidence[0].Slashing)
+	assert.DeepEqual(t, []uint64{2}, res.Evidence[0].SlashableIndices)
+	assert.Equal(t, slashpb.SlashingReason_DOUBLE_VOTE, res.Evidence[0].Reason)
+	assert.Equal(t, slashpb.SlashingStatus_DETECTED, res.Evidence[0].Status)
+	assert.Equal(t, slashpb.SlashingReason_SURROUND_VOTE, res.Evidence[1].Reason)
+	assert.Equal(t, slashpb.SlashingStatus_INCLUDED, res.Evidence[1].Status)
+	assert.Equal(t, slashpb.SlashingStatus_SUBMITTED, res.Evidence[2].Status)
+
+	res, err = server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{ValidatorIndices: []uint64{3, 4}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	assert.DeepEqual(t, surround, res.Evidence[0].Slashing)
+
+	res, err = server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{StartEpoch: 2, EndEpoch: 4})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	assert.DeepEqual(t, surround, res.Evidence[0].Slashing)
+
+	res, err = server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{
+		Statuses: []slashpb.SlashingStatus{slashpb.SlashingStatus_SUBMITTED},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	assert.DeepEqual(t, reverted, res.Evidence[0].Slashing)
+
+	_, err = server.AttesterSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{StartEpoch: 2, EndEpoch: 1})
+	assert.ErrorContains(t, "end epoch 1 is before start epoch 2", err)
+}
+
+func TestServer_ProposerSlashingEvidence(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupSlasherDB(t, false)
+	server := Server{ctx: ctx, slasherDB: db}
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	detected := proposerSlashing(1, 2*slotsPerEpoch)
+	submitted := proposerSlashing(2, 1)
+	require.NoError(t, db.SaveProposerSlashing(ctx, types.Active, detected))
+	require.NoError(t, db.SaveProposerSlashing(ctx, types.Submitted, submitted))
+
+	res, err := server.ProposerSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Evidence))
+	assert.DeepEqual(t, submitted, res.Evidence[0].Slashing)
+	assert.Equal(t, slashpb.SlashingStatus_SUBMITTED, res.Evidence[0].Status)
+	assert.Equal(t, slashpb.SlashingReason_DOUBLE_PROPOSAL, res.Evidence[0].Reason)
+	assert.DeepEqual(t, detected, res.Evidence[1].Slashing)
+	assert.Equal(t, slashpb.SlashingStatus_DETECTED, res.Evidence[1].Status)
+
+	res, err = server.ProposerSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{ValidatorIndices: []uint64{1}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	assert.DeepEqual(t, detected, res.Evidence[0].Slashing)
+
+	res, err = server.ProposerSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{StartEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Evidence))
+	assert.DeepEqual(t, detected, res.Evidence[0].Slashing)
+
+	res, err = server.ProposerSlashingEvidence(ctx, &slashpb.SlashingEvidenceRequest{
+		Statuses: []slashpb.SlashingStatus{slashpb.SlashingStatus_INCLUDED},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Evidence))
+}
@@ -0,0 +1,102 @@
+/**
+ * Fork choice export
+ *
+ * Exports the fork choice tree of a beacon node, with the weights, checkpoints and best children of its
+ * nodes and the canonical chain highlighted, as JSON or as a Graphviz DOT graph. The beacon node must
+ * run with --enable-debug-rpc-endpoints.
+ *
+ * Example: forkchoice-export --endpoint 127.0.0.1:4000 --format dot --output tree.dot && dot -Tsvg tree.dot -o tree.svg
+ *
+ * A JSON snapshot dumped by a beacon node on a deep reorg (see --fork-choice-dump-reorg-depth) can be
+ * converted to a DOT graph offline: forkchoice-export --snapshot forkchoice-slot-100-1600000000.json
+ */
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var (
+	endpoint = flag.String("endpoint", "127.0.0.1:4000", "gRPC endpoint of the beacon node")
+	format   = flag.String("format", "dot", "Export format, json or dot")
+	snapshot = flag.String("snapshot", "", "Path to a JSON fork choice snapshot to convert to a DOT graph instead of querying a beacon node")
+	output   = flag.String("output", "", "Path of the output file, the export is written to stdout if empty")
+	timeout  = flag.Duration("timeout", 10*time.Second, "Timeout of the request to the beacon node")
+)
+
+var log = logrus.WithField("prefix", "forkchoice_export")
+
+func main() {
+	flag.Parse()
+
+	var data string
+	var err error
+	if *snapshot != "" {
+		data, err = convertSnapshot(*snapshot)
+	} else {
+		data, err = exportFromNode()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *output == "" {
+		if _, err := os.Stdout.WriteString(data); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if err := ioutil.WriteFile(*output, []byte(data), 0600); err != nil {
+		log.Fatalf("Could not write output file: %v", err)
+	}
+}
+
+func exportFromNode() (string, error) {
+	f, ok := pbrpc.ForkChoiceExportRequest_Format_value[strings.ToUpper(*format)]
+	if !ok {
+		return "", errors.Errorf("unknown export format %s", *format)
+	}
+	conn, err := grpc.Dial(*endpoint, grpc.WithInsecure())
+	if err != nil {
+		return "", errors.Wrap(err, "could not dial beacon node")
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection")
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	res, err := pbrpc.NewDebugClient(conn).GetForkChoiceExport(ctx, &pbrpc.ForkChoiceExportRequest{
+		Format: pbrpc.ForkChoiceExportRequest_Format(f),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "could not export fork choice tree")
+	}
+	return res.Data, nil
+}
+
+func convertSnapshot(path string) (string, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read snapshot")
+	}
+	tree := &protoarray.ExportedTree{}
+	if err := json.Unmarshal(enc, tree); err != nil {
+		return "", errors.Wrap(err, "could not decode snapshot")
+	}
+	return tree.DOT(), nil
+}
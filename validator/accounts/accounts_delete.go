@@ -102,7 +102,7 @@ func DeleteAccountCli(cliCtx *cli.Context) error {
 // DeleteAccount deletes the accounts that the user requests to be deleted from the wallet.
 func DeleteAccount(ctx context.Context, cfg *AccountsConfig) error {
 	switch cfg.Wallet.KeymanagerKind() {
-	case keymanager.Remote:
+	case keymanager.Remote, keymanager.Web3Signer:
 		return errors.New("cannot delete accounts for a remote keymanager")
 	case keymanager.Imported:
 		km, ok := cfg.Keymanager.(*imported.Keymanager)
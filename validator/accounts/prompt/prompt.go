@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -145,6 +146,38 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	url := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	root := cliCtx.String(flags.Web3SignerGenesisValidatorsRootFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if url == "" {
+		url, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as http://localhost:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if root == "" {
+		root, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Genesis validators root of the network (such as 0x04700007fabc...)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	newCfg := &web3signer.KeymanagerOpts{
+		BaseURL:               strings.TrimRight(url, "\r\n"),
+		GenesisValidatorsRoot: strings.TrimRight(root, "\r\n"),
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
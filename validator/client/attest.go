@@ -60,11 +60,37 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		return
 	}
 
+	var indexInCommittee uint64
+	var found bool
+	for i, vID := range duty.Committee {
+		if vID == duty.ValidatorIndex {
+			indexInCommittee = uint64(i)
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Errorf("Validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
+		if v.emitAccountMetrics {
+			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return
+	}
+
 	indexedAtt := &ethpb.IndexedAttestation{
 		AttestingIndices: []uint64{duty.ValidatorIndex},
 		Data:             data,
 	}
+	// Signing is locked until the slashing protection of the attestation is saved, so the
+	// signing of a key can be stopped with its slashing protection up to date.
+	v.signingLock.RLock()
+	if v.stoppedKeys[pubKey] {
+		v.signingLock.RUnlock()
+		log.Info("Signing was stopped for validating key, not attesting")
+		return
+	}
 	if err := v.preAttSignValidations(ctx, indexedAtt, pubKey); err != nil {
+		v.signingLock.RUnlock()
 		log.WithError(err).Error("Failed attestation slashing protection check")
 		log.WithFields(
 			attestationLogFields(pubKey, indexedAtt),
@@ -74,6 +100,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 
 	sig, signingRoot, err := v.signAtt(ctx, pubKey, data)
 	if err != nil {
+		v.signingLock.RUnlock()
 		log.WithError(err).Error("Could not sign attestation")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -81,23 +108,6 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		return
 	}
 
-	var indexInCommittee uint64
-	var found bool
-	for i, vID := range duty.Committee {
-		if vID == duty.ValidatorIndex {
-			indexInCommittee = uint64(i)
-			found = true
-			break
-		}
-	}
-	if !found {
-		log.Errorf("Validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
-		if v.emitAccountMetrics {
-			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
-		}
-		return
-	}
-
 	aggregationBitfield := bitfield.NewBitlist(uint64(len(duty.Committee)))
 	aggregationBitfield.SetBitAt(indexInCommittee, true)
 	attestation := &ethpb.Attestation{
@@ -108,6 +118,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 
 	indexedAtt.Signature = sig
 	if err := v.postAttSignUpdate(ctx, indexedAtt, pubKey, signingRoot); err != nil {
+		v.signingLock.RUnlock()
 		log.WithError(err).Error("Failed attestation slashing protection check")
 		log.WithFields(
 			attestationLogFields(pubKey, indexedAtt),
@@ -117,6 +128,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 	if err := v.SaveProtection(ctx, pubKey); err != nil {
 		log.WithError(err).Errorf("Could not save validator: %#x protection", pubKey)
 	}
+	v.signingLock.RUnlock()
 	attResp, err := v.validatorClient.ProposeAttestation(ctx, attestation)
 	if err != nil {
 		log.WithError(err).Error("Could not submit attestation to beacon node")
@@ -54,6 +54,17 @@ func (v *validator) preAttSignValidations(ctx context.Context, indexedAtt *ethpb
 	if err != nil {
 		return errors.Wrap(err, "could not check if attestation is slashable")
 	}
+	if !slashable && featureconfig.Get().MinimalSlashingProtection {
+		slashable, err = v.isNewAttSlashableMinimal(
+			ctx,
+			pubKey,
+			indexedAtt.Data.Source.Epoch,
+			indexedAtt.Data.Target.Epoch,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not check if attestation is slashable")
+		}
+	}
 	if slashable {
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -102,25 +113,39 @@ func (v *validator) postAttSignUpdate(ctx context.Context, indexedAtt *ethpb.Ind
 	if err != nil {
 		return errors.Wrap(err, "could not check if attestation is slashable")
 	}
+	if !slashable && featureconfig.Get().MinimalSlashingProtection {
+		slashable, err = v.isNewAttSlashableMinimal(
+			ctx,
+			pubKey,
+			indexedAtt.Data.Source.Epoch,
+			indexedAtt.Data.Target.Epoch,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not check if attestation is slashable")
+		}
+	}
 	if slashable {
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
 		return errors.New(failedAttLocalProtectionErr)
 	}
-	newHistory, err := kv.MarkAllAsAttestedSinceLatestWrittenEpoch(
-		ctx,
-		attesterHistory,
-		indexedAtt.Data.Target.Epoch,
-		&kv.HistoryData{
-			Source:      indexedAtt.Data.Source.Epoch,
-			SigningRoot: signingRoot[:],
-		},
-	)
-	if err != nil {
-		return errors.Wrapf(err, "could not mark epoch %d as attested", indexedAtt.Data.Target.Epoch)
+	// No attestation history is kept in minimal slashing protection mode.
+	if !featureconfig.Get().MinimalSlashingProtection {
+		newHistory, err := kv.MarkAllAsAttestedSinceLatestWrittenEpoch(
+			ctx,
+			attesterHistory,
+			indexedAtt.Data.Target.Epoch,
+			&kv.HistoryData{
+				Source:      indexedAtt.Data.Source.Epoch,
+				SigningRoot: signingRoot[:],
+			},
+		)
+		if err != nil {
+			return errors.Wrapf(err, "could not mark epoch %d as attested", indexedAtt.Data.Target.Epoch)
+		}
+		v.attesterHistoryByPubKey[pubKey] = newHistory
 	}
-	v.attesterHistoryByPubKey[pubKey] = newHistory
 
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		if !v.protector.CommitAttestation(ctx, indexedAtt) {
@@ -131,12 +156,43 @@ func (v *validator) postAttSignUpdate(ctx context.Context, indexedAtt *ethpb.Ind
 		}
 	}
 
-	// Save source and target epochs to satisfy EIP3076 requirements.
-	// The DB methods below will replace the lowest epoch in DB if necessary.
-	if err := v.db.SaveLowestSignedSourceEpoch(ctx, pubKey, indexedAtt.Data.Source.Epoch); err != nil {
-		return err
+	// Save source and target epochs to satisfy EIP3076 requirements, in a single transaction.
+	// The lowest epochs in DB are replaced if necessary, and the highest ones are saved in both
+	// slashing protection modes, so the minimal slashing protection mode can be enabled at any time.
+	return v.db.SaveSignedAttestationEpochs(ctx, pubKey, indexedAtt.Data.Source.Epoch, indexedAtt.Data.Target.Epoch)
+}
+
+// isNewAttSlashableMinimal uses the highest signed source and target epochs of a validator to
+// determine if an attestation of sourceEpoch and targetEpoch would be slashable, following the
+// minimal interpretation of EIP-3076: attestations with a source epoch lower than the highest
+// signed source epoch, or a target epoch not higher than the highest signed target epoch, are refused.
+func (v *validator) isNewAttSlashableMinimal(
+	ctx context.Context,
+	pubKey [48]byte,
+	sourceEpoch,
+	targetEpoch uint64,
+) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "isNewAttSlashableMinimal")
+	defer span.End()
+
+	highestSource, sourceExists, err := v.db.HighestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get highest signed source epoch")
+	}
+	highestTarget, targetExists, err := v.db.HighestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get highest signed target epoch")
 	}
-	return v.db.SaveLowestSignedTargetEpoch(ctx, pubKey, indexedAtt.Data.Target.Epoch)
+	if (sourceExists && sourceEpoch < highestSource) || (targetExists && targetEpoch <= highestTarget) {
+		log.WithFields(logrus.Fields{
+			"targetEpoch":              targetEpoch,
+			"sourceEpoch":              sourceEpoch,
+			"highestSignedTargetEpoch": highestTarget,
+			"highestSignedSourceEpoch": highestSource,
+		}).Warn("Attempted to submit an attestation lower than the highest signed epochs, but blocked by minimal slashing protection")
+		return true, nil
+	}
+	return false, nil
 }
 
 // isNewAttSlashable uses the attestation history to determine if an attestation of sourceEpoch
@@ -810,3 +810,49 @@ func Test_differenceOutsideWeakSubjectivityBounds(t *testing.T) {
 		})
 	}
 }
+
+func TestPostSignatureUpdate_MinimalSlashingProtection(t *testing.T) {
+	config := &featureconfig.Flags{
+		MinimalSlashingProtection: true,
+	}
+	reset := featureconfig.InitWithReset(config)
+	defer reset()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	ctx := context.Background()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	newAtt := func(source, target uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1, 2},
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+			},
+		}
+	}
+	sr := [32]byte{1}
+	require.NoError(t, validator.postAttSignUpdate(ctx, newAtt(4, 10), pubKey, sr))
+
+	// Only the highest signed epochs are saved, the attestation history is left untouched.
+	e, exists, err := validator.db.HighestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, uint64(4), e)
+	e, _, err = validator.db.HighestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), e)
+	require.DeepEqual(t, kv.NewAttestationHistoryArray(0), validator.attesterHistoryByPubKey[pubKey])
+
+	// Even the same attestation is refused, as its target is not higher than the highest signed target.
+	err = validator.postAttSignUpdate(ctx, newAtt(4, 10), pubKey, sr)
+	require.ErrorContains(t, failedAttLocalProtectionErr, err)
+	// A source lower than the highest signed source is refused.
+	err = validator.postAttSignUpdate(ctx, newAtt(3, 11), pubKey, sr)
+	require.ErrorContains(t, failedAttLocalProtectionErr, err)
+	require.NoError(t, validator.postAttSignUpdate(ctx, newAtt(4, 11), pubKey, sr))
+	e, _, err = validator.db.HighestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), e)
+}
@@ -216,6 +216,55 @@ func TestAttestToBlockHead_BlocksDoubleAtt(t *testing.T) {
 	require.LogsContain(t, hook, failedAttLocalProtectionErr)
 }
 
+func TestAttestToBlockHead_StoppedKeyDoesNotAttest(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	validatorIndex := uint64(7)
+	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			CommitteeIndex: 5,
+			Committee:      committee,
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+	m.validatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Times(2).Return(&ethpb.AttestationData{
+		BeaconBlockRoot: make([]byte, 32),
+		Target:          &ethpb.Checkpoint{Root: make([]byte, 32), Epoch: 4},
+		Source:          &ethpb.Checkpoint{Root: make([]byte, 32), Epoch: 3},
+	}, nil)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Times(2).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().ProposeAttestation(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.Attestation{}),
+	).Return(&ethpb.AttestResponse{AttestationDataRoot: make([]byte, 32)}, nil /* error */)
+
+	validator.SubmitAttestation(context.Background(), 30, pubKey)
+	require.NoError(t, validator.StopSigning(context.Background(), [][48]byte{pubKey}))
+	validator.SubmitAttestation(context.Background(), 31, pubKey)
+	require.LogsContain(t, hook, "Signing was stopped for validating key")
+
+	// The history of the attestation signed before signing was stopped is in the database.
+	history, err := validator.db.AttestationHistoryForPubKeysV2(context.Background(), [][48]byte{pubKey})
+	require.NoError(t, err)
+	targetData, err := history[pubKey].GetTargetData(context.Background(), 4)
+	require.NoError(t, err)
+	require.Equal(t, false, targetData.IsEmpty())
+
+	validator.ResumeSigning([][48]byte{pubKey})
+	require.Equal(t, false, validator.stoppedKeys[pubKey])
+}
+
 func TestAttestToBlockHead_BlocksSurroundAtt(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, validatorKey, finish := setup(t)
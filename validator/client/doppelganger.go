@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// doppelgangerEpochs is the number of full epochs the chain is watched for activity of
+// our validating keys before they are allowed to perform their duties.
+const doppelgangerEpochs = 2
+
+// addedKey tracks the doppelganger detection of a validating key added after startup.
+type addedKey struct {
+	// startEpoch is the epoch the key was first seen in, which is not checked.
+	startEpoch uint64
+	// checkedEpochs is the number of epochs the key was found not to be live in.
+	checkedEpochs uint64
+}
+
+// DetectDoppelgangers watches the chain for attestations or blocks of our validating keys
+// for a few epochs, before any of them is used to sign. Keys which are found to be live
+// are being used by another validator client, so they are excluded from performing any
+// duty to avoid getting slashed.
+//
+// The epoch the validator client starts in is skipped, as a previous run of this same
+// validator client may have legitimately performed duties in it. Every following epoch
+// is checked at its last slot, once its attestations have had the time to be included,
+// while it is still the previous epoch of the beacon node. The keys added while or after
+// detecting doppelgangers at startup are checked separately before performing any duty.
+func (v *validator) DetectDoppelgangers(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.DetectDoppelgangers")
+	defer span.End()
+
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	var startEpoch uint64
+	started := false
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.New("context canceled")
+		case slot := <-v.NextSlot():
+			epoch := helpers.SlotToEpoch(slot)
+			if !started {
+				startEpoch = epoch
+				started = true
+				log.WithField("epoch", startEpoch).Infof(
+					"Waiting for %d epochs to detect whether our validating keys are used by another validator client",
+					doppelgangerEpochs,
+				)
+			}
+			if !helpers.IsEpochEnd(slot) || epoch == 0 || epoch-1 <= startEpoch {
+				continue
+			}
+			if err := v.checkDoppelgangers(ctx, epoch-1, validatingKeys); err != nil {
+				return err
+			}
+			if epoch-1 == startEpoch+doppelgangerEpochs {
+				v.checkedKeys = make(map[[48]byte]bool, len(validatingKeys))
+				for _, key := range validatingKeys {
+					v.checkedKeys[key] = true
+				}
+				log.Info("Doppelganger detection finished, starting to perform duties")
+				return nil
+			}
+		}
+	}
+}
+
+// CheckDoppelgangersOfAddedKeys checks the validating keys added after the startup detection
+// of doppelgangers at the last slot of every epoch, the same way DetectDoppelgangers does.
+// An added key does not perform any duty until it was found not to be live for a few epochs.
+func (v *validator) CheckDoppelgangersOfAddedKeys(ctx context.Context, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelgangersOfAddedKeys")
+	defer span.End()
+
+	epoch := helpers.SlotToEpoch(slot)
+	if len(v.addedKeys) == 0 || !helpers.IsEpochEnd(slot) || epoch == 0 {
+		return nil
+	}
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	current := make(map[[48]byte]bool, len(validatingKeys))
+	for _, key := range validatingKeys {
+		current[key] = true
+	}
+	var keys [][48]byte
+	for key, added := range v.addedKeys {
+		// The key was removed before its detection finished.
+		if !current[key] {
+			delete(v.addedKeys, key)
+			continue
+		}
+		if epoch-1 > added.startEpoch {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := v.checkDoppelgangers(ctx, epoch-1, keys); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if v.doppelgangerKeys[key] {
+			delete(v.addedKeys, key)
+			continue
+		}
+		added := v.addedKeys[key]
+		added.checkedEpochs++
+		if added.checkedEpochs < doppelgangerEpochs {
+			continue
+		}
+		delete(v.addedKeys, key)
+		v.checkedKeys[key] = true
+		log.WithField("publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(key[:]))).Info(
+			"Doppelganger detection finished for added validating key, starting to perform its duties")
+	}
+	return nil
+}
+
+// checkDoppelgangers queries the beacon node for the liveness of the given validating keys in
+// the given epoch, and flags the live ones as doppelgangers.
+func (v *validator) checkDoppelgangers(ctx context.Context, epoch uint64, validatingKeys [][48]byte) error {
+	res, err := v.livenessClient.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{
+		Epoch:      epoch,
+		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not get the liveness of validators in epoch %d", epoch)
+	}
+	for _, liveness := range res.Liveness {
+		if !liveness.IsLive {
+			continue
+		}
+		pubKey := bytesutil.ToBytes48(liveness.PublicKey)
+		if v.doppelgangerKeys == nil {
+			v.doppelgangerKeys = make(map[[48]byte]bool)
+		}
+		v.doppelgangerKeys[pubKey] = true
+		fmtKey := fmt.Sprintf("%#x", liveness.PublicKey)
+		ValidatorDoppelgangerGaugeVec.WithLabelValues(fmtKey).Set(1)
+		log.WithFields(logrus.Fields{
+			"publicKey":      fmtKey,
+			"validatorIndex": liveness.Index,
+			"epoch":          epoch,
+		}).Error("Doppelganger detected! This validating key is used by another validator client, " +
+			"it will not perform any duty. Stop the other validator client before restarting this one")
+	}
+	return nil
+}
+
+// filterDoppelgangers removes the keys detected as doppelgangers from the validating keys, as
+// well as the keys added after the startup detection of doppelgangers which were not checked
+// yet. The added keys seen for the first time start being checked from the given epoch.
+func (v *validator) filterDoppelgangers(validatingKeys [][48]byte, epoch uint64) [][48]byte {
+	if len(v.doppelgangerKeys) == 0 && v.checkedKeys == nil {
+		return validatingKeys
+	}
+	filtered := make([][48]byte, 0, len(validatingKeys))
+	for _, key := range validatingKeys {
+		if v.doppelgangerKeys[key] {
+			continue
+		}
+		if v.checkedKeys != nil && !v.checkedKeys[key] {
+			if _, ok := v.addedKeys[key]; !ok {
+				if v.addedKeys == nil {
+					v.addedKeys = make(map[[48]byte]*addedKey)
+				}
+				v.addedKeys[key] = &addedKey{startEpoch: epoch}
+				log.WithField("publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(key[:]))).Infof(
+					"Validating key added, waiting for %d epochs to detect whether it is used by another validator client",
+					doppelgangerEpochs,
+				)
+			}
+			continue
+		}
+		filtered = append(filtered, key)
+	}
+	return filtered
+}
@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+)
+
+func TestCheckDoppelgangers_ExcludesLiveKeysFromDuties(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	livenessClient := mock.NewMockLivenessClient(ctrl)
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	km := genMockKeymanger(3)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		keyManager:      km,
+		livenessClient:  livenessClient,
+		validatorClient: validatorClient,
+	}
+
+	doppelganger := keys[1]
+	livenessClient.EXPECT().GetValidatorLiveness(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *pbrpc.ValidatorLivenessRequest, _ ...grpc.CallOption) (*pbrpc.ValidatorLivenessResponse, error) {
+		assert.Equal(t, uint64(3), req.Epoch)
+		require.Equal(t, len(keys), len(req.PublicKeys))
+		res := &pbrpc.ValidatorLivenessResponse{Epoch: req.Epoch}
+		for i, pubKey := range req.PublicKeys {
+			res.Liveness = append(res.Liveness, &pbrpc.ValidatorLivenessResponse_ValidatorLiveness{
+				PublicKey: pubKey,
+				Index:     uint64(i),
+				IsLive:    string(pubKey) == string(doppelganger[:]),
+			})
+		}
+		return res, nil
+	})
+	require.NoError(t, v.checkDoppelgangers(context.Background(), 3, keys))
+	require.LogsContain(t, hook, "Doppelganger detected!")
+	assert.Equal(t, 1, len(v.doppelgangerKeys))
+	assert.Equal(t, true, v.doppelgangerKeys[doppelganger])
+
+	// The duties of the doppelganger are not requested, neither for this epoch nor the next one.
+	validatorClient.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+		require.Equal(t, len(keys)-1, len(req.PublicKeys))
+		for _, pubKey := range req.PublicKeys {
+			assert.NotEqual(t, string(doppelganger[:]), string(pubKey))
+		}
+		return &ethpb.DutiesResponse{}, nil
+	}).Times(2)
+	validatorClient.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil)
+	require.NoError(t, v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch))
+}
+
+func TestCheckDoppelgangers_NoneLive(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	livenessClient := mock.NewMockLivenessClient(ctrl)
+	km := genMockKeymanger(2)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		keyManager:     km,
+		livenessClient: livenessClient,
+	}
+	livenessClient.EXPECT().GetValidatorLiveness(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&pbrpc.ValidatorLivenessResponse{
+		Liveness: []*pbrpc.ValidatorLivenessResponse_ValidatorLiveness{{IsLive: false}, {IsLive: false}},
+	}, nil)
+	require.NoError(t, v.checkDoppelgangers(context.Background(), 1, keys))
+	require.LogsDoNotContain(t, hook, "Doppelganger detected!")
+	assert.Equal(t, 0, len(v.doppelgangerKeys))
+}
+
+func TestCheckDoppelgangers_LivenessError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	livenessClient := mock.NewMockLivenessClient(ctrl)
+	km := genMockKeymanger(1)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	v := validator{
+		keyManager:     km,
+		livenessClient: livenessClient,
+	}
+	livenessClient.EXPECT().GetValidatorLiveness(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, errors.New("bad"))
+	assert.ErrorContains(t, "could not get the liveness of validators in epoch 1", v.checkDoppelgangers(context.Background(), 1, keys))
+}
+
+func TestCheckDoppelgangersOfAddedKeys(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	livenessClient := mock.NewMockLivenessClient(ctrl)
+	km := genMockKeymanger(3)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	// The first key was checked at startup, the other ones were added afterwards.
+	v := validator{
+		keyManager:     km,
+		livenessClient: livenessClient,
+		checkedKeys:    map[[48]byte]bool{keys[0]: true},
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	doppelganger := keys[2]
+
+	// The added keys do not perform duties, and are checked from the epoch they are seen in.
+	assert.DeepEqual(t, [][48]byte{keys[0]}, v.filterDoppelgangers(keys, 5))
+	require.Equal(t, 2, len(v.addedKeys))
+
+	// Nothing is checked before the last slot of an epoch, nor in the epoch the keys were added in.
+	require.NoError(t, v.CheckDoppelgangersOfAddedKeys(ctx, 8*slotsPerEpoch-2))
+	require.NoError(t, v.CheckDoppelgangersOfAddedKeys(ctx, 7*slotsPerEpoch-1))
+
+	var checkedEpochs []uint64
+	livenessClient.EXPECT().GetValidatorLiveness(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *pbrpc.ValidatorLivenessRequest, _ ...grpc.CallOption) (*pbrpc.ValidatorLivenessResponse, error) {
+		checkedEpochs = append(checkedEpochs, req.Epoch)
+		require.Equal(t, 2, len(req.PublicKeys))
+		res := &pbrpc.ValidatorLivenessResponse{Epoch: req.Epoch}
+		for _, pubKey := range req.PublicKeys {
+			res.Liveness = append(res.Liveness, &pbrpc.ValidatorLivenessResponse_ValidatorLiveness{
+				PublicKey: pubKey,
+				// The doppelganger only becomes live in the second checked epoch.
+				IsLive: string(pubKey) == string(doppelganger[:]) && req.Epoch == 7,
+			})
+		}
+		return res, nil
+	}).Times(2)
+	require.NoError(t, v.CheckDoppelgangersOfAddedKeys(ctx, 8*slotsPerEpoch-1))
+	assert.DeepEqual(t, [][48]byte{keys[0]}, v.filterDoppelgangers(keys, 8))
+	require.NoError(t, v.CheckDoppelgangersOfAddedKeys(ctx, 9*slotsPerEpoch-1))
+	assert.DeepEqual(t, []uint64{6, 7}, checkedEpochs)
+
+	// The key which was not live in both epochs performs its duties, the doppelganger never does.
+	assert.DeepEqual(t, [][48]byte{keys[0], keys[1]}, v.filterDoppelgangers(keys, 9))
+	assert.Equal(t, 0, len(v.addedKeys))
+	assert.Equal(t, true, v.doppelgangerKeys[doppelganger])
+}
+
+func TestCheckDoppelgangersOfAddedKeys_RemovedKey(t *testing.T) {
+	ctx := context.Background()
+	km := genMockKeymanger(1)
+	v := validator{
+		keyManager:  km,
+		checkedKeys: make(map[[48]byte]bool),
+		addedKeys:   map[[48]byte]*addedKey{{'a'}: {startEpoch: 1}},
+	}
+	// The liveness of a key removed before its detection finished is not requested.
+	require.NoError(t, v.CheckDoppelgangersOfAddedKeys(ctx, 4*params.BeaconConfig().SlotsPerEpoch-1))
+	assert.Equal(t, 0, len(v.addedKeys))
+}
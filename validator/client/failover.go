@@ -0,0 +1,290 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+)
+
+const (
+	// maxHeadSlotLag is the number of slots a beacon node head may be behind the best head slot
+	// of the configured beacon nodes before the node is considered unhealthy.
+	maxHeadSlotLag = 4
+	// nodeHealthCheckTimeout bounds the time spent querying the health of a beacon node.
+	nodeHealthCheckTimeout = 2 * time.Second
+)
+
+// BeaconNodeHealth is the last known health of one of the beacon nodes the validator client
+// is configured with.
+type BeaconNodeHealth struct {
+	Endpoint string
+	Healthy  bool
+	Syncing  bool
+	HeadSlot uint64
+	Peers    int
+	Err      error
+}
+
+type beaconNode struct {
+	endpoint     string
+	conn         *grpc.ClientConn
+	nodeClient   ethpb.NodeClient
+	beaconClient ethpb.BeaconChainClient
+}
+
+// beaconNodeFailover watches the sync status, head slot and peer count of each of the beacon
+// nodes configured in the endpoint, and routes the validator client connection to a healthy one.
+// It replaces the multiple endpoints resolver of the validator client connection so that, with the
+// default pick_first load balancer, every request goes to the active beacon node.
+type beaconNodeFailover struct {
+	nodes     []*beaconNode
+	interval  time.Duration
+	lock      sync.RWMutex
+	health    []*BeaconNodeHealth
+	active    int
+	resolvers map[*failoverResolver]bool
+	// updateLock serializes the resolver updates, which are done without holding lock as the
+	// client connection may call back into the resolver while updating its state.
+	updateLock sync.Mutex
+}
+
+func newBeaconNodeFailover(ctx context.Context, endpoint string, dialOpts []grpc.DialOption) (*beaconNodeFailover, error) {
+	endpoints := strings.Split(endpoint, ",")
+	nodes := make([]*beaconNode, len(endpoints))
+	for i, e := range endpoints {
+		conn, err := grpc.DialContext(ctx, e, dialOpts...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not dial endpoint %s", e)
+		}
+		nodes[i] = &beaconNode{
+			endpoint:     e,
+			conn:         conn,
+			nodeClient:   ethpb.NewNodeClient(conn),
+			beaconClient: ethpb.NewBeaconChainClient(conn),
+		}
+	}
+	return newBeaconNodeFailoverFromNodes(nodes), nil
+}
+
+func newBeaconNodeFailoverFromNodes(nodes []*beaconNode) *beaconNodeFailover {
+	health := make([]*BeaconNodeHealth, len(nodes))
+	for i, n := range nodes {
+		health[i] = &BeaconNodeHealth{Endpoint: n.endpoint}
+	}
+	return &beaconNodeFailover{
+		nodes:     nodes,
+		interval:  time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+		health:    health,
+		resolvers: make(map[*failoverResolver]bool),
+	}
+}
+
+// run checks the health of the beacon nodes once per interval until the context is done.
+func (f *beaconNodeFailover) run(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+	for {
+		f.check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check queries the health of every beacon node, then fails over to a healthy beacon node if
+// the active one is not healthy anymore.
+func (f *beaconNodeFailover) check(ctx context.Context) {
+	if f.updateHealth(ctx) {
+		f.updateResolvers()
+	}
+}
+
+// updateHealth refreshes the health of the beacon nodes and returns whether the active beacon
+// node changed.
+func (f *beaconNodeFailover) updateHealth(ctx context.Context) bool {
+	health := make([]*BeaconNodeHealth, len(f.nodes))
+	var wg sync.WaitGroup
+	for i, n := range f.nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			health[i] = n.health(ctx)
+		}(i, n)
+	}
+	wg.Wait()
+
+	var bestHead uint64
+	for _, h := range health {
+		if h.Err == nil && h.HeadSlot > bestHead {
+			bestHead = h.HeadSlot
+		}
+	}
+	for _, h := range health {
+		h.Healthy = h.Err == nil && !h.Syncing && h.Peers > 0 && h.HeadSlot+maxHeadSlotLag >= bestHead
+		healthy := float64(0)
+		if h.Healthy {
+			healthy = 1
+		}
+		BeaconNodeHealthyGaugeVec.WithLabelValues(h.Endpoint).Set(healthy)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.health = health
+	// The active beacon node is kept as long as it is healthy, to avoid switching back and forth
+	// between beacon nodes with close head slots.
+	if health[f.active].Healthy {
+		return false
+	}
+	best := -1
+	for i, h := range health {
+		if !h.Healthy {
+			continue
+		}
+		if best == -1 || h.HeadSlot > health[best].HeadSlot ||
+			(h.HeadSlot == health[best].HeadSlot && h.Peers > health[best].Peers) {
+			best = i
+		}
+	}
+	if best == -1 {
+		log.WithField("endpoint", health[f.active].Endpoint).Warn("No healthy beacon node to fail over to")
+		return false
+	}
+	log.WithFields(logrus.Fields{
+		"from":     health[f.active].Endpoint,
+		"to":       health[best].Endpoint,
+		"headSlot": health[best].HeadSlot,
+		"peers":    health[best].Peers,
+	}).Warn("Beacon node is unhealthy, failing over to another beacon node")
+	BeaconNodeFailoverCount.Inc()
+	f.setActive(best)
+	return true
+}
+
+// setActive marks the beacon node at the given index as the active one. The lock must be held
+// by the caller, and the resolvers updated once it is released.
+func (f *beaconNodeFailover) setActive(i int) {
+	BeaconNodeActiveGaugeVec.WithLabelValues(f.nodes[f.active].endpoint).Set(0)
+	BeaconNodeActiveGaugeVec.WithLabelValues(f.nodes[i].endpoint).Set(1)
+	f.active = i
+}
+
+// updateResolvers routes the validator client connections to the active beacon node. The lock
+// must not be held by the caller.
+func (f *beaconNodeFailover) updateResolvers() {
+	f.updateLock.Lock()
+	defer f.updateLock.Unlock()
+	f.lock.RLock()
+	endpoint := f.nodes[f.active].endpoint
+	resolvers := make([]*failoverResolver, 0, len(f.resolvers))
+	for r := range f.resolvers {
+		resolvers = append(resolvers, r)
+	}
+	f.lock.RUnlock()
+	for _, r := range resolvers {
+		r.update(endpoint)
+	}
+}
+
+// Active returns the endpoint of the beacon node the validator client talks to.
+func (f *beaconNodeFailover) Active() string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.nodes[f.active].endpoint
+}
+
+// Health returns the last known health of the beacon nodes.
+func (f *beaconNodeFailover) Health() []*BeaconNodeHealth {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	health := make([]*BeaconNodeHealth, len(f.health))
+	for i, h := range f.health {
+		hCopy := *h
+		health[i] = &hCopy
+	}
+	return health
+}
+
+func (f *beaconNodeFailover) close() {
+	for _, n := range f.nodes {
+		if n.conn == nil {
+			continue
+		}
+		if err := n.conn.Close(); err != nil {
+			log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not close connection")
+		}
+	}
+}
+
+// Build the resolver of the validator client connection, which resolves the endpoint to the
+// address of the active beacon node.
+func (f *beaconNodeFailover) Build(_ resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	f.updateLock.Lock()
+	defer f.updateLock.Unlock()
+	f.lock.Lock()
+	r := &failoverResolver{failover: f, cc: cc}
+	f.resolvers[r] = true
+	endpoint := f.nodes[f.active].endpoint
+	BeaconNodeActiveGaugeVec.WithLabelValues(endpoint).Set(1)
+	f.lock.Unlock()
+	r.update(endpoint)
+	return r, nil
+}
+
+// Scheme of the resolver, the default one as the endpoint is a list of addresses without scheme.
+func (*beaconNodeFailover) Scheme() string {
+	return resolver.GetDefaultScheme()
+}
+
+type failoverResolver struct {
+	failover *beaconNodeFailover
+	cc       resolver.ClientConn
+}
+
+func (r *failoverResolver) update(endpoint string) {
+	r.cc.UpdateState(resolver.State{Addresses: []resolver.Address{{Addr: endpoint}}})
+}
+
+func (*failoverResolver) ResolveNow(_ resolver.ResolveNowOptions) {}
+
+func (r *failoverResolver) Close() {
+	r.failover.lock.Lock()
+	defer r.failover.lock.Unlock()
+	delete(r.failover.resolvers, r)
+}
+
+func (n *beaconNode) health(ctx context.Context) *BeaconNodeHealth {
+	ctx, cancel := context.WithTimeout(ctx, nodeHealthCheckTimeout)
+	defer cancel()
+	h := &BeaconNodeHealth{Endpoint: n.endpoint}
+	syncStatus, err := n.nodeClient.GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not get sync status")
+		return h
+	}
+	h.Syncing = syncStatus.Syncing
+	head, err := n.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not get chain head")
+		return h
+	}
+	h.HeadSlot = head.HeadSlot
+	peers, err := n.nodeClient.ListPeers(ctx, &ptypes.Empty{})
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not list peers")
+		return h
+	}
+	h.Peers = len(peers.Peers)
+	return h
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/resolver"
+)
+
+type fakeBeaconNode struct {
+	ethpb.NodeClient
+	ethpb.BeaconChainClient
+	syncing  bool
+	headSlot uint64
+	peers    int
+	err      error
+}
+
+func (f *fakeBeaconNode) GetSyncStatus(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ethpb.SyncStatus{Syncing: f.syncing}, nil
+}
+
+func (f *fakeBeaconNode) GetChainHead(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: f.headSlot}, nil
+}
+
+func (f *fakeBeaconNode) ListPeers(_ context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*ethpb.Peers, error) {
+	return &ethpb.Peers{Peers: make([]*ethpb.Peer, f.peers)}, nil
+}
+
+type fakeClientConn struct {
+	resolver.ClientConn
+	addrs []resolver.Address
+}
+
+func (f *fakeClientConn) UpdateState(s resolver.State) {
+	f.addrs = s.Addresses
+}
+
+func testFailover(nodes ...*fakeBeaconNode) *beaconNodeFailover {
+	beaconNodes := make([]*beaconNode, len(nodes))
+	for i, n := range nodes {
+		beaconNodes[i] = &beaconNode{
+			endpoint:     fmt.Sprintf("127.0.0.1:%d", 4000+i),
+			nodeClient:   n,
+			beaconClient: n,
+		}
+	}
+	return newBeaconNodeFailoverFromNodes(beaconNodes)
+}
+
+func TestBeaconNodeFailover_Check(t *testing.T) {
+	ctx := context.Background()
+	first := &fakeBeaconNode{headSlot: 100, peers: 10}
+	second := &fakeBeaconNode{headSlot: 100, peers: 5}
+	third := &fakeBeaconNode{headSlot: 101, peers: 1}
+	f := testFailover(first, second, third)
+	cc := &fakeClientConn{}
+	_, err := f.Build(resolver.Target{}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []resolver.Address{{Addr: "127.0.0.1:4000"}}, cc.addrs)
+
+	f.check(ctx)
+	assert.Equal(t, "127.0.0.1:4000", f.Active())
+	for _, h := range f.Health() {
+		assert.Equal(t, true, h.Healthy, "Beacon node %s is not healthy", h.Endpoint)
+	}
+
+	// The active beacon node falls behind, the healthy node with the best head is picked.
+	first.headSlot = 90
+	f.check(ctx)
+	assert.Equal(t, "127.0.0.1:4002", f.Active())
+	assert.DeepEqual(t, []resolver.Address{{Addr: "127.0.0.1:4002"}}, cc.addrs)
+	assert.Equal(t, false, f.Health()[0].Healthy)
+
+	// The active beacon node is kept while it is healthy.
+	first.headSlot = 101
+	f.check(ctx)
+	assert.Equal(t, "127.0.0.1:4002", f.Active())
+
+	// The active beacon node loses its peers.
+	third.peers = 0
+	f.check(ctx)
+	assert.Equal(t, "127.0.0.1:4000", f.Active())
+	assert.DeepEqual(t, []resolver.Address{{Addr: "127.0.0.1:4000"}}, cc.addrs)
+}
+
+func TestBeaconNodeFailover_Check_Unhealthy(t *testing.T) {
+	ctx := context.Background()
+	first := &fakeBeaconNode{headSlot: 100, peers: 10}
+	second := &fakeBeaconNode{headSlot: 100, peers: 10, syncing: true}
+	third := &fakeBeaconNode{err: errors.New("connection refused")}
+	f := testFailover(first, second, third)
+	cc := &fakeClientConn{}
+	_, err := f.Build(resolver.Target{}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+
+	f.check(ctx)
+	health := f.Health()
+	assert.Equal(t, true, health[0].Healthy)
+	assert.Equal(t, false, health[1].Healthy)
+	assert.Equal(t, true, health[1].Syncing)
+	assert.Equal(t, false, health[2].Healthy)
+	assert.ErrorContains(t, "connection refused", health[2].Err)
+
+	// No beacon node is healthy, the active one is kept.
+	first.syncing = true
+	f.check(ctx)
+	assert.Equal(t, "127.0.0.1:4000", f.Active())
+	assert.DeepEqual(t, []resolver.Address{{Addr: "127.0.0.1:4000"}}, cc.addrs)
+
+	// The syncing beacon node catches up.
+	second.syncing = false
+	f.check(ctx)
+	assert.Equal(t, "127.0.0.1:4001", f.Active())
+	assert.DeepEqual(t, []resolver.Address{{Addr: "127.0.0.1:4001"}}, cc.addrs)
+}
+
+// reentrantClientConn reads the failover state while the client connection state is updated,
+// as a gRPC client connection does when it closes or rebuilds its resolver.
+type reentrantClientConn struct {
+	resolver.ClientConn
+	failover *beaconNodeFailover
+	active   string
+}
+
+func (c *reentrantClientConn) UpdateState(_ resolver.State) {
+	c.active = c.failover.Active()
+}
+
+func TestBeaconNodeFailover_UpdatesResolversWithoutLock(t *testing.T) {
+	ctx := context.Background()
+	first := &fakeBeaconNode{headSlot: 100, peers: 10}
+	second := &fakeBeaconNode{headSlot: 100, peers: 10}
+	f := testFailover(first, second)
+	cc := &reentrantClientConn{failover: f}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := f.Build(resolver.Target{}, cc, resolver.BuildOptions{})
+		require.NoError(t, err)
+		first.syncing = true
+		f.check(ctx)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Resolver update deadlocked on the failover lock")
+	}
+	assert.Equal(t, "127.0.0.1:4001", cc.active)
+}
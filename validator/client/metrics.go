@@ -37,6 +37,17 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorDoppelgangerGaugeVec used to flag the validating keys detected as used by another validator client.
+	ValidatorDoppelgangerGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "doppelganger_detected",
+			Help:      "1 if the validating key was detected as used by another validator client",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
 	// ValidatorAggSuccessVec used to count successful aggregations.
 	ValidatorAggSuccessVec = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -183,6 +194,36 @@ var (
 			"pubkey",
 		},
 	)
+	// BeaconNodeHealthyGaugeVec used to track the health of the beacon nodes by endpoint.
+	BeaconNodeHealthyGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "1 if the beacon node is synced, has peers and is close to the best head slot",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BeaconNodeActiveGaugeVec used to flag the beacon node the validator client talks to.
+	BeaconNodeActiveGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_active",
+			Help:      "1 if the beacon node is the one duties are fetched from and submitted to",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	// BeaconNodeFailoverCount used to count the switches from an unhealthy beacon node to a healthy one.
+	BeaconNodeFailoverCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failovers_total",
+			Help:      "The number of times the validator client switched to another beacon node",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
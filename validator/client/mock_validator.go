@@ -5,6 +5,7 @@ import (
 	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
 )
 
@@ -41,6 +42,14 @@ type FakeValidator struct {
 	IndexToPubkeyMap                  map[uint64][48]byte
 	PubkeyToIndexMap                  map[[48]byte]uint64
 	PubkeysToStatusesMap              map[[48]byte]ethpb.ValidatorStatus
+	AccountsChangedFeed               event.Feed
+	RemoveDutiesOfRemovedKeysCalled   bool
+	RemoveDutiesOfRemovedKeysArg1     [][48]byte
+	DetectDoppelgangersCalled         bool
+	DetectDoppelgangersRet            error
+	CheckDoppelgangersOfAddedKeysArg1 uint64
+	StopSigningArg1                   [][48]byte
+	ResumeSigningArg1                 [][48]byte
 }
 
 type ctxKey string
@@ -159,6 +168,11 @@ func (fv *FakeValidator) LogNextDutyTimeLeft(slot uint64) error {
 // UpdateDomainDataCaches for mocking.
 func (fv *FakeValidator) UpdateDomainDataCaches(context.Context, uint64) {}
 
+// PruneSlashingProtectionHistory for mocking.
+func (fv *FakeValidator) PruneSlashingProtectionHistory(context.Context, uint64) error {
+	return nil
+}
+
 // BalancesByPubkeys for mocking.
 func (fv *FakeValidator) BalancesByPubkeys(_ context.Context) map[[48]byte]uint64 {
 	return fv.Balances
@@ -186,3 +200,37 @@ func (fv *FakeValidator) AllValidatorsAreExited(ctx context.Context) (bool, erro
 	}
 	return ctx.Value(allValidatorsAreExitedCtxKey).(bool), nil
 }
+
+// SubscribeAccountChanges for mocking.
+func (fv *FakeValidator) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return fv.AccountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// RemoveDutiesOfRemovedKeys for mocking.
+func (fv *FakeValidator) RemoveDutiesOfRemovedKeys(validatingKeys [][48]byte) {
+	fv.RemoveDutiesOfRemovedKeysCalled = true
+	fv.RemoveDutiesOfRemovedKeysArg1 = validatingKeys
+}
+
+// StopSigning for mocking.
+func (fv *FakeValidator) StopSigning(_ context.Context, pubKeys [][48]byte) error {
+	fv.StopSigningArg1 = pubKeys
+	return nil
+}
+
+// ResumeSigning for mocking.
+func (fv *FakeValidator) ResumeSigning(pubKeys [][48]byte) {
+	fv.ResumeSigningArg1 = pubKeys
+}
+
+// DetectDoppelgangers for mocking.
+func (fv *FakeValidator) DetectDoppelgangers(_ context.Context) error {
+	fv.DetectDoppelgangersCalled = true
+	return fv.DetectDoppelgangersRet
+}
+
+// CheckDoppelgangersOfAddedKeys for mocking.
+func (fv *FakeValidator) CheckDoppelgangersOfAddedKeys(_ context.Context, slot uint64) error {
+	fv.CheckDoppelgangersOfAddedKeysArg1 = slot
+	return nil
+}
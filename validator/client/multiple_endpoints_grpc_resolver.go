@@ -12,6 +12,7 @@ import (
 // It can be used with any grpc load balancer (pick_first, round_robin). Default is pick_first.
 // Round robin can be used by adding the following option:
 // grpc.WithDefaultServiceConfig("{\"loadBalancingConfig\":[{\"round_robin\":{}}]}")
+// The validator client connection uses the health aware beaconNodeFailover resolver instead.
 type multipleEndpointsGrpcResolverBuilder struct{}
 
 func (*multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
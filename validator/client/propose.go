@@ -76,7 +76,16 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		return
 	}
 
+	// Signing is locked until the slashing protection of the block is saved, so the
+	// signing of a key can be stopped with its slashing protection up to date.
+	v.signingLock.RLock()
+	if v.stoppedKeys[pubKey] {
+		v.signingLock.RUnlock()
+		log.Info("Signing was stopped for validating key, not proposing")
+		return
+	}
 	if err := v.preBlockSignValidations(ctx, pubKey, b); err != nil {
+		v.signingLock.RUnlock()
 		log.WithFields(
 			blockLogFields(pubKey, b, nil),
 		).WithError(err).Error("Failed block slashing protection check")
@@ -86,6 +95,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	// Sign returned block from beacon node
 	sig, domain, err := v.signBlock(ctx, pubKey, epoch, b)
 	if err != nil {
+		v.signingLock.RUnlock()
 		log.WithError(err).Error("Failed to sign block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
@@ -98,11 +108,13 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	}
 
 	if err := v.postBlockSignUpdate(ctx, pubKey, blk, domain); err != nil {
+		v.signingLock.RUnlock()
 		log.WithFields(
 			blockLogFields(pubKey, b, sig),
 		).WithError(err).Error("Failed block slashing protection check")
 		return
 	}
+	v.signingLock.RUnlock()
 
 	// Propose and broadcast block via beacon node
 	blkResp, err := v.validatorClient.ProposeBlock(ctx, blk)
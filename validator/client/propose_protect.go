@@ -34,6 +34,23 @@ func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte
 		}
 		return errors.New(failedPreBlockSignLocalErr)
 	}
+	// In minimal slashing protection mode, only blocks with a slot higher than the
+	// highest signed proposal slot are considered safe.
+	if featureconfig.Get().MinimalSlashingProtection {
+		highestSignedSlot, err := v.db.HighestSignedProposal(ctx, pubKey)
+		if err != nil {
+			if v.emitAccountMetrics {
+				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.Wrap(err, "failed to get highest signed proposal")
+		}
+		if block.Slot <= highestSignedSlot {
+			if v.emitAccountMetrics {
+				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.New(failedPreBlockSignLocalErr)
+		}
+	}
 
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		blockHdr, err := blockutil.BeaconBlockHeaderFromBlock(block)
@@ -76,6 +93,15 @@ func (v *validator) postBlockSignUpdate(ctx context.Context, pubKey [48]byte, bl
 		}
 		return errors.Wrap(err, "failed to compute signing root for block")
 	}
+	if featureconfig.Get().MinimalSlashingProtection {
+		if err := v.db.SaveHighestSignedProposal(ctx, pubKey, block.Block.Slot); err != nil {
+			if v.emitAccountMetrics {
+				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.Wrap(err, "failed to save highest signed proposal")
+		}
+		return nil
+	}
 	if err := v.db.SaveProposalHistoryForSlot(ctx, pubKey, block.Block.Slot, signingRoot[:]); err != nil {
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
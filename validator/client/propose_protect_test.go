@@ -98,3 +98,38 @@ func TestPostBlockSignUpdate(t *testing.T) {
 	err = validator.postBlockSignUpdate(context.Background(), pubKey, emptyBlock, &ethpb.DomainResponse{SignatureDomain: make([]byte, 32)})
 	require.NoError(t, err, "Expected allowed attestation not to throw error")
 }
+
+func TestPreBlockSignLocalValidation_MinimalSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	config := &featureconfig.Flags{
+		MinimalSlashingProtection: true,
+	}
+	reset := featureconfig.InitWithReset(config)
+	defer reset()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 10
+	err := validator.postBlockSignUpdate(ctx, pubKey, block, &ethpb.DomainResponse{SignatureDomain: make([]byte, 32)})
+	require.NoError(t, err)
+
+	// Only the highest signed proposal slot is saved.
+	_, exists, err := validator.db.ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+	highestSignedSlot, err := validator.db.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), highestSignedSlot)
+
+	// Blocks with a slot not higher than the highest signed proposal slot are refused.
+	err = validator.preBlockSignValidations(ctx, pubKey, block.Block)
+	require.ErrorContains(t, failedPreBlockSignLocalErr, err)
+	block.Block.Slot = 9
+	err = validator.preBlockSignValidations(ctx, pubKey, block.Block)
+	require.ErrorContains(t, failedPreBlockSignLocalErr, err)
+	block.Block.Slot = 11
+	require.NoError(t, validator.preBlockSignValidations(ctx, pubKey, block.Block))
+}
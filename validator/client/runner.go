@@ -8,6 +8,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
@@ -36,10 +37,21 @@ type Validator interface {
 	LogNextDutyTimeLeft(slot uint64) error
 	ResetAttesterProtectionData()
 	UpdateDomainDataCaches(ctx context.Context, slot uint64)
+	PruneSlashingProtectionHistory(ctx context.Context, slot uint64) error
 	WaitForWalletInitialization(ctx context.Context) error
 	AllValidatorsAreExited(ctx context.Context) (bool, error)
+	SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription
+	RemoveDutiesOfRemovedKeys(validatingKeys [][48]byte)
+	StopSigning(ctx context.Context, pubKeys [][48]byte) error
+	ResumeSigning(pubKeys [][48]byte)
+	DetectDoppelgangers(ctx context.Context) error
+	CheckDoppelgangersOfAddedKeys(ctx context.Context, slot uint64) error
 }
 
+// slashingProtectionPruningEpochs is the number of epochs between two prunings of the
+// slashing protection history.
+const slashingProtectionPruningEpochs = 256
+
 // Run the main validator routine. This routine exits if the context is
 // canceled.
 //
@@ -72,6 +84,11 @@ func run(ctx context.Context, v Validator) {
 	if err := v.WaitForActivation(ctx); err != nil {
 		log.Fatalf("Could not wait for validator activation: %v", err)
 	}
+	if featureconfig.Get().DoppelgangerProtection {
+		if err := v.DetectDoppelgangers(ctx); err != nil {
+			log.Fatalf("Could not detect doppelgangers: %v", err)
+		}
+	}
 	headSlot, err := v.CanonicalHeadSlot(ctx)
 	if err != nil {
 		log.Fatalf("Could not get current canonical head slot: %v", err)
@@ -80,6 +97,15 @@ func run(ctx context.Context, v Validator) {
 		handleAssignmentError(err, headSlot)
 	}
 
+	// The slashing protection history is pruned in the background at startup, then on a long
+	// interval, as pruning goes through the history of every validating key.
+	prunedSlot := headSlot
+	go pruneSlashingProtectionHistory(ctx, v, headSlot)
+
+	accountsChangedChan := make(chan [][48]byte, 1)
+	sub := v.SubscribeAccountChanges(accountsChangedChan)
+	defer sub.Unsubscribe()
+
 	for {
 		ctx, span := trace.StartSpan(ctx, "validator.processSlot")
 
@@ -88,6 +114,10 @@ func run(ctx context.Context, v Validator) {
 			log.Info("Context canceled, stopping validator")
 			span.End()
 			return // Exit if context is canceled.
+		case validatingKeys := <-accountsChangedChan:
+			// Stop performing the duties of removed keys without waiting for the next epoch.
+			v.RemoveDutiesOfRemovedKeys(validatingKeys)
+			span.End()
 		case slot := <-v.NextSlot():
 			span.AddAttributes(trace.Int64Attribute("slot", int64(slot)))
 
@@ -106,6 +136,12 @@ func run(ctx context.Context, v Validator) {
 			log := log.WithField("slot", slot)
 			log.WithField("deadline", deadline).Debug("Set deadline for proposals and attestations")
 
+			if featureconfig.Get().DoppelgangerProtection {
+				if err := v.CheckDoppelgangersOfAddedKeys(ctx, slot); err != nil {
+					log.WithError(err).Error("Could not detect doppelgangers of added validating keys")
+				}
+			}
+
 			// Keep trying to update assignments if they are nil or if we are past an
 			// epoch transition in the beacon node's state.
 			if err := v.UpdateDuties(ctx, slot); err != nil {
@@ -126,6 +162,11 @@ func run(ctx context.Context, v Validator) {
 				go v.UpdateDomainDataCaches(ctx, slot+1)
 			}
 
+			if slot >= prunedSlot+slashingProtectionPruningEpochs*params.BeaconConfig().SlotsPerEpoch {
+				prunedSlot = slot
+				go pruneSlashingProtectionHistory(ctx, v, slot)
+			}
+
 			var wg sync.WaitGroup
 
 			allRoles, err := v.RolesAt(ctx, slot)
@@ -181,3 +222,9 @@ func handleAssignmentError(err error, slot uint64) {
 		log.WithField("error", err).Error("Failed to update assignments")
 	}
 }
+
+func pruneSlashingProtectionHistory(ctx context.Context, v Validator, slot uint64) {
+	if err := v.PruneSlashingProtectionHistory(ctx, slot); err != nil {
+		log.WithError(err).Error("Could not prune slashing protection history")
+	}
+}
@@ -47,6 +47,37 @@ func TestCancelledContext_ChecksSlasherReady(t *testing.T) {
 	assert.Equal(t, true, v.SlasherReadyCalled, "Expected SlasherReady() to be called")
 }
 
+func TestDetectDoppelgangers_CalledWhenEnabled(t *testing.T) {
+	v := &FakeValidator{}
+	reset := featureconfig.InitWithReset(&featureconfig.Flags{
+		DoppelgangerProtection: true,
+	})
+	defer reset()
+	run(cancelledContext(), v)
+	assert.Equal(t, true, v.DetectDoppelgangersCalled, "Expected DetectDoppelgangers() to be called")
+}
+
+func TestCheckDoppelgangersOfAddedKeys_CalledWhenEnabled(t *testing.T) {
+	v := &FakeValidator{}
+	reset := featureconfig.InitWithReset(&featureconfig.Flags{
+		DoppelgangerProtection: true,
+	})
+	defer reset()
+	ctx, cancel := context.WithCancel(context.Background())
+	slot := uint64(55)
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	go func() {
+		ticker <- slot
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	assert.Equal(t, slot, v.CheckDoppelgangersOfAddedKeysArg1, "Expected CheckDoppelgangersOfAddedKeys(%d) to be called", slot)
+}
+
 func TestUpdateDuties_NextSlot(t *testing.T) {
 	v := &FakeValidator{}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -183,3 +214,23 @@ func TestAllValidatorsAreExited_NextSlot(t *testing.T) {
 	run(ctx, v)
 	assert.LogsContain(t, hook, "All validators are exited")
 }
+
+func TestAccountsChanged_RemovesDutiesOfRemovedKeys(t *testing.T) {
+	v := &FakeValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	validatingKeys := [][48]byte{{1}, {2}}
+	go func() {
+		// Wait for the runner to subscribe to the account changes.
+		for v.AccountsChangedFeed.Send(validatingKeys) == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		// The second send only completes once the first change was received.
+		v.AccountsChangedFeed.Send(validatingKeys)
+
+		cancel()
+	}()
+	run(ctx, v)
+	require.Equal(t, true, v.RemoveDutiesOfRemovedKeysCalled, "Expected RemoveDutiesOfRemovedKeys() to be called")
+	assert.DeepEqual(t, validatingKeys, v.RemoveDutiesOfRemovedKeysArg1)
+}
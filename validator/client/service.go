@@ -53,6 +53,13 @@ type BeaconNodeInfoFetcher interface {
 	BeaconLogsEndpoint(ctx context.Context) (string, error)
 }
 
+// BeaconNodesHealthFetcher can retrieve the beacon node the validator client
+// talks to, as well as the health of the configured beacon nodes.
+type BeaconNodesHealthFetcher interface {
+	ActiveBeaconNode() (string, bool)
+	BeaconNodesHealth() []*BeaconNodeHealth
+}
+
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
@@ -76,6 +83,7 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	graffitiStruct        *graffiti.Graffiti
+	failover              *beaconNodeFailover
 }
 
 // Config for the validator service.
@@ -156,6 +164,18 @@ func (v *ValidatorService) Start() {
 		}
 	}
 
+	if strings.Contains(v.endpoint, ",") {
+		failover, err := newBeaconNodeFailover(v.ctx, v.endpoint, dialOpts)
+		if err != nil {
+			log.Errorf("Could not dial endpoints: %s, %v", v.endpoint, err)
+			return
+		}
+		v.failover = failover
+		// The resolver registered first takes precedence over the multiple endpoints resolver.
+		dialOpts = append([]grpc.DialOption{grpc.WithResolvers(failover)}, dialOpts...)
+		go failover.run(v.ctx)
+	}
+
 	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
@@ -186,6 +206,7 @@ func (v *ValidatorService) Start() {
 		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
 		node:                           ethpb.NewNodeClient(v.conn),
+		livenessClient:                 pbrpc.NewLivenessClient(v.conn),
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -209,6 +230,9 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.failover != nil {
+		v.failover.close()
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
@@ -255,6 +279,42 @@ func (v *ValidatorService) recheckKeys(ctx context.Context) {
 	}
 }
 
+// StopSigning stops the validator client from signing with the given public keys, once the
+// signatures in progress are done and the slashing protection of the keys is saved to the
+// database. It is used before the slashing protection of deleted keys is exported.
+func (v *ValidatorService) StopSigning(ctx context.Context, pubKeys [][48]byte) error {
+	if v.validator == nil {
+		return nil
+	}
+	return v.validator.StopSigning(ctx, pubKeys)
+}
+
+// ResumeSigning allows the validator client to sign again with the given public keys.
+func (v *ValidatorService) ResumeSigning(pubKeys [][48]byte) {
+	if v.validator == nil {
+		return
+	}
+	v.validator.ResumeSigning(pubKeys)
+}
+
+// ActiveBeaconNode returns the endpoint of the beacon node the validator client talks to, and
+// whether it was picked among several beacon nodes based on their health.
+func (v *ValidatorService) ActiveBeaconNode() (string, bool) {
+	if v.failover == nil {
+		return v.endpoint, false
+	}
+	return v.failover.Active(), true
+}
+
+// BeaconNodesHealth returns the last known health of the beacon nodes, when the validator client
+// is configured with several of them.
+func (v *ValidatorService) BeaconNodesHealth() []*BeaconNodeHealth {
+	if v.failover == nil {
+		return nil
+	}
+	return v.failover.Health()
+}
+
 // ConstructDialOptions constructs a list of grpc dial options
 func ConstructDialOptions(
 	maxCallRecvMsgSize int,
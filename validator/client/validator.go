@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"strings"
 	"sync"
@@ -21,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -60,6 +62,7 @@ type validator struct {
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
 	prevBalanceLock                    sync.RWMutex
 	attesterHistoryByPubKeyLock        sync.RWMutex
+	signingLock                        sync.RWMutex
 	walletInitializedFeed              *event.Feed
 	genesisTime                        uint64
 	domainDataCache                    *ristretto.Cache
@@ -74,11 +77,22 @@ type validator struct {
 	keyManager                         keymanager.IKeymanager
 	beaconClient                       ethpb.BeaconChainClient
 	validatorClient                    ethpb.BeaconNodeValidatorClient
+	livenessClient                     pbrpc.LivenessClient
 	protector                          slashingprotection.Protector
 	db                                 vdb.Database
 	graffiti                           []byte
 	voteStats                          voteStats
 	graffitiStruct                     *graffiti.Graffiti
+	doppelgangerKeys                   map[[48]byte]bool
+	checkedKeys                        map[[48]byte]bool
+	addedKeys                          map[[48]byte]*addedKey
+	stoppedKeys                        map[[48]byte]bool
+}
+
+// accountChangesNotifier is implemented by the keymanagers notifying their subscribers
+// of the validating public keys whenever their accounts change.
+type accountChangesNotifier interface {
+	SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription
 }
 
 // Done cleans up the validator.
@@ -302,6 +316,89 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 	return time.Unix(int64(v.genesisTime), 0 /*ns*/).Add(time.Duration(secs) * time.Second)
 }
 
+// SubscribeAccountChanges subscribes to the validating public keys of the keymanager
+// every time its accounts change. A subscription which never sends is returned if the
+// keymanager does not notify account changes.
+func (v *validator) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	if km, ok := v.keyManager.(accountChangesNotifier); ok {
+		return km.SubscribeAccountChanges(pubKeysChan)
+	}
+	return event.NewSubscription(func(unsubscribed <-chan struct{}) error {
+		<-unsubscribed
+		return nil
+	})
+}
+
+// RemoveDutiesOfRemovedKeys drops the duties of the public keys which are no longer
+// among the given validating public keys, so the validator stops performing them
+// right away instead of waiting for the duties of the next epoch.
+func (v *validator) RemoveDutiesOfRemovedKeys(validatingKeys [][48]byte) {
+	if v.duties == nil {
+		return
+	}
+	keys := make(map[[48]byte]bool, len(validatingKeys))
+	for _, key := range validatingKeys {
+		keys[key] = true
+	}
+	filter := func(duties []*ethpb.DutiesResponse_Duty) []*ethpb.DutiesResponse_Duty {
+		filtered := make([]*ethpb.DutiesResponse_Duty, 0, len(duties))
+		for _, duty := range duties {
+			if keys[bytesutil.ToBytes48(duty.PublicKey)] {
+				filtered = append(filtered, duty)
+				continue
+			}
+			log.WithField(
+				"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(duty.PublicKey)),
+			).Info("Validating key was removed, dropping its duties")
+		}
+		return filtered
+	}
+	// The duties are replaced rather than modified in place, as they may be read
+	// concurrently by the routines performing the duties of the current slot.
+	v.duties = &ethpb.DutiesResponse{
+		Duties:             filter(v.duties.Duties),
+		CurrentEpochDuties: filter(v.duties.CurrentEpochDuties),
+		NextEpochDuties:    filter(v.duties.NextEpochDuties),
+	}
+}
+
+// StopSigning stops signing with the given public keys. It waits for the signatures in
+// progress to be done and their slashing protection to be saved, then saves the attestation
+// history of the keys held in memory, so the slashing protection in the database covers every
+// signature ever made with the keys.
+func (v *validator) StopSigning(ctx context.Context, pubKeys [][48]byte) error {
+	v.signingLock.Lock()
+	defer v.signingLock.Unlock()
+	if v.stoppedKeys == nil {
+		v.stoppedKeys = make(map[[48]byte]bool)
+	}
+	for _, pubKey := range pubKeys {
+		v.stoppedKeys[pubKey] = true
+	}
+	for _, pubKey := range pubKeys {
+		v.attesterHistoryByPubKeyLock.RLock()
+		_, ok := v.attesterHistoryByPubKey[pubKey]
+		v.attesterHistoryByPubKeyLock.RUnlock()
+		// Only the history loaded in memory may be more recent than the one in the database.
+		if !ok {
+			continue
+		}
+		if err := v.SaveProtection(ctx, pubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResumeSigning allows signing again with the given public keys, after their signing was stopped.
+func (v *validator) ResumeSigning(pubKeys [][48]byte) {
+	v.signingLock.Lock()
+	defer v.signingLock.Unlock()
+	for _, pubKey := range pubKeys {
+		delete(v.stoppedKeys, pubKey)
+	}
+}
+
 // UpdateDuties checks the slot number to determine if the validator's
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
@@ -324,6 +421,8 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	if err != nil {
 		return err
 	}
+	// Keys used by another validator client must not perform any duty.
+	validatingKeys = v.filterDoppelgangers(validatingKeys, helpers.SlotToEpoch(slot))
 	req := &ethpb.DutiesRequest{
 		Epoch:      slot / params.BeaconConfig().SlotsPerEpoch,
 		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
@@ -479,7 +578,11 @@ func (v *validator) ResetAttesterProtectionData() {
 }
 
 // SaveProtection saves the attestation information currently in validator state.
+// No attestation history is saved in minimal slashing protection mode.
 func (v *validator) SaveProtection(ctx context.Context, pubKey [48]byte) error {
+	if featureconfig.Get().MinimalSlashingProtection {
+		return nil
+	}
 	v.attesterHistoryByPubKeyLock.RLock()
 	defer v.attesterHistoryByPubKeyLock.RUnlock()
 	if err := v.db.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, v.attesterHistoryByPubKey[pubKey]); err != nil {
@@ -489,6 +592,38 @@ func (v *validator) SaveProtection(ctx context.Context, pubKey [48]byte) error {
 	return nil
 }
 
+// PruneSlashingProtectionHistory deletes the slashing protection history older than the weak
+// subjectivity period, which is no longer used to protect the validating keys, or all of it in
+// minimal slashing protection mode. The highest signed epochs and slot of the keys are kept.
+func (v *validator) PruneSlashingProtectionHistory(ctx context.Context, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "validator.PruneSlashingProtectionHistory")
+	defer span.End()
+
+	var pruneEpoch, pruneSlot uint64
+	if featureconfig.Get().MinimalSlashingProtection {
+		pruneEpoch, pruneSlot = math.MaxUint64, math.MaxUint64
+	} else {
+		epoch := helpers.SlotToEpoch(slot)
+		wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+		if epoch <= wsPeriod {
+			return nil
+		}
+		pruneEpoch = epoch - wsPeriod
+		startSlot, err := helpers.StartSlot(pruneEpoch)
+		if err != nil {
+			return err
+		}
+		pruneSlot = startSlot
+	}
+	if err := v.db.PruneAttestationHistory(ctx, pruneEpoch); err != nil {
+		return errors.Wrap(err, "could not prune attestation history")
+	}
+	if err := v.db.PruneProposalHistory(ctx, pruneSlot); err != nil {
+		return errors.Wrap(err, "could not prune proposal history")
+	}
+	return nil
+}
+
 // isAggregator checks if a validator is an aggregator of a given slot, it uses the selection algorithm outlined in:
 // https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#aggregation-selection
 func (v *validator) isAggregator(ctx context.Context, committee []uint64, slot uint64, pubKey [48]byte) (bool, error) {
@@ -14,6 +14,7 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -560,6 +561,39 @@ func TestUpdateDuties_OK(t *testing.T) {
 	assert.Equal(t, resp.Duties[0].ValidatorIndex, v.duties.Duties[0].ValidatorIndex, "Unexpected validator assignments")
 }
 
+func TestRemoveDutiesOfRemovedKeys(t *testing.T) {
+	hook := logTest.NewGlobal()
+	keptKey := [48]byte{1}
+	removedKey := [48]byte{2}
+	v := validator{
+		duties: &ethpb.DutiesResponse{
+			Duties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: keptKey[:], AttesterSlot: 1},
+				{PublicKey: removedKey[:], AttesterSlot: 2},
+			},
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: removedKey[:]},
+			},
+			NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: keptKey[:]},
+				{PublicKey: removedKey[:]},
+			},
+		},
+	}
+	v.RemoveDutiesOfRemovedKeys([][48]byte{keptKey})
+	require.Equal(t, 1, len(v.duties.Duties))
+	assert.DeepEqual(t, keptKey[:], v.duties.Duties[0].PublicKey)
+	assert.Equal(t, 0, len(v.duties.CurrentEpochDuties))
+	require.Equal(t, 1, len(v.duties.NextEpochDuties))
+	assert.DeepEqual(t, keptKey[:], v.duties.NextEpochDuties[0].PublicKey)
+	assert.LogsContain(t, hook, "Validating key was removed")
+
+	roles, err := v.RolesAt(context.Background(), 2)
+	require.NoError(t, err)
+	_, ok := roles[removedKey]
+	assert.Equal(t, false, ok, "Expected no roles for the removed key")
+}
+
 func TestUpdateProtections_OK(t *testing.T) {
 	ctx := context.Background()
 	pubKey1 := [48]byte{1}
@@ -651,6 +685,63 @@ func TestSaveProtection_OK(t *testing.T) {
 	require.DeepEqual(t, history1, savedHistories[pubKey1], "Unexpected retrieved history")
 }
 
+func TestPruneSlashingProtectionHistory_OK(t *testing.T) {
+	ctx := context.Background()
+	pubKey1 := [48]byte{1}
+	pubKey2 := [48]byte{2}
+	db := dbTest.SetupDB(t, [][48]byte{pubKey1, pubKey2})
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	newHistory := func(source, target uint64) kv.EncHistoryData {
+		history, err := kv.NewAttestationHistoryArray(0).SetTargetData(ctx, target, &kv.HistoryData{Source: source, SigningRoot: []byte{1}})
+		require.NoError(t, err)
+		history, err = history.SetLatestEpochWritten(ctx, target)
+		require.NoError(t, err)
+		return history
+	}
+	histories := map[[48]byte]kv.EncHistoryData{
+		pubKey1: newHistory(1, 2),
+		pubKey2: newHistory(wsPeriod+4, wsPeriod+5),
+	}
+	require.NoError(t, db.SaveAttestationHistoryForPubKeysV2(ctx, histories))
+	recentSlot := (wsPeriod + 5) * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey1, 1, []byte{1}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey2, recentSlot, []byte{1}))
+
+	v := validator{db: db}
+	// The history older than the weak subjectivity period is pruned.
+	require.NoError(t, v.PruneSlashingProtectionHistory(ctx, (wsPeriod+3)*params.BeaconConfig().SlotsPerEpoch))
+	savedHistories, err := db.AttestationHistoryForPubKeysV2(ctx, [][48]byte{pubKey1, pubKey2})
+	require.NoError(t, err)
+	require.DeepEqual(t, kv.NewAttestationHistoryArray(0), savedHistories[pubKey1])
+	require.DeepEqual(t, histories[pubKey2], savedHistories[pubKey2])
+	_, exists, err := db.ProposalHistoryForSlot(ctx, pubKey1, 1)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+	_, exists, err = db.ProposalHistoryForSlot(ctx, pubKey2, recentSlot)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	target, _, err := db.HighestSignedTargetEpoch(ctx, pubKey1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), target)
+
+	// All the history is pruned in minimal slashing protection mode.
+	reset := featureconfig.InitWithReset(&featureconfig.Flags{MinimalSlashingProtection: true})
+	defer reset()
+	require.NoError(t, v.PruneSlashingProtectionHistory(ctx, (wsPeriod+3)*params.BeaconConfig().SlotsPerEpoch))
+	savedHistories, err = db.AttestationHistoryForPubKeysV2(ctx, [][48]byte{pubKey2})
+	require.NoError(t, err)
+	require.DeepEqual(t, kv.NewAttestationHistoryArray(0), savedHistories[pubKey2])
+	_, exists, err = db.ProposalHistoryForSlot(ctx, pubKey2, recentSlot)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+	source, _, err := db.HighestSignedSourceEpoch(ctx, pubKey2)
+	require.NoError(t, err)
+	require.Equal(t, wsPeriod+4, source)
+	slot, err := db.HighestSignedProposal(ctx, pubKey2)
+	require.NoError(t, err)
+	require.Equal(t, recentSlot, slot)
+}
+
 func TestRolesAt_OK(t *testing.T) {
 	v, m, validatorKey, finish := setup(t)
 	defer finish()
@@ -29,15 +29,24 @@ type ValidatorDB interface {
 	LowestSignedProposal(ctx context.Context, publicKey [48]byte) (uint64, error)
 	ProposalHistoryForSlot(ctx context.Context, publicKey [48]byte, slot uint64) ([32]byte, bool, error)
 	SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]byte, slot uint64, signingRoot []byte) error
+	SaveHighestSignedProposal(ctx context.Context, publicKey [48]byte, slot uint64) error
+	ProposalHistoryForPubKey(ctx context.Context, publicKey [48]byte) ([]*kv.Proposal, error)
 	ProposedPublicKeys(ctx context.Context) ([][48]byte, error)
+	PruneProposalHistory(ctx context.Context, slot uint64) error
 
 	// Attester protection related methods.
 	LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (uint64, error)
 	LowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (uint64, error)
 	SaveLowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error
 	SaveLowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error
+	HighestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (uint64, bool, error)
+	HighestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (uint64, bool, error)
+	SaveHighestSignedTargetEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error
+	SaveHighestSignedSourceEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error
+	SaveSignedAttestationEpochs(ctx context.Context, publicKey [48]byte, source, target uint64) error
 	AttestationHistoryForPubKeysV2(ctx context.Context, publicKeys [][48]byte) (map[[48]byte]kv.EncHistoryData, error)
 	SaveAttestationHistoryForPubKeysV2(ctx context.Context, historyByPubKeys map[[48]byte]kv.EncHistoryData) error
 	SaveAttestationHistoryForPubKeyV2(ctx context.Context, pubKey [48]byte, history kv.EncHistoryData) error
 	AttestedPublicKeys(ctx context.Context) ([][48]byte, error)
+	PruneAttestationHistory(ctx context.Context, epoch uint64) error
 }
@@ -8,20 +8,29 @@ import (
 	"go.opencensus.io/trace"
 )
 
-// AttestedPublicKeys retrieves all public keys in our attestation history bucket.
+// AttestedPublicKeys retrieves all public keys in our attestation history bucket, as well as
+// the public keys with a highest signed target epoch whose attestation history was pruned.
 func (store *Store) AttestedPublicKeys(ctx context.Context) ([][48]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.AttestedPublicKeys")
 	defer span.End()
 	var err error
 	attestedPublicKeys := make([][48]byte, 0)
 	err = store.view(func(tx *bolt.Tx) error {
-		bucket := tx.Bucket(newHistoricAttestationsBucket)
-		return bucket.ForEach(func(key []byte, _ []byte) error {
-			pubKeyBytes := [48]byte{}
-			copy(pubKeyBytes[:], key)
-			attestedPublicKeys = append(attestedPublicKeys, pubKeyBytes)
-			return nil
-		})
+		seen := make(map[[48]byte]bool)
+		for _, bucketName := range [][]byte{newHistoricAttestationsBucket, highestSignedTargetBucket} {
+			if err := tx.Bucket(bucketName).ForEach(func(key []byte, _ []byte) error {
+				pubKeyBytes := [48]byte{}
+				copy(pubKeyBytes[:], key)
+				if !seen[pubKeyBytes] {
+					seen[pubKeyBytes] = true
+					attestedPublicKeys = append(attestedPublicKeys, pubKeyBytes)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
 	})
 	return attestedPublicKeys, err
 }
@@ -176,3 +185,98 @@ func (store *Store) SaveLowestSignedTargetEpoch(ctx context.Context, publicKey [
 		return nil
 	})
 }
+
+// HighestSignedSourceEpoch returns the highest signed source epoch for a validator public key,
+// and whether a source epoch was signed at all by the public key.
+func (store *Store) HighestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (uint64, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.HighestSignedSourceEpoch")
+	defer span.End()
+
+	return store.highestSignedEpoch(highestSignedSourceBucket, publicKey)
+}
+
+// HighestSignedTargetEpoch returns the highest signed target epoch for a validator public key,
+// and whether a target epoch was signed at all by the public key.
+func (store *Store) HighestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (uint64, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.HighestSignedTargetEpoch")
+	defer span.End()
+
+	return store.highestSignedEpoch(highestSignedTargetBucket, publicKey)
+}
+
+// SaveHighestSignedSourceEpoch saves the highest signed source epoch for a validator public key.
+func (store *Store) SaveHighestSignedSourceEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveHighestSignedSourceEpoch")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		return saveHighestSigned(tx.Bucket(highestSignedSourceBucket), publicKey, epoch)
+	})
+}
+
+// SaveHighestSignedTargetEpoch saves the highest signed target epoch for a validator public key.
+func (store *Store) SaveHighestSignedTargetEpoch(ctx context.Context, publicKey [48]byte, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveHighestSignedTargetEpoch")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		return saveHighestSigned(tx.Bucket(highestSignedTargetBucket), publicKey, epoch)
+	})
+}
+
+// SaveSignedAttestationEpochs saves the source and target epochs of an attestation signed by a
+// validator public key as its lowest and highest signed epochs where they override the saved ones,
+// all in a single transaction.
+func (store *Store) SaveSignedAttestationEpochs(ctx context.Context, publicKey [48]byte, source, target uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveSignedAttestationEpochs")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		if err := saveLowestSigned(tx.Bucket(lowestSignedSourceBucket), publicKey, source); err != nil {
+			return err
+		}
+		if err := saveLowestSigned(tx.Bucket(lowestSignedTargetBucket), publicKey, target); err != nil {
+			return err
+		}
+		if err := saveHighestSigned(tx.Bucket(highestSignedSourceBucket), publicKey, source); err != nil {
+			return err
+		}
+		return saveHighestSigned(tx.Bucket(highestSignedTargetBucket), publicKey, target)
+	})
+}
+
+func (store *Store) highestSignedEpoch(bucketName []byte, publicKey [48]byte) (uint64, bool, error) {
+	var exists bool
+	var highestSignedEpoch uint64
+	err := store.view(func(tx *bolt.Tx) error {
+		highestSignedEpochBytes := tx.Bucket(bucketName).Get(publicKey[:])
+		// 8 because bytesutil.BytesToUint64BigEndian will return 0 if input is less than 8 bytes.
+		if len(highestSignedEpochBytes) < 8 {
+			return nil
+		}
+		exists = true
+		highestSignedEpoch = bytesutil.BytesToUint64BigEndian(highestSignedEpochBytes)
+		return nil
+	})
+	return highestSignedEpoch, exists, err
+}
+
+// saveHighestSigned overrides the value saved for a public key in a bucket of highest
+// signed epochs or slots if the incoming value is higher.
+func saveHighestSigned(bucket *bolt.Bucket, publicKey [48]byte, value uint64) error {
+	highestSignedBytes := bucket.Get(publicKey[:])
+	if len(highestSignedBytes) >= 8 && value <= bytesutil.BytesToUint64BigEndian(highestSignedBytes) {
+		return nil
+	}
+	return bucket.Put(publicKey[:], bytesutil.Uint64ToBytesBigEndian(value))
+}
+
+// saveLowestSigned overrides the value saved for a public key in a bucket of lowest
+// signed epochs if the incoming value is lower.
+func saveLowestSigned(bucket *bolt.Bucket, publicKey [48]byte, value uint64) error {
+	lowestSignedBytes := bucket.Get(publicKey[:])
+	if len(lowestSignedBytes) >= 8 && value >= bytesutil.BytesToUint64BigEndian(lowestSignedBytes) {
+		return nil
+	}
+	return bucket.Put(publicKey[:], bytesutil.Uint64ToBytesBigEndian(value))
+}
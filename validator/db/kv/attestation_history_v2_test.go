@@ -175,3 +175,73 @@ func TestLowestSignedTargetEpoch_SaveRetrieveReplace(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint64(199), got)
 }
+
+func TestHighestSignedEpochs_SaveRetrieveReplace(t *testing.T) {
+	ctx := context.Background()
+	p0 := [48]byte{0}
+	validatorDB := setupDB(t, [][48]byte{p0})
+
+	// Nothing is signed yet.
+	_, exists, err := validatorDB.HighestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+	_, exists, err = validatorDB.HighestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+
+	// Can save epoch 0.
+	require.NoError(t, validatorDB.SaveHighestSignedSourceEpoch(ctx, p0, 0))
+	require.NoError(t, validatorDB.SaveHighestSignedTargetEpoch(ctx, p0, 0))
+	got, exists, err := validatorDB.HighestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, uint64(0), got)
+
+	// Can replace.
+	require.NoError(t, validatorDB.SaveHighestSignedSourceEpoch(ctx, p0, 100))
+	require.NoError(t, validatorDB.SaveHighestSignedTargetEpoch(ctx, p0, 101))
+	got, _, err = validatorDB.HighestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), got)
+	got, _, err = validatorDB.HighestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), got)
+
+	// Can not replace.
+	require.NoError(t, validatorDB.SaveHighestSignedSourceEpoch(ctx, p0, 99))
+	require.NoError(t, validatorDB.SaveHighestSignedTargetEpoch(ctx, p0, 100))
+	got, _, err = validatorDB.HighestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), got)
+	got, _, err = validatorDB.HighestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), got)
+
+	// Public keys with a highest signed target epoch are attested public keys.
+	keys, err := validatorDB.AttestedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{p0}, keys)
+}
+
+func TestSaveSignedAttestationEpochs(t *testing.T) {
+	ctx := context.Background()
+	p0 := [48]byte{0}
+	validatorDB := setupDB(t, [][48]byte{p0})
+
+	require.NoError(t, validatorDB.SaveSignedAttestationEpochs(ctx, p0, 5, 6))
+	require.NoError(t, validatorDB.SaveSignedAttestationEpochs(ctx, p0, 3, 4))
+	require.NoError(t, validatorDB.SaveSignedAttestationEpochs(ctx, p0, 7, 8))
+
+	lowestSource, err := validatorDB.LowestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), lowestSource)
+	lowestTarget, err := validatorDB.LowestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), lowestTarget)
+	highestSource, _, err := validatorDB.HighestSignedSourceEpoch(ctx, p0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), highestSource)
+	highestTarget, _, err := validatorDB.HighestSignedTargetEpoch(ctx, p0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), highestTarget)
+}
@@ -93,6 +93,8 @@ func NewKVStore(dirPath string, pubKeys [][48]byte) (*Store, error) {
 			newHistoricProposalsBucket,
 			lowestSignedSourceBucket,
 			lowestSignedTargetBucket,
+			highestSignedSourceBucket,
+			highestSignedTargetBucket,
 			lowestSignedProposalsBucket,
 			highestSignedProposalsBucket,
 		)
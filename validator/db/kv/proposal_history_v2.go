@@ -116,6 +116,42 @@ func (store *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]b
 	return err
 }
 
+// ProposalHistoryForPubKey returns the proposals in the history of a validator public key,
+// sorted by slot.
+func (store *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [48]byte) ([]*Proposal, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForPubKey")
+	defer span.End()
+
+	proposals := make([]*Proposal, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		valBucket := tx.Bucket(newHistoricProposalsBucket).Bucket(publicKey[:])
+		if valBucket == nil {
+			return nil
+		}
+		return valBucket.ForEach(func(slot []byte, signingRoot []byte) error {
+			sr := make([]byte, len(signingRoot))
+			copy(sr, signingRoot)
+			proposals = append(proposals, &Proposal{
+				Slot:        bytesutil.BytesToUint64BigEndian(slot),
+				SigningRoot: sr,
+			})
+			return nil
+		})
+	})
+	return proposals, err
+}
+
+// SaveHighestSignedProposal saves the highest signed proposal slot for a validator public key
+// without saving the proposal itself in its proposal history.
+func (store *Store) SaveHighestSignedProposal(ctx context.Context, publicKey [48]byte, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveHighestSignedProposal")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		return saveHighestSigned(tx.Bucket(highestSignedProposalsBucket), publicKey, slot)
+	})
+}
+
 // LowestSignedProposal returns the lowest signed proposal slot for a validator public key.
 // If no data exists, returning 0 is a sensible default.
 func (store *Store) LowestSignedProposal(ctx context.Context, publicKey [48]byte) (uint64, error) {
@@ -255,3 +255,39 @@ func TestStore_HighestSignedProposal(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, uint64(3), slot)
 }
+
+func TestStore_ProposalHistoryForPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [48]byte{3}
+	validatorDB := setupDB(t, [][48]byte{pubkey})
+
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, [48]byte{4})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(proposals))
+
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubkey, 3, []byte{3}))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubkey, 1, []byte{1}))
+	proposals, err = validatorDB.ProposalHistoryForPubKey(ctx, pubkey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*Proposal{
+		{Slot: 1, SigningRoot: []byte{1}},
+		{Slot: 3, SigningRoot: []byte{3}},
+	}, proposals)
+}
+
+func TestStore_SaveHighestSignedProposal(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [48]byte{3}
+	validatorDB := setupDB(t, [][48]byte{pubkey})
+
+	require.NoError(t, validatorDB.SaveHighestSignedProposal(ctx, pubkey, 2))
+	require.NoError(t, validatorDB.SaveHighestSignedProposal(ctx, pubkey, 1))
+	slot, err := validatorDB.HighestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), slot)
+
+	// The proposal itself is not saved in the proposal history.
+	_, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubkey, 2)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+}
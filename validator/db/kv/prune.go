@@ -0,0 +1,202 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PruneAttestationHistory prunes the attestations with a target epoch lower than the given epoch
+// from the attestation history of the validator public keys. The history of the public keys whose
+// latest attested target epoch is lower than the given epoch is deleted, after saving their highest
+// signed source and target epochs, so the keys keep a minimal slashing protection. The history of
+// the other public keys is trimmed. Every public key is pruned in its own transaction, so the
+// attestations being signed are not blocked for the whole pruning.
+func (store *Store) PruneAttestationHistory(ctx context.Context, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.PruneAttestationHistory")
+	defer span.End()
+
+	var pubKeys [][]byte
+	if err := store.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(newHistoricAttestationsBucket).ForEach(func(key []byte, _ []byte) error {
+			pubKeys = append(pubKeys, bytesutil.SafeCopyBytes(key))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, key := range pubKeys {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := store.update(func(tx *bolt.Tx) error {
+			return pruneAttestationHistoryForPubKey(ctx, tx, key, epoch)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pruneAttestationHistoryForPubKey(ctx context.Context, tx *bolt.Tx, key []byte, epoch uint64) error {
+	bucket := tx.Bucket(newHistoricAttestationsBucket)
+	enc := bucket.Get(key)
+	if enc == nil {
+		return nil
+	}
+	// The history is modified in place, so it must not be the memory of the database.
+	history := make(EncHistoryData, len(enc))
+	copy(history, enc)
+	latestEpochWritten, err := history.GetLatestEpochWritten(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "could not get latest epoch written for public key %#x", key)
+	}
+	if latestEpochWritten >= epoch {
+		trimmed, changed, err := trimAttestationHistory(ctx, history, latestEpochWritten, epoch)
+		if err != nil {
+			return errors.Wrapf(err, "could not trim attestation history for public key %#x", key)
+		}
+		if !changed {
+			return nil
+		}
+		return bucket.Put(key, trimmed)
+	}
+	highestSource, ok, err := highestSourceInHistory(ctx, history)
+	if err != nil {
+		return errors.Wrapf(err, "could not get highest source epoch for public key %#x", key)
+	}
+	if ok {
+		pubKey := bytesutil.ToBytes48(key)
+		if err := saveHighestSigned(tx.Bucket(highestSignedSourceBucket), pubKey, highestSource); err != nil {
+			return err
+		}
+		if err := saveHighestSigned(tx.Bucket(highestSignedTargetBucket), pubKey, latestEpochWritten); err != nil {
+			return err
+		}
+	}
+	if err := bucket.Delete(key); err != nil {
+		return errors.Wrapf(err, "could not prune attestation history for public key %#x", key)
+	}
+	return nil
+}
+
+// trimAttestationHistory clears the attestations with a target epoch lower than the given epoch
+// from an attestation history, and truncates the history past the entries still in use. It returns
+// whether the history was changed.
+func trimAttestationHistory(
+	ctx context.Context,
+	history EncHistoryData,
+	latestEpochWritten,
+	epoch uint64,
+) (EncHistoryData, bool, error) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	numEntries := uint64(len(history)-latestEpochWrittenSize) / historySize
+	latestIndex := latestEpochWritten % wsPeriod
+	// The entry of the latest epoch written is always kept.
+	lastUsedIndex := latestIndex
+	changed := false
+	for i := uint64(0); i < numEntries; i++ {
+		hd, err := history.GetTargetData(ctx, i)
+		if err != nil {
+			return nil, false, err
+		}
+		if hd.IsEmpty() {
+			continue
+		}
+		// Entries hold the targets of the weak subjectivity period up to the latest epoch written.
+		distance := (latestIndex + wsPeriod - i) % wsPeriod
+		if distance > latestEpochWritten || latestEpochWritten-distance < epoch {
+			history, err = history.SetTargetData(ctx, i, emptyHistoryData())
+			if err != nil {
+				return nil, false, err
+			}
+			changed = true
+			continue
+		}
+		if i > lastUsedIndex {
+			lastUsedIndex = i
+		}
+	}
+	if size := latestEpochWrittenSize + (lastUsedIndex+1)*historySize; size < uint64(len(history)) {
+		history = history[:size]
+		changed = true
+	}
+	return history, changed, nil
+}
+
+// PruneProposalHistory deletes the proposals older than the given slot from the proposal
+// history of all validator public keys, keeping their highest signed proposal slot. Every public
+// key is pruned in its own transaction, so the blocks being signed are not blocked for the whole
+// pruning.
+func (store *Store) PruneProposalHistory(ctx context.Context, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.PruneProposalHistory")
+	defer span.End()
+
+	var pubKeys [][]byte
+	if err := store.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(newHistoricProposalsBucket).ForEach(func(key []byte, _ []byte) error {
+			pubKeys = append(pubKeys, bytesutil.SafeCopyBytes(key))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, key := range pubKeys {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := store.update(func(tx *bolt.Tx) error {
+			return pruneProposalHistoryForPubKey(tx, key, slot)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pruneProposalHistoryForPubKey(tx *bolt.Tx, key []byte, slot uint64) error {
+	valBucket := tx.Bucket(newHistoricProposalsBucket).Bucket(key)
+	if valBucket == nil {
+		return nil
+	}
+	highestSignedBkt := tx.Bucket(highestSignedProposalsBucket)
+	c := valBucket.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.First() {
+		proposalSlot := bytesutil.BytesToUint64BigEndian(k)
+		if proposalSlot >= slot {
+			break
+		}
+		if err := saveHighestSigned(highestSignedBkt, bytesutil.ToBytes48(key), proposalSlot); err != nil {
+			return err
+		}
+		if err := c.Delete(); err != nil {
+			return errors.Wrapf(err, "could not prune slot %d in proposal history", proposalSlot)
+		}
+	}
+	return nil
+}
+
+// highestSourceInHistory returns the highest source epoch of the attestations in an
+// attestation history, and whether the history has any attestation at all.
+func highestSourceInHistory(ctx context.Context, history EncHistoryData) (uint64, bool, error) {
+	var found bool
+	var highestSource uint64
+	for i := uint64(0); i < uint64(len(history)-latestEpochWrittenSize)/historySize; i++ {
+		hd, err := history.GetTargetData(ctx, i)
+		if err != nil {
+			return 0, false, err
+		}
+		if hd.IsEmpty() {
+			continue
+		}
+		if !found || hd.Source > highestSource {
+			highestSource = hd.Source
+		}
+		found = true
+	}
+	return highestSource, found, nil
+}
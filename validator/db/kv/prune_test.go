@@ -0,0 +1,171 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_PruneAttestationHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][48]byte{{1}, {2}, {3}}
+	validatorDB := setupDB(t, pubKeys)
+
+	history1, err := MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, NewAttestationHistoryArray(0), 4, &HistoryData{
+		Source:      2,
+		SigningRoot: []byte{1},
+	})
+	require.NoError(t, err)
+	history1, err = MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, history1, 5, &HistoryData{
+		Source:      1,
+		SigningRoot: []byte{2},
+	})
+	require.NoError(t, err)
+	history2, err := MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, NewAttestationHistoryArray(0), 10, &HistoryData{
+		Source:      9,
+		SigningRoot: []byte{3},
+	})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveAttestationHistoryForPubKeysV2(ctx, map[[48]byte]EncHistoryData{
+		pubKeys[0]: history1,
+		pubKeys[1]: history2,
+		// A public key without any attestation.
+		pubKeys[2]: NewAttestationHistoryArray(0),
+	}))
+
+	require.NoError(t, validatorDB.PruneAttestationHistory(ctx, 6))
+	histories, err := validatorDB.AttestationHistoryForPubKeysV2(ctx, pubKeys)
+	require.NoError(t, err)
+	assert.DeepEqual(t, NewAttestationHistoryArray(0), histories[pubKeys[0]])
+	// The entries of the targets lower than the pruning epoch are cleared from active public keys.
+	assert.DeepEqual(t, clearTargets(t, history2, 6), histories[pubKeys[1]])
+
+	// The highest signed epochs of the pruned public keys are kept.
+	source, exists, err := validatorDB.HighestSignedSourceEpoch(ctx, pubKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, uint64(2), source)
+	target, _, err := validatorDB.HighestSignedTargetEpoch(ctx, pubKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), target)
+	_, exists, err = validatorDB.HighestSignedTargetEpoch(ctx, pubKeys[1])
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+	_, exists, err = validatorDB.HighestSignedTargetEpoch(ctx, pubKeys[2])
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	keys, err := validatorDB.AttestedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKeys[1], pubKeys[0]}, keys)
+}
+
+func TestStore_PruneAttestationHistory_TrimsActiveKeys(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][48]byte{{1}}
+	validatorDB := setupDB(t, pubKeys)
+
+	history, err := MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, NewAttestationHistoryArray(0), 4, &HistoryData{
+		Source:      3,
+		SigningRoot: []byte{1},
+	})
+	require.NoError(t, err)
+	history, err = MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, history, 10, &HistoryData{
+		Source:      9,
+		SigningRoot: []byte{2},
+	})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKeys[0], history))
+
+	// The attestation targeting an epoch lower than the pruning epoch is cleared.
+	require.NoError(t, validatorDB.PruneAttestationHistory(ctx, 6))
+	histories, err := validatorDB.AttestationHistoryForPubKeysV2(ctx, pubKeys)
+	require.NoError(t, err)
+	assert.DeepEqual(t, clearTargets(t, history, 6), histories[pubKeys[0]])
+	hd, err := histories[pubKeys[0]].GetTargetData(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, true, hd.IsEmpty())
+	hd, err = histories[pubKeys[0]].GetTargetData(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), hd.Source)
+}
+
+func TestStore_PruneAttestationHistory_TruncatesActiveKeys(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][48]byte{{1}}
+	validatorDB := setupDB(t, pubKeys)
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.WeakSubjectivityPeriod = 16
+	params.OverrideBeaconConfig(cfg)
+	wsPeriod := cfg.WeakSubjectivityPeriod
+
+	// The history wraps around, and its last entries hold targets older than the pruning epoch.
+	history, err := MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, NewAttestationHistoryArray(0), wsPeriod-1, &HistoryData{
+		Source:      wsPeriod - 2,
+		SigningRoot: []byte{1},
+	})
+	require.NoError(t, err)
+	history, err = MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, history, wsPeriod+1, &HistoryData{
+		Source:      wsPeriod,
+		SigningRoot: []byte{2},
+	})
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.SaveAttestationHistoryForPubKeyV2(ctx, pubKeys[0], history))
+
+	require.NoError(t, validatorDB.PruneAttestationHistory(ctx, wsPeriod))
+	histories, err := validatorDB.AttestationHistoryForPubKeysV2(ctx, pubKeys)
+	require.NoError(t, err)
+	assert.DeepEqual(t, clearTargets(t, history, wsPeriod)[:latestEpochWrittenSize+2*historySize], histories[pubKeys[0]])
+}
+
+// clearTargets clears the entries of the targets lower than an epoch from an attestation history.
+func clearTargets(t *testing.T, history EncHistoryData, epoch uint64) EncHistoryData {
+	ctx := context.Background()
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	latest, err := history.GetLatestEpochWritten(ctx)
+	require.NoError(t, err)
+	cleared := make(EncHistoryData, len(history))
+	copy(cleared, history)
+	for target := uint64(0); target < epoch; target++ {
+		if target+wsPeriod <= latest || uint64(len(history)) < latestEpochWrittenSize+(target%wsPeriod+1)*historySize {
+			continue
+		}
+		cleared, err = cleared.SetTargetData(ctx, target, emptyHistoryData())
+		require.NoError(t, err)
+	}
+	return cleared
+}
+
+func TestStore_PruneProposalHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][48]byte{{1}, {2}}
+	validatorDB := setupDB(t, pubKeys)
+
+	for _, slot := range []uint64{1, 5, 10} {
+		require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], slot, []byte{byte(slot)}))
+	}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[1], 2, []byte{2}))
+
+	require.NoError(t, validatorDB.PruneProposalHistory(ctx, 5))
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKeys[0])
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*Proposal{
+		{Slot: 5, SigningRoot: []byte{5}},
+		{Slot: 10, SigningRoot: []byte{10}},
+	}, proposals)
+	proposals, err = validatorDB.ProposalHistoryForPubKey(ctx, pubKeys[1])
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(proposals))
+
+	// The highest signed proposal slots are kept.
+	slot, err := validatorDB.HighestSignedProposal(ctx, pubKeys[1])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), slot)
+	keys, err := validatorDB.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+}
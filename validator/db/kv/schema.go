@@ -17,6 +17,10 @@ var (
 	lowestSignedSourceBucket = []byte("lowest-signed-source-bucket")
 	lowestSignedTargetBucket = []byte("lowest-signed-target-bucket")
 
+	// Buckets for highest signed source and target epoch for individual validator.
+	highestSignedSourceBucket = []byte("highest-signed-source-bucket")
+	highestSignedTargetBucket = []byte("highest-signed-target-bucket")
+
 	// Lowest and highest signed proposals.
 	lowestSignedProposalsBucket  = []byte("lowest-signed-proposals-bucket")
 	highestSignedProposalsBucket = []byte("highest-signed-proposals-bucket")
@@ -213,6 +213,13 @@ func (dr *Keymanager) DeleteAccounts(ctx context.Context, publicKeys [][]byte) e
 			return errors.Wrap(err, "failed to initialize keys caches")
 		}
 	}
+	// We notify subscribers of the remaining keys right away, so the validator client
+	// stops performing duties for the deleted accounts without waiting on the file watcher.
+	remainingPubKeys, err := dr.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return err
+	}
+	dr.accountsChangedFeed.Send(remainingPubKeys)
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	mock "github.com/prysmaticlabs/prysm/validator/accounts/testing"
@@ -26,8 +27,9 @@ func TestImportedKeymanager_RemoveAccounts(t *testing.T) {
 		WalletPassword: password,
 	}
 	dr := &Keymanager{
-		wallet:        wallet,
-		accountsStore: &accountStore{},
+		wallet:              wallet,
+		accountsStore:       &accountStore{},
+		accountsChangedFeed: new(event.Feed),
 	}
 	numAccounts := 5
 	ctx := context.Background()
@@ -42,8 +44,17 @@ func TestImportedKeymanager_RemoveAccounts(t *testing.T) {
 
 	accountToRemove := uint64(2)
 	accountPubKey := accounts[accountToRemove]
+	accountsChangedChan := make(chan [][48]byte, 1)
+	sub := dr.SubscribeAccountChanges(accountsChangedChan)
+	defer sub.Unsubscribe()
 	// Remove an account from the keystore.
 	require.NoError(t, dr.DeleteAccounts(ctx, [][]byte{accountPubKey[:]}))
+	// Subscribers are notified of the remaining accounts.
+	remainingAccounts := <-accountsChangedChan
+	require.Equal(t, numAccounts-1, len(remainingAccounts))
+	for _, pubKey := range remainingAccounts {
+		assert.NotEqual(t, accountPubKey, pubKey)
+	}
 	// Ensure the keystore file was written to the wallet
 	// and ensure we can decrypt it using the EIP-2335 standard.
 	var encodedKeystore []byte
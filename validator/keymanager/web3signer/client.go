@@ -0,0 +1,100 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Number of times a request failing with a network or server side error is
+// retried, and the delay before the first retry which doubles on each attempt.
+var (
+	maxRetries = 3
+	retryDelay = 500 * time.Millisecond
+)
+
+// retryableError marks a failure which may succeed if the request is sent again.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+// doRequest sends a request to the remote signer, retrying it with an
+// exponential backoff while it fails with a retryable error, and returns
+// the body of the successful response.
+func (k *Keymanager) doRequest(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	delay := retryDelay
+	for attempt := 0; ; attempt++ {
+		resp, err := k.sendRequest(ctx, method, path, body)
+		if err == nil {
+			return resp, nil
+		}
+		retryable, ok := err.(*retryableError)
+		if !ok {
+			return nil, err
+		}
+		if attempt >= maxRetries {
+			return nil, retryable.err
+		}
+		log.WithError(retryable.err).WithFields(logrus.Fields{
+			"path":    path,
+			"attempt": attempt + 1,
+		}).Debug("Request to remote signer failed, retrying")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (k *Keymanager) sendRequest(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, k.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, &retryableError{err: errors.Wrap(err, "could not reach remote signer")}
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &retryableError{err: errors.Wrap(err, "could not read response from remote signer")}
+	}
+	msg := strings.TrimSpace(string(respBody))
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return respBody, nil
+	case resp.StatusCode == http.StatusPreconditionFailed:
+		return nil, ErrSigningDenied
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrPublicKeyNotFound
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return nil, &retryableError{err: fmt.Errorf("remote signer responded with status %d: %s", resp.StatusCode, msg)}
+	default:
+		return nil, fmt.Errorf("remote signer responded with status %d: %s", resp.StatusCode, msg)
+	}
+}
@@ -0,0 +1,29 @@
+/*
+Package web3signer defines a keymanager implementation which signs eth2 data
+structures through a remote signer speaking the Web3Signer HTTP API.
+
+Validating public keys are discovered by listing the keys loaded in the remote
+signer:
+
+	GET /api/v1/eth2/publicKeys
+
+Signing requests are sent to the endpoint of the signing key as typed signing
+payloads, carrying the signing root computed by the validator client along with
+the fork information and the data structure being signed, so the remote signer
+can apply its own slashing protection:
+
+	POST /api/v1/eth2/sign/{pubkey}
+	{
+	  "type": "ATTESTATION",
+	  "fork_info": {
+	    "fork": {"previous_version": "0x00000000", "current_version": "0x00000000", "epoch": "0"},
+	    "genesis_validators_root": "0x..."
+	  },
+	  "signingRoot": "0x...",
+	  "attestation": {...}
+	}
+
+Requests failing because of a network error or a server side error are retried
+with an exponential backoff before giving up.
+*/
+package web3signer
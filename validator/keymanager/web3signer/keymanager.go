@@ -0,0 +1,207 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logrus.WithField("prefix", "web3signer-keymanager")
+	// ErrSigningDenied defines a failure from the remote signer when
+	// a signing operation was refused by its slashing protection.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+	// ErrPublicKeyNotFound defines a failure from the remote signer when
+	// the requested signing key is not loaded in the remote signer.
+	ErrPublicKeyNotFound = errors.New("public key not found in remote signer")
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	defaultTimeout = 10 * time.Second
+)
+
+// KeymanagerOpts for a Web3Signer keymanager.
+type KeymanagerOpts struct {
+	BaseURL               string `json:"url"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a Web3Signer keymanager.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+	// HTTPClient used to reach the remote signer, a client with a default
+	// timeout is used if nil.
+	HTTPClient *http.Client
+}
+
+// Keymanager implementation using remote signing keys via the Web3Signer HTTP API.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	client                *http.Client
+	baseURL               string
+	genesisValidatorsRoot []byte
+}
+
+// NewKeymanager instantiates a new Web3Signer keymanager from configuration options.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil {
+		return nil, errors.New("keymanager options are missing")
+	}
+	baseURL, err := url.Parse(cfg.Opts.BaseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse remote signer url")
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf("remote signer url %q must use the http or https scheme", cfg.Opts.BaseURL)
+	}
+	genesisValidatorsRoot, err := hex.DecodeString(strings.TrimPrefix(cfg.Opts.GenesisValidatorsRoot, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode genesis validators root")
+	}
+	if len(genesisValidatorsRoot) != 32 {
+		return nil, fmt.Errorf("genesis validators root must be 32 bytes, received %d", len(genesisValidatorsRoot))
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Keymanager{
+		opts:                  cfg.Opts,
+		client:                client,
+		baseURL:               strings.TrimSuffix(cfg.Opts.BaseURL, "/"),
+		genesisValidatorsRoot: genesisValidatorsRoot,
+	}, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of a Web3Signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	strURL := fmt.Sprintf("%s: %s\n", au.BrightMagenta("Remote signer URL"), opts.BaseURL)
+	if _, err := b.WriteString(strURL); err != nil {
+		log.Error(err)
+		return ""
+	}
+	strRoot := fmt.Sprintf(
+		"%s: %s\n", au.BrightMagenta("Genesis validators root"), opts.GenesisValidatorsRoot,
+	)
+	if _, err := b.WriteString(strRoot); err != nil {
+		log.Error(err)
+		return ""
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the Web3Signer keymanager.
+func (k *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return k.opts
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys loaded in the remote signer.
+func (k *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	body, err := k.doRequest(ctx, http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list public keys from remote signer")
+	}
+	var hexKeys []string
+	if err := json.Unmarshal(body, &hexKeys); err != nil {
+		return nil, errors.Wrap(err, "could not decode public keys from remote signer")
+	}
+	pubKeys := make([][48]byte, len(hexKeys))
+	for i, hexKey := range hexKeys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", hexKey)
+		}
+		if len(pubKey) != 48 {
+			return nil, fmt.Errorf("public key %s is not 48 bytes", hexKey)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	return pubKeys, nil
+}
+
+// FetchAllValidatingPublicKeys fetches the list of all public keys, which are the
+// same as the validating public keys for a remote signer.
+func (k *Keymanager) FetchAllValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	return k.FetchValidatingPublicKeys(ctx)
+}
+
+// Sign signs a message for a validator key by sending a typed signing request
+// to the remote signer.
+func (k *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	payload, err := k.signingRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal signing request")
+	}
+	body, err := k.doRequest(ctx, http.MethodPost, fmt.Sprintf("%s%#x", signPath, req.PublicKey), enc)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := decodeSignature(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature from remote signer")
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+// decodeSignature accepts both the plain text and the JSON signing responses
+// of the remote signer.
+func decodeSignature(body []byte) ([]byte, error) {
+	sigHex := strings.TrimSpace(string(body))
+	if strings.HasPrefix(sigHex, "{") {
+		var resp struct {
+			Signature string `json:"signature"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, err
+		}
+		sigHex = resp.Signature
+	}
+	return hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+}
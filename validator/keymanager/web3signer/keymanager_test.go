@@ -0,0 +1,301 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	mock "github.com/prysmaticlabs/prysm/validator/keymanager/web3signer/testing"
+)
+
+var testGenesisValidatorsRoot = fmt.Sprintf("%#x", bytesutil.PadTo([]byte{0x04, 0x70}, 32))
+
+func setupKeymanager(t *testing.T, numKeys int) (*Keymanager, *mock.Server, []bls.SecretKey) {
+	keys := make([]bls.SecretKey, numKeys)
+	for i := range keys {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	srv := mock.NewServer(keys)
+	t.Cleanup(srv.Close)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		Opts: &KeymanagerOpts{
+			BaseURL:               srv.URL,
+			GenesisValidatorsRoot: testGenesisValidatorsRoot,
+		},
+	})
+	require.NoError(t, err)
+	return km, srv, keys
+}
+
+func setRetryDelay(t *testing.T, delay time.Duration) {
+	prev := retryDelay
+	retryDelay = delay
+	t.Cleanup(func() {
+		retryDelay = prev
+	})
+}
+
+func TestNewKeymanager_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *KeymanagerOpts
+		wantErr string
+	}{
+		{name: "missing options", wantErr: "keymanager options are missing"},
+		{
+			name:    "unsupported scheme",
+			opts:    &KeymanagerOpts{BaseURL: "localhost:9000", GenesisValidatorsRoot: testGenesisValidatorsRoot},
+			wantErr: "must use the http or https scheme",
+		},
+		{
+			name:    "invalid genesis validators root",
+			opts:    &KeymanagerOpts{BaseURL: "http://localhost:9000", GenesisValidatorsRoot: "0x0102"},
+			wantErr: "genesis validators root must be 32 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: tt.opts})
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestKeymanager_OptionsFileRoundTrip(t *testing.T) {
+	opts := &KeymanagerOpts{
+		BaseURL:               "https://signer.example.com:9000",
+		GenesisValidatorsRoot: testGenesisValidatorsRoot,
+	}
+	enc, err := MarshalOptionsFile(context.Background(), opts)
+	require.NoError(t, err)
+	decoded, err := UnmarshalOptionsFile(ioutil.NopCloser(bytes.NewReader(enc)))
+	require.NoError(t, err)
+	assert.DeepEqual(t, opts, decoded)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	km, _, keys := setupKeymanager(t, 3)
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, len(keys), len(pubKeys))
+	for i, key := range keys {
+		assert.DeepEqual(t, key.PublicKey().Marshal(), pubKeys[i][:])
+	}
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	km, srv, keys := setupKeymanager(t, 1)
+	pubKey := keys[0].PublicKey().Marshal()
+	att := testutil.NewAttestation().Data
+	att.Slot = 65
+	att.Target.Epoch = 2
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = 100
+	tests := []struct {
+		name      string
+		req       *validatorpb.SignRequest
+		wantType  string
+		wantField string
+	}{
+		{
+			name:      "block",
+			req:       &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Block{Block: blk}},
+			wantType:  blockType,
+			wantField: "block",
+		},
+		{
+			name:      "attestation",
+			req:       &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AttestationData{AttestationData: att}},
+			wantType:  attestationType,
+			wantField: "attestation",
+		},
+		{
+			name: "aggregate and proof",
+			req: &validatorpb.SignRequest{
+				Object: &validatorpb.SignRequest_AggregateAttestationAndProof{
+					AggregateAttestationAndProof: &ethpb.AggregateAttestationAndProof{
+						AggregatorIndex: 3,
+						Aggregate:       &ethpb.Attestation{Data: att, AggregationBits: []byte{0x03}},
+						SelectionProof:  make([]byte, 96),
+					},
+				},
+			},
+			wantType:  aggregateAndProofType,
+			wantField: "aggregate_and_proof",
+		},
+		{
+			name:      "aggregation slot",
+			req:       &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: 65}},
+			wantType:  aggregationSlotType,
+			wantField: "aggregation_slot",
+		},
+		{
+			name:      "randao reveal",
+			req:       &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 2}},
+			wantType:  randaoRevealType,
+			wantField: "randao_reveal",
+		},
+		{
+			name:      "voluntary exit",
+			req:       &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 9}}},
+			wantType:  voluntaryExitType,
+			wantField: "voluntary_exit",
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			req.PublicKey = pubKey
+			req.SigningRoot = bytesutil.PadTo([]byte(tt.name), 32)
+			sig, err := km.Sign(context.Background(), req)
+			require.NoError(t, err)
+			assert.Equal(t, true, sig.Verify(keys[0].PublicKey(), req.SigningRoot))
+
+			requests := srv.SigningRequests()
+			require.Equal(t, i+1, len(requests))
+			payload := requests[i]
+			assert.Equal(t, tt.wantType, payload["type"])
+			assert.Equal(t, fmt.Sprintf("%#x", req.SigningRoot), payload["signingRoot"])
+			assert.NotNil(t, payload[tt.wantField])
+			forkInfo, ok := payload["fork_info"].(map[string]interface{})
+			require.Equal(t, true, ok)
+			assert.Equal(t, testGenesisValidatorsRoot, forkInfo["genesis_validators_root"])
+			fork, ok := forkInfo["fork"].(map[string]interface{})
+			require.Equal(t, true, ok)
+			assert.Equal(t, fmt.Sprintf("%#x", params.BeaconConfig().GenesisForkVersion), fork["current_version"])
+		})
+	}
+}
+
+func TestKeymanager_Sign_SpecEncoding(t *testing.T) {
+	km, srv, keys := setupKeymanager(t, 1)
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = 100
+	blk.ProposerIndex = 7
+	blk.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}, Signature: []byte{0x0a}},
+	}
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   keys[0].PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Block{Block: blk},
+	})
+	require.NoError(t, err)
+
+	block := srv.SigningRequests()[0]["block"].(map[string]interface{})
+	assert.Equal(t, "100", block["slot"])
+	assert.Equal(t, "7", block["proposer_index"])
+	assert.Equal(t, fmt.Sprintf("%#x", make([]byte, 32)), block["parent_root"])
+	exits := block["body"].(map[string]interface{})["voluntary_exits"].([]interface{})
+	require.Equal(t, 1, len(exits))
+	exit := exits[0].(map[string]interface{})
+	assert.Equal(t, "0x0a", exit["signature"])
+	message, ok := exit["message"].(map[string]interface{})
+	require.Equal(t, true, ok, "Expected spec field name message")
+	assert.Equal(t, "2", message["validator_index"])
+}
+
+func TestKeymanager_Sign_Errors(t *testing.T) {
+	km, srv, keys := setupKeymanager(t, 1)
+	req := &validatorpb.SignRequest{
+		PublicKey:   keys[0].PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	}
+
+	srv.DenySigning(true)
+	_, err := km.Sign(context.Background(), req)
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	srv.DenySigning(false)
+
+	unknownKey, err := bls.RandKey()
+	require.NoError(t, err)
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   unknownKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, ErrPublicKeyNotFound.Error(), err)
+
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   keys[0].PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+	})
+	assert.ErrorContains(t, "unsupported sign request object", err)
+}
+
+func TestKeymanager_Retries(t *testing.T) {
+	setRetryDelay(t, time.Millisecond)
+	km, srv, keys := setupKeymanager(t, 1)
+
+	srv.FailNextRequests(maxRetries)
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(pubKeys))
+
+	srv.FailNextRequests(maxRetries + 1)
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   keys[0].PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, "remote signer responded with status 503", err)
+}
+
+func TestKeymanager_DoesNotRetryClientErrors(t *testing.T) {
+	setRetryDelay(t, time.Millisecond)
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "Bad request", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		Opts: &KeymanagerOpts{BaseURL: srv.URL, GenesisValidatorsRoot: testGenesisValidatorsRoot},
+	})
+	require.NoError(t, err)
+
+	_, err = km.FetchValidatingPublicKeys(context.Background())
+	assert.ErrorContains(t, "remote signer responded with status 400", err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestKeymanager_RetriesStopOnContextCancellation(t *testing.T) {
+	setRetryDelay(t, time.Minute)
+	km, srv, _ := setupKeymanager(t, 1)
+	srv.FailNextRequests(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := km.FetchValidatingPublicKeys(ctx)
+	assert.ErrorContains(t, context.DeadlineExceeded.Error(), err)
+}
+
+func TestDecodeSignature(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := key.Sign([]byte("hello")).Marshal()
+
+	plain, err := decodeSignature([]byte(fmt.Sprintf("%#x\n", sig)))
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig, plain)
+
+	fromJSON, err := decodeSignature([]byte(fmt.Sprintf(`{"signature": "%#x"}`, sig)))
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig, fromJSON)
+}
@@ -0,0 +1,92 @@
+package web3signer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/specencoding"
+)
+
+// Signing request types of the Web3Signer API.
+const (
+	blockType             = "BLOCK"
+	attestationType       = "ATTESTATION"
+	aggregateAndProofType = "AGGREGATE_AND_PROOF"
+	aggregationSlotType   = "AGGREGATION_SLOT"
+	randaoRevealType      = "RANDAO_REVEAL"
+	voluntaryExitType     = "VOLUNTARY_EXIT"
+)
+
+type signingRequest struct {
+	Type              string           `json:"type"`
+	ForkInfo          *forkInfo        `json:"fork_info"`
+	SigningRoot       string           `json:"signingRoot"`
+	Block             interface{}      `json:"block,omitempty"`
+	Attestation       interface{}      `json:"attestation,omitempty"`
+	AggregateAndProof interface{}      `json:"aggregate_and_proof,omitempty"`
+	AggregationSlot   *aggregationSlot `json:"aggregation_slot,omitempty"`
+	RandaoReveal      *randaoReveal    `json:"randao_reveal,omitempty"`
+	VoluntaryExit     interface{}      `json:"voluntary_exit,omitempty"`
+}
+
+type forkInfo struct {
+	Fork                  interface{} `json:"fork"`
+	GenesisValidatorsRoot string      `json:"genesis_validators_root"`
+}
+
+type aggregationSlot struct {
+	Slot string `json:"slot"`
+}
+
+type randaoReveal struct {
+	Epoch string `json:"epoch"`
+}
+
+// signingRequest builds the typed Web3Signer signing payload of a sign request.
+func (k *Keymanager) signingRequest(req *validatorpb.SignRequest) (*signingRequest, error) {
+	payload := &signingRequest{
+		SigningRoot: fmt.Sprintf("%#x", req.SigningRoot),
+	}
+	var epoch uint64
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		payload.Type = blockType
+		payload.Block = specencoding.Encode(obj.Block)
+		epoch = helpers.SlotToEpoch(obj.Block.GetSlot())
+	case *validatorpb.SignRequest_AttestationData:
+		payload.Type = attestationType
+		payload.Attestation = specencoding.Encode(obj.AttestationData)
+		epoch = obj.AttestationData.GetTarget().GetEpoch()
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		payload.Type = aggregateAndProofType
+		payload.AggregateAndProof = specencoding.Encode(obj.AggregateAttestationAndProof)
+		epoch = helpers.SlotToEpoch(obj.AggregateAttestationAndProof.GetAggregate().GetData().GetSlot())
+	case *validatorpb.SignRequest_Exit:
+		payload.Type = voluntaryExitType
+		payload.VoluntaryExit = specencoding.Encode(obj.Exit)
+		epoch = obj.Exit.GetEpoch()
+	case *validatorpb.SignRequest_Slot:
+		payload.Type = aggregationSlotType
+		payload.AggregationSlot = &aggregationSlot{Slot: strconv.FormatUint(obj.Slot, 10)}
+		epoch = helpers.SlotToEpoch(obj.Slot)
+	case *validatorpb.SignRequest_Epoch:
+		payload.Type = randaoRevealType
+		payload.RandaoReveal = &randaoReveal{Epoch: strconv.FormatUint(obj.Epoch, 10)}
+		epoch = obj.Epoch
+	default:
+		return nil, fmt.Errorf("unsupported sign request object %T", req.Object)
+	}
+	fork, err := p2putils.Fork(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine fork")
+	}
+	payload.ForkInfo = &forkInfo{
+		Fork:                  specencoding.Encode(fork),
+		GenesisValidatorsRoot: fmt.Sprintf("%#x", k.genesisValidatorsRoot),
+	}
+	return payload, nil
+}
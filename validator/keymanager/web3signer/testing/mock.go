@@ -0,0 +1,151 @@
+// Package testing provides a mock remote signer implementing the subset of the
+// Web3Signer HTTP API used by the Web3Signer keymanager.
+package testing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+)
+
+// objectFields maps each supported signing request type to the field holding
+// the data structure being signed.
+var objectFields = map[string]string{
+	"BLOCK":               "block",
+	"ATTESTATION":         "attestation",
+	"AGGREGATE_AND_PROOF": "aggregate_and_proof",
+	"AGGREGATION_SLOT":    "aggregation_slot",
+	"RANDAO_REVEAL":       "randao_reveal",
+	"VOLUNTARY_EXIT":      "voluntary_exit",
+}
+
+// Server is a mock remote signer holding its signing keys in memory.
+type Server struct {
+	*httptest.Server
+	lock            sync.Mutex
+	keys            map[[48]byte]bls.SecretKey
+	pubKeys         [][48]byte
+	failures        int
+	deny            bool
+	signingRequests []map[string]interface{}
+}
+
+// NewServer starts a mock remote signer signing with the given keys. The server
+// must be closed by the caller.
+func NewServer(keys []bls.SecretKey) *Server {
+	s := &Server{
+		keys: make(map[[48]byte]bls.SecretKey, len(keys)),
+	}
+	for _, key := range keys {
+		pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+		s.keys[pubKey] = key
+		s.pubKeys = append(s.pubKeys, pubKey)
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// FailNextRequests makes the next n requests fail with a service unavailable error.
+func (s *Server) FailNextRequests(n int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failures = n
+}
+
+// DenySigning makes signing requests fail as if refused by slashing protection.
+func (s *Server) DenySigning(deny bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.deny = deny
+}
+
+// SigningRequests returns the decoded signing requests received by the server.
+func (s *Server) SigningRequests() []map[string]interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.signingRequests
+}
+
+// ServeHTTP handles the remote signer API requests.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		http.Error(w, "Service unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == publicKeysPath:
+		pubKeys := make([]string, len(s.pubKeys))
+		for i, pubKey := range s.pubKeys {
+			pubKeys[i] = fmt.Sprintf("%#x", pubKey)
+		}
+		writeJSON(w, pubKeys)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, signPath):
+		s.sign(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) sign(w http.ResponseWriter, r *http.Request) {
+	pubKey, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, signPath), "0x"))
+	if err != nil || len(pubKey) != 48 {
+		http.Error(w, "Invalid public key", http.StatusBadRequest)
+		return
+	}
+	key, ok := s.keys[bytesutil.ToBytes48(pubKey)]
+	if !ok {
+		http.Error(w, "Public key not found", http.StatusNotFound)
+		return
+	}
+	var req map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid signing request", http.StatusBadRequest)
+		return
+	}
+	reqType, _ := req["type"].(string)
+	field, ok := objectFields[reqType]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unsupported signing request type %q", reqType), http.StatusBadRequest)
+		return
+	}
+	if req[field] == nil || req["fork_info"] == nil {
+		http.Error(w, fmt.Sprintf("Signing request of type %s is missing fields", reqType), http.StatusBadRequest)
+		return
+	}
+	signingRoot, _ := req["signingRoot"].(string)
+	root, err := hex.DecodeString(strings.TrimPrefix(signingRoot, "0x"))
+	if err != nil || len(root) != 32 {
+		http.Error(w, "Invalid signing root", http.StatusBadRequest)
+		return
+	}
+	s.signingRequests = append(s.signingRequests, req)
+	if s.deny {
+		http.Error(w, "Signing operation failed due to slashing protection rules", http.StatusPreconditionFailed)
+		return
+	}
+	writeJSON(w, map[string]string{
+		"signature": fmt.Sprintf("%#x", key.Sign(root).Marshal()),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
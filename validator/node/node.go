@@ -5,6 +5,7 @@ package node
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -444,22 +445,23 @@ func (s *ValidatorClient) registerRPCService(cliCtx *cli.Context, km keymanager.
 	nodeGatewayEndpoint := cliCtx.String(flags.BeaconRPCGatewayProviderFlag.Name)
 	walletDir := cliCtx.String(flags.WalletDirFlag.Name)
 	server := rpc.NewServer(cliCtx.Context, &rpc.Config{
-		ValDB:                   s.db,
-		Host:                    rpcHost,
-		Port:                    fmt.Sprintf("%d", rpcPort),
-		WalletInitializedFeed:   s.walletInitialized,
-		ValidatorService:        vs,
-		SyncChecker:             vs,
-		GenesisFetcher:          vs,
-		BeaconNodeInfoFetcher:   vs,
-		NodeGatewayEndpoint:     nodeGatewayEndpoint,
-		WalletDir:               walletDir,
-		Wallet:                  s.wallet,
-		Keymanager:              km,
-		ValidatorGatewayHost:    validatorGatewayHost,
-		ValidatorGatewayPort:    validatorGatewayPort,
-		ValidatorMonitoringHost: validatorMonitoringHost,
-		ValidatorMonitoringPort: validatorMonitoringPort,
+		ValDB:                    s.db,
+		Host:                     rpcHost,
+		Port:                     fmt.Sprintf("%d", rpcPort),
+		WalletInitializedFeed:    s.walletInitialized,
+		ValidatorService:         vs,
+		SyncChecker:              vs,
+		GenesisFetcher:           vs,
+		BeaconNodeInfoFetcher:    vs,
+		BeaconNodesHealthFetcher: vs,
+		NodeGatewayEndpoint:      nodeGatewayEndpoint,
+		WalletDir:                walletDir,
+		Wallet:                   s.wallet,
+		Keymanager:               km,
+		ValidatorGatewayHost:     validatorGatewayHost,
+		ValidatorGatewayPort:     validatorGatewayPort,
+		ValidatorMonitoringHost:  validatorMonitoringHost,
+		ValidatorMonitoringPort:  validatorMonitoringPort,
 	})
 	return s.services.RegisterService(server)
 }
@@ -478,10 +480,18 @@ func (s *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 	rpcAddr := fmt.Sprintf("%s:%d", rpcHost, rpcPort)
 	gatewayAddress := fmt.Sprintf("%s:%d", gatewayHost, gatewayPort)
 	allowedOrigins := strings.Split(cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
+	var rpcServer *rpc.Server
+	if err := s.services.FetchService(&rpcServer); err != nil {
+		return err
+	}
+	// The standard keymanager API is served over plain HTTP next to the gRPC gateway.
+	mux := http.NewServeMux()
+	mux.Handle(rpc.KeystoresPath, rpcServer.KeymanagerAPIHandler())
 	gatewaySrv := gateway.New(
 		cliCtx.Context,
 		rpcAddr,
 		gatewayAddress,
+		mux,
 		allowedOrigins,
 	)
 	return s.services.RegisterService(gatewaySrv)
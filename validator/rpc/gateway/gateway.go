@@ -34,13 +34,17 @@ func New(
 	ctx context.Context,
 	remoteAddress,
 	gatewayAddress string,
+	mux *http.ServeMux,
 	allowedOrigins []string,
 ) *Gateway {
+	if mux == nil {
+		mux = http.NewServeMux()
+	}
 	return &Gateway{
 		remoteAddr:     remoteAddress,
 		gatewayAddr:    gatewayAddress,
 		ctx:            ctx,
-		mux:            http.NewServeMux(),
+		mux:            mux,
 		allowedOrigins: allowedOrigins,
 	}
 }
@@ -7,18 +7,31 @@ import (
 
 	ptypes "github.com/gogo/protobuf/types"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/client"
 )
 
 // GetBeaconNodeConnection retrieves the current beacon node connection
-// information, as well as its sync status.
+// information, as well as its sync status. When the validator client is
+// configured with several beacon nodes, the endpoint is the one of the
+// healthy beacon node the validator client is routed to, and the health
+// of every beacon node is included.
 func (s *Server) GetBeaconNodeConnection(ctx context.Context, _ *ptypes.Empty) (*pb.NodeConnectionResponse, error) {
+	endpoint := s.nodeGatewayEndpoint
+	var beaconNodes []*pb.BeaconNodeHealth
+	if s.beaconNodesHealthFetcher != nil {
+		if active, ok := s.beaconNodesHealthFetcher.ActiveBeaconNode(); ok {
+			endpoint = active
+			beaconNodes = beaconNodesHealth(active, s.beaconNodesHealthFetcher.BeaconNodesHealth())
+		}
+	}
 	syncStatus, err := s.syncChecker.Syncing(ctx)
 	if err != nil || s.validatorService.Status() != nil {
 		return &pb.NodeConnectionResponse{
 			GenesisTime:        0,
-			BeaconNodeEndpoint: s.nodeGatewayEndpoint,
+			BeaconNodeEndpoint: endpoint,
 			Connected:          false,
 			Syncing:            false,
+			BeaconNodes:        beaconNodes,
 		}, nil
 	}
 	genesis, err := s.genesisFetcher.GenesisInfo(ctx)
@@ -28,12 +41,31 @@ func (s *Server) GetBeaconNodeConnection(ctx context.Context, _ *ptypes.Empty) (
 	return &pb.NodeConnectionResponse{
 		GenesisTime:            uint64(time.Unix(genesis.GenesisTime.Seconds, 0).Unix()),
 		DepositContractAddress: genesis.DepositContractAddress,
-		BeaconNodeEndpoint:     s.nodeGatewayEndpoint,
+		BeaconNodeEndpoint:     endpoint,
 		Connected:              true,
 		Syncing:                syncStatus,
+		BeaconNodes:            beaconNodes,
 	}, nil
 }
 
+func beaconNodesHealth(active string, health []*client.BeaconNodeHealth) []*pb.BeaconNodeHealth {
+	beaconNodes := make([]*pb.BeaconNodeHealth, len(health))
+	for i, h := range health {
+		beaconNodes[i] = &pb.BeaconNodeHealth{
+			Endpoint: h.Endpoint,
+			Active:   h.Endpoint == active,
+			Healthy:  h.Healthy,
+			Syncing:  h.Syncing,
+			HeadSlot: h.HeadSlot,
+			Peers:    uint64(h.Peers),
+		}
+		if h.Err != nil {
+			beaconNodes[i].Error = h.Err.Error()
+		}
+	}
+	return beaconNodes
+}
+
 // GetLogsEndpoints for the beacon and validator client.
 func (s *Server) GetLogsEndpoints(ctx context.Context, _ *ptypes.Empty) (*pb.LogsEndpointResponse, error) {
 	beaconLogsEndpoint, err := s.beaconNodeInfoFetcher.BeaconLogsEndpoint(ctx)
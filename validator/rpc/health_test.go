@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -64,6 +65,51 @@ func TestServer_GetBeaconNodeConnection(t *testing.T) {
 	require.DeepEqual(t, want, got)
 }
 
+type mockBeaconNodesHealthFetcher struct {
+	active string
+	health []*client.BeaconNodeHealth
+}
+
+func (m *mockBeaconNodesHealthFetcher) ActiveBeaconNode() (string, bool) {
+	return m.active, true
+}
+
+func (m *mockBeaconNodesHealthFetcher) BeaconNodesHealth() []*client.BeaconNodeHealth {
+	return m.health
+}
+
+func TestServer_GetBeaconNodeConnection_BeaconNodesHealth(t *testing.T) {
+	ctx := context.Background()
+	vs, err := client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s := &Server{
+		walletInitialized:   true,
+		validatorService:    vs,
+		syncChecker:         &mockSyncChecker{syncing: false},
+		genesisFetcher:      &mockGenesisFetcher{},
+		nodeGatewayEndpoint: "localhost:4000,localhost:4001",
+		beaconNodesHealthFetcher: &mockBeaconNodesHealthFetcher{
+			active: "localhost:4001",
+			health: []*client.BeaconNodeHealth{
+				{Endpoint: "localhost:4000", Err: errors.New("connection refused")},
+				{Endpoint: "localhost:4001", Healthy: true, HeadSlot: 100, Peers: 10},
+			},
+		},
+	}
+	got, err := s.GetBeaconNodeConnection(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	want := &pb.NodeConnectionResponse{
+		BeaconNodeEndpoint: "localhost:4001",
+		Connected:          false,
+		Syncing:            false,
+		BeaconNodes: []*pb.BeaconNodeHealth{
+			{Endpoint: "localhost:4000", Error: "connection refused"},
+			{Endpoint: "localhost:4001", Active: true, Healthy: true, HeadSlot: 100, Peers: 10},
+		},
+	}
+	require.DeepEqual(t, want, got)
+}
+
 func TestServer_GetLogsEndpoints(t *testing.T) {
 	ctx := context.Background()
 	s := &Server{
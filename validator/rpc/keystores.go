@@ -0,0 +1,357 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
+	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// KeystoresPath is the path of the standard keymanager API listing, importing
+// and deleting the keystores of the validator client.
+const KeystoresPath = "/eth/v1/keystores"
+
+// maxKeystoresRequestSize bounds the size of the request bodies of the keymanager API,
+// which hold keystores and their slashing protection history.
+const maxKeystoresRequestSize = 32 << 20
+
+// Statuses of the keystores reported by the standard keymanager API.
+const (
+	keystoreImported  = "imported"
+	keystoreDuplicate = "duplicate"
+	keystoreDeleted   = "deleted"
+	keystoreNotActive = "not_active"
+	keystoreNotFound  = "not_found"
+	keystoreError     = "error"
+)
+
+type keystoreData struct {
+	ValidatingPubkey string `json:"validating_pubkey"`
+	DerivationPath   string `json:"derivation_path,omitempty"`
+	Readonly         bool   `json:"readonly"`
+}
+
+type listKeystoresResponse struct {
+	Data []*keystoreData `json:"data"`
+}
+
+type importKeystoresRequest struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+
+type deleteKeystoresRequest struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+type keystoreStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+type importKeystoresResponse struct {
+	Data []*keystoreStatus `json:"data"`
+}
+
+type deleteKeystoresResponse struct {
+	Data               []*keystoreStatus `json:"data"`
+	SlashingProtection string            `json:"slashing_protection"`
+}
+
+type keystoresError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// KeymanagerAPIHandler serves the standard keymanager API, which lists, imports and
+// deletes the keystores of the validator client. Requests must be authenticated
+// with the same JWT as the validator gRPC API.
+func (s *Server) KeymanagerAPIHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := s.authorizeHTTP(r); err != nil {
+			writeKeystoresError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			s.listKeystores(w, r)
+		case http.MethodPost:
+			s.importKeystores(w, r)
+		case http.MethodDelete:
+			s.deleteKeystores(w, r)
+		default:
+			writeKeystoresError(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s not allowed", r.Method))
+		}
+	})
+}
+
+// Authorize the bearer token of an HTTP request is valid.
+func (s *Server) authorizeHTTP(r *http.Request) error {
+	authHeader := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return fmt.Errorf("invalid auth header, needs Bearer {token}")
+	}
+	if _, err := jwt.Parse(strings.TrimPrefix(authHeader, "Bearer "), s.validateJWT); err != nil {
+		return fmt.Errorf("could not parse JWT token: %v", err)
+	}
+	return nil
+}
+
+func (s *Server) listKeystores(w http.ResponseWriter, r *http.Request) {
+	if s.keymanager == nil {
+		writeKeystoresError(w, http.StatusInternalServerError, "No keymanager initialized")
+		return
+	}
+	pubKeys, err := s.keymanager.FetchValidatingPublicKeys(r.Context())
+	if err != nil {
+		writeKeystoresError(w, http.StatusInternalServerError, fmt.Sprintf("Could not retrieve public keys: %v", err))
+		return
+	}
+	// Keys of remote signers are managed by the remote signer, not by the validator client.
+	var readonly bool
+	switch s.keymanager.(type) {
+	case *remote.Keymanager, *web3signer.Keymanager:
+		readonly = true
+	}
+	data := make([]*keystoreData, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		data[i] = &keystoreData{
+			ValidatingPubkey: fmt.Sprintf("%#x", pubKey),
+			Readonly:         readonly,
+		}
+	}
+	writeKeystoresJSON(w, &listKeystoresResponse{Data: data})
+}
+
+func (s *Server) importKeystores(w http.ResponseWriter, r *http.Request) {
+	km, ok := s.keymanager.(*imported.Keymanager)
+	if !ok {
+		writeKeystoresError(w, http.StatusBadRequest, "Only imported wallets can import keystores")
+		return
+	}
+	req := &importKeystoresRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxKeystoresRequestSize)).Decode(req); err != nil {
+		writeKeystoresError(w, http.StatusBadRequest, fmt.Sprintf("Could not decode request body: %v", err))
+		return
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		writeKeystoresError(
+			w,
+			http.StatusBadRequest,
+			fmt.Sprintf("Received %d keystores but %d passwords", len(req.Keystores), len(req.Passwords)),
+		)
+		return
+	}
+	// The slashing protection history is imported first, so no key is ever used
+	// without its history.
+	if req.SlashingProtection != "" {
+		if err := slashingprotection.ImportStandardProtectionJSON(
+			r.Context(), s.valDB, strings.NewReader(req.SlashingProtection),
+		); err != nil {
+			writeKeystoresError(w, http.StatusBadRequest, fmt.Sprintf("Could not import slashing protection: %v", err))
+			return
+		}
+	}
+	existingPubKeys, err := km.FetchAllValidatingPublicKeys(r.Context())
+	if err != nil {
+		writeKeystoresError(w, http.StatusInternalServerError, fmt.Sprintf("Could not retrieve public keys: %v", err))
+		return
+	}
+	existing := make(map[[48]byte]bool, len(existingPubKeys))
+	for _, pubKey := range existingPubKeys {
+		existing[pubKey] = true
+	}
+	statuses := make([]*keystoreStatus, len(req.Keystores))
+	privKeys := make([][]byte, 0, len(req.Keystores))
+	pubKeys := make([][]byte, 0, len(req.Keystores))
+	importedPubKeys := make([][48]byte, 0, len(req.Keystores))
+	importedIndices := make([]int, 0, len(req.Keystores))
+	for i, encoded := range req.Keystores {
+		privKey, pubKey, err := decryptKeystore(encoded, req.Passwords[i])
+		if err != nil {
+			statuses[i] = &keystoreStatus{Status: keystoreError, Message: err.Error()}
+			continue
+		}
+		if existing[bytesutil.ToBytes48(pubKey)] {
+			statuses[i] = &keystoreStatus{Status: keystoreDuplicate}
+			continue
+		}
+		existing[bytesutil.ToBytes48(pubKey)] = true
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, pubKey)
+		importedPubKeys = append(importedPubKeys, bytesutil.ToBytes48(pubKey))
+		importedIndices = append(importedIndices, i)
+	}
+	if len(privKeys) > 0 {
+		status := &keystoreStatus{Status: keystoreImported}
+		if err := km.ImportKeypairs(r.Context(), privKeys, pubKeys); err != nil {
+			status = &keystoreStatus{Status: keystoreError, Message: err.Error()}
+		} else {
+			// Keys deleted earlier may be imported back.
+			if s.validatorService != nil {
+				s.validatorService.ResumeSigning(importedPubKeys)
+			}
+			if s.walletInitializedFeed != nil {
+				s.walletInitializedFeed.Send(s.wallet)
+			}
+		}
+		for _, i := range importedIndices {
+			statuses[i] = status
+		}
+	}
+	writeKeystoresJSON(w, &importKeystoresResponse{Data: statuses})
+}
+
+func (s *Server) deleteKeystores(w http.ResponseWriter, r *http.Request) {
+	km, ok := s.keymanager.(*imported.Keymanager)
+	if !ok {
+		writeKeystoresError(w, http.StatusBadRequest, "Only imported wallets can delete keystores")
+		return
+	}
+	req := &deleteKeystoresRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxKeystoresRequestSize)).Decode(req); err != nil {
+		writeKeystoresError(w, http.StatusBadRequest, fmt.Sprintf("Could not decode request body: %v", err))
+		return
+	}
+	existingPubKeys, err := km.FetchAllValidatingPublicKeys(r.Context())
+	if err != nil {
+		writeKeystoresError(w, http.StatusInternalServerError, fmt.Sprintf("Could not retrieve public keys: %v", err))
+		return
+	}
+	existing := make(map[[48]byte]bool, len(existingPubKeys))
+	for _, pubKey := range existingPubKeys {
+		existing[pubKey] = true
+	}
+	protected, err := s.protectedPublicKeys(r)
+	if err != nil {
+		writeKeystoresError(w, http.StatusInternalServerError, fmt.Sprintf("Could not retrieve slashing protection: %v", err))
+		return
+	}
+	statuses := make([]*keystoreStatus, len(req.Pubkeys))
+	deletedPubKeys := make([][]byte, 0, len(req.Pubkeys))
+	stoppedPubKeys := make([][48]byte, 0, len(req.Pubkeys))
+	deletedIndices := make([]int, 0, len(req.Pubkeys))
+	exportedPubKeys := make([][48]byte, 0, len(req.Pubkeys))
+	for i, hexKey := range req.Pubkeys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil || len(pubKey) != 48 {
+			statuses[i] = &keystoreStatus{Status: keystoreError, Message: fmt.Sprintf("Invalid public key %s", hexKey)}
+			continue
+		}
+		key := bytesutil.ToBytes48(pubKey)
+		switch {
+		case existing[key]:
+			delete(existing, key)
+			deletedPubKeys = append(deletedPubKeys, pubKey)
+			stoppedPubKeys = append(stoppedPubKeys, key)
+			deletedIndices = append(deletedIndices, i)
+			exportedPubKeys = append(exportedPubKeys, key)
+			statuses[i] = &keystoreStatus{Status: keystoreDeleted}
+		case protected[key]:
+			exportedPubKeys = append(exportedPubKeys, key)
+			statuses[i] = &keystoreStatus{Status: keystoreNotActive}
+		default:
+			statuses[i] = &keystoreStatus{Status: keystoreNotFound}
+		}
+	}
+	if len(deletedPubKeys) > 0 {
+		// Signing with the deleted keys is stopped before they are exported, so no signature
+		// can be made after their slashing protection is exported.
+		if s.validatorService != nil {
+			if err := s.validatorService.StopSigning(r.Context(), stoppedPubKeys); err != nil {
+				writeKeystoresError(w, http.StatusInternalServerError, fmt.Sprintf("Could not stop signing: %v", err))
+				return
+			}
+		}
+		// Deleting the accounts notifies the validator client, which stops
+		// performing the duties of the deleted keys right away.
+		if err := km.DeleteAccounts(r.Context(), deletedPubKeys); err != nil {
+			if s.validatorService != nil {
+				s.validatorService.ResumeSigning(stoppedPubKeys)
+			}
+			for _, i := range deletedIndices {
+				statuses[i] = &keystoreStatus{Status: keystoreError, Message: err.Error()}
+			}
+		}
+	}
+	interchangeJSON, err := slashingprotection.ExportStandardProtectionJSONForPublicKeys(
+		r.Context(), s.valDB, exportedPubKeys,
+	)
+	if err != nil {
+		writeKeystoresError(w, http.StatusInternalServerError, fmt.Sprintf("Could not export slashing protection: %v", err))
+		return
+	}
+	encodedJSON, err := json.Marshal(interchangeJSON)
+	if err != nil {
+		writeKeystoresError(w, http.StatusInternalServerError, fmt.Sprintf("Could not encode slashing protection: %v", err))
+		return
+	}
+	writeKeystoresJSON(w, &deleteKeystoresResponse{
+		Data:               statuses,
+		SlashingProtection: string(encodedJSON),
+	})
+}
+
+// protectedPublicKeys returns the public keys having slashing protection history.
+func (s *Server) protectedPublicKeys(r *http.Request) (map[[48]byte]bool, error) {
+	proposedPublicKeys, err := s.valDB.ProposedPublicKeys(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	attestedPublicKeys, err := s.valDB.AttestedPublicKeys(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	protected := make(map[[48]byte]bool, len(proposedPublicKeys)+len(attestedPublicKeys))
+	for _, pubKey := range append(proposedPublicKeys, attestedPublicKeys...) {
+		protected[pubKey] = true
+	}
+	return protected, nil
+}
+
+// decryptKeystore decrypts an EIP-2335 keystore, returning its private and public keys.
+func decryptKeystore(encoded, password string) ([]byte, []byte, error) {
+	keystore := &keymanager.Keystore{}
+	if err := json.Unmarshal([]byte(encoded), keystore); err != nil {
+		return nil, nil, fmt.Errorf("not a valid EIP-2335 keystore JSON file: %v", err)
+	}
+	privKeyBytes, err := keystorev4.New().Decrypt(keystore.Crypto, password)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid checksum") {
+			return nil, nil, fmt.Errorf("incorrect password for keystore %s", keystore.Pubkey)
+		}
+		return nil, nil, fmt.Errorf("could not decrypt keystore %s: %v", keystore.Pubkey, err)
+	}
+	privKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("not a valid BLS private key in keystore %s: %v", keystore.Pubkey, err)
+	}
+	return privKeyBytes, privKey.PublicKey().Marshal(), nil
+}
+
+func writeKeystoresJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("Could not write keymanager API response")
+	}
+}
+
+func writeKeystoresError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(&keystoresError{Code: code, Message: message}); err != nil {
+		log.WithError(err).Error("Could not write keymanager API error")
+	}
+}
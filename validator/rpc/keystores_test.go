@@ -0,0 +1,268 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func setupKeystoresServer(t *testing.T) (*Server, *imported.Keymanager, string) {
+	imported.ResetCaches()
+	localWalletDir := setupWalletDir(t)
+	defaultWalletPath = localWalletDir
+	ctx := context.Background()
+	strongPass := "29384283xasjasd32%%&*@*#*"
+	w, err := accounts.CreateWalletWithKeymanager(ctx, &accounts.CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      defaultWalletPath,
+			KeymanagerKind: keymanager.Imported,
+			WalletPassword: strongPass,
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx)
+	require.NoError(t, err)
+	jwtKey, err := createRandomJWTKey()
+	require.NoError(t, err)
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	require.NoError(t, valDB.SaveGenesisValidatorsRoot(ctx, make([]byte, 32)))
+	s := &Server{
+		valDB:                 valDB,
+		keymanager:            km,
+		wallet:                w,
+		walletInitializedFeed: new(event.Feed),
+		jwtKey:                jwtKey,
+	}
+	token, _, err := s.createTokenString()
+	require.NoError(t, err)
+	importedKM, ok := km.(*imported.Keymanager)
+	require.Equal(t, true, ok)
+	return s, importedKM, token
+}
+
+func createKeystore(t *testing.T, password string) (string, bls.SecretKey) {
+	encryptor := keystorev4.New()
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt(privKey.Marshal(), password)
+	require.NoError(t, err)
+	item := &keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Pubkey:  fmt.Sprintf("%x", privKey.PublicKey().Marshal()),
+		Name:    encryptor.Name(),
+	}
+	encodedFile, err := json.MarshalIndent(item, "", "\t")
+	require.NoError(t, err)
+	return string(encodedFile), privKey
+}
+
+func keystoresRequest(t *testing.T, s *Server, token, method string, body interface{}) *httptest.ResponseRecorder {
+	var reqBody bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&reqBody).Encode(body))
+	}
+	req := httptest.NewRequest(method, KeystoresPath, &reqBody)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.KeymanagerAPIHandler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServer_KeymanagerAPI_Unauthorized(t *testing.T) {
+	s, _, _ := setupKeystoresServer(t)
+	rec := keystoresRequest(t, s, "", http.MethodGet, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = keystoresRequest(t, s, "badtoken", http.MethodGet, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestServer_KeymanagerAPI_ImportListDelete(t *testing.T) {
+	s, km, token := setupKeystoresServer(t)
+	ctx := context.Background()
+	password := "password"
+	keystore1, privKey1 := createKeystore(t, password)
+	keystore2, privKey2 := createKeystore(t, password)
+	pubKey1 := privKey1.PublicKey().Marshal()
+	pubKey2 := privKey2.PublicKey().Marshal()
+
+	// Import two keystores, along with a duplicate one and one with a wrong password.
+	rec := keystoresRequest(t, s, token, http.MethodPost, &importKeystoresRequest{
+		Keystores: []string{keystore1, keystore2, keystore1, keystore2},
+		Passwords: []string{password, password, password, "wrong"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	importResp := &importKeystoresResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), importResp))
+	require.Equal(t, 4, len(importResp.Data))
+	assert.Equal(t, keystoreImported, importResp.Data[0].Status)
+	assert.Equal(t, keystoreImported, importResp.Data[1].Status)
+	assert.Equal(t, keystoreDuplicate, importResp.Data[2].Status)
+	assert.Equal(t, keystoreError, importResp.Data[3].Status)
+	assert.Equal(t, true, strings.Contains(importResp.Data[3].Message, "incorrect password"))
+
+	// List the imported keystores.
+	rec = keystoresRequest(t, s, token, http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	listResp := &listKeystoresResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), listResp))
+	require.Equal(t, 2, len(listResp.Data))
+	listed := make(map[string]bool)
+	for _, item := range listResp.Data {
+		assert.Equal(t, false, item.Readonly)
+		listed[item.ValidatingPubkey] = true
+	}
+	assert.Equal(t, true, listed[fmt.Sprintf("%#x", pubKey1)])
+	assert.Equal(t, true, listed[fmt.Sprintf("%#x", pubKey2)])
+
+	// The first key has signed a block.
+	var pubKey1Bytes [48]byte
+	copy(pubKey1Bytes[:], pubKey1)
+	require.NoError(t, s.valDB.SaveProposalHistoryForSlot(ctx, pubKey1Bytes, 3, make([]byte, 32)))
+
+	// Delete the first key and an unknown key.
+	accountsChangedChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(accountsChangedChan)
+	defer sub.Unsubscribe()
+	rec = keystoresRequest(t, s, token, http.MethodDelete, &deleteKeystoresRequest{
+		Pubkeys: []string{fmt.Sprintf("%#x", pubKey1), fmt.Sprintf("%#x", [48]byte{1}), "0xbad"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	deleteResp := &deleteKeystoresResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), deleteResp))
+	require.Equal(t, 3, len(deleteResp.Data))
+	assert.Equal(t, keystoreDeleted, deleteResp.Data[0].Status)
+	assert.Equal(t, keystoreNotFound, deleteResp.Data[1].Status)
+	assert.Equal(t, keystoreError, deleteResp.Data[2].Status)
+
+	// The validator client is notified of the remaining keys.
+	remainingPubKeys := <-accountsChangedChan
+	require.Equal(t, 1, len(remainingPubKeys))
+	assert.DeepEqual(t, pubKey2, remainingPubKeys[0][:])
+
+	// The slashing protection history of the deleted key is returned.
+	interchangeJSON := &interchangeformat.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(deleteResp.SlashingProtection), interchangeJSON))
+	require.Equal(t, 1, len(interchangeJSON.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey1), interchangeJSON.Data[0].Pubkey)
+	require.Equal(t, 1, len(interchangeJSON.Data[0].SignedBlocks))
+	assert.Equal(t, "3", interchangeJSON.Data[0].SignedBlocks[0].Slot)
+
+	// Deleting the key again reports it as not active, still returning its history.
+	rec = keystoresRequest(t, s, token, http.MethodDelete, &deleteKeystoresRequest{
+		Pubkeys: []string{fmt.Sprintf("%#x", pubKey1)},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	deleteResp = &deleteKeystoresResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), deleteResp))
+	require.Equal(t, 1, len(deleteResp.Data))
+	assert.Equal(t, keystoreNotActive, deleteResp.Data[0].Status)
+	interchangeJSON = &interchangeformat.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(deleteResp.SlashingProtection), interchangeJSON))
+	assert.Equal(t, 1, len(interchangeJSON.Data))
+}
+
+func TestServer_KeymanagerAPI_DeleteExportsAttestations(t *testing.T) {
+	s, _, token := setupKeystoresServer(t)
+	ctx := context.Background()
+	password := "password"
+	keystore, privKey := createKeystore(t, password)
+	pubKey := privKey.PublicKey().Marshal()
+	rec := keystoresRequest(t, s, token, http.MethodPost, &importKeystoresRequest{
+		Keystores: []string{keystore},
+		Passwords: []string{password},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// The key has only signed attestations.
+	pubKeyBytes := bytesutil.ToBytes48(pubKey)
+	history := kv.NewAttestationHistoryArray(3)
+	history, err := history.SetTargetData(ctx, 3, &kv.HistoryData{Source: 2, SigningRoot: bytesutil.PadTo([]byte{1}, 32)})
+	require.NoError(t, err)
+	history, err = history.SetLatestEpochWritten(ctx, 3)
+	require.NoError(t, err)
+	require.NoError(t, s.valDB.SaveAttestationHistoryForPubKeysV2(ctx, map[[48]byte]kv.EncHistoryData{pubKeyBytes: history}))
+
+	rec = keystoresRequest(t, s, token, http.MethodDelete, &deleteKeystoresRequest{
+		Pubkeys: []string{fmt.Sprintf("%#x", pubKey)},
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	deleteResp := &deleteKeystoresResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), deleteResp))
+	require.Equal(t, 1, len(deleteResp.Data))
+	assert.Equal(t, keystoreDeleted, deleteResp.Data[0].Status)
+
+	interchangeJSON := &interchangeformat.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(deleteResp.SlashingProtection), interchangeJSON))
+	require.Equal(t, 1, len(interchangeJSON.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey), interchangeJSON.Data[0].Pubkey)
+	require.Equal(t, 1, len(interchangeJSON.Data[0].SignedAttestations))
+	assert.Equal(t, "2", interchangeJSON.Data[0].SignedAttestations[0].SourceEpoch)
+	assert.Equal(t, "3", interchangeJSON.Data[0].SignedAttestations[0].TargetEpoch)
+	assert.Equal(t, fmt.Sprintf("%#x", bytesutil.PadTo([]byte{1}, 32)), interchangeJSON.Data[0].SignedAttestations[0].SigningRoot)
+}
+
+func TestServer_KeymanagerAPI_ImportSlashingProtection(t *testing.T) {
+	s, _, token := setupKeystoresServer(t)
+	ctx := context.Background()
+	password := "password"
+	keystore, privKey := createKeystore(t, password)
+	pubKey := fmt.Sprintf("%#x", privKey.PublicKey().Marshal())
+	interchangeJSON := &interchangeformat.EIPSlashingProtectionFormat{
+		Data: []*interchangeformat.ProtectionData{
+			{
+				Pubkey: pubKey,
+				SignedBlocks: []*interchangeformat.SignedBlock{
+					{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+				},
+			},
+		},
+	}
+	interchangeJSON.Metadata.InterchangeFormatVersion = interchangeformat.INTERCHANGE_FORMAT_VERSION
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{})
+	encoded, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+
+	rec := keystoresRequest(t, s, token, http.MethodPost, &importKeystoresRequest{
+		Keystores:          []string{keystore},
+		Passwords:          []string{password},
+		SlashingProtection: string(encoded),
+	})
+	require.Equal(t, http.StatusOK, rec.Code)
+	proposedPublicKeys, err := s.valDB.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposedPublicKeys))
+	assert.Equal(t, pubKey, fmt.Sprintf("%#x", proposedPublicKeys[0]))
+
+	// Mismatched keystores and passwords are rejected.
+	rec = keystoresRequest(t, s, token, http.MethodPost, &importKeystoresRequest{
+		Keystores: []string{keystore},
+	})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -1,15 +1,52 @@
 package interchangeformat
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/db"
 )
 
 // ExportStandardProtectionJSON extracts all slashing protection data from a validator database
 // and packages it into an EIP-3076 compliant, standard
 func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database) (*EIPSlashingProtectionFormat, error) {
+	// Extract the existing public keys in our database.
+	protectedPublicKeys, err := protectedPublicKeys(ctx, validatorDB)
+	if err != nil {
+		return nil, err
+	}
+	return exportStandardProtectionJSON(ctx, validatorDB, protectedPublicKeys)
+}
+
+// ExportStandardProtectionJSONForPublicKeys extracts the slashing protection data of the
+// specified public keys from a validator database and packages it into an EIP-3076 compliant,
+// standard format. Public keys without any slashing protection data are left out.
+func ExportStandardProtectionJSONForPublicKeys(
+	ctx context.Context, validatorDB db.Database, pubKeys [][48]byte,
+) (*EIPSlashingProtectionFormat, error) {
+	protectedPublicKeys, err := protectedPublicKeys(ctx, validatorDB)
+	if err != nil {
+		return nil, err
+	}
+	protected := make(map[[48]byte]bool, len(protectedPublicKeys))
+	for _, pubKey := range protectedPublicKeys {
+		protected[pubKey] = true
+	}
+	filteredPubKeys := make([][48]byte, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if protected[pubKey] {
+			filteredPubKeys = append(filteredPubKeys, pubKey)
+		}
+	}
+	return exportStandardProtectionJSON(ctx, validatorDB, filteredPubKeys)
+}
+
+func exportStandardProtectionJSON(
+	ctx context.Context, validatorDB db.Database, pubKeys [][48]byte,
+) (*EIPSlashingProtectionFormat, error) {
 	interchangeJSON := &EIPSlashingProtectionFormat{}
 	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
 	if err != nil {
@@ -22,15 +59,10 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisRootHex
 	interchangeJSON.Metadata.InterchangeFormatVersion = INTERCHANGE_FORMAT_VERSION
 
-	// Extract the existing public keys in our database.
-	proposedPublicKeys, err := validatorDB.ProposedPublicKeys(ctx)
-	if err != nil {
-		return nil, err
-	}
 	dataByPubKey := make(map[[48]byte]*ProtectionData)
 
 	// Extract the signed proposals by public keys.
-	for _, pubKey := range proposedPublicKeys {
+	for _, pubKey := range pubKeys {
 		pubKeyHex, err := pubKeyToHexString(pubKey[:])
 		if err != nil {
 			return nil, err
@@ -39,10 +71,14 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 		if err != nil {
 			return nil, err
 		}
+		signedAttestations, err := getSignedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, err
+		}
 		dataByPubKey[pubKey] = &ProtectionData{
 			Pubkey:             pubKeyHex,
 			SignedBlocks:       signedBlocks,
-			SignedAttestations: nil,
+			SignedAttestations: signedAttestations,
 		}
 	}
 
@@ -55,8 +91,35 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 	return interchangeJSON, nil
 }
 
+// protectedPublicKeys returns the public keys with a proposal or an attestation history in the
+// database.
+func protectedPublicKeys(ctx context.Context, validatorDB db.Database) ([][48]byte, error) {
+	proposedPublicKeys, err := validatorDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attestedPublicKeys, err := validatorDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[[48]byte]bool, len(proposedPublicKeys))
+	for _, pubKey := range proposedPublicKeys {
+		seen[pubKey] = true
+	}
+	for _, pubKey := range attestedPublicKeys {
+		if !seen[pubKey] {
+			seen[pubKey] = true
+			proposedPublicKeys = append(proposedPublicKeys, pubKey)
+		}
+	}
+	return proposedPublicKeys, nil
+}
+
+// getSignedBlocksByPubKey returns the proposal history of a public key. If the proposals up to the
+// highest signed proposal slot were pruned from the history, a block at this slot without signing
+// root is added, so importing the blocks still protects the key from signing at lower slots.
 func getSignedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [48]byte) ([]*SignedBlock, error) {
-	lowestSignedSlot, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
 	if err != nil {
 		return nil, err
 	}
@@ -64,25 +127,109 @@ func getSignedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKe
 	if err != nil {
 		return nil, err
 	}
-	signedBlocks := make([]*SignedBlock, 0)
-	for i := lowestSignedSlot; i <= highestSignedSlot; i++ {
-		if ctx.Err() != nil {
-			return nil, ctx.Err()
+	signedBlocks := make([]*SignedBlock, 0, len(proposals)+1)
+	for _, proposal := range proposals {
+		signingRoot := bytesutil.ToBytes32(proposal.SigningRoot)
+		signingRootHex, err := rootToHexString(signingRoot[:])
+		if err != nil {
+			return nil, err
 		}
-		signingRoot, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, i)
+		signedBlocks = append(signedBlocks, &SignedBlock{
+			Slot:        fmt.Sprintf("%d", proposal.Slot),
+			SigningRoot: signingRootHex,
+		})
+	}
+	if highestSignedSlot > 0 && (len(proposals) == 0 || proposals[len(proposals)-1].Slot < highestSignedSlot) {
+		signedBlocks = append(signedBlocks, &SignedBlock{
+			Slot: fmt.Sprintf("%d", highestSignedSlot),
+		})
+	}
+	return signedBlocks, nil
+}
+
+// getSignedAttestationsByPubKey returns the attestation history of a public key, sorted by target
+// epoch. If the highest signed target epoch is higher than the ones in the history, because the
+// history was pruned or not kept in minimal slashing protection mode, the attestation at the highest
+// signed source and target epochs is returned as well. The export only depends on the data in the
+// database, as it may have been written in either slashing protection mode.
+func getSignedAttestationsByPubKey(
+	ctx context.Context, validatorDB db.Database, pubKey [48]byte,
+) ([]*SignedAttestation, error) {
+	histories, err := validatorDB.AttestationHistoryForPubKeysV2(ctx, [][48]byte{pubKey})
+	if err != nil {
+		return nil, err
+	}
+	history, ok := histories[pubKey]
+	if !ok || len(history) == 0 {
+		return getMinimalSignedAttestationsByPubKey(ctx, validatorDB, pubKey)
+	}
+	latestEpochWritten, err := history.GetLatestEpochWritten(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The history only holds the targets of the last weak subjectivity period.
+	var lowestTarget uint64
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if latestEpochWritten >= wsPeriod {
+		lowestTarget = latestEpochWritten - wsPeriod + 1
+	}
+	signedAttestations := make([]*SignedAttestation, 0)
+	for target := lowestTarget; target <= latestEpochWritten; target++ {
+		hd, err := history.GetTargetData(ctx, target)
 		if err != nil {
 			return nil, err
 		}
-		if exists {
-			signingRootHex, err := rootToHexString(signingRoot[:])
+		if hd.IsEmpty() {
+			continue
+		}
+		var signingRootHex string
+		if !bytes.Equal(hd.SigningRoot, params.BeaconConfig().ZeroHash[:]) {
+			signingRootHex, err = rootToHexString(hd.SigningRoot)
 			if err != nil {
 				return nil, err
 			}
-			signedBlocks = append(signedBlocks, &SignedBlock{
-				Slot:        fmt.Sprintf("%d", i),
-				SigningRoot: signingRootHex,
-			})
 		}
+		signedAttestations = append(signedAttestations, &SignedAttestation{
+			SourceEpoch: fmt.Sprintf("%d", hd.Source),
+			TargetEpoch: fmt.Sprintf("%d", target),
+			SigningRoot: signingRootHex,
+		})
 	}
-	return signedBlocks, nil
+	highestTarget, ok, err := validatorDB.HighestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if ok && highestTarget > latestEpochWritten {
+		minimalAttestations, err := getMinimalSignedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		signedAttestations = append(signedAttestations, minimalAttestations...)
+	}
+	return signedAttestations, nil
+}
+
+// getMinimalSignedAttestationsByPubKey returns a single attestation without signing root at the
+// highest signed source and target epochs of a public key, as allowed by EIP-3076 for minimal
+// slashing protection databases.
+func getMinimalSignedAttestationsByPubKey(
+	ctx context.Context, validatorDB db.Database, pubKey [48]byte,
+) ([]*SignedAttestation, error) {
+	highestSource, sourceExists, err := validatorDB.HighestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	highestTarget, targetExists, err := validatorDB.HighestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !sourceExists || !targetExists {
+		return nil, nil
+	}
+	return []*SignedAttestation{
+		{
+			SourceEpoch: fmt.Sprintf("%d", highestSource),
+			TargetEpoch: fmt.Sprintf("%d", highestTarget),
+		},
+	}, nil
 }
@@ -58,3 +58,45 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 		assert.DeepEqual(t, blk, signedBlocks[i])
 	}
 }
+
+func TestExportStandardProtectionJSONForPublicKeys(t *testing.T) {
+	pubKeys := [][48]byte{
+		{1},
+		{2},
+	}
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, make([]byte, 32)))
+	dummyRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 1, dummyRoot[:]))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[1], 2, dummyRoot[:]))
+
+	// Public keys unknown to the database are left out of the export.
+	interchangeJSON, err := ExportStandardProtectionJSONForPublicKeys(ctx, validatorDB, [][48]byte{pubKeys[1], {3}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(interchangeJSON.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKeys[1]), interchangeJSON.Data[0].Pubkey)
+	require.Equal(t, 1, len(interchangeJSON.Data[0].SignedBlocks))
+	assert.Equal(t, "2", interchangeJSON.Data[0].SignedBlocks[0].Slot)
+
+	interchangeJSON, err = ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(interchangeJSON.Data))
+}
+
+func Test_getSignedBlocksByPubKey_Pruned(t *testing.T) {
+	pubKeys := [][48]byte{
+		{1},
+	}
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	dummyRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 1, dummyRoot[:]))
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 5, dummyRoot[:]))
+	require.NoError(t, validatorDB.PruneProposalHistory(ctx, 10))
+
+	// The highest signed proposal slot is exported without signing root once pruned from the history.
+	signedBlocks, err := getSignedBlocksByPubKey(ctx, validatorDB, pubKeys[0])
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*SignedBlock{{Slot: "5"}}, signedBlocks)
+}
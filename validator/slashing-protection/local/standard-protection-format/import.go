@@ -74,6 +74,8 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 	// We save the histories to disk as atomic operations, ensuring that this only occurs
 	// until after we successfully parse all data from the JSON file. If there is any error
 	// in parsing the JSON proposal and attesting histories, we will not reach this point.
+	// The histories are saved along with the highest signed slots and epochs whatever the
+	// slashing protection mode, so the imported data protects the keys in both modes.
 	for pubKey, proposalHistory := range proposalHistoryByPubKey {
 		bar := initializeProgressBar(
 			len(proposalHistory.Proposals),
@@ -92,7 +94,7 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		return errors.Wrap(err, "could not save attesting history from imported JSON to database")
 	}
 
-	return saveLowestSourceTargetToDB(ctx, validatorDB, signedAttsByPubKey)
+	return saveLowestAndHighestSourceTargetToDB(ctx, validatorDB, signedAttsByPubKey)
 }
 
 func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJSON *EIPSlashingProtectionFormat) error {
@@ -253,10 +255,12 @@ func transformSignedAttestations(ctx context.Context, atts []*SignedAttestation)
 	return &attestingHistory, nil
 }
 
-// This saves the lowest source and target epoch from the individual validator to the DB.
-func saveLowestSourceTargetToDB(ctx context.Context, validatorDB db.Database, signedAttsByPubKey map[[48]byte][]*SignedAttestation) error {
-	validatorLowestSourceEpoch := make(map[[48]byte]uint64) // Validator public key to lowest attested source epoch.
-	validatorLowestTargetEpoch := make(map[[48]byte]uint64) // Validator public key to lowest attested target epoch.
+// This saves the lowest and highest source and target epoch from the individual validator to the DB.
+func saveLowestAndHighestSourceTargetToDB(ctx context.Context, validatorDB db.Database, signedAttsByPubKey map[[48]byte][]*SignedAttestation) error {
+	validatorLowestSourceEpoch := make(map[[48]byte]uint64)  // Validator public key to lowest attested source epoch.
+	validatorLowestTargetEpoch := make(map[[48]byte]uint64)  // Validator public key to lowest attested target epoch.
+	validatorHighestSourceEpoch := make(map[[48]byte]uint64) // Validator public key to highest attested source epoch.
+	validatorHighestTargetEpoch := make(map[[48]byte]uint64) // Validator public key to highest attested target epoch.
 	for pubKey, signedAtts := range signedAttsByPubKey {
 		for _, att := range signedAtts {
 			source, err := uint64FromString(att.SourceEpoch)
@@ -279,6 +283,12 @@ func saveLowestSourceTargetToDB(ctx context.Context, validatorDB db.Database, si
 			} else if target < te {
 				validatorLowestTargetEpoch[pubKey] = target
 			}
+			if source > validatorHighestSourceEpoch[pubKey] {
+				validatorHighestSourceEpoch[pubKey] = source
+			}
+			if target > validatorHighestTargetEpoch[pubKey] {
+				validatorHighestTargetEpoch[pubKey] = target
+			}
 		}
 	}
 
@@ -298,5 +308,15 @@ func saveLowestSourceTargetToDB(ctx context.Context, validatorDB db.Database, si
 			return err
 		}
 	}
+
+	// Save highest source and target epoch to DB for every validator in the map.
+	for k := range validatorLowestSourceEpoch {
+		if err := validatorDB.SaveHighestSignedSourceEpoch(ctx, k, validatorHighestSourceEpoch[k]); err != nil {
+			return err
+		}
+		if err := validatorDB.SaveHighestSignedTargetEpoch(ctx, k, validatorHighestTargetEpoch[k]); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -744,7 +744,7 @@ func Test_parseUniqueSignedAttestationsByPubKey(t *testing.T) {
 	}
 }
 
-func Test_saveLowestSourceTargetToDBt_Ok(t *testing.T) {
+func Test_saveLowestAndHighestSourceTargetToDB_Ok(t *testing.T) {
 	ctx := context.Background()
 	numValidators := 2
 	publicKeys := createRandomPubKeys(t, numValidators)
@@ -753,7 +753,7 @@ func Test_saveLowestSourceTargetToDBt_Ok(t *testing.T) {
 	m := make(map[[48]byte][]*SignedAttestation)
 	m[publicKeys[0]] = []*SignedAttestation{{SourceEpoch: "1", TargetEpoch: "2"}, {SourceEpoch: "3", TargetEpoch: "4"}}
 	m[publicKeys[1]] = []*SignedAttestation{{SourceEpoch: "8", TargetEpoch: "7"}, {SourceEpoch: "6", TargetEpoch: "5"}}
-	require.NoError(t, saveLowestSourceTargetToDB(ctx, validatorDB, m))
+	require.NoError(t, saveLowestAndHighestSourceTargetToDB(ctx, validatorDB, m))
 
 	got, err := validatorDB.LowestSignedTargetEpoch(ctx, publicKeys[0])
 	require.NoError(t, err)
@@ -767,6 +767,20 @@ func Test_saveLowestSourceTargetToDBt_Ok(t *testing.T) {
 	got, err = validatorDB.LowestSignedSourceEpoch(ctx, publicKeys[1])
 	require.NoError(t, err)
 	require.Equal(t, uint64(6), got)
+
+	got, exists, err := validatorDB.HighestSignedTargetEpoch(ctx, publicKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	require.Equal(t, uint64(4), got)
+	got, _, err = validatorDB.HighestSignedTargetEpoch(ctx, publicKeys[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), got)
+	got, _, err = validatorDB.HighestSignedSourceEpoch(ctx, publicKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), got)
+	got, _, err = validatorDB.HighestSignedSourceEpoch(ctx, publicKeys[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), got)
 }
 
 func mockSlashingProtectionJSON(
@@ -4,9 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 )
 
@@ -35,10 +40,20 @@ func TestImportExport_RoundTrip(t *testing.T) {
 	eipStandard, err := ExportStandardProtectionJSON(ctx, validatorDB)
 	require.NoError(t, err)
 
-	// TODO(#7813): We have only implemented the export functionality
-	// for proposals history at the moment, so we do not check attesting history.
-	for i := range wanted.Data {
-		wanted.Data[i].SignedAttestations = nil
+	// The empty entries of the attesting histories are not exported, and public keys
+	// without proposals are exported with an empty proposal history.
+	farFutureEpoch := fmt.Sprintf("%d", params.BeaconConfig().FarFutureEpoch)
+	for _, item := range wanted.Data {
+		signedAttestations := make([]*SignedAttestation, 0)
+		for _, att := range item.SignedAttestations {
+			if att.SourceEpoch != farFutureEpoch {
+				signedAttestations = append(signedAttestations, att)
+			}
+		}
+		item.SignedAttestations = signedAttestations
+		if item.SignedBlocks == nil {
+			item.SignedBlocks = make([]*SignedBlock, 0)
+		}
 	}
 
 	// We compare the metadata fields from import to export.
@@ -58,3 +73,100 @@ func TestImportExport_RoundTrip(t *testing.T) {
 		require.DeepEqual(t, want, item)
 	}
 }
+
+func TestImportExport_RoundTrip_PrunedHistory(t *testing.T) {
+	ctx := context.Background()
+	numValidators := 5
+	publicKeys := createRandomPubKeys(t, numValidators)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	attestingHistory, proposalHistory := mockAttestingAndProposalHistories(t, numValidators)
+	wanted := mockSlashingProtectionJSON(t, publicKeys, attestingHistory, proposalHistory)
+	blob, err := json.Marshal(wanted)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+	// The whole history is pruned, as in minimal slashing protection mode.
+	require.NoError(t, validatorDB.PruneAttestationHistory(ctx, math.MaxUint64))
+	require.NoError(t, validatorDB.PruneProposalHistory(ctx, math.MaxUint64))
+
+	eipStandard, err := ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	require.Equal(t, wanted.Metadata, eipStandard.Metadata)
+	require.Equal(t, len(wanted.Data), len(eipStandard.Data))
+	// Only a single block at the highest signed slot and a single attestation at the highest
+	// signed source and target epochs are exported for every public key, without signing roots.
+	dataByPubKey := make(map[string]*ProtectionData)
+	for _, item := range eipStandard.Data {
+		dataByPubKey[item.Pubkey] = item
+	}
+	for _, item := range wanted.Data {
+		var highestSlot, highestSource, highestTarget uint64
+		for _, blk := range item.SignedBlocks {
+			slot, err := uint64FromString(blk.Slot)
+			require.NoError(t, err)
+			if slot > highestSlot {
+				highestSlot = slot
+			}
+		}
+		for _, att := range item.SignedAttestations {
+			source, err := uint64FromString(att.SourceEpoch)
+			require.NoError(t, err)
+			target, err := uint64FromString(att.TargetEpoch)
+			require.NoError(t, err)
+			if source > highestSource {
+				highestSource = source
+			}
+			if target > highestTarget {
+				highestTarget = target
+			}
+		}
+		wantedBlocks := make([]*SignedBlock, 0)
+		if len(item.SignedBlocks) > 0 {
+			wantedBlocks = append(wantedBlocks, &SignedBlock{Slot: fmt.Sprintf("%d", highestSlot)})
+		}
+		got, ok := dataByPubKey[item.Pubkey]
+		require.Equal(t, true, ok)
+		require.DeepEqual(t, wantedBlocks, got.SignedBlocks)
+		require.DeepEqual(t, []*SignedAttestation{{
+			SourceEpoch: fmt.Sprintf("%d", highestSource),
+			TargetEpoch: fmt.Sprintf("%d", highestTarget),
+		}}, got.SignedAttestations)
+	}
+
+	// The exported minimal interchange can be imported again without changing the protection data.
+	blob, err = json.Marshal(eipStandard)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+	require.NoError(t, validatorDB.PruneAttestationHistory(ctx, math.MaxUint64))
+	require.NoError(t, validatorDB.PruneProposalHistory(ctx, math.MaxUint64))
+	reexported, err := ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	for _, item := range reexported.Data {
+		require.DeepEqual(t, dataByPubKey[item.Pubkey], item)
+	}
+}
+
+func TestExport_MinimalSlashingProtection_ExportsHistory(t *testing.T) {
+	reset := featureconfig.InitWithReset(&featureconfig.Flags{MinimalSlashingProtection: true})
+	defer reset()
+	ctx := context.Background()
+	numValidators := 5
+	publicKeys := createRandomPubKeys(t, numValidators)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	// The attestation history was saved before any highest signed epoch was, and is exported
+	// even though minimal slashing protection is enabled.
+	attestingHistory, _ := mockAttestingAndProposalHistories(t, numValidators)
+	historyByPubKey := make(map[[48]byte]kv.EncHistoryData, numValidators)
+	for i, pubKey := range publicKeys {
+		historyByPubKey[pubKey] = attestingHistory[i]
+	}
+	require.NoError(t, validatorDB.SaveAttestationHistoryForPubKeysV2(ctx, historyByPubKey))
+
+	eipStandard, err := ExportStandardProtectionJSONForPublicKeys(ctx, validatorDB, publicKeys)
+	require.NoError(t, err)
+	require.Equal(t, numValidators, len(eipStandard.Data))
+	for _, item := range eipStandard.Data {
+		require.NotEqual(t, 0, len(item.SignedAttestations))
+	}
+}